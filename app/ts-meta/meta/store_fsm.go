@@ -167,6 +167,7 @@ var applyFunc = map[proto2.Command_Type]func(fsm *storeFSM, cmd *proto2.Command)
 	proto2.Command_UpdateMeasurementCommand:         applyUpdateMeasurement,
 	proto2.Command_UpdateNodeTmpIndexCommand:        applyUpdateNodeTmpIndexCommand,
 	proto2.Command_InsertFilesCommand:               applyInsertFilesCommand,
+	proto2.Command_DrainNodeCommand:                 applyDrainNodeCommand,
 }
 
 func applyCreateDatabase(fsm *storeFSM, cmd *proto2.Command) interface{} {
@@ -409,6 +410,10 @@ func applyUpdateNodeTmpIndexCommand(fsm *storeFSM, cmd *proto2.Command) interfac
 	return fsm.applyUpdateNodeTmpIndexCommand(cmd)
 }
 
+func applyDrainNodeCommand(fsm *storeFSM, cmd *proto2.Command) interface{} {
+	return fsm.applyDrainNodeCommand(cmd)
+}
+
 func applyInsertFilesCommand(fsm *storeFSM, cmd *proto2.Command) interface{} {
 	return fsm.applyInsertFilesCommand(cmd)
 }
@@ -833,6 +838,10 @@ func (fsm *storeFSM) applyUpdateMeasurementCommand(cmd *proto2.Command) interfac
 	return meta2.ApplyUpdateMeasurement(fsm.data, cmd)
 }
 
+func (fsm *storeFSM) applyDrainNodeCommand(cmd *proto2.Command) interface{} {
+	return meta2.ApplyDrainNode(fsm.data, cmd)
+}
+
 func (fsm *storeFSM) applyUpdateNodeTmpIndexCommand(cmd *proto2.Command) interface{} {
 	ext, _ := proto.GetExtension(cmd, proto2.E_UpdateNodeTmpIndexCommand_Command)
 	v, ok := ext.(*proto2.UpdateNodeTmpIndexCommand)
@@ -75,6 +75,8 @@ type Server struct {
 	arrowFlightService *arrowflight.Service
 	RecordWriter       *coordinator.RecordWriter
 
+	auditLogger *coordinator2.AuditLogger
+
 	// joinPeers are the metaservers specified at run time to join this server to
 	metaJoinPeers []string
 
@@ -223,6 +225,15 @@ func (s *Server) initQueryExecutor(c *config.TSSql) {
 	metaExecutor.MetaClient = s.MetaClient
 	metaExecutor.SetTimeOut(time.Duration(c.Coordinator.MetaExecutorWriteTimeout))
 
+	if c.AuditLog.Enabled {
+		s.auditLogger = coordinator2.NewAuditLogger(c.AuditLog)
+	}
+
+	var cardinalityCache *coordinator2.CardinalityCache
+	if c.Coordinator.CardinalityCacheInterval > 0 {
+		cardinalityCache = coordinator2.NewCardinalityCache()
+	}
+
 	s.QueryExecutor = query.NewExecutor(cpu.GetCpuNum())
 	s.QueryExecutor.StatementExecutor = &coordinator2.StatementExecutor{
 		MetaClient:  s.MetaClient,
@@ -234,14 +245,43 @@ func (s *Server) initQueryExecutor(c *config.TSSql) {
 			NetStore:   s.TSDBStore,
 			Logger:     s.Logger.With(zap.String("shardMapper", "cluster")),
 		},
-		MetaExecutor:            metaExecutor,
-		MaxQueryMem:             int64(c.Coordinator.MaxQueryMem),
-		QueryTimeCompareEnabled: c.Coordinator.QueryTimeCompareEnabled,
-		RetentionPolicyLimit:    c.Coordinator.RetentionPolicyLimit,
-		StmtExecLogger:          Logger.NewLogger(errno.ModuleQueryEngine).With(zap.String("query", "StatementExecutor")),
-		Hostname:                config.CombineDomain(s.config.HTTP.Domain, s.config.HTTP.BindAddress),
-		SqlConfigs:              c.ShowConfigs(),
-	}
+		MetaExecutor:               metaExecutor,
+		SubscriberManager:          s.SubscriberManager,
+		ContinuousQueryService:     s.cqService,
+		SubscriptionAllowDatabases: c.Coordinator.SubscriptionAllowDatabases,
+		SubscriptionDenyDatabases:  c.Coordinator.SubscriptionDenyDatabases,
+		MaxQueryMem:                int64(c.Coordinator.MaxQueryMem),
+		QueryTimeCompareEnabled:    c.Coordinator.QueryTimeCompareEnabled,
+		RetentionPolicyLimit:       c.Coordinator.RetentionPolicyLimit,
+		QueryRateLimiter:           coordinator2.NewDatabaseQueryLimiter(c.Coordinator.DatabaseQueryRateLimit),
+		MeasurementScanLimiter:     coordinator2.NewMeasurementScanLimiter(c.Coordinator.MeasurementScanLimit),
+		LimitRequiredMeasurements:  c.Coordinator.LimitRequiredMeasurements,
+		QueryEventBus:              s.QueryExecutor.EventBus,
+		AuditLogger:                s.auditLogger,
+		MaxSelectSeriesHardLimit:   c.Coordinator.MaxSelectSeriesHardLimit,
+		MaxSelectSources:           c.Coordinator.MaxSelectSources,
+		MaxShowMeasurements:        c.Coordinator.MaxShowMeasurements,
+		MaxShowSeries:              c.Coordinator.MaxShowSeries,
+		MaxSelectEmitBytes:         int64(c.Coordinator.MaxSelectEmitBytes),
+		SelectIntoAutoCreate:       c.Coordinator.SelectIntoAutoCreate,
+		MaxSelectIntoPoints:        c.Coordinator.MaxSelectIntoPoints,
+		ErrorVerbosity:             c.Coordinator.ErrorVerbosity,
+		NameValidation:             c.Coordinator.NameValidation,
+		LogStatementsAfter:         time.Duration(c.Coordinator.LogStatementsAfter),
+		PrivilegeCacheTTL:          time.Duration(c.Coordinator.PrivilegeCacheTTL),
+		QueryTraceSampleRate:       c.Coordinator.QueryTraceSampleRate,
+		SlowQueryThreshold:         time.Duration(c.Coordinator.SlowQueryThreshold),
+		SlowQueries:                coordinator2.NewSlowQueryLog(c.Coordinator.SlowQueryBufferSize),
+		CardinalityCache:           cardinalityCache,
+		StmtExecLogger:             Logger.NewLogger(errno.ModuleQueryEngine).With(zap.String("query", "StatementExecutor")),
+		Hostname:                   config.CombineDomain(s.config.HTTP.Domain, s.config.HTTP.BindAddress),
+		SqlConfigs:                 c.ShowConfigs(),
+		SqlConfig:                  c,
+		ServerInfo:                 s.info,
+		BuildType:                  s.httpService.Handler.BuildType,
+		StartTime:                  time.Now(),
+	}
+	s.QueryExecutor.StatementExecutor.(*coordinator2.StatementExecutor).NormalizeLimits()
 	s.QueryExecutor.TaskManager.QueryTimeout = time.Duration(c.Coordinator.QueryTimeout)
 	s.QueryExecutor.TaskManager.LogQueriesAfter = time.Duration(c.Coordinator.LogQueriesAfter)
 	s.QueryExecutor.TaskManager.MaxConcurrentQueries = c.Coordinator.MaxConcurrentQueries
@@ -350,9 +390,37 @@ func (s *Server) Open() error {
 		go s.handleCPUThreshold(s.config.HTTP.CPUThreshold, 5*time.Minute)
 	}
 
+	if interval := time.Duration(s.config.Coordinator.CardinalityCacheInterval); interval > 0 {
+		go s.QueryExecutor.StatementExecutor.(*coordinator2.StatementExecutor).RunCardinalityPrecompute(s.ctx, interval)
+	}
+
+	if s.config.Coordinator.WarmupConnections {
+		go s.warmupConnections()
+	}
+
 	return nil
 }
 
+// warmupConnections establishes the connections to the data nodes known at startup, a node which can not be
+// connected does not fail the startup, it is connected by the first query reading it.
+func (s *Server) warmupConnections() {
+	nodes, err := s.MetaClient.DataNodes()
+	if err != nil {
+		s.Logger.Warn("failed to get the data nodes to warm up the connections", zap.Error(err))
+		return
+	}
+	start := time.Now()
+	errs := netstorage.WarmupConnections(nodes)
+	for i := range nodes {
+		if err, ok := errs[nodes[i].ID]; ok {
+			s.Logger.Warn("failed to warm up the connections to data node", zap.Uint64("id", nodes[i].ID),
+				zap.String("host", nodes[i].TCPHost), zap.Error(err))
+		}
+	}
+	s.Logger.Info("connections warmed up", zap.Int("nodes", len(nodes)-len(errs)), zap.Int("failed", len(errs)),
+		zap.Duration("duration", time.Since(start)))
+}
+
 // deprecated handleCPUThreshold
 func (s *Server) handleCPUThreshold(threshold int, duration time.Duration) {
 	ticker := time.NewTicker(duration)
@@ -405,6 +473,10 @@ func (s *Server) Close() error {
 		util.MustClose(s.QueryExecutor)
 	}
 
+	if s.auditLogger != nil {
+		util.MustClose(s.auditLogger)
+	}
+
 	if s.MetaClient != nil {
 		util.MustClose(s.MetaClient)
 	}
@@ -483,6 +555,7 @@ func (s *Server) initStatisticsPusher() {
 	stat.InitRuntimeStatistics(globalTags, int(time.Duration(s.config.Monitor.StoreInterval).Seconds()))
 	stat.NewMetaStatistics().Init(globalTags)
 	stat.InitExecutorStatistics(globalTags)
+	stat.InitStatementStatistics(globalTags)
 	stat.NewErrnoStat().Init(globalTags)
 	stat.NewLogKeeperStatistics().Init(globalTags)
 
@@ -492,6 +565,7 @@ func (s *Server) initStatisticsPusher() {
 		stat.CollectSqlSlowQueryStatistics,
 		stat.CollectRuntimeStatistics,
 		stat.CollectExecutorStatistics,
+		stat.CollectStatementStatistics,
 		stat.NewErrnoStat().Collect,
 		stat.NewLogKeeperStatistics().Collect,
 	)
@@ -501,6 +575,7 @@ func (s *Server) initStatisticsPusher() {
 	s.statisticsPusher.RegisterOps(stat.CollectOpsSqlSlowQueryStatistics)
 	s.statisticsPusher.RegisterOps(stat.CollectOpsRuntimeStatistics)
 	s.statisticsPusher.RegisterOps(stat.CollectExecutorStatisticsOps)
+	s.statisticsPusher.RegisterOps(stat.CollectOpsStatementStatistics)
 	s.statisticsPusher.RegisterOps(stat.NewErrnoStat().CollectOps)
 
 	s.statisticsPusher.Start()
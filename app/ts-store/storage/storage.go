@@ -70,6 +70,8 @@ type StoreEngine interface {
 	GetShardSplitPoints(string, uint32, uint64, []int64) ([]string, error)
 	SeriesCardinality(string, []uint32, []string, influxql.Expr, influxql.TimeRange) ([]meta.MeasurementCardinalityInfo, error)
 	SeriesExactCardinality(string, []uint32, []string, influxql.Expr, influxql.TimeRange) (map[string]uint64, error)
+	DeleteSeries(string, string, []uint32, []string, influxql.Expr, influxql.TimeRange) error
+	DropSeries(string, []uint32, []string, influxql.Expr) error
 	TagKeys(string, []uint32, []string, influxql.Expr, influxql.TimeRange) ([]string, error)
 	SeriesKeys(string, []uint32, []string, influxql.Expr, influxql.TimeRange) ([]string, error)
 	TagValues(string, []uint32, map[string][][]byte, influxql.Expr, influxql.TimeRange) (netstorage.TablesTagSets, error)
@@ -541,6 +543,16 @@ func (s *Storage) SeriesExactCardinality(db string, ptIDs []uint32, measurements
 	return s.engine.SeriesExactCardinality(db, ptIDs, ms, condition, tr)
 }
 
+func (s *Storage) DeleteSeries(db, rp string, ptIDs []uint32, measurements []string, condition influxql.Expr, tr influxql.TimeRange) error {
+	ms := stringSlice2BytesSlice(measurements)
+	return s.engine.DeleteSeries(db, rp, ptIDs, ms, condition, tr)
+}
+
+func (s *Storage) DropSeries(db string, ptIDs []uint32, measurements []string, condition influxql.Expr) error {
+	ms := stringSlice2BytesSlice(measurements)
+	return s.engine.DropSeries(db, ptIDs, ms, condition)
+}
+
 func (s *Storage) GetEngine() netstorage.Engine {
 	return s.engine
 }
@@ -53,6 +53,10 @@ func newHandler(typ uint8) RPCHandler {
 		return &ShowTagKeys{}
 	case netstorage.RaftMessagesRequestMessage:
 		return &RaftMessages{}
+	case netstorage.DeleteSeriesRequestMessage:
+		return &DeleteSeries{}
+	case netstorage.DropSeriesRequestMessage:
+		return &DropSeries{}
 	default:
 		return nil
 	}
@@ -261,3 +265,37 @@ func (h *RaftMessages) SetMessage(msg codec.BinaryCodec) error {
 	h.req = req
 	return nil
 }
+
+type DeleteSeries struct {
+	BaseHandler
+
+	req *netstorage.DeleteSeriesRequest
+	rsp *netstorage.DeleteSeriesResponse
+}
+
+func (h *DeleteSeries) SetMessage(msg codec.BinaryCodec) error {
+	h.rsp = &netstorage.DeleteSeriesResponse{}
+	req, ok := msg.(*netstorage.DeleteSeriesRequest)
+	if !ok {
+		return executor.NewInvalidTypeError("*netstorage.DeleteSeriesRequest", msg)
+	}
+	h.req = req
+	return nil
+}
+
+type DropSeries struct {
+	BaseHandler
+
+	req *netstorage.DropSeriesRequest
+	rsp *netstorage.DropSeriesResponse
+}
+
+func (h *DropSeries) SetMessage(msg codec.BinaryCodec) error {
+	h.rsp = &netstorage.DropSeriesResponse{}
+	req, ok := msg.(*netstorage.DropSeriesRequest)
+	if !ok {
+		return executor.NewInvalidTypeError("*netstorage.DropSeriesRequest", msg)
+	}
+	h.req = req
+	return nil
+}
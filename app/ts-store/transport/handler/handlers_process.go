@@ -77,6 +77,20 @@ func (h *SeriesExactCardinality) Process() (codec.BinaryCodec, error) {
 	return h.rsp, nil
 }
 
+func (h *DeleteSeries) Process() (codec.BinaryCodec, error) {
+	h.rsp.Err = processDDL(h.req.Condition, func(expr influxql.Expr, tr influxql.TimeRange) error {
+		return h.store.DeleteSeries(*h.req.Db, h.req.GetRp(), h.req.PtIDs, h.req.Measurements, expr, tr)
+	})
+	return h.rsp, nil
+}
+
+func (h *DropSeries) Process() (codec.BinaryCodec, error) {
+	h.rsp.Err = processDDL(h.req.Condition, func(expr influxql.Expr, _ influxql.TimeRange) error {
+		return h.store.DropSeries(*h.req.Db, h.req.PtIDs, h.req.Measurements, expr)
+	})
+	return h.rsp, nil
+}
+
 func (h *SeriesKeys) Process() (codec.BinaryCodec, error) {
 	h.rsp.Err = processDDL(h.req.Condition, func(expr influxql.Expr, tr influxql.TimeRange) error {
 		var err error
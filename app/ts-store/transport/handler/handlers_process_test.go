@@ -113,6 +113,25 @@ func TestProcessShowTagValues(t *testing.T) {
 
 type MockEngine struct {
 	netstorage.Engine
+
+	deletedSeries   [][]byte
+	deleteCondition influxql.Expr
+	deleteTimeRange influxql.TimeRange
+	deleteRp        string
+}
+
+func (e *MockEngine) DeleteSeries(_, rp string, _ []uint32, measurements [][]byte, condition influxql.Expr, tr influxql.TimeRange) error {
+	e.deleteRp = rp
+	e.deletedSeries = measurements
+	e.deleteCondition = condition
+	e.deleteTimeRange = tr
+	return nil
+}
+
+func (e *MockEngine) DropSeries(_ string, _ []uint32, measurements [][]byte, condition influxql.Expr) error {
+	e.deletedSeries = measurements
+	e.deleteCondition = condition
+	return nil
 }
 
 func (e *MockEngine) TagKeys(_ string, _ []uint32, _ [][]byte, _ influxql.Expr, _ influxql.TimeRange) ([]string, error) {
@@ -210,6 +229,76 @@ func TestProcessSeriesKeys(t *testing.T) {
 	response.Err = nil
 }
 
+func TestProcessDeleteSeries(t *testing.T) {
+	db := path.Join(dataPath, "db0")
+	condition := "host = 'a' AND time < 1000"
+	rp := "rp0"
+
+	h := newHandler(netstorage.DeleteSeriesRequestMessage)
+	if err := h.SetMessage(&netstorage.DeleteSeriesRequest{
+		SeriesKeysRequest: netstorage.SeriesKeysRequest{
+			SeriesKeysRequest: internal.SeriesKeysRequest{
+				Db:           &db,
+				PtIDs:        []uint32{1},
+				Measurements: []string{"cpu_0000"},
+				Condition:    &condition,
+				Rp:           &rp,
+			},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	engine := &MockEngine{}
+	s := &storage.Storage{}
+	s.SetEngine(engine)
+	h.SetStore(s)
+
+	rsp, _ := h.Process()
+	response, ok := rsp.(*netstorage.DeleteSeriesResponse)
+	if !ok {
+		t.Fatal("response type is invalid")
+	}
+	assert.NoError(t, response.Error())
+	assert.Equal(t, [][]byte{[]byte("cpu_0000")}, engine.deletedSeries)
+	assert.Equal(t, "rp0", engine.deleteRp)
+	assert.Equal(t, "host::tag = 'a'", engine.deleteCondition.String())
+	assert.Equal(t, int64(999), engine.deleteTimeRange.Max.UnixNano())
+}
+
+func TestProcessDropSeries(t *testing.T) {
+	db := path.Join(dataPath, "db0")
+	condition := "host = 'a'"
+
+	h := newHandler(netstorage.DropSeriesRequestMessage)
+	if err := h.SetMessage(&netstorage.DropSeriesRequest{
+		SeriesKeysRequest: netstorage.SeriesKeysRequest{
+			SeriesKeysRequest: internal.SeriesKeysRequest{
+				Db:           &db,
+				PtIDs:        []uint32{1},
+				Measurements: []string{"cpu_0000"},
+				Condition:    &condition,
+			},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	engine := &MockEngine{}
+	s := &storage.Storage{}
+	s.SetEngine(engine)
+	h.SetStore(s)
+
+	rsp, _ := h.Process()
+	response, ok := rsp.(*netstorage.DropSeriesResponse)
+	if !ok {
+		t.Fatal("response type is invalid")
+	}
+	assert.NoError(t, response.Error())
+	assert.Equal(t, [][]byte{[]byte("cpu_0000")}, engine.deletedSeries)
+	assert.Equal(t, "host::tag = 'a'", engine.deleteCondition.String())
+}
+
 func TestProcessRaftMessages(t *testing.T) {
 	h := newHandler(netstorage.RaftMessagesRequestMessage)
 	if err := h.SetMessage(&netstorage.RaftMessagesRequest{
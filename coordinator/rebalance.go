@@ -0,0 +1,86 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"sort"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/hashicorp/serf/serf"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+)
+
+// PtMove is a partition moved from one data node to another to rebalance a database.
+type PtMove struct {
+	PtId uint32
+	From uint64
+	To   uint64
+}
+
+// PlanRebalance computes the partition moves which spread the online pts of a database evenly
+// over the alive and not segregated data nodes.
+// The node owning the most pts repeatedly gives its highest pt to the node owning the fewest,
+// until the pt counts of any two nodes differ by at most one.
+// Pts which are not online or whose owner is not a candidate are left to HA and never moved.
+func PlanRebalance(pts meta2.DBPtInfos, nodes []meta2.DataNode) []PtMove {
+	owned := make(map[uint64][]uint32, len(nodes))
+	for i := range nodes {
+		if nodes[i].Status == serf.StatusAlive && nodes[i].SegregateStatus == meta2.Normal {
+			owned[nodes[i].ID] = nil
+		}
+	}
+	if len(owned) < 2 {
+		return nil
+	}
+
+	for i := range pts {
+		if pts[i].Status != meta2.Online {
+			continue
+		}
+		if ptIds, ok := owned[pts[i].Owner.NodeID]; ok {
+			owned[pts[i].Owner.NodeID] = append(ptIds, pts[i].PtId)
+		}
+	}
+
+	nodeIds := make([]uint64, 0, len(owned))
+	for id, ptIds := range owned {
+		nodeIds = append(nodeIds, id)
+		sort.Slice(ptIds, func(i, j int) bool { return ptIds[i] < ptIds[j] })
+	}
+	sort.Slice(nodeIds, func(i, j int) bool { return nodeIds[i] < nodeIds[j] })
+
+	var moves []PtMove
+	for {
+		most, fewest := nodeIds[0], nodeIds[0]
+		for _, id := range nodeIds[1:] {
+			if len(owned[id]) > len(owned[most]) {
+				most = id
+			}
+			if len(owned[id]) < len(owned[fewest]) {
+				fewest = id
+			}
+		}
+		if len(owned[most])-len(owned[fewest]) <= 1 {
+			return moves
+		}
+
+		from := owned[most]
+		pt := from[len(from)-1]
+		owned[most] = from[:len(from)-1]
+		owned[fewest] = append(owned[fewest], pt)
+		moves = append(moves, PtMove{PtId: pt, From: most, To: fewest})
+	}
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/hashicorp/serf/serf"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/stretchr/testify/assert"
+)
+
+func newRebalanceNodes(ids ...uint64) []meta2.DataNode {
+	nodes := make([]meta2.DataNode, 0, len(ids))
+	for _, id := range ids {
+		nodes = append(nodes, meta2.DataNode{NodeInfo: meta2.NodeInfo{ID: id, Status: serf.StatusAlive}})
+	}
+	return nodes
+}
+
+func newRebalancePts(owners map[uint64][]uint32) meta2.DBPtInfos {
+	var pts meta2.DBPtInfos
+	for nodeId, ptIds := range owners {
+		for _, ptId := range ptIds {
+			pts = append(pts, meta2.PtInfo{PtId: ptId, Owner: meta2.PtOwner{NodeID: nodeId}, Status: meta2.Online})
+		}
+	}
+	return pts
+}
+
+func TestPlanRebalance(t *testing.T) {
+	// node 3 is newly added and owns no pt
+	pts := newRebalancePts(map[uint64][]uint32{1: {0, 1, 2, 3, 4, 5}, 2: {6, 7}})
+	moves := PlanRebalance(pts, newRebalanceNodes(1, 2, 3))
+	assert.Equal(t, []PtMove{
+		{PtId: 5, From: 1, To: 3},
+		{PtId: 4, From: 1, To: 3},
+		{PtId: 3, From: 1, To: 2},
+	}, moves)
+
+	// already balanced
+	pts = newRebalancePts(map[uint64][]uint32{1: {0, 1}, 2: {2}, 3: {3, 4}})
+	assert.Empty(t, PlanRebalance(pts, newRebalanceNodes(1, 2, 3)))
+
+	// a single node has nothing to balance with
+	assert.Empty(t, PlanRebalance(newRebalancePts(map[uint64][]uint32{1: {0, 1, 2}}), newRebalanceNodes(1)))
+}
+
+func TestPlanRebalance_SkipUnavailable(t *testing.T) {
+	pts := newRebalancePts(map[uint64][]uint32{1: {0, 1, 2, 3}, 2: {4}, 3: {5, 6}})
+	nodes := newRebalanceNodes(1, 2, 3, 4)
+	// node 3 is down and node 4 is segregated, neither gives nor receives pts
+	nodes[2].Status = serf.StatusFailed
+	nodes[3].SegregateStatus = meta2.Segregating
+	// pt 3 is being migrated by HA
+	for i := range pts {
+		if pts[i].PtId == 3 {
+			pts[i].Status = meta2.PrepareOffload
+		}
+	}
+
+	moves := PlanRebalance(pts, nodes)
+	assert.Equal(t, []PtMove{{PtId: 2, From: 1, To: 2}}, moves)
+}
@@ -73,6 +73,118 @@ func (csm *ClusterShardMapper) Close() error {
 	return nil
 }
 
+// ShardRoute is the shard, the pt and the node a query of a shard is routed to.
+type ShardRoute struct {
+	RetentionPolicy string
+	ShardGroupID    uint64
+	ShardID         uint64
+	// ReadShardID is the shard read in place of the shard, 0 if the shard is not read
+	ReadShardID uint64
+	PtID        uint32
+	// NodeID is the owner of the pt, 0 if the pt is out of the pt view or the query is split on the read nodes
+	NodeID uint64
+	// ReadNodes are the nodes the queries of a read-write split shard are distributed on
+	ReadNodes []uint64
+}
+
+// ShardRoutes returns the routes of the shards of the database, by retention policy and shard id. The mapper keeps
+// no cache, the routes are those mapShards would compute now at the default availability level: the shards which are
+// not alive are not read, those of a master on a drained node are read from another replica, and the queries of a
+// read-write split shard group are distributed on the read nodes which are not drained.
+func (csm *ClusterShardMapper) ShardRoutes(database string) ([]ShardRoute, error) {
+	dbi, err := csm.MetaClient.Database(database)
+	if err != nil {
+		return nil, err
+	}
+	ptView, err := csm.MetaClient.DBPtView(database)
+	if err != nil {
+		return nil, err
+	}
+	readPts, err := csm.availabilityReadPts(database, influxql.DefaultAvailability)
+	if err != nil {
+		return nil, err
+	}
+
+	var readNodes []uint64
+	var routes []ShardRoute
+	for rpName, rp := range dbi.RetentionPolicies {
+		for i := range rp.ShardGroups {
+			sg := &rp.ShardGroups[i]
+			if sg.Deleted() {
+				continue
+			}
+			aliveShardIdxes := csm.MetaClient.GetAliveShards(database, sg)
+			readShardIdxes := aliveShardIdxes
+			if readPts != nil {
+				readShardIdxes = readPtShards(sg, aliveShardIdxes, readPts)
+			}
+			readShards := make(map[int]int, len(aliveShardIdxes))
+			for j, idx := range aliveShardIdxes {
+				readShards[idx] = readShardIdxes[j]
+			}
+			rwSplit := sg.EngineType == config.COLUMNSTORE && isRWSplit(dbi, rp)
+			if rwSplit && readNodes == nil {
+				if readNodes, err = csm.undrainedReadNodes(); err != nil {
+					return nil, err
+				}
+			}
+
+			for j := range sg.Shards {
+				sh := &sg.Shards[j]
+				if sh.MarkDelete || len(sh.Owners) == 0 {
+					continue
+				}
+				route := ShardRoute{RetentionPolicy: rpName, ShardGroupID: sg.ID, ShardID: sh.ID, PtID: sh.Owners[0]}
+				if idx, ok := readShards[j]; ok && len(sg.Shards[idx].Owners) > 0 {
+					route.ReadShardID = sg.Shards[idx].ID
+					route.PtID = sg.Shards[idx].Owners[0]
+					if rwSplit {
+						route.ReadNodes = readNodes
+					} else if int(route.PtID) < len(ptView) {
+						route.NodeID = ptView[route.PtID].Owner.NodeID
+					}
+				}
+				routes = append(routes, route)
+			}
+		}
+	}
+	sort.Slice(routes, func(i, j int) bool {
+		if routes[i].RetentionPolicy != routes[j].RetentionPolicy {
+			return routes[i].RetentionPolicy < routes[j].RetentionPolicy
+		}
+		return routes[i].ShardID < routes[j].ShardID
+	})
+	return routes, nil
+}
+
+// isRWSplit reports whether the queries of the column store measurements of the retention policy are split on the
+// read nodes, the measurements take the obs options of the database if they have none.
+func isRWSplit(dbi *meta2.DatabaseInfo, rp *meta2.RetentionPolicyInfo) bool {
+	if dbi.Options != nil {
+		return true
+	}
+	for _, m := range rp.Measurements {
+		if m.EngineType == config.COLUMNSTORE && m.ObsOptions != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// undrainedReadNodes returns the ids of the read nodes distShardsToReadNodes distributes the queries on.
+func (csm *ClusterShardMapper) undrainedReadNodes() ([]uint64, error) {
+	nodes, err := csm.MetaClient.AliveReadNodes()
+	if err != nil {
+		return nil, err
+	}
+	nodes = undrainedNodes(nodes)
+	ids := make([]uint64, 0, len(nodes))
+	for i := range nodes {
+		ids = append(ids, nodes[i].ID)
+	}
+	return ids, nil
+}
+
 func (csm *ClusterShardMapper) mapMstShards(s *influxql.Measurement, csming *ClusterShardMapping, tmin, tmax time.Time, condition influxql.Expr, opt *query.SelectOptions) error {
 	source, shardKeyInfo, measurements, engineTypes, err := csm.getTargetShardMsg(s)
 	if err != nil {
@@ -87,10 +199,17 @@ func (csm *ClusterShardMapper) mapMstShards(s *influxql.Measurement, csming *Clu
 		if err != nil {
 			return err
 		}
+		if err = csming.checkTimeout(); err != nil {
+			return err
+		}
 		if len(groups) == 0 {
 			csming.ShardMap[source] = nil
 			return nil
 		}
+		readPts, err := csm.availabilityReadPts(s.Database, opt.Availability)
+		if err != nil {
+			return err
+		}
 		shardInfosByPtID := make(map[uint32][]executor.ShardInfo)
 		//firstSetTimeRange := true
 		for i, g := range groups {
@@ -103,11 +222,14 @@ func (csm *ClusterShardMapper) mapMstShards(s *influxql.Measurement, csming *Clu
 				shardKeyInfo = measurements[0].GetShardKey(groups[i].ID)
 			}
 			aliveShardIdxes := csm.MetaClient.GetAliveShards(s.Database, &groups[i])
+			if readPts != nil {
+				aliveShardIdxes = readPtShards(&groups[i], aliveShardIdxes, readPts)
+			}
 			var shs []meta2.ShardInfo
 			if opt.HintType == hybridqp.FullSeriesQuery || opt.HintType == hybridqp.SpecificSeriesQuery {
 				shs, csming.seriesKey = groups[i].TargetShardsHintQuery(measurements[0], shardKeyInfo, condition, opt, aliveShardIdxes)
 			} else {
-				shs = groups[i].TargetShards(measurements[0], shardKeyInfo, condition, aliveShardIdxes)
+				shs = groups[i].TargetShards(measurements[0], shardKeyInfo, condition, opt, aliveShardIdxes)
 			}
 
 			csm.updateShardInfosByPtID(s, g, shs, &shardInfosByPtID)
@@ -117,6 +239,130 @@ func (csm *ClusterShardMapper) mapMstShards(s *influxql.Measurement, csming *Clu
 	return nil
 }
 
+// availabilityReadPts returns the pt read in place of the master of each replica group of the database at the
+// availability level. It returns nil for the default level without drained node or without replication,
+// the masters are read then. At the default level, a master on a drained node is replaced by another replica.
+func (csm *ClusterShardMapper) availabilityReadPts(database string, level influxql.ReadAvailability) (map[uint32]uint32, error) {
+	if !config.IsReplication() {
+		return nil, nil
+	}
+	drained, err := csm.drainedNodes()
+	if err != nil {
+		return nil, err
+	}
+	if level == influxql.DefaultAvailability && len(drained) == 0 {
+		return nil, nil
+	}
+	ptView, err := csm.MetaClient.DBPtView(database)
+	if err != nil {
+		return nil, err
+	}
+	repGroups := csm.MetaClient.DBRepGroups(database)
+	readPts := make(map[uint32]uint32, len(repGroups))
+	for i := range repGroups {
+		if level == influxql.DefaultAvailability {
+			if !isPtDrained(ptView, drained, repGroups[i].MasterPtID) {
+				continue
+			}
+			if readPt, err := readReplica(database, &repGroups[i], ptView, drained, influxql.AnyAvailability); err == nil {
+				readPts[repGroups[i].MasterPtID] = readPt
+			}
+			continue
+		}
+		readPt, err := readReplica(database, &repGroups[i], ptView, drained, level)
+		if err != nil {
+			return nil, err
+		}
+		readPts[repGroups[i].MasterPtID] = readPt
+	}
+	return readPts, nil
+}
+
+// readReplica returns the replica a replica group is read from, the master if it is readable and a replica on a
+// drained node last. The availability level only requires that many replicas to be readable, the group is still read
+// from this single replica.
+func readReplica(database string, rg *meta2.ReplicaGroup, ptView meta2.DBPtInfos, drained map[uint64]struct{}, level influxql.ReadAvailability) (uint32, error) {
+	isReadable := func(ptID uint32) bool {
+		return int(ptID) < len(ptView) && ptView[ptID].Status == meta2.Online
+	}
+	replicas := make([]uint32, 0, len(rg.Peers)+1)
+	if isReadable(rg.MasterPtID) {
+		replicas = append(replicas, rg.MasterPtID)
+	}
+	for _, peer := range rg.Peers {
+		if peer.PtRole != meta2.Catcher && isReadable(peer.ID) {
+			replicas = append(replicas, peer.ID)
+		}
+	}
+	sort.SliceStable(replicas, func(i, j int) bool {
+		return !isPtDrained(ptView, drained, replicas[i]) && isPtDrained(ptView, drained, replicas[j])
+	})
+
+	replicaN := len(rg.Peers) + 1
+	required := 1
+	switch level {
+	case influxql.QuorumAvailability:
+		required = replicaN/2 + 1
+	case influxql.AllAvailability:
+		required = replicaN
+	}
+	if len(replicas) < required {
+		return 0, errno.NewError(errno.ReadAvailabilityUnreachable, rg.ID, database, len(replicas), level, required)
+	}
+	return replicas[0], nil
+}
+
+// drainedNodes returns the data nodes drained by DRAIN NODE, nil if there is none.
+func (csm *ClusterShardMapper) drainedNodes() (map[uint64]struct{}, error) {
+	nodes, err := csm.MetaClient.DataNodes()
+	if err != nil {
+		return nil, err
+	}
+	var drained map[uint64]struct{}
+	for i := range nodes {
+		if !nodes[i].Drained {
+			continue
+		}
+		if drained == nil {
+			drained = make(map[uint64]struct{})
+		}
+		drained[nodes[i].ID] = struct{}{}
+	}
+	return drained, nil
+}
+
+// isPtDrained reports whether the pt is on a drained node.
+func isPtDrained(ptView meta2.DBPtInfos, drained map[uint64]struct{}, ptID uint32) bool {
+	if int(ptID) >= len(ptView) {
+		return false
+	}
+	_, ok := drained[ptView[ptID].Owner.NodeID]
+	return ok
+}
+
+// readPtShards replaces the shards of the masters by the shards of the same group owned by the pts read in
+// their place, keeping the order of the shards the shard key is routed on.
+func readPtShards(sg *meta2.ShardGroupInfo, aliveShardIdxes []int, readPts map[uint32]uint32) []int {
+	shardIdxByPt := make(map[uint32]int, len(sg.Shards))
+	for i := range sg.Shards {
+		if len(sg.Shards[i].Owners) > 0 {
+			shardIdxByPt[sg.Shards[i].Owners[0]] = i
+		}
+	}
+	shardIdxes := make([]int, 0, len(aliveShardIdxes))
+	for _, idx := range aliveShardIdxes {
+		if owners := sg.Shards[idx].Owners; len(owners) > 0 {
+			if readPt, ok := readPts[owners[0]]; ok {
+				if readIdx, ok := shardIdxByPt[readPt]; ok {
+					idx = readIdx
+				}
+			}
+		}
+		shardIdxes = append(shardIdxes, idx)
+	}
+	return shardIdxes
+}
+
 func (csm *ClusterShardMapper) updateShardInfosByPtID(s *influxql.Measurement, sg meta2.ShardGroupInfo, shs []meta2.ShardInfo,
 	shardInfosByPtID *map[uint32][]executor.ShardInfo) {
 	var ptID uint32
@@ -226,6 +472,8 @@ type ClusterShardMapping struct {
 	// use for spec or full series hint query
 	seriesKey []byte
 	Logger    *logger.Logger
+
+	startTime time.Time
 }
 
 func NewClusterShardMapping(csm *ClusterShardMapper, tmin, tmax time.Time) *ClusterShardMapping {
@@ -239,14 +487,42 @@ func NewClusterShardMapping(csm *ClusterShardMapper, tmin, tmax time.Time) *Clus
 		MinTime:     tmin,
 		MaxTime:     tmax,
 		seriesKey:   make([]byte, 0),
+		startTime:   time.Now(),
 	}
 	return csming
 }
 
+// checkTimeout fails with errno.ShardMapperTimeout once the mapping took longer than Timeout,
+// such as when the meta data is slow to answer.
+func (csm *ClusterShardMapping) checkTimeout() error {
+	if csm.Timeout > 0 && time.Since(csm.startTime) > csm.Timeout {
+		return errno.NewError(errno.ShardMapperTimeout, csm.Timeout)
+	}
+	return nil
+}
+
 func (csm *ClusterShardMapping) GetSeriesKey() []byte {
 	return csm.seriesKey
 }
 
+// ShardIDs returns the ids of the shards mapped for all the sources, sorted in ascending order.
+func (csm *ClusterShardMapping) ShardIDs() []uint64 {
+	seen := make(map[uint64]struct{})
+	for _, ptShards := range csm.ShardMap {
+		for _, shards := range ptShards {
+			for i := range shards {
+				seen[shards[i].ID] = struct{}{}
+			}
+		}
+	}
+	ids := make([]uint64, 0, len(seen))
+	for id := range seen {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
 func (csm *ClusterShardMapping) NodeNumbers() int {
 	nods, _ := csm.MetaClient.DataNodes()
 	if len(nods) == 0 {
@@ -432,6 +708,7 @@ func (csm *ClusterShardMapping) distShardsToReadNodes(src *influxql.Measurement,
 	if err != nil {
 		return nil, nil, err
 	}
+	nodes = undrainedNodes(nodes)
 	// sort ptids
 	pIds := make([]uint32, 0, len(shardInfosByDBPT))
 	for pId := range shardInfosByDBPT {
@@ -462,6 +739,20 @@ func (csm *ClusterShardMapping) distShardsToReadNodes(src *influxql.Measurement,
 	return shardsMapByNode, sourcesMapByPtId, nil
 }
 
+// undrainedNodes returns the nodes which are not drained, or all of them if they are all drained.
+func undrainedNodes(nodes []meta2.DataNode) []meta2.DataNode {
+	undrained := make([]meta2.DataNode, 0, len(nodes))
+	for i := range nodes {
+		if !nodes[i].Drained {
+			undrained = append(undrained, nodes[i])
+		}
+	}
+	if len(undrained) == 0 || len(undrained) == len(nodes) {
+		return nodes
+	}
+	return undrained
+}
+
 func (csm *ClusterShardMapping) GetShardAndSourcesMap(sources influxql.Sources) (map[uint64]map[uint32][]executor.ShardInfo, map[uint32]influxql.Sources, error) {
 	shardsMapByNode := make(map[uint64]map[uint32][]executor.ShardInfo) // {"nodeId": {"ptId": []ShardInfo } }
 	sourcesMapByPtId := make(map[uint32]influxql.Sources)               // {"ptId": influxql.Sources }
@@ -706,8 +997,15 @@ func (csm *ClusterShardMapping) makeRemoteQuery(ctx context.Context, src influxq
 	return rq, nil
 }
 
+// LogicalPlanCost only reports the number of shards mapped for the source of the measurement,
+// the other costs can not be known without accessing the store.
 func (csm *ClusterShardMapping) LogicalPlanCost(m *influxql.Measurement, opt query.ProcessorOptions) (hybridqp.LogicalPlanCost, error) {
-	return hybridqp.LogicalPlanCost{}, nil
+	var cost hybridqp.LogicalPlanCost
+	source := Source{Database: m.Database, RetentionPolicy: m.RetentionPolicy}
+	for _, shards := range csm.ShardMap[source] {
+		cost.NumShards += int64(len(shards))
+	}
+	return cost, nil
 }
 
 // Close clears out the list of mapped shards.
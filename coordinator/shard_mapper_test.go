@@ -40,6 +40,7 @@ import (
 	"github.com/openGemini/openGemini/lib/obs"
 	"github.com/openGemini/openGemini/lib/record"
 	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/sysconfig"
 	"github.com/openGemini/openGemini/lib/util"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
@@ -579,6 +580,383 @@ func TestMapMstShards(t *testing.T) {
 	csm.mapShards(shardMapping2, []influxql.Source{subquery}, timeStart, timeEnd, nil, opt1)
 }
 
+func TestClusterShardMapping_ShardIDs(t *testing.T) {
+	timeStart := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	timeMid := time.Date(2022, 1, 15, 0, 0, 0, 0, time.UTC)
+	timeEnd := time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC)
+	newShards := func(ids ...uint64) []meta.ShardInfo {
+		shards := make([]meta.ShardInfo, 0, len(ids))
+		for i, id := range ids {
+			shards = append(shards, meta.ShardInfo{ID: id, Owners: []uint32{uint32(i)}, Tier: util.Hot, IndexID: 1})
+		}
+		return shards
+	}
+	csm := &ClusterShardMapper{
+		Logger: logger.NewLogger(1),
+	}
+	csm.MetaClient = &mocShardMapperMetaClient{
+		databases: map[string]*meta.DatabaseInfo{
+			"db0": {
+				Name:                   "db0",
+				DefaultRetentionPolicy: "rp0",
+				RetentionPolicies: map[string]*meta.RetentionPolicyInfo{
+					"rp0": {
+						Name: "rp0",
+						Measurements: map[string]*meta.MeasurementInfo{
+							"mst": {
+								Name:       "mst",
+								ShardKeys:  []meta.ShardKeyInfo{{ShardKey: []string{"host"}, Type: "hash", ShardGroup: 1}},
+								EngineType: config.TSSTORE,
+							},
+						},
+						ShardGroups: []meta.ShardGroupInfo{
+							{ID: 1, StartTime: timeStart, EndTime: timeMid, Shards: newShards(1, 2), EngineType: config.TSSTORE},
+							{ID: 2, StartTime: timeMid, EndTime: timeEnd, Shards: newShards(4, 3), EngineType: config.TSSTORE},
+						},
+					},
+				},
+			},
+		},
+	}
+	source := &influxql.Measurement{Database: "db0", RetentionPolicy: "rp0", Name: "mst", EngineType: config.TSSTORE}
+	mapShards := func(tmin, tmax time.Time) []uint64 {
+		shardMapping := &ClusterShardMapping{
+			ShardMap:  map[Source]map[uint32][]executor.ShardInfo{},
+			seriesKey: make([]byte, 0),
+		}
+		require.NoError(t, csm.mapShards(shardMapping, []influxql.Source{source}, tmin, tmax, nil, &query.SelectOptions{}))
+		return shardMapping.ShardIDs()
+	}
+
+	assert.Equal(t, []uint64{1, 2}, mapShards(timeStart, timeMid.Add(-time.Hour)))
+	assert.Equal(t, []uint64{3, 4}, mapShards(timeMid, timeEnd.Add(-time.Hour)))
+	assert.Equal(t, []uint64{1, 2, 3, 4}, mapShards(timeStart, timeEnd))
+	assert.Empty(t, (&ClusterShardMapping{}).ShardIDs())
+}
+
+// slowShardMapperMetaClient answers the shard groups after a delay, as a slow meta node does.
+type slowShardMapperMetaClient struct {
+	*mocShardMapperMetaClient
+	delay time.Duration
+}
+
+func (m *slowShardMapperMetaClient) ShardGroupsByTimeRange(database, policy string, min, max time.Time) ([]meta.ShardGroupInfo, error) {
+	time.Sleep(m.delay)
+	return m.mocShardMapperMetaClient.ShardGroupsByTimeRange(database, policy, min, max)
+}
+
+func TestClusterShardMapper_MapShardsTimeout(t *testing.T) {
+	timeStart := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	timeEnd := time.Date(2022, 1, 15, 0, 0, 0, 0, time.UTC)
+	mc := &slowShardMapperMetaClient{mocShardMapperMetaClient: &mocShardMapperMetaClient{
+		databases: map[string]*meta.DatabaseInfo{
+			"db0": {
+				Name:                   "db0",
+				DefaultRetentionPolicy: "rp0",
+				RetentionPolicies: map[string]*meta.RetentionPolicyInfo{
+					"rp0": {
+						Name: "rp0",
+						Measurements: map[string]*meta.MeasurementInfo{
+							"mst": {
+								Name:       "mst",
+								ShardKeys:  []meta.ShardKeyInfo{{ShardKey: []string{"host"}, Type: "hash", ShardGroup: 1}},
+								EngineType: config.TSSTORE,
+							},
+						},
+						ShardGroups: []meta.ShardGroupInfo{{ID: 1, StartTime: timeStart, EndTime: timeEnd, EngineType: config.TSSTORE,
+							Shards: []meta.ShardInfo{{ID: 1, Owners: []uint32{0}, Tier: util.Hot, IndexID: 1}}}},
+					},
+				},
+			},
+		},
+	}}
+	csm := &ClusterShardMapper{
+		Logger:     logger.NewLogger(1),
+		Timeout:    50 * time.Millisecond,
+		MetaClient: mc,
+	}
+	sources := influxql.Sources{&influxql.Measurement{Database: "db0", RetentionPolicy: "rp0", Name: "mst", EngineType: config.TSSTORE}}
+	tr := influxql.TimeRange{Min: timeStart, Max: timeEnd.Add(-time.Hour)}
+
+	// the meta client answers after the timeout
+	mc.delay = 100 * time.Millisecond
+	_, err := csm.MapShards(sources, tr, query.SelectOptions{}, nil)
+	assert.True(t, errno.Equal(err, errno.ShardMapperTimeout))
+
+	// the meta client answers in time
+	mc.delay = 0
+	sg, err := csm.MapShards(sources, tr, query.SelectOptions{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1}, sg.(*ClusterShardMapping).ShardIDs())
+
+	// no timeout
+	csm.Timeout = 0
+	_, err = csm.MapShards(sources, tr, query.SelectOptions{}, nil)
+	require.NoError(t, err)
+}
+
+func TestMapShards_ForceBroadcastHint(t *testing.T) {
+	timeStart := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	timeEnd := time.Date(2022, 1, 15, 0, 0, 0, 0, time.UTC)
+	shards := []meta.ShardInfo{
+		{ID: 1, Owners: []uint32{0}, Tier: util.Hot, IndexID: 1},
+		{ID: 2, Owners: []uint32{1}, Tier: util.Hot, IndexID: 1},
+		{ID: 3, Owners: []uint32{2}, Tier: util.Hot, IndexID: 1},
+	}
+	csm := &ClusterShardMapper{
+		Logger: logger.NewLogger(1),
+	}
+	csm.MetaClient = &mocShardMapperMetaClient{
+		databases: map[string]*meta.DatabaseInfo{
+			"db0": {
+				Name:                   "db0",
+				DefaultRetentionPolicy: "rp0",
+				RetentionPolicies: map[string]*meta.RetentionPolicyInfo{
+					"rp0": {
+						Name: "rp0",
+						Measurements: map[string]*meta.MeasurementInfo{
+							"mst": {
+								Name:       "mst",
+								ShardKeys:  []meta.ShardKeyInfo{{ShardKey: []string{"host"}, Type: "hash", ShardGroup: 1}},
+								Schema:     map[string]int32{"host": influx.Field_Type_Tag, "value": influx.Field_Type_Float},
+								EngineType: config.TSSTORE,
+							},
+						},
+						ShardGroups: []meta.ShardGroupInfo{
+							{ID: 1, StartTime: timeStart, EndTime: timeEnd, Shards: shards, EngineType: config.TSSTORE},
+						},
+					},
+				},
+			},
+		},
+	}
+	source := &influxql.Measurement{Database: "db0", RetentionPolicy: "rp0", Name: "mst", EngineType: config.TSSTORE}
+	condition := &influxql.BinaryExpr{
+		Op:  influxql.EQ,
+		LHS: &influxql.VarRef{Val: "host"},
+		RHS: &influxql.StringLiteral{Val: "server01"},
+	}
+	mapShards := func(opt *query.SelectOptions) []uint64 {
+		shardMapping := &ClusterShardMapping{
+			ShardMap:  map[Source]map[uint32][]executor.ShardInfo{},
+			seriesKey: make([]byte, 0),
+		}
+		require.NoError(t, csm.mapShards(shardMapping, []influxql.Source{source}, timeStart, timeEnd, condition, opt))
+		return shardMapping.ShardIDs()
+	}
+
+	require.Equal(t, int64(0), sysconfig.GetEnableForceBroadcastQuery())
+	// the condition on the shard key targets a single shard
+	assert.Len(t, mapShards(&query.SelectOptions{}), 1)
+	// the hint broadcasts the query while the config stays off
+	assert.Equal(t, []uint64{1, 2, 3}, mapShards(&query.SelectOptions{ForceBroadcast: true}))
+	assert.Equal(t, int64(0), sysconfig.GetEnableForceBroadcastQuery())
+	assert.Len(t, mapShards(&query.SelectOptions{}), 1)
+}
+
+// replicaShardMapperMetaClient is a topology of two replica groups of three replicas, one shard on each pt.
+type replicaShardMapperMetaClient struct {
+	*mocShardMapperMetaClient
+	ptView    meta.DBPtInfos
+	repGroups []meta.ReplicaGroup
+}
+
+func (m *replicaShardMapperMetaClient) DBPtView(database string) (meta.DBPtInfos, error) {
+	return m.ptView, nil
+}
+
+func (m *replicaShardMapperMetaClient) DBRepGroups(database string) []meta.ReplicaGroup {
+	return m.repGroups
+}
+
+// GetAliveShards returns the shards of the masters, as in replication.
+func (m *replicaShardMapperMetaClient) GetAliveShards(database string, sgi *meta.ShardGroupInfo) []int {
+	var idxes []int
+	for i := range sgi.Shards {
+		if m.repGroups[m.ptView[sgi.Shards[i].Owners[0]].RGID].IsMasterPt(sgi.Shards[i].Owners[0]) {
+			idxes = append(idxes, i)
+		}
+	}
+	return idxes
+}
+
+func newReplicaShardMapperMetaClient(timeStart, timeEnd time.Time) *replicaShardMapperMetaClient {
+	var shards []meta.ShardInfo
+	var ptView meta.DBPtInfos
+	var nodes []meta.DataNode
+	for pt := uint32(0); pt < 6; pt++ {
+		shards = append(shards, meta.ShardInfo{ID: uint64(pt) + 1, Owners: []uint32{pt}, Tier: util.Hot, IndexID: 1})
+		ptView = append(ptView, meta.PtInfo{PtId: pt, Status: meta.Online, RGID: pt / 3, Owner: meta.PtOwner{NodeID: uint64(pt) + 1}})
+		nodes = append(nodes, meta.DataNode{NodeInfo: meta.NodeInfo{ID: uint64(pt) + 1}})
+	}
+	return &replicaShardMapperMetaClient{
+		mocShardMapperMetaClient: &mocShardMapperMetaClient{
+			cacheData: &meta.Data{DataNodes: nodes},
+			databases: map[string]*meta.DatabaseInfo{
+				"db0": {
+					Name:                   "db0",
+					DefaultRetentionPolicy: "rp0",
+					RetentionPolicies: map[string]*meta.RetentionPolicyInfo{
+						"rp0": {
+							Name: "rp0",
+							Measurements: map[string]*meta.MeasurementInfo{
+								"mst": {
+									Name:       "mst",
+									ShardKeys:  []meta.ShardKeyInfo{{ShardKey: []string{"host"}, Type: "hash", ShardGroup: 1}},
+									EngineType: config.TSSTORE,
+								},
+							},
+							ShardGroups: []meta.ShardGroupInfo{
+								{ID: 1, StartTime: timeStart, EndTime: timeEnd, Shards: shards, EngineType: config.TSSTORE},
+							},
+						},
+					},
+				},
+			},
+		},
+		ptView: ptView,
+		repGroups: []meta.ReplicaGroup{
+			{ID: 0, MasterPtID: 0, Peers: []meta.Peer{{ID: 1, PtRole: meta.Slave}, {ID: 2, PtRole: meta.Slave}}},
+			{ID: 1, MasterPtID: 3, Peers: []meta.Peer{{ID: 4, PtRole: meta.Slave}, {ID: 5, PtRole: meta.Catcher}}},
+		},
+	}
+}
+
+func TestReadReplica(t *testing.T) {
+	mc := newReplicaShardMapperMetaClient(time.Time{}, time.Time{})
+	readReplicaOf := func(rg int, level influxql.ReadAvailability) uint32 {
+		readPt, err := readReplica("db0", &mc.repGroups[rg], mc.ptView, nil, level)
+		require.NoError(t, err)
+		return readPt
+	}
+
+	// the levels only require replicas to be readable, the master alone is read
+	assert.Equal(t, uint32(0), readReplicaOf(0, influxql.AnyAvailability))
+	assert.Equal(t, uint32(0), readReplicaOf(0, influxql.QuorumAvailability))
+	assert.Equal(t, uint32(0), readReplicaOf(0, influxql.AllAvailability))
+
+	// a catching up replica can not be read
+	assert.Equal(t, uint32(3), readReplicaOf(1, influxql.QuorumAvailability))
+	_, err := readReplica("db0", &mc.repGroups[1], mc.ptView, nil, influxql.AllAvailability)
+	assert.True(t, errno.Equal(err, errno.ReadAvailabilityUnreachable))
+
+	// an offline master is replaced by a slave
+	mc.ptView[0].Status = meta.Offline
+	assert.Equal(t, uint32(1), readReplicaOf(0, influxql.AnyAvailability))
+	assert.Equal(t, uint32(1), readReplicaOf(0, influxql.QuorumAvailability))
+	_, err = readReplica("db0", &mc.repGroups[0], mc.ptView, nil, influxql.AllAvailability)
+	assert.True(t, errno.Equal(err, errno.ReadAvailabilityUnreachable))
+	mc.ptView[1].Status = meta.Offline
+	_, err = readReplica("db0", &mc.repGroups[0], mc.ptView, nil, influxql.QuorumAvailability)
+	assert.True(t, errno.Equal(err, errno.ReadAvailabilityUnreachable))
+}
+
+func TestMapShards_ReadAvailability(t *testing.T) {
+	require.NoError(t, config.SetHaPolicy(config.RepPolicy))
+	defer func() {
+		_ = config.SetHaPolicy(config.WAFPolicy)
+	}()
+	timeStart := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	timeEnd := time.Date(2022, 1, 15, 0, 0, 0, 0, time.UTC)
+	mc := newReplicaShardMapperMetaClient(timeStart, timeEnd)
+	csm := &ClusterShardMapper{
+		Logger:     logger.NewLogger(1),
+		MetaClient: mc,
+	}
+	source := &influxql.Measurement{Database: "db0", RetentionPolicy: "rp0", Name: "mst", EngineType: config.TSSTORE}
+	mapShards := func(level influxql.ReadAvailability) ([]uint64, error) {
+		shardMapping := &ClusterShardMapping{
+			ShardMap:  map[Source]map[uint32][]executor.ShardInfo{},
+			seriesKey: make([]byte, 0),
+		}
+		err := csm.mapShards(shardMapping, []influxql.Source{source}, timeStart, timeEnd, nil, &query.SelectOptions{Availability: level})
+		return shardMapping.ShardIDs(), err
+	}
+
+	// the masters are read while all the replicas are online
+	for _, level := range []influxql.ReadAvailability{influxql.DefaultAvailability, influxql.AnyAvailability, influxql.QuorumAvailability} {
+		shardIDs, err := mapShards(level)
+		require.NoError(t, err)
+		assert.Equal(t, []uint64{1, 4}, shardIDs, level.String())
+	}
+	// the catching up replica of the second group can not be read
+	_, err := mapShards(influxql.AllAvailability)
+	assert.True(t, errno.Equal(err, errno.ReadAvailabilityUnreachable))
+
+	// once all the replicas are readable, only the shards of the masters are read
+	mc.repGroups[1].Peers[1].PtRole = meta.Slave
+	shardIDs, err := mapShards(influxql.AllAvailability)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1, 4}, shardIDs)
+	mc.repGroups[1].Peers[1].PtRole = meta.Catcher
+
+	// a slave is read in place of the offline master of the first group
+	mc.ptView[0].Status = meta.Offline
+	for _, level := range []influxql.ReadAvailability{influxql.AnyAvailability, influxql.QuorumAvailability} {
+		shardIDs, err := mapShards(level)
+		require.NoError(t, err)
+		assert.Equal(t, []uint64{2, 4}, shardIDs, level.String())
+	}
+	// the default level keeps reading the masters
+	shardIDs, err = mapShards(influxql.DefaultAvailability)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1, 4}, shardIDs)
+
+	// without replication the availability level changes nothing
+	require.NoError(t, config.SetHaPolicy(config.WAFPolicy))
+	shardIDs, err = mapShards(influxql.AllAvailability)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1, 4}, shardIDs)
+}
+
+func TestMapShards_DrainedNode(t *testing.T) {
+	require.NoError(t, config.SetHaPolicy(config.RepPolicy))
+	defer func() {
+		_ = config.SetHaPolicy(config.WAFPolicy)
+	}()
+	timeStart := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	timeEnd := time.Date(2022, 1, 15, 0, 0, 0, 0, time.UTC)
+	mc := newReplicaShardMapperMetaClient(timeStart, timeEnd)
+	nodes := mc.cacheData.DataNodes
+	csm := &ClusterShardMapper{
+		Logger:     logger.NewLogger(1),
+		MetaClient: mc,
+	}
+	source := &influxql.Measurement{Database: "db0", RetentionPolicy: "rp0", Name: "mst", EngineType: config.TSSTORE}
+	mapShards := func(level influxql.ReadAvailability) []uint64 {
+		shardMapping := &ClusterShardMapping{
+			ShardMap:  map[Source]map[uint32][]executor.ShardInfo{},
+			seriesKey: make([]byte, 0),
+		}
+		require.NoError(t, csm.mapShards(shardMapping, []influxql.Source{source}, timeStart, timeEnd, nil, &query.SelectOptions{Availability: level}))
+		return shardMapping.ShardIDs()
+	}
+
+	// the master of the first group is on the drained node 1, a slave is read in its place
+	nodes[0].Drained = true
+	assert.Equal(t, []uint64{2, 4}, mapShards(influxql.DefaultAvailability))
+	assert.Equal(t, []uint64{2, 4}, mapShards(influxql.QuorumAvailability))
+
+	// all the replicas of the first group but one are drained
+	nodes[1].Drained = true
+	assert.Equal(t, []uint64{3, 4}, mapShards(influxql.AnyAvailability))
+
+	nodes[0].Drained = false
+	nodes[1].Drained = false
+	assert.Equal(t, []uint64{1, 4}, mapShards(influxql.DefaultAvailability))
+}
+
+func TestUndrainedNodes(t *testing.T) {
+	nodes := []meta.DataNode{{NodeInfo: meta.NodeInfo{ID: 1}}, {NodeInfo: meta.NodeInfo{ID: 2}}}
+	assert.Equal(t, nodes, undrainedNodes(nodes))
+
+	// the new queries avoid the drained read node
+	nodes[1].Drained = true
+	assert.Equal(t, nodes[:1], undrainedNodes(nodes))
+	// the drained node is used if all the nodes are drained
+	assert.Equal(t, nodes[1:], undrainedNodes(nodes[1:]))
+}
+
 func TestShardMapperExprRewriter(t *testing.T) {
 	fields := make(map[string]*influxql.FieldNameSpace)
 	fields["mst.f1"] = &influxql.FieldNameSpace{
@@ -1452,3 +1830,65 @@ func Test_MapTypeBatchBinOp(t *testing.T) {
 		t.Fatal("Test_MapTypeBatchBinOp err")
 	}
 }
+
+func TestClusterShardMapping_LogicalPlanCost(t *testing.T) {
+	shardMapping := &ClusterShardMapping{
+		ShardMap: map[Source]map[uint32][]executor.ShardInfo{
+			{Database: "db0", RetentionPolicy: "rp0"}: {
+				0: {{ID: 1}, {ID: 2}},
+				1: {{ID: 3}},
+			},
+		},
+	}
+
+	cost, err := shardMapping.LogicalPlanCost(&influxql.Measurement{Database: "db0", RetentionPolicy: "rp0", Name: "mst"}, query.ProcessorOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), cost.NumShards)
+
+	cost, err = shardMapping.LogicalPlanCost(&influxql.Measurement{Database: "db0", RetentionPolicy: "rp1", Name: "mst"}, query.ProcessorOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), cost.NumShards)
+}
+
+func TestShardRoutes(t *testing.T) {
+	require.NoError(t, config.SetHaPolicy(config.RepPolicy))
+	defer func() {
+		_ = config.SetHaPolicy(config.WAFPolicy)
+	}()
+	mc := newReplicaShardMapperMetaClient(time.Time{}, time.Time{})
+	rp := mc.databases["db0"].RetentionPolicies["rp0"]
+	rp.ShardGroups[0].Shards[5].MarkDelete = true
+	rp.ShardGroups = append(rp.ShardGroups, meta.ShardGroupInfo{ID: 2, DeletedAt: time.Now(), Shards: []meta.ShardInfo{{ID: 7, Owners: []uint32{0}}}})
+	mc.databases["db0"].RetentionPolicies["rp1"] = &meta.RetentionPolicyInfo{
+		Name:         "rp1",
+		Measurements: map[string]*meta.MeasurementInfo{"mst": {Name: "mst", EngineType: config.COLUMNSTORE, ObsOptions: &obs.ObsOptions{Enabled: true}}},
+		ShardGroups:  []meta.ShardGroupInfo{{ID: 3, EngineType: config.COLUMNSTORE, Shards: []meta.ShardInfo{{ID: 9, Owners: []uint32{3}}, {ID: 8, Owners: []uint32{4}}}}},
+	}
+	nodes := mc.cacheData.DataNodes
+	// the master of the first group is on the drained node 1, pt 3 moved to node 2
+	nodes[0].Drained = true
+	mc.ptView[3].Owner.NodeID = 2
+	// the read-write split queries avoid the drained read node 6
+	nodes[4].Role = meta.NodeReader
+	nodes[5].Role = meta.NodeReader
+	nodes[5].Drained = true
+	csm := &ClusterShardMapper{MetaClient: mc, Logger: logger.NewLogger(errno.ModuleUnknown)}
+
+	routes, err := csm.ShardRoutes("db0")
+	require.NoError(t, err)
+	assert.Equal(t, []ShardRoute{
+		{RetentionPolicy: "rp0", ShardGroupID: 1, ShardID: 1, ReadShardID: 2, PtID: 1, NodeID: 2},
+		{RetentionPolicy: "rp0", ShardGroupID: 1, ShardID: 2, PtID: 1},
+		{RetentionPolicy: "rp0", ShardGroupID: 1, ShardID: 3, PtID: 2},
+		{RetentionPolicy: "rp0", ShardGroupID: 1, ShardID: 4, ReadShardID: 4, PtID: 3, NodeID: 2},
+		{RetentionPolicy: "rp0", ShardGroupID: 1, ShardID: 5, PtID: 4},
+		{RetentionPolicy: "rp1", ShardGroupID: 3, ShardID: 8, PtID: 4},
+		{RetentionPolicy: "rp1", ShardGroupID: 3, ShardID: 9, ReadShardID: 9, PtID: 3, ReadNodes: []uint64{5}},
+	}, routes)
+
+	// the master is read again once its node is not drained
+	nodes[0].Drained = false
+	routes, err = csm.ShardRoutes("db0")
+	require.NoError(t, err)
+	assert.Equal(t, ShardRoute{RetentionPolicy: "rp0", ShardGroupID: 1, ShardID: 1, ReadShardID: 1, PtID: 0, NodeID: 1}, routes[0])
+}
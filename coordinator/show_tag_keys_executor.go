@@ -102,6 +102,7 @@ func (e *ShowTagKeysExecutor) Execute(stmt *influxql.ShowTagKeysStatement) (nets
 		sort.Strings(tk.Keys)
 		tagKeys = append(tagKeys, *tk)
 	}
+	sort.Stable(tagKeys)
 
 	if len(tagKeys) == 0 {
 		return nil, nil
@@ -116,6 +116,16 @@ func (e *ShowTagValuesExecutor) emitCardinality(tagValues TagValuesSlice) (model
 			continue
 		}
 
+		keyCounts := e.countByKey(values)
+		if len(keyCounts) > 1 {
+			rows = append(rows, &models.Row{
+				Name:    m.Name,
+				Columns: []string{"key", "count"},
+				Values:  keyCounts,
+			})
+			continue
+		}
+
 		rows = append(rows, &models.Row{
 			Name:    m.Name,
 			Columns: []string{"count"},
@@ -128,6 +138,20 @@ func (e *ShowTagValuesExecutor) emitCardinality(tagValues TagValuesSlice) (model
 	return rows, nil
 }
 
+// countByKey counts the distinct values of each tag key, the values must be deduplicated and sorted by key
+func (e *ShowTagValuesExecutor) countByKey(values netstorage.TagSets) [][]interface{} {
+	var keyCounts [][]interface{}
+	for i := 0; i < len(values); {
+		j := i + 1
+		for j < len(values) && values[j].Key == values[i].Key {
+			j++
+		}
+		keyCounts = append(keyCounts, []interface{}{values[i].Key, j - i})
+		i = j
+	}
+	return keyCounts
+}
+
 func (e *ShowTagValuesExecutor) applyLimit(offset, limit, orderBy int, values netstorage.TagSets) netstorage.TagSets {
 	size := len(values)
 	if offset >= size {
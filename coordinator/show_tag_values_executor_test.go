@@ -256,6 +256,28 @@ func TestShowTagValuesExecutorCardinality(t *testing.T) {
 	}
 }
 
+func TestShowTagValuesExecutorCardinalityPerKey(t *testing.T) {
+	e := NewShowTagValuesExecutor(logger.NewLogger(errno.ModuleUnknown),
+		&mockMC{}, &mockME{}, &mockNS{})
+	e.Cardinality(influxql.Dimensions{})
+	rows, err := e.Execute(&influxql.ShowTagValuesStatement{
+		Database: "db_two_keys",
+		Sources:  append(influxql.Sources{}, &influxql.Measurement{}),
+		Op:       influxql.IN,
+		TagKeyExpr: &influxql.ListLiteral{
+			Vals: []string{"author", "host"},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, models.Rows{
+		&models.Row{
+			Name:    "mst",
+			Columns: []string{"key", "count"},
+			Values:  [][]interface{}{{"author", 3}, {"host", 2}},
+		},
+	}, rows)
+}
+
 func TestApplyLimit(t *testing.T) {
 	e := &ShowTagValuesExecutor{}
 
@@ -379,6 +401,19 @@ func (m *mockNS) ShowTagKeys(nodeID uint64, db string, ptId []uint32, measuremen
 }
 
 func (m *mockNS) TagValues(nodeID uint64, db string, ptIDs []uint32, tagKeys map[string]map[string]struct{}, cond influxql.Expr, limit int, disorder bool) (netstorage.TablesTagSets, error) {
+	if db == "db_two_keys" {
+		return append(netstorage.TablesTagSets{}, netstorage.TableTagSets{
+			Name: "mst",
+			Values: netstorage.TagSets{
+				{Key: "author", Value: "mao"},
+				{Key: "host", Value: "server01"},
+				{Key: "author", Value: "tai"},
+				{Key: "author", Value: "san"},
+				{Key: "host", Value: "server02"},
+			},
+		}), nil
+	}
+
 	if nodeID == 1 {
 		return append(netstorage.TablesTagSets{}, netstorage.TableTagSets{
 			Name: "mst",
@@ -23,10 +23,12 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/influxdata/influxdb/models"
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/crypto"
 	"github.com/openGemini/openGemini/lib/logger"
@@ -114,11 +116,55 @@ func NewHTTPSClient(url *url.URL, timeout time.Duration, skipVerify bool, certs
 type WriteRequest struct {
 	Client       int
 	LineProtocol []byte
+	enqueueTime  time.Time
+}
+
+// pendingRequests records the write requests of a destination which are not delivered yet
+type pendingRequests struct {
+	mu       sync.Mutex
+	requests map[*WriteRequest]struct{}
+}
+
+func (p *pendingRequests) add(wr *WriteRequest) {
+	p.mu.Lock()
+	p.requests[wr] = struct{}{}
+	p.mu.Unlock()
+}
+
+func (p *pendingRequests) remove(wr *WriteRequest) {
+	p.mu.Lock()
+	delete(p.requests, wr)
+	p.mu.Unlock()
+}
+
+// stat returns the number of pending requests and the age of the oldest one
+func (p *pendingRequests) stat(now time.Time) (int, time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var oldest time.Time
+	for wr := range p.requests {
+		if oldest.IsZero() || wr.enqueueTime.Before(oldest) {
+			oldest = wr.enqueueTime
+		}
+	}
+	if oldest.IsZero() {
+		return 0, 0
+	}
+	return len(p.requests), now.Sub(oldest)
+}
+
+// DestinationStat is the delivery state of a subscription destination
+type DestinationStat struct {
+	Destination      string
+	QueueDepth       int
+	OldestPendingAge time.Duration
 }
 
 type BaseWriter struct {
 	ch      chan *WriteRequest
 	clients []Client
+	pending []*pendingRequests
 	db      string
 	rp      string
 	name    string
@@ -126,13 +172,20 @@ type BaseWriter struct {
 }
 
 func NewBaseWriter(db, rp, name string, clients []Client, logger *logger.Logger) BaseWriter {
-	return BaseWriter{db: db, rp: rp, name: name, clients: clients, logger: logger}
+	pending := make([]*pendingRequests, len(clients))
+	for i := range pending {
+		pending[i] = &pendingRequests{requests: make(map[*WriteRequest]struct{})}
+	}
+	return BaseWriter{db: db, rp: rp, name: name, clients: clients, pending: pending, logger: logger}
 }
 
 func (w *BaseWriter) Send(wr *WriteRequest) {
+	wr.enqueueTime = time.Now()
+	w.pending[wr.Client].add(wr)
 	select {
 	case w.ch <- wr:
 	default:
+		w.pending[wr.Client].remove(wr)
 		w.logger.Error("failed to send write request to write buffer", zap.String("dest", w.clients[wr.Client].Destination()),
 			zap.String("db", w.db), zap.String("rp", w.rp))
 	}
@@ -141,6 +194,7 @@ func (w *BaseWriter) Send(wr *WriteRequest) {
 func (w *BaseWriter) Run() {
 	for wr := range w.ch {
 		err := w.clients[wr.Client].Send(w.db, w.rp, wr.LineProtocol)
+		w.pending[wr.Client].remove(wr)
 		if err != nil {
 			w.logger.Error("failed to forward write request", zap.String("dest", w.clients[wr.Client].Destination()),
 				zap.String("db", w.db), zap.String("rp", w.rp), zap.Error(err))
@@ -148,6 +202,17 @@ func (w *BaseWriter) Run() {
 	}
 }
 
+// Stats returns the delivery state of each destination
+func (w *BaseWriter) Stats() []DestinationStat {
+	now := time.Now()
+	stats := make([]DestinationStat, len(w.clients))
+	for i, c := range w.clients {
+		stats[i].Destination = c.Destination()
+		stats[i].QueueDepth, stats[i].OldestPendingAge = w.pending[i].stat(now)
+	}
+	return stats
+}
+
 func (w *BaseWriter) Name() string {
 	return w.name
 }
@@ -174,6 +239,7 @@ type SubscriberWriter interface {
 	Start(concurrency, buffersize int)
 	Stop()
 	Clients() []Client
+	Stats() []DestinationStat
 }
 
 type AllWriter struct {
@@ -182,7 +248,7 @@ type AllWriter struct {
 
 func (w *AllWriter) Write(lineProtocol []byte) {
 	for i := 0; i < len(w.clients); i++ {
-		wr := &WriteRequest{i, lineProtocol}
+		wr := &WriteRequest{Client: i, LineProtocol: lineProtocol}
 		w.Send(wr)
 	}
 }
@@ -365,6 +431,38 @@ func (s *SubscriberManager) Send(db, rp string, lineProtocol []byte) {
 	}
 }
 
+// ShowSubscriptionsDetail returns the delivery lag of each subscription destination
+func (s *SubscriberManager) ShowSubscriptionsDetail() models.Rows {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	var rows models.Rows
+	for db, rps := range s.writers {
+		row := &models.Row{Columns: []string{"retention_policy", "name", "destination", "queue_depth", "oldest_pending_age"}, Name: db}
+		for rp, writers := range rps {
+			for _, w := range writers {
+				for _, stat := range w.Stats() {
+					row.Values = append(row.Values, []interface{}{rp, w.Name(), stat.Destination, stat.QueueDepth, stat.OldestPendingAge.String()})
+				}
+			}
+		}
+		if len(row.Values) == 0 {
+			continue
+		}
+		sort.SliceStable(row.Values, func(i, j int) bool {
+			if row.Values[i][0] != row.Values[j][0] {
+				return row.Values[i][0].(string) < row.Values[j][0].(string)
+			}
+			return row.Values[i][1].(string) < row.Values[j][1].(string)
+		})
+		rows = append(rows, row)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].Name < rows[j].Name
+	})
+	return rows
+}
+
 func (s *SubscriberManager) StopAllWriters() {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
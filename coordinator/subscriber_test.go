@@ -98,6 +98,42 @@ func TestAnyWriter(t *testing.T) {
 	close(ch)
 }
 
+func TestShowSubscriptionsDetail(t *testing.T) {
+	clients := []Client{&MockSubscriberClient{"http://127.0.0.1:8086"}, &MockSubscriberClient{"http://127.0.0.1:8087"}}
+	w := &AllWriter{NewBaseWriter("db0", "rp0", "sub0", clients, logger.NewLogger(errno.ModuleCoordinator))}
+	// no goroutine consumes the write buffer, so the writer is backed up
+	w.ch = make(chan *WriteRequest, 10)
+
+	s := NewSubscriberManager(config.NewSubscriber(), &MockSubscriberMetaClient{}, logger.NewLogger(errno.ModuleCoordinator))
+	s.writers["db0"] = map[string][]SubscriberWriter{"rp0": {w}}
+
+	line := []byte("cpu_load,host=server-01 value=75.31")
+	w.Write(line)
+	w.Write(line)
+	time.Sleep(10 * time.Millisecond)
+
+	rows := s.ShowSubscriptionsDetail()
+	assert2.Equal(t, 1, len(rows))
+	assert2.Equal(t, "db0", rows[0].Name)
+	assert2.Equal(t, []string{"retention_policy", "name", "destination", "queue_depth", "oldest_pending_age"}, rows[0].Columns)
+	assert2.Equal(t, 2, len(rows[0].Values))
+	for i, value := range rows[0].Values {
+		assert2.Equal(t, clients[i].Destination(), value[2])
+		assert2.Equal(t, 2, value[3])
+		age, err := time.ParseDuration(value[4].(string))
+		assert2.NoError(t, err)
+		assert2.True(t, age >= 10*time.Millisecond)
+	}
+
+	// the lag disappears once the requests are delivered
+	close(w.ch)
+	w.Run()
+	for _, stat := range w.Stats() {
+		assert2.Equal(t, 0, stat.QueueDepth)
+		assert2.Equal(t, time.Duration(0), stat.OldestPendingAge)
+	}
+}
+
 func JudgeSame(dbis map[string]*meta.DatabaseInfo, writers map[string]map[string][]SubscriberWriter) error {
 	for _, dbi := range dbis {
 		for _, rpi := range dbi.RetentionPolicies {
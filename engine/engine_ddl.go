@@ -231,8 +231,47 @@ func (e *Engine) DropMeasurement(db string, rp string, name string, shardIds []u
 	return nil
 }
 
-func (e *Engine) DropSeries(database string, sources []influxql.Source, ptId []uint32, condition influxql.Expr) (int, error) {
-	panic("implement me")
+func (e *Engine) DeleteSeries(db, rp string, ptIDs []uint32, measurements [][]byte, condition influxql.Expr, tr influxql.TimeRange) error {
+	return e.deleteSeries(db, ptIDs, func(pt *DBPTInfo) error {
+		return pt.deleteSeries(rp, measurements, condition, tr)
+	})
+}
+
+func (e *Engine) DropSeries(db string, ptIDs []uint32, measurements [][]byte, condition influxql.Expr) error {
+	return e.deleteSeries(db, ptIDs, func(pt *DBPTInfo) error {
+		return pt.dropSeries(measurements, condition)
+	})
+}
+
+// deleteSeries calls del on each partition of the db in ptIDs, holding a reference to the partitions.
+func (e *Engine) deleteSeries(db string, ptIDs []uint32, del func(pt *DBPTInfo) error) error {
+	e.mu.RLock()
+	var err error
+	if ptIDs, err = e.checkAndAddRefPTSNoLock(db, ptIDs); err != nil {
+		e.mu.RUnlock()
+		return err
+	}
+	defer e.unrefDBPTs(db, ptIDs)
+	pts, ok := e.DBPartitions[db]
+	e.mu.RUnlock()
+	if !ok {
+		return nil
+	}
+
+	for i := range ptIDs {
+		pt, ok := pts[ptIDs[i]]
+		if !ok {
+			continue
+		}
+		pt.mu.RLock()
+		err = del(pt)
+		pt.mu.RUnlock()
+		if err != nil {
+			e.log.Error("delete series fail", zap.String("db", db), zap.Uint32("pt", ptIDs[i]), zap.Error(err))
+			return err
+		}
+	}
+	return nil
 }
 
 func (e *Engine) TagKeys(db string, ptIDs []uint32, measurements [][]byte, condition influxql.Expr, tr influxql.TimeRange) ([]string, error) {
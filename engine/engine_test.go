@@ -877,6 +877,110 @@ func TestEngine_SeriesCardinality(t *testing.T) {
 	assert(err == nil, "no error expected")
 }
 
+func TestEngine_DeleteSeries(t *testing.T) {
+	dir := t.TempDir()
+	eng, err := initEngine1(dir, config.TSSTORE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer eng.Close()
+
+	msNames := []string{"cpu"}
+	tm := time.Now().Truncate(time.Second)
+	rows, _, _ := GenDataRecord(msNames, 10, 200, time.Second, tm, false, true, false)
+	if err := eng.WriteRows("db0", "rp0", 0, 1, rows, nil); err != nil {
+		t.Fatal(err)
+	}
+	dbInfo := eng.DBPartitions["db0"][0]
+	dbInfo.indexBuilder[659].GetPrimaryIndex().(*tsi.MergeSetIndex).DebugFlush()
+
+	allTime := influxql.TimeRange{Min: time.Unix(0, influxql.MinTime).UTC(), Max: time.Unix(0, influxql.MaxTime).UTC()}
+	cardinality := func() uint64 {
+		ret, err := eng.SeriesExactCardinality("db0", []uint32{0}, [][]byte{[]byte(msNames[0])}, nil, allTime)
+		require.NoError(t, err)
+		return ret[msNames[0]]
+	}
+	require.Equal(t, uint64(10), cardinality())
+
+	// pt not found
+	err = eng.DeleteSeries("db0", "rp0", []uint32{0xff}, [][]byte{[]byte(msNames[0])}, nil, allTime)
+	require.True(t, errno.Equal(err, errno.PtNotFound))
+
+	// an index partly covered by the time range fails the deletion
+	indexTime := dbInfo.indexBuilder[659].Ident().Index.TimeRange
+	err = eng.DeleteSeries("db0", "rp0", []uint32{0}, [][]byte{[]byte(msNames[0])}, nil, influxql.TimeRange{Min: indexTime.StartTime.Add(time.Hour), Max: allTime.Max})
+	require.True(t, errno.Equal(err, errno.ErrDeletePartialIndex))
+	require.Equal(t, uint64(10), cardinality())
+
+	// the indexes of other retention policies and out of the time range are kept
+	err = eng.DeleteSeries("db0", "rp1", []uint32{0}, [][]byte{[]byte(msNames[0])}, nil, allTime)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), cardinality())
+	err = eng.DeleteSeries("db0", "rp0", []uint32{0}, [][]byte{[]byte(msNames[0])}, nil, influxql.TimeRange{Min: allTime.Min, Max: indexTime.StartTime.Add(-time.Hour)})
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), cardinality())
+
+	// only the series matching the condition are deleted
+	condition := influxql.MustParseExpr(`tagkey1='tagvalue1_1'`)
+	influxql.WalkFunc(condition, func(node influxql.Node) {
+		if ref, ok := node.(*influxql.VarRef); ok {
+			ref.Type = influxql.Tag
+		}
+	})
+	err = eng.DeleteSeries("db0", "rp0", []uint32{0}, [][]byte{[]byte(msNames[0])}, condition, allTime)
+	require.NoError(t, err)
+	require.Equal(t, uint64(9), cardinality())
+
+	err = eng.DeleteSeries("db0", "rp0", []uint32{0}, [][]byte{[]byte(msNames[0])}, nil, allTime)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), cardinality())
+}
+
+func TestEngine_DropSeries(t *testing.T) {
+	dir := t.TempDir()
+	eng, err := initEngine1(dir, config.TSSTORE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer eng.Close()
+
+	msNames := []string{"cpu"}
+	tm := time.Now().Truncate(time.Second)
+	rows, _, _ := GenDataRecord(msNames, 10, 200, time.Second, tm, false, true, false)
+	if err := eng.WriteRows("db0", "rp0", 0, 1, rows, nil); err != nil {
+		t.Fatal(err)
+	}
+	dbInfo := eng.DBPartitions["db0"][0]
+	dbInfo.indexBuilder[659].GetPrimaryIndex().(*tsi.MergeSetIndex).DebugFlush()
+
+	allTime := influxql.TimeRange{Min: time.Unix(0, influxql.MinTime).UTC(), Max: time.Unix(0, influxql.MaxTime).UTC()}
+	cardinality := func() uint64 {
+		ret, err := eng.SeriesExactCardinality("db0", []uint32{0}, [][]byte{[]byte(msNames[0])}, nil, allTime)
+		require.NoError(t, err)
+		return ret[msNames[0]]
+	}
+	require.Equal(t, uint64(10), cardinality())
+
+	// pt not found
+	err = eng.DropSeries("db0", []uint32{0xff}, [][]byte{[]byte(msNames[0])}, nil)
+	require.True(t, errno.Equal(err, errno.PtNotFound))
+
+	// only the series matching the condition are dropped
+	condition := influxql.MustParseExpr(`tagkey1='tagvalue1_1'`)
+	influxql.WalkFunc(condition, func(node influxql.Node) {
+		if ref, ok := node.(*influxql.VarRef); ok {
+			ref.Type = influxql.Tag
+		}
+	})
+	err = eng.DropSeries("db0", []uint32{0}, [][]byte{[]byte(msNames[0])}, condition)
+	require.NoError(t, err)
+	require.Equal(t, uint64(9), cardinality())
+
+	err = eng.DropSeries("db0", []uint32{0}, [][]byte{[]byte(msNames[0])}, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), cardinality())
+}
+
 func TestEngine_TagValues(t *testing.T) {
 	dir := t.TempDir()
 	eng, err := initEngine1(dir, config.TSSTORE)
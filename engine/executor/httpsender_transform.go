@@ -42,6 +42,9 @@ type HttpChunkSender struct {
 	RowChunk RowChunk
 	opt      *query.ProcessorOptions
 
+	// columnTypes are the types of the columns of the rows, taken from the output schema of the plan
+	columnTypes []string
+
 	rowsGenerator *RowsGenerator
 }
 
@@ -228,8 +231,9 @@ func (w *HttpChunkSender) GetRows(chunk Chunk) models.Rows {
 
 func (w *HttpChunkSender) sendRows(rows models.Rows, partial bool) {
 	rc := query.RowsChan{
-		Rows:    rows,
-		Partial: partial,
+		Rows:        rows,
+		Partial:     partial,
+		ColumnTypes: w.columnTypes,
 	}
 
 	if w.opt.AbortChan == nil {
@@ -243,6 +247,17 @@ func (w *HttpChunkSender) sendRows(rows models.Rows, partial bool) {
 	}
 }
 
+// SetColumnTypes sets the types of the columns of the rows to send from the row data type of the chunks,
+// the type of the leading time column included.
+func (w *HttpChunkSender) SetColumnTypes(rdt hybridqp.RowDataType) {
+	fields := rdt.Fields()
+	w.columnTypes = make([]string, 0, len(fields)+1)
+	w.columnTypes = append(w.columnTypes, influxql.Time.String())
+	for _, f := range fields {
+		w.columnTypes = append(w.columnTypes, f.Expr.(*influxql.VarRef).Type.String())
+	}
+}
+
 func (w *HttpChunkSender) Release() {
 	if w.rowsGenerator != nil {
 		w.rowsGenerator.Release()
@@ -556,6 +571,7 @@ func NewHttpSenderTransform(inRowDataType hybridqp.RowDataType, schema *QuerySch
 		Writer: NewHttpChunkSender(schema.Options().(*query.ProcessorOptions)),
 		schema: schema,
 	}
+	trans.Writer.SetColumnTypes(inRowDataType)
 
 	return trans
 }
@@ -663,6 +679,7 @@ func NewHttpSenderHintTransform(inRowDataType hybridqp.RowDataType, schema *Quer
 		Writer: NewHttpChunkSender(schema.Options().(*query.ProcessorOptions)),
 		schema: schema,
 	}
+	trans.Writer.SetColumnTypes(inRowDataType)
 
 	return trans
 }
@@ -418,3 +418,43 @@ func genChunk(outRowDataType hybridqp.RowDataType) executor.Chunk {
 
 	return ck
 }
+
+func TestHttpSenderTransform_ColumnTypes(t *testing.T) {
+	fields := mockFieldsAndTags()
+	inRowDataType := hybridqp.NewRowDataTypeImpl(varRefsFromFields(fields)...)
+	opt := query.ProcessorOptions{
+		ChunkSize:   1024,
+		ChunkedSize: 10000,
+		RowsChan:    make(chan query.RowsChan, 1),
+	}
+	schema := executor.NewQuerySchema(fields, mockColumnNames(), &opt, nil)
+	schema.SetOpt(&opt)
+	httpSender := executor.NewHttpSenderTransform(inRowDataType, schema)
+
+	// the boolean column is all null, its type still comes from the output schema
+	ck := executor.NewChunkBuilder(inRowDataType).NewChunk("cpu")
+	ck.AppendTagsAndIndex(*executor.NewChunkTags(nil, nil), 0)
+	for i := 0; i < 3; i++ {
+		ck.AppendTime(int64(i))
+		ck.Column(0).AppendFloatValue(float64(i))
+		ck.Column(0).AppendNotNil()
+		ck.Column(1).AppendIntegerValue(int64(i))
+		ck.Column(1).AppendNotNil()
+		ck.Column(2).AppendNil()
+		ck.Column(3).AppendStringValue(strconv.Itoa(i))
+		ck.Column(3).AppendNotNil()
+		ck.Column(4).AppendStringValue("tv1")
+		ck.Column(4).AppendNotNil()
+		ck.Column(5).AppendStringValue("tv2")
+		ck.Column(5).AppendNotNil()
+	}
+	httpSender.Writer.Write(ck, true)
+
+	rc := <-opt.RowsChan
+	require.Len(t, rc.Rows, 1)
+	require.Equal(t, []string{"time", "float", "integer", "boolean", "string", "tag", "tag"}, rc.ColumnTypes)
+	require.Len(t, rc.ColumnTypes, len(rc.Rows[0].Columns))
+	for _, values := range rc.Rows[0].Values {
+		require.Nil(t, values[3])
+	}
+}
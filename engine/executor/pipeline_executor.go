@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"runtime/debug"
+	"strings"
 	"sync"
 
 	"github.com/openGemini/openGemini/engine/executor/spdy"
@@ -58,8 +59,16 @@ type PipelineExecutor struct {
 	cancelFunc   context.CancelFunc
 	contextMutex sync.Mutex
 
-	aborted bool
-	crashed bool
+	aborted  bool
+	crashed  bool
+	finished bool
+
+	// abortWg tracks the goroutines walking the dag to abort sink transforms,
+	// Execute waits for them before releasing the processors.
+	abortWg sync.WaitGroup
+
+	// releaseFuncs are called after the processors are released
+	releaseFuncs []func()
 
 	RunTimeStats *statistics.StatisticTimer
 }
@@ -118,7 +127,9 @@ func (exec *PipelineExecutor) closeSinkTransform() {
 		return
 	}
 
+	exec.abortWg.Add(1)
 	go func() {
+		defer exec.abortWg.Done()
 		exec.dag.DepthFirstWalkVertex(exec, exec.root)
 	}()
 }
@@ -139,7 +150,8 @@ func (exec *PipelineExecutor) Abort() {
 	exec.contextMutex.Lock()
 	defer exec.contextMutex.Unlock()
 
-	if exec.aborted {
+	// the executor may have already completed, the sink transforms are released then
+	if exec.aborted || exec.finished {
 		return
 	}
 	exec.aborted = true
@@ -176,6 +188,15 @@ func (exec *PipelineExecutor) Release() {
 				zap.Bool("aborted", exec.aborted), zap.Bool("crashed", exec.crashed), zap.String("query", "PipelineExecutor"))
 		}
 	}
+	for _, fn := range exec.releaseFuncs {
+		fn()
+	}
+	exec.releaseFuncs = nil
+}
+
+// AddReleaseFunc registers fn to be called once the executor is released, such as the resources held by the query.
+func (exec *PipelineExecutor) AddReleaseFunc(fn func()) {
+	exec.releaseFuncs = append(exec.releaseFuncs, fn)
 }
 
 func (exec *PipelineExecutor) ExecuteExecutor(ctx context.Context) error {
@@ -190,10 +211,18 @@ func (exec *PipelineExecutor) initContext(ctx context.Context) error {
 		return errno.NewError(errno.PipelineExecuting, exec.context, exec.cancelFunc)
 	}
 	exec.context, exec.cancelFunc = context.WithCancel(ctx)
+	exec.finished = false
 	exec.contextMutex.Unlock()
 	return nil
 }
 
+func (exec *PipelineExecutor) finish() {
+	exec.contextMutex.Lock()
+	exec.finished = true
+	exec.contextMutex.Unlock()
+	exec.abortWg.Wait()
+}
+
 func (exec *PipelineExecutor) destroyContext() {
 	exec.contextMutex.Lock()
 	exec.context, exec.cancelFunc = nil, nil
@@ -252,6 +281,7 @@ func (exec *PipelineExecutor) Execute(ctx context.Context) error {
 		}(p)
 	}
 	wg.Wait()
+	exec.finish()
 	exec.Release()
 	exec.destroyContext()
 
@@ -278,6 +308,49 @@ func (exec *PipelineExecutor) SetDag(d *TransformDag) {
 	exec.dag = d
 }
 
+// Explain renders the transforms of the executor without executing them, one line per transform
+// from the root, the inputs of a transform are indented below it.
+func (exec *PipelineExecutor) Explain() []string {
+	if exec.dag == nil || exec.root == nil {
+		return nil
+	}
+	var lines []string
+	exec.explainVertex(exec.root, NewSpacer(), &lines)
+	return lines
+}
+
+func (exec *PipelineExecutor) explainVertex(vertex *TransformVertex, spacer *Spacer, lines *[]string) {
+	var b strings.Builder
+	b.WriteString(spacer.String())
+	b.WriteString(vertex.transform.Name())
+	values := vertex.transform.Explain()
+	for i, vp := range values {
+		if i == 0 {
+			b.WriteString("(")
+		} else {
+			b.WriteString(",")
+		}
+		b.WriteString(vp.First)
+		b.WriteString("=[")
+		b.WriteString(fmt.Sprintf("%v", vp.Second))
+		b.WriteString("]")
+	}
+	if len(values) > 0 {
+		b.WriteString(")")
+	}
+	*lines = append(*lines, b.String())
+
+	info, ok := exec.dag.mapVertexToInfo[vertex]
+	if !ok {
+		return
+	}
+	spacer.Add(2)
+	for _, edge := range info.backwardEdges {
+		exec.explainVertex(edge.from, spacer, lines)
+	}
+	spacer.Sub(2)
+}
+
 type TransformVertex struct {
 	node      hybridqp.QueryNode
 	transform Processor
@@ -294,6 +367,10 @@ func (t *TransformVertex) GetTransform() Processor {
 	return t.transform
 }
 
+func (t *TransformVertex) GetNode() hybridqp.QueryNode {
+	return t.node
+}
+
 type TransformVertexVisitor interface {
 	Visit(*TransformVertex) TransformVertexVisitor
 }
@@ -20,6 +20,8 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -296,6 +298,93 @@ func TestAbortPipeline(t *testing.T) {
 	executor.Release()
 }
 
+type releaseCheckSink struct {
+	*executor.NilSink
+
+	released            int32
+	abortedAfterRelease int32
+}
+
+func (sink *releaseCheckSink) IsSink() bool {
+	return true
+}
+
+func (sink *releaseCheckSink) Abort() {
+	if atomic.LoadInt32(&sink.released) == 1 {
+		atomic.StoreInt32(&sink.abortedAfterRelease, 1)
+	}
+}
+
+func (sink *releaseCheckSink) Release() error {
+	atomic.StoreInt32(&sink.released, 1)
+	return nil
+}
+
+func TestAbortCompletedPipeline(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		source := executor.NewSourceFromSingleChunk(buildRowDataType(), buildChunk())
+		sink := &releaseCheckSink{NilSink: executor.NewNilSink(buildRowDataType())}
+
+		sourceVertex := executor.NewTransformVertex(nil, source)
+		sinkVertex := executor.NewTransformVertex(nil, sink)
+		dag := executor.NewTransformDag()
+		dag.AddVertex(sourceVertex)
+		dag.AddVertex(sinkVertex)
+		dag.AddEdge(sourceVertex, sinkVertex)
+		pipelineExecutor := executor.NewPipelineExecutorFromDag(dag, sinkVertex)
+
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			require.NoError(t, pipelineExecutor.Execute(context.Background()))
+		}()
+		go func() {
+			defer wg.Done()
+			pipelineExecutor.Abort()
+		}()
+		wg.Wait()
+
+		// abort after completion must be a no-op
+		pipelineExecutor.Abort()
+		require.Equal(t, int32(0), atomic.LoadInt32(&sink.abortedAfterRelease))
+	}
+}
+
+type explainedSink struct {
+	*executor.NilSink
+}
+
+func (sink *explainedSink) Explain() []executor.ValuePair {
+	return []executor.ValuePair{{First: "limit", Second: 2}, {First: "offset", Second: 1}}
+}
+
+func TestPipelineExecutorExplain(t *testing.T) {
+	source := executor.NewSourceFromSingleChunk(buildRowDataType(), buildChunk())
+	trans := executor.NewLimitTransform([]hybridqp.RowDataType{buildRowDataType()}, []hybridqp.RowDataType{buildRowDataType()},
+		&query.ProcessorOptions{ChunkSize: 100, Limit: 2, Offset: 1},
+		executor.LimitTransformParameters{Limit: 2, Offset: 1, LimitType: hybridqp.SingleRowLimit})
+	sink := &explainedSink{NilSink: executor.NewNilSink(buildRowDataType())}
+
+	sourceVertex := executor.NewTransformVertex(nil, source)
+	transVertex := executor.NewTransformVertex(nil, trans)
+	sinkVertex := executor.NewTransformVertex(nil, sink)
+	dag := executor.NewTransformDag()
+	dag.AddVertex(sourceVertex)
+	dag.AddVertex(transVertex)
+	dag.AddVertex(sinkVertex)
+	dag.AddEdge(sourceVertex, transVertex)
+	dag.AddEdge(transVertex, sinkVertex)
+	pipelineExecutor := executor.NewPipelineExecutorFromDag(dag, sinkVertex)
+
+	require.Equal(t, []string{
+		"NilSink(limit=[2],offset=[1])",
+		"  LimitTransform",
+		"    SourceFromSingleChunk",
+	}, pipelineExecutor.Explain())
+	require.Nil(t, executor.NewPipelineExecutorFromDag(dag, nil).Explain())
+}
+
 func TestPipelineByFunction(t *testing.T) {
 	chunk := buildChunk()
 
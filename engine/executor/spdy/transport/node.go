@@ -86,6 +86,16 @@ func (n *Node) dial(idx uint64) error {
 	return nil
 }
 
+// Warmup dials all the connection pools of the node, it stops at the first pool which can not be dialed.
+func (n *Node) Warmup() error {
+	for idx := range n.pools {
+		if err := n.dial(uint64(idx)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (n *Node) setStatisticsJob(job *statistics.SpdyJob) {
 	if job == nil {
 		return
@@ -125,3 +125,27 @@ func TestStat(t *testing.T) {
 	InitStatistics(AppStore)
 	assert.Equal(t, uint16(statistics.Store2Meta)<<8, NewMetaNodeManager().job.Key())
 }
+
+func TestNodeWarmup(t *testing.T) {
+	node := &Node{
+		nodeID:  1,
+		address: "127.0.0.10:17980",
+		pools:   make([]*spdy.MultiplexedSessionPool, 4),
+	}
+	// the first pool which can not be dialed stops the warmup
+	assert.Error(t, node.Warmup())
+	for _, p := range node.pools {
+		assert.Nil(t, p)
+	}
+
+	ln, err := net.Listen("tcp", node.address)
+	assert.NoError(t, err)
+	defer func() {
+		_ = ln.Close()
+	}()
+	assert.NoError(t, node.Warmup())
+	for _, p := range node.pools {
+		assert.True(t, p != nil && p.Available())
+	}
+	node.Close()
+}
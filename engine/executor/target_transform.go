@@ -59,6 +59,8 @@ type TargetTransform struct {
 	builder *ChunkBuilder
 	// row count that is written
 	writenRowCount int64
+	// row count admitted to be written, it is checked against opt.MaxIntoPoints before the write
+	admittedRowCount int64
 
 	writeWorker int
 	writeChan   chan Chunk
@@ -270,6 +272,9 @@ func (trans *TargetTransform) writeTarget(chunk Chunk) error {
 	}
 
 	pr := table.Active()
+	if limit := trans.opt.MaxIntoPoints; limit > 0 && atomic.AddInt64(&trans.admittedRowCount, int64(len(pr))) > limit {
+		return errno.NewError(errno.SelectIntoPointsExceeded, atomic.LoadInt64(&trans.writenRowCount), limit)
+	}
 	if err := trans.writer.RetryWritePointRows(trans.mst.Database, trans.mst.RetentionPolicy, pr); err != nil {
 		return err
 	}
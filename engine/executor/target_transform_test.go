@@ -0,0 +1,119 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockPointWriter struct {
+	rows       int
+	fields     []string
+	timestamps []int64
+}
+
+func (w *mockPointWriter) RetryWritePointRows(_, _ string, points []influx.Row) error {
+	w.rows += len(points)
+	for i := range points {
+		w.timestamps = append(w.timestamps, points[i].Timestamp)
+		for _, f := range points[i].Fields {
+			w.fields = append(w.fields, f.Key)
+		}
+	}
+	return nil
+}
+
+func TestTargetTransform_MaxIntoPoints(t *testing.T) {
+	rt := hybridqp.NewRowDataTypeImpl(influxql.VarRef{Val: "value", Type: influxql.Float})
+	newChunk := func() Chunk {
+		chunk := NewChunkBuilder(rt).NewChunk("mst")
+		chunk.AppendTagsAndIndex(ChunkTags{}, 0)
+		chunk.AppendTimes([]int64{1, 2, 3})
+		chunk.Column(0).AppendFloatValues([]float64{1, 2, 3})
+		chunk.Column(0).AppendManyNotNil(3)
+		return chunk
+	}
+	newTrans := func(limit int64) (*TargetTransform, *mockPointWriter) {
+		opt := &query.ProcessorOptions{ChunkSize: 10, MaxIntoPoints: limit}
+		outRt := hybridqp.NewRowDataTypeImpl(influxql.VarRef{Val: "written", Type: influxql.Integer})
+		trans, err := NewTargetTransform(rt, outRt, nil, opt, nil, &influxql.Measurement{Database: "db0", Name: "dst"})
+		require.NoError(t, err)
+		writer := &mockPointWriter{}
+		trans.writer = writer
+		return trans, writer
+	}
+
+	// under the cap
+	trans, writer := newTrans(6)
+	require.NoError(t, trans.writeTarget(newChunk()))
+	require.NoError(t, trans.writeTarget(newChunk()))
+	assert.Equal(t, 6, writer.rows)
+
+	// over the cap, the exceeding batch is not written
+	err := trans.writeTarget(newChunk())
+	assert.True(t, errno.Equal(err, errno.SelectIntoPointsExceeded))
+	assert.EqualError(t, err, "the INTO query wrote 6 points, exceeding max-select-into-points(6)")
+	assert.Equal(t, 6, writer.rows)
+
+	// unlimited if 0
+	trans, writer = newTrans(0)
+	for i := 0; i < 5; i++ {
+		require.NoError(t, trans.writeTarget(newChunk()))
+	}
+	assert.Equal(t, 15, writer.rows)
+}
+
+func TestTargetTransform_FieldNames(t *testing.T) {
+	// the time column is omitted for SELECT INTO as for any SELECT, the fields are aliased after the column names
+	// and the points are written at the time of their source
+	stmt := &influxql.SelectStatement{
+		Fields: influxql.Fields{
+			{Expr: &influxql.VarRef{Val: "f1", Type: influxql.Float}, Alias: "a"},
+			{Expr: &influxql.VarRef{Val: "f2", Type: influxql.Float}},
+		},
+		Target:   &influxql.Target{Measurement: &influxql.Measurement{Database: "db0", Name: "dst"}},
+		OmitTime: true,
+	}
+	opt := &query.ProcessorOptions{ChunkSize: 10}
+	schema := NewQuerySchema(stmt.Fields, stmt.ColumnNames(), opt, nil)
+	rt := hybridqp.NewRowDataTypeImpl(schema.FieldsRef()...)
+
+	chunk := NewChunkBuilder(rt).NewChunk("mst")
+	chunk.AppendTagsAndIndex(ChunkTags{}, 0)
+	chunk.AppendTimes([]int64{1000})
+	for i := 0; i < 2; i++ {
+		chunk.Column(i).AppendFloatValues([]float64{float64(i)})
+		chunk.Column(i).AppendManyNotNil(1)
+	}
+
+	outRt := hybridqp.NewRowDataTypeImpl(influxql.VarRef{Val: "written", Type: influxql.Integer})
+	trans, err := NewTargetTransform(rt, outRt, nil, opt, schema, stmt.Target.Measurement)
+	require.NoError(t, err)
+	writer := &mockPointWriter{}
+	trans.writer = writer
+	require.NoError(t, trans.writeTarget(chunk))
+	assert.Equal(t, []string{"a", "f2"}, writer.fields)
+	assert.Equal(t, []int64{1000}, writer.timestamps)
+}
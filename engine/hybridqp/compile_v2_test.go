@@ -77,6 +77,25 @@ func TestIsSpecificSeriesQuery(t *testing.T) {
 
 }
 
+func TestIsForceBroadcastQuery(t *testing.T) {
+	parse := func(sql string) *influxql.SelectStatement {
+		parser := influxql.NewParser(strings.NewReader(sql))
+		yaccParser := influxql.NewYyParser(parser.GetScanner(), make(map[string]interface{}))
+		yaccParser.ParseTokens()
+		query, err := yaccParser.GetQuery()
+		require.NoError(t, err)
+		selectStmt, ok := query.Statements[0].(*influxql.SelectStatement)
+		require.True(t, ok)
+		return selectStmt
+	}
+
+	assert.True(t, hybridqp.IsForceBroadcastQuery(parse(`select /*+ force_broadcast */ value from cpu where host = 'server01'`)))
+	assert.True(t, hybridqp.IsForceBroadcastQuery(parse(`select /*+ full_series force_broadcast */ value from cpu where host = 'server01'`)))
+	assert.True(t, hybridqp.IsForceBroadcastQuery(parse(`select count(value) from (select /*+ force_broadcast */ value from cpu where host = 'server01')`)))
+	assert.False(t, hybridqp.IsForceBroadcastQuery(parse(`select value from cpu where host = 'server01'`)))
+	assert.False(t, hybridqp.IsForceBroadcastQuery(parse(`select /*+ full_series */ value from cpu where host = 'server01'`)))
+}
+
 func TestVerifyHintStmt(t *testing.T) {
 	sql := `select /*+ Exact_Statistic_Query */ value from cpu limit 1`
 	sqlReader := strings.NewReader(sql)
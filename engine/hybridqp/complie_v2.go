@@ -49,6 +49,21 @@ func VerifyHintStmt(stmt *influxql.SelectStatement, opt Options) error {
 	return nil
 }
 
+// IsForceBroadcastQuery Hint, the hint of a subquery forces the broadcast of the whole query
+func IsForceBroadcastQuery(stmt *influxql.SelectStatement) bool {
+	for _, hint := range stmt.Hints {
+		if hint.String() == influxql.ForceBroadcastQuery {
+			return true
+		}
+	}
+	for _, s := range stmt.Sources {
+		if s, ok := s.(*influxql.SubQuery); ok && IsForceBroadcastQuery(s.Statement) {
+			return true
+		}
+	}
+	return false
+}
+
 // FilterNullColumnQuery Hint
 func FilterNullColumnQuery(stmt *influxql.SelectStatement) bool {
 	for _, s := range stmt.Sources {
@@ -213,6 +213,32 @@ func (iBuilder *IndexBuilder) Overlaps(tr influxql.TimeRange) bool {
 	return !iBuilder.startTime.After(tr.Max) && iBuilder.endTime.After(tr.Min)
 }
 
+// CoveredBy returns true if the whole time range of the index is in tr, the end time of the index is exclusive.
+func (iBuilder *IndexBuilder) CoveredBy(tr influxql.TimeRange) bool {
+	return !iBuilder.startTime.Before(tr.Min) && !iBuilder.endTime.After(tr.Max.Add(time.Nanosecond))
+}
+
+// DeleteSeries deletes the series of the measurement matching the condition from the whole primary index,
+// maxDaysForSearch days of the index at a time.
+func (iBuilder *IndexBuilder) DeleteSeries(name []byte, condition influxql.Expr) error {
+	idx, ok := iBuilder.GetPrimaryIndex().(*MergeSetIndex)
+	if !ok {
+		return nil
+	}
+	end := iBuilder.endTime.UnixNano()
+	window := int64(maxDaysForSearch * nsPerDay)
+	for min := iBuilder.startTime.UnixNano(); min < end; min += window {
+		max := min + window - 1
+		if max > end {
+			max = end
+		}
+		if err := idx.DeleteTSIDs(name, condition, TimeRange{Min: min, Max: max}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (iBuilder *IndexBuilder) CreateIndexIfNotExists(mmRows *dictpool.Dict, needSecondaryIndex bool) error {
 	primaryIndex := iBuilder.GetPrimaryIndex()
 	var wg sync.WaitGroup
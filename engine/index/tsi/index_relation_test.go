@@ -475,6 +475,28 @@ func TestDeleteTSIDs_Relation(t *testing.T) {
 	})
 }
 
+func TestIndexBuilder_DeleteSeries(t *testing.T) {
+	path := t.TempDir()
+	idx, idxBuilder := getTestIndexAndBuilder(path, config.TSSTORE)
+	defer idxBuilder.Close()
+	CreateIndexByBuild(idxBuilder, idx)
+
+	dst, err := idx.SearchSeries(make([][]byte, 0, 1), []byte("mn-1"), nil, defaultTR)
+	require.NoError(t, err)
+	assert1.Len(t, dst, 5)
+
+	// the index spans more days than a single deletion can search
+	idxBuilder.startTime = time.Now().Add(-100 * 24 * time.Hour)
+	require.NoError(t, idxBuilder.DeleteSeries([]byte("mn-1"), MustParseExpr(`tk1='value1'`)))
+
+	dst, err = idx.SearchSeries(make([][]byte, 0, 1), []byte("mn-1"), nil, defaultTR)
+	require.NoError(t, err)
+	assert1.Len(t, dst, 3)
+	for _, key := range dst {
+		assert1.NotContains(t, string(key), "tk1=value1,")
+	}
+}
+
 func TestSearchTagValues_Relation(t *testing.T) {
 	path := t.TempDir()
 	idx, idxBuilder := getTestIndexAndBuilder(path, config.TSSTORE)
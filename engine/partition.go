@@ -929,6 +929,50 @@ func (dbPT *DBPTInfo) seriesCardinalityWithCondition(measurements [][]byte, cond
 	return measurementCardinalityInfos, nil
 }
 
+// deleteSeries deletes the series of the measurements matching the condition from the indexes of the retention
+// policy overlapping tr. A deleted series is no longer queried, its points are left to the retention policy.
+// So tr must cover every index it overlaps, otherwise the points of the index out of tr would be lost too,
+// and nothing is deleted.
+func (dbPT *DBPTInfo) deleteSeries(rp string, measurements [][]byte, condition influxql.Expr, tr influxql.TimeRange) error {
+	var indexBuilders []*tsi.IndexBuilder
+	for _, indexBuilder := range dbPT.indexBuilder {
+		if indexBuilder.RPName() != rp || !indexBuilder.Overlaps(tr) {
+			continue
+		}
+		if !indexBuilder.CoveredBy(tr) {
+			tr := indexBuilder.Ident().Index.TimeRange
+			return errno.NewError(errno.ErrDeletePartialIndex, indexBuilder.GetIndexID(), tr.StartTime, tr.EndTime)
+		}
+		indexBuilders = append(indexBuilders, indexBuilder)
+	}
+
+	for _, indexBuilder := range indexBuilders {
+		if err := deleteIndexSeries(indexBuilder, measurements, condition); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dropSeries deletes the series of the measurements matching the condition from all the indexes.
+func (dbPT *DBPTInfo) dropSeries(measurements [][]byte, condition influxql.Expr) error {
+	for _, indexBuilder := range dbPT.indexBuilder {
+		if err := deleteIndexSeries(indexBuilder, measurements, condition); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func deleteIndexSeries(indexBuilder *tsi.IndexBuilder, measurements [][]byte, condition influxql.Expr) error {
+	for i := range measurements {
+		if err := indexBuilder.DeleteSeries(measurements[i], condition); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (dbPT *DBPTInfo) enableDBPtBgr() {
 	dbPT.mu.Lock()
 	defer dbPT.mu.Unlock()
@@ -0,0 +1,93 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"errors"
+	"path/filepath"
+
+	"github.com/influxdata/influxdb/toml"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// AuditLogFileName is the name of the audit log file, without the extension
+const AuditLogFileName = "audit"
+
+// AuditLog is the configuration of the statement audit log, which records every executed statement
+// with its user, source address and outcome, separately from the operational log.
+// The rotation settings have the same meaning as those of the [logging] section.
+type AuditLog struct {
+	Enabled         bool      `toml:"enabled"`
+	Path            string    `toml:"path"`
+	MaxSize         toml.Size `toml:"max-size"`
+	MaxNum          int       `toml:"max-num"`
+	MaxAge          int       `toml:"max-age"`
+	CompressEnabled bool      `toml:"compress-enabled"`
+}
+
+func NewAuditLog() AuditLog {
+	return AuditLog{
+		Enabled:         false,
+		Path:            filepath.Join(openGeminiDir(), DefaultSubPath),
+		MaxSize:         toml.Size(DefaultMaxSize),
+		MaxNum:          DefaultMaxNum,
+		MaxAge:          DefaultMaxAge,
+		CompressEnabled: DefaultCompressEnabled,
+	}
+}
+
+func (c AuditLog) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.MaxSize <= 0 {
+		return errors.New("audit-log max-size must be positive")
+	}
+	if c.MaxNum <= 0 {
+		return errors.New("audit-log max-num must be positive")
+	}
+	if c.MaxAge <= 0 {
+		return errors.New("audit-log max-age must be positive")
+	}
+	if c.Path == "" {
+		return errors.New("audit-log path must not be empty")
+	}
+	return nil
+}
+
+func (c *AuditLog) ShowConfigs() map[string]interface{} {
+	return map[string]interface{}{
+		"audit-log.enabled":          c.Enabled,
+		"audit-log.path":             c.Path,
+		"audit-log.max-size":         rewriteMaxSize(c.MaxSize),
+		"audit-log.max-num":          c.MaxNum,
+		"audit-log.max-age":          c.MaxAge,
+		"audit-log.compress-enabled": c.CompressEnabled,
+	}
+}
+
+// NewLumberjackLogger returns the rotated file the audit log is written to.
+func (c *AuditLog) NewLumberjackLogger() *lumberjack.Logger {
+	logger := &Logger{
+		MaxSize:         c.MaxSize,
+		MaxNum:          c.MaxNum,
+		MaxAge:          c.MaxAge,
+		CompressEnabled: c.CompressEnabled,
+		Path:            c.Path,
+	}
+	return logger.NewLumberjackLogger(AuditLogFileName)
+}
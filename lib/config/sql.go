@@ -18,6 +18,7 @@ package config
 
 import (
 	"errors"
+	"fmt"
 	"runtime"
 	"time"
 
@@ -51,6 +52,30 @@ const (
 	DefaultShardTier                = "warm"
 	DefaultForceBroadcastQuery      = false
 	DefaultRetentionPolicyLimit     = 100
+	DefaultSelectIntoAutoCreate     = true
+
+	// DefaultSlowQueryThreshold is the default elapsed time after which a SELECT statement is kept by SHOW SLOW QUERIES.
+	DefaultSlowQueryThreshold = 10 * time.Second
+	// DefaultSlowQueryBufferSize is the default number of slow queries kept by SHOW SLOW QUERIES.
+	DefaultSlowQueryBufferSize = 100
+	// DefaultMaxShowSeries is the default maximum number of series keys SHOW SERIES collects from the nodes.
+	DefaultMaxShowSeries = 1000000
+)
+
+const (
+	// ErrorVerbosityInternal returns the errors to the clients as they are
+	ErrorVerbosityInternal = "internal"
+	// ErrorVerbosityExternal hides the internal detail of the errors returned to the clients
+	ErrorVerbosityExternal = "external"
+)
+
+const (
+	// NameValidationDefault accepts the printable names without the characters reserved by the storage
+	NameValidationDefault = "default"
+	// NameValidationASCII also rejects the names with non-ASCII characters
+	NameValidationASCII = "ascii"
+	// NameValidationStrict only accepts the names made of ASCII letters, digits, underscores and hyphens
+	NameValidationStrict = "strict"
 )
 
 /*
@@ -77,6 +102,7 @@ type TSSql struct {
 	Coordinator Coordinator `toml:"coordinator"`
 	Monitor     Monitor     `toml:"monitor"`
 	Logging     Logger      `toml:"logging"`
+	AuditLog    AuditLog    `toml:"audit-log"`
 	Gossip      *Gossip     `toml:"gossip"`
 	Spdy        Spdy        `toml:"spdy"`
 
@@ -101,6 +127,7 @@ func NewTSSql(enableGossip bool) *TSSql {
 	c.Coordinator = NewCoordinator()
 	c.Monitor = NewMonitor(AppSql)
 	c.Logging = NewLogger(AppSql)
+	c.AuditLog = NewAuditLog()
 	c.Meta = NewMeta()
 	c.HTTP = httpdConfig.NewConfig()
 	c.Analysis = NewCastor()
@@ -157,21 +184,7 @@ func (c *TSSql) Corrector(cpuNum, cpuAllocRatio int) {
 
 // Validate returns an error if the config is invalid.
 func (c *TSSql) Validate() error {
-	items := []Validator{
-		c.Common,
-		c.Monitor,
-		c.TLS,
-		c.Logging,
-		c.Coordinator,
-		c.HTTP,
-		c.Spdy,
-		c.Analysis,
-		c.Sherlock,
-		c.Subscriber,
-		c.ContinuousQuery,
-	}
-
-	for _, item := range items {
+	for _, item := range c.sections() {
 		if err := item.Validate(); err != nil {
 			return err
 		}
@@ -180,6 +193,62 @@ func (c *TSSql) Validate() error {
 	return nil
 }
 
+type section struct {
+	name string
+	Validator
+}
+
+// sections returns the validated sections of the config, named after their toml tables.
+func (c *TSSql) sections() []section {
+	return []section{
+		{"common", c.Common},
+		{"monitor", c.Monitor},
+		{"tls", c.TLS},
+		{"logging", c.Logging},
+		{"audit-log", c.AuditLog},
+		{"coordinator", c.Coordinator},
+		{"http", c.HTTP},
+		{"spdy", c.Spdy},
+		{"castor", c.Analysis},
+		{"sherlock", c.Sherlock},
+		{"subscriber", c.Subscriber},
+		{"continuous_queries", c.ContinuousQuery},
+	}
+}
+
+// ConfigCheck is the result of one check of the config, Err is nil if the check passed.
+type ConfigCheck struct {
+	Name string
+	Err  error
+}
+
+// Check validates every section of the config, then the settings which depend on each other.
+// Unlike Validate, it runs all the checks even if some of them fail.
+func (c *TSSql) Check() []ConfigCheck {
+	var checks []ConfigCheck
+	for _, item := range c.sections() {
+		checks = append(checks, ConfigCheck{Name: item.name, Err: item.Validate()})
+	}
+
+	check := func(name string, ok bool, msg string) {
+		var err error
+		if !ok {
+			err = errors.New(msg)
+		}
+		checks = append(checks, ConfigCheck{Name: name, Err: err})
+	}
+	check("http.https-certificate", !c.HTTP.HTTPSEnabled || c.HTTP.HTTPSCertificate != "",
+		"http https-enabled requires https-certificate")
+	check("http.flight-auth-enabled", !c.HTTP.FlightAuthEnabled || c.HTTP.FlightEnabled,
+		"http flight-auth-enabled requires flight-enabled")
+	// requests are only enqueued when the concurrency is limited
+	check("http.max-enqueued-write-limit", c.HTTP.MaxEnqueuedWriteLimit <= 0 || c.HTTP.MaxConcurrentWriteLimit > 0,
+		"http max-enqueued-write-limit requires max-concurrent-write-limit")
+	check("http.max-enqueued-query-limit", c.HTTP.MaxEnqueuedQueryLimit <= 0 || c.HTTP.MaxConcurrentQueryLimit > 0,
+		"http max-enqueued-query-limit requires max-concurrent-query-limit")
+	return checks
+}
+
 // ApplyEnvOverrides apply the environment configuration on top of the config.
 func (c *TSSql) ApplyEnvOverrides(fn func(string) string) error {
 	return toml.ApplyEnvOverrides(fn, "TSSQL", c)
@@ -208,6 +277,9 @@ func (c *TSSql) ShowConfigs() map[string]interface{} {
 	for k, v := range c.Logging.ShowConfigs() {
 		sqlConfig[k] = v
 	}
+	for k, v := range c.AuditLog.ShowConfigs() {
+		sqlConfig[k] = v
+	}
 	for k, v := range c.Spdy.ShowConfigs() {
 		sqlConfig[k] = v
 	}
@@ -233,6 +305,7 @@ type Coordinator struct {
 	MaxConcurrentQueries int           `toml:"max-concurrent-queries"`
 	QueryTimeout         toml.Duration `toml:"query-timeout"`
 	LogQueriesAfter      toml.Duration `toml:"log-queries-after"`
+	LogStatementsAfter   toml.Duration `toml:"log-statements-after"`
 	ShardWriterTimeout   toml.Duration `toml:"shard-writer-timeout"`
 	ShardMapperTimeout   toml.Duration `toml:"shard-mapper-timeout"`
 	// Maximum number of memory bytes to use from the query
@@ -250,6 +323,66 @@ type Coordinator struct {
 	QueryLimitFlag          bool `toml:"query-limit-flag"`
 	QueryTimeCompareEnabled bool `toml:"query-time-compare-enabled"`
 	ForceBroadcastQuery     bool `toml:"force-broadcast-query"`
+
+	// Maximum number of queries per second for each database, databases not listed are not limited
+	DatabaseQueryRateLimit map[string]int `toml:"database-query-rate-limit"`
+
+	// Maximum number of concurrent queries scanning each measurement of a database, measurements not listed are not limited
+	MeasurementScanLimit map[string]map[string]int `toml:"measurement-scan-limit"`
+
+	// Measurements of each database which can only be queried by SELECT statements with a LIMIT
+	LimitRequiredMeasurements map[string][]string `toml:"limit-required-measurements"`
+
+	// Maximum series number a query can request by the max_series_n hint
+	MaxSelectSeriesHardLimit int `toml:"max-select-series-hard-limit"`
+
+	// Maximum number of measurements the sources of a SELECT statement can resolve to, unlimited if 0
+	MaxSelectSources int `toml:"max-select-sources"`
+
+	// Maximum number of measurements the sources of SHOW FIELD KEYS and SHOW TAG KEYS can resolve to, unlimited if 0
+	MaxShowMeasurements int `toml:"max-show-measurements"`
+
+	// Maximum number of series keys SHOW SERIES collects from the nodes, unlimited if 0
+	MaxShowSeries int `toml:"max-show-series"`
+
+	// Maximum number of bytes a SELECT statement can return to the client, estimated from the rows, unlimited if 0
+	MaxSelectEmitBytes toml.Size `toml:"max-select-emit-bytes"`
+
+	// Create the absent target measurement of SELECT INTO with the shard key and engine type of its source,
+	// a SELECT INTO with an absent target fails if false
+	SelectIntoAutoCreate bool `toml:"select-into-auto-create"`
+
+	// Maximum number of points a SELECT INTO statement can write, unlimited if 0
+	MaxSelectIntoPoints int64 `toml:"max-select-into-points"`
+
+	// Verbosity of the errors returned to the clients, internal or external
+	ErrorVerbosity string `toml:"error-verbosity"`
+
+	// How long the privileges of a user are cached for the authorization of statements, disabled if 0
+	PrivilegeCacheTTL toml.Duration `toml:"privilege-cache-ttl"`
+
+	// Fraction of the SELECT statements traced and logged, between 0 and 1, none if 0
+	QueryTraceSampleRate float64 `toml:"query-trace-sample-rate"`
+
+	// Strictness of the validation of the database and measurement names created by statements, default, ascii or strict
+	NameValidation string `toml:"name-validation"`
+
+	// Elapsed time after which a SELECT statement is kept for SHOW SLOW QUERIES, none if 0
+	SlowQueryThreshold toml.Duration `toml:"slow-query-threshold"`
+	// Number of the most recent slow queries kept for SHOW SLOW QUERIES, none if 0
+	SlowQueryBufferSize int `toml:"slow-query-buffer-size"`
+
+	// Interval of the background job caching the series cardinality of each database for SHOW SERIES CARDINALITY,
+	// disabled if 0
+	CardinalityCacheInterval toml.Duration `toml:"cardinality-cache-interval"`
+
+	// Establish the connections of the queries to all the data nodes at startup instead of at the first queries
+	WarmupConnections bool `toml:"warmup-connections"`
+
+	// Databases allowed to create subscriptions, all databases are allowed if empty
+	SubscriptionAllowDatabases []string `toml:"subscription-allow-databases"`
+	// Databases denied to create subscriptions, which takes precedence over the allow list
+	SubscriptionDenyDatabases []string `toml:"subscription-deny-databases"`
 }
 
 // NewCoordinator returns an instance of Config with defaults.
@@ -269,6 +402,12 @@ func NewCoordinator() Coordinator {
 		ShardTier:                DefaultShardTier,
 		RetentionPolicyLimit:     DefaultRetentionPolicyLimit,
 		ForceBroadcastQuery:      DefaultForceBroadcastQuery,
+		SelectIntoAutoCreate:     DefaultSelectIntoAutoCreate,
+		ErrorVerbosity:           ErrorVerbosityInternal,
+		NameValidation:           NameValidationDefault,
+		SlowQueryThreshold:       toml.Duration(DefaultSlowQueryThreshold),
+		SlowQueryBufferSize:      DefaultSlowQueryBufferSize,
+		MaxShowSeries:            DefaultMaxShowSeries,
 	}
 }
 
@@ -283,27 +422,103 @@ func (c Coordinator) Validate() error {
 	if c.ShardMapperTimeout < 0 {
 		return errors.New("coordinator shard-mapper-timeout can not be negative")
 	}
+	if c.LogStatementsAfter < 0 {
+		return errors.New("coordinator log-statements-after can not be negative")
+	}
+	if c.MaxSelectSeriesHardLimit < 0 {
+		return errors.New("coordinator max-select-series-hard-limit can not be negative")
+	}
+	if c.MaxSelectSources < 0 {
+		return errors.New("coordinator max-select-sources can not be negative")
+	}
+	if c.MaxShowMeasurements < 0 {
+		return errors.New("coordinator max-show-measurements can not be negative")
+	}
+	if c.MaxShowSeries < 0 {
+		return errors.New("coordinator max-show-series can not be negative")
+	}
+	if c.MaxSelectIntoPoints < 0 {
+		return errors.New("coordinator max-select-into-points can not be negative")
+	}
+	switch c.ErrorVerbosity {
+	case "", ErrorVerbosityInternal, ErrorVerbosityExternal:
+	default:
+		return fmt.Errorf("coordinator error-verbosity must be %s or %s", ErrorVerbosityInternal, ErrorVerbosityExternal)
+	}
+	switch c.NameValidation {
+	case "", NameValidationDefault, NameValidationASCII, NameValidationStrict:
+	default:
+		return fmt.Errorf("coordinator name-validation must be %s, %s or %s", NameValidationDefault, NameValidationASCII, NameValidationStrict)
+	}
+	if c.PrivilegeCacheTTL < 0 {
+		return errors.New("coordinator privilege-cache-ttl can not be negative")
+	}
+	if c.QueryTraceSampleRate < 0 || c.QueryTraceSampleRate > 1 {
+		return errors.New("coordinator query-trace-sample-rate must be between 0 and 1")
+	}
+	if c.SlowQueryThreshold < 0 {
+		return errors.New("coordinator slow-query-threshold can not be negative")
+	}
+	if c.SlowQueryBufferSize < 0 {
+		return errors.New("coordinator slow-query-buffer-size can not be negative")
+	}
+	if c.CardinalityCacheInterval < 0 {
+		return errors.New("coordinator cardinality-cache-interval can not be negative")
+	}
+	for db, limit := range c.DatabaseQueryRateLimit {
+		if limit < 0 {
+			return fmt.Errorf("coordinator database-query-rate-limit of database %s can not be negative", db)
+		}
+	}
+	for db, msts := range c.MeasurementScanLimit {
+		for mst, limit := range msts {
+			if limit < 0 {
+				return fmt.Errorf("coordinator measurement-scan-limit of measurement %s.%s can not be negative", db, mst)
+			}
+		}
+	}
 	return nil
 }
 
 func (c *Coordinator) ShowConfigs() map[string]interface{} {
 	return map[string]interface{}{
-		"coordinator.write-timeout":               c.WriteTimeout,
-		"coordinator.max-concurrent-queries":      c.MaxConcurrentQueries,
-		"coordinator.log-queries-after":           c.LogQueriesAfter,
-		"coordinator.shard-writer-timeout":        c.ShardWriterTimeout,
-		"coordinator.shard-mapper-timeout":        c.ShardMapperTimeout,
-		"coordinator.max-query-mem":               c.MaxQueryMem,
-		"coordinator.meta-executor-write-timeout": c.MetaExecutorWriteTimeout,
-		"coordinator.query-timeout":               c.QueryTimeout,
-		"coordinator.query-limit-interval-time":   c.QueryLimitIntervalTime,
-		"coordinator.query-limit-level":           c.QueryLimitLevel,
-		"coordinator.query-limit-flag":            c.QueryLimitFlag,
-		"coordinator.query-time-compare-enabled":  c.QueryTimeCompareEnabled,
-		"coordinator.force-broadcast-query":       c.ForceBroadcastQuery,
-		"coordinator.shard-tier":                  c.ShardTier,
-		"coordinator.rp-limit":                    c.RetentionPolicyLimit,
-		"coordinator.time-range-limit":            c.TimeRangeLimit,
-		"coordinator.tag-limit":                   c.TagLimit,
+		"coordinator.write-timeout":                c.WriteTimeout,
+		"coordinator.max-concurrent-queries":       c.MaxConcurrentQueries,
+		"coordinator.log-queries-after":            c.LogQueriesAfter,
+		"coordinator.log-statements-after":         c.LogStatementsAfter,
+		"coordinator.shard-writer-timeout":         c.ShardWriterTimeout,
+		"coordinator.shard-mapper-timeout":         c.ShardMapperTimeout,
+		"coordinator.max-query-mem":                c.MaxQueryMem,
+		"coordinator.meta-executor-write-timeout":  c.MetaExecutorWriteTimeout,
+		"coordinator.query-timeout":                c.QueryTimeout,
+		"coordinator.query-limit-interval-time":    c.QueryLimitIntervalTime,
+		"coordinator.query-limit-level":            c.QueryLimitLevel,
+		"coordinator.query-limit-flag":             c.QueryLimitFlag,
+		"coordinator.query-time-compare-enabled":   c.QueryTimeCompareEnabled,
+		"coordinator.force-broadcast-query":        c.ForceBroadcastQuery,
+		"coordinator.shard-tier":                   c.ShardTier,
+		"coordinator.rp-limit":                     c.RetentionPolicyLimit,
+		"coordinator.time-range-limit":             c.TimeRangeLimit,
+		"coordinator.tag-limit":                    c.TagLimit,
+		"coordinator.database-query-rate-limit":    c.DatabaseQueryRateLimit,
+		"coordinator.measurement-scan-limit":       c.MeasurementScanLimit,
+		"coordinator.limit-required-measurements":  c.LimitRequiredMeasurements,
+		"coordinator.max-select-series-hard-limit": c.MaxSelectSeriesHardLimit,
+		"coordinator.max-select-sources":           c.MaxSelectSources,
+		"coordinator.max-show-measurements":        c.MaxShowMeasurements,
+		"coordinator.max-show-series":              c.MaxShowSeries,
+		"coordinator.max-select-emit-bytes":        c.MaxSelectEmitBytes,
+		"coordinator.select-into-auto-create":      c.SelectIntoAutoCreate,
+		"coordinator.max-select-into-points":       c.MaxSelectIntoPoints,
+		"coordinator.error-verbosity":              c.ErrorVerbosity,
+		"coordinator.privilege-cache-ttl":          c.PrivilegeCacheTTL,
+		"coordinator.query-trace-sample-rate":      c.QueryTraceSampleRate,
+		"coordinator.name-validation":              c.NameValidation,
+		"coordinator.slow-query-threshold":         c.SlowQueryThreshold,
+		"coordinator.slow-query-buffer-size":       c.SlowQueryBufferSize,
+		"coordinator.cardinality-cache-interval":   c.CardinalityCacheInterval,
+		"coordinator.warmup-connections":           c.WarmupConnections,
+		"coordinator.subscription-allow-databases": c.SubscriptionAllowDatabases,
+		"coordinator.subscription-deny-databases":  c.SubscriptionDenyDatabases,
 	}
 }
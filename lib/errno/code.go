@@ -108,6 +108,21 @@ const (
 	SeriesBucketLacks            = 1126
 	ChunkReaderCursor            = 1127
 	ApplyFuncErr                 = 1128
+	RateLimited                  = 1129
+	MaxConcurrentQueriesExceeded = 1130
+	NoDatabasePrivilege          = 1131
+	SelectSourcesLimitExceeded   = 1132
+	SelectEmitBytesExceeded      = 1133
+	SelectIntoTargetNotFound     = 1134
+	SelectIntoPointsExceeded     = 1135
+	MeasurementScanLimited       = 1136
+	SelectLimitRequired          = 1137
+	ShardMapperTimeout           = 1138
+	ShowSourcesLimitExceeded     = 1139
+	ReadAvailabilityUnreachable  = 1140
+	DrainNodeUnreplicatedPt      = 1141
+	DrainNodeTimeout             = 1142
+	ShowSeriesLimitExceeded      = 1143
 )
 
 // promql2influxql
@@ -291,6 +306,8 @@ const (
 	OpsMapInValid                      = 4054
 	OpMarshalErr                       = 4055
 	SqlNodeNotFound                    = 4056
+	StreamSrcMeasurementNotFound       = 4057
+	ShardGroupDurationExceedsDuration  = 4058
 )
 
 // meta-client process
@@ -300,6 +317,7 @@ const (
 	InvalidPwdLooks    = 4103
 	InvalidPwdComplex  = 4104
 	InvalidUsernameLen = 4105
+	UserNotFound       = 4106
 )
 
 // write process
@@ -394,6 +412,7 @@ const (
 	ErrQuerySchemaUpperBound   = 6020
 	ErrValueTypeFullTextIndex  = 6021
 	ErrSearchSeriesKey         = 6022
+	ErrDeletePartialIndex      = 6023
 )
 
 const (
@@ -78,11 +78,14 @@ const (
 )
 
 type Error struct {
-	errno  Errno
-	msg    string
-	level  Level
-	stack  []byte
-	module Module
+	errno      Errno
+	msg        string
+	level      Level
+	stack      []byte
+	module     Module
+	retryAfter time.Duration
+	retries    uint32
+	retryWait  time.Duration
 }
 
 func (s *Error) Error() string {
@@ -134,6 +137,31 @@ func (s *Error) SetMessage(message string) {
 	s.msg = message
 }
 
+// SetRetryAfter sets the suggested delay before the rejected request is retried.
+func (s *Error) SetRetryAfter(d time.Duration) *Error {
+	s.retryAfter = d
+	return s
+}
+
+// RetryAfter returns the suggested delay before the rejected request is retried, 0 means no suggestion.
+func (s *Error) RetryAfter() time.Duration {
+	return s.retryAfter
+}
+
+// WithRetries returns a copy of the error recording the number of retries made before the request failed
+// and the time they took. The error itself is left untouched, as the same *Error may be returned to other requests.
+func (s *Error) WithRetries(retries uint32, wait time.Duration) *Error {
+	e := *s
+	e.retries = retries
+	e.retryWait = wait
+	return &e
+}
+
+// Retries returns the number of retries made before the request failed and the time they took.
+func (s *Error) Retries() (uint32, time.Duration) {
+	return s.retries, s.retryWait
+}
+
 func NewError(errno Errno, args ...interface{}) *Error {
 	msg, ok := messageMap[errno]
 	if !ok || msg == nil {
@@ -180,6 +208,24 @@ func Equal(err error, errno ...Errno) bool {
 	return false
 }
 
+// RetryAfter returns the suggested retry delay carried by err, 0 if err is not an *Error or has no suggestion.
+func RetryAfter(err error) time.Duration {
+	e, ok := err.(*Error)
+	if !ok {
+		return 0
+	}
+	return e.RetryAfter()
+}
+
+// Retries returns the number of retries and the time they took carried by err, 0 if err is not an *Error.
+func Retries(err error) (uint32, time.Duration) {
+	e, ok := err.(*Error)
+	if !ok {
+		return 0, 0
+	}
+	return e.Retries()
+}
+
 func NewBuiltIn(err error, module Module) *Error {
 	if e, ok := err.(*Error); ok {
 		return e
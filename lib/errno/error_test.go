@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/stretchr/testify/assert"
@@ -263,3 +264,37 @@ func BenchmarkErrsPool(b *testing.B) {
 		errno.NewErrsPool().Put(errs)
 	}
 }
+
+func TestRetryAfter(t *testing.T) {
+	err := errno.NewError(errno.RateLimited, "db0")
+	assert.Equal(t, time.Duration(0), errno.RetryAfter(err))
+
+	err.SetRetryAfter(time.Second)
+	assert.Equal(t, time.Second, errno.RetryAfter(err))
+	assert.Equal(t, time.Duration(0), errno.RetryAfter(errors.New("rate limited")))
+	assert.Equal(t, time.Duration(0), errno.RetryAfter(nil))
+}
+
+func TestRetries(t *testing.T) {
+	err := errno.NewError(errno.PtNotFound)
+	retries, wait := errno.Retries(err)
+	assert.Equal(t, uint32(0), retries)
+	assert.Equal(t, time.Duration(0), wait)
+
+	retried := err.WithRetries(150, 30*time.Second)
+	retries, wait = errno.Retries(retried)
+	assert.Equal(t, uint32(150), retries)
+	assert.Equal(t, 30*time.Second, wait)
+	assert.Equal(t, err.Errno(), retried.Errno())
+	assert.Equal(t, err.Error(), retried.Error())
+
+	// the shared error is not annotated
+	retries, wait = errno.Retries(err)
+	assert.Equal(t, uint32(0), retries)
+	assert.Equal(t, time.Duration(0), wait)
+
+	retries, _ = errno.Retries(errno.NewBuiltIn(errors.New("broken pipe"), errno.ModuleCoordinator).WithRetries(3, time.Second))
+	assert.Equal(t, uint32(3), retries)
+	retries, _ = errno.Retries(errors.New("broken pipe"))
+	assert.Equal(t, uint32(0), retries)
+}
@@ -245,6 +245,21 @@ var messageMap = map[Errno]*Message{
 	ErrInputTimeExceedTimeRange:    newFatalMessage("input time exceeds the query time range. start=%d, end=%d, time=%d", ModuleQueryEngine),
 	FailedPutNodeMaxIterNum:        newFatalMessage("failed to put the max iter num for the inc query, queryID=%s. [Node]", ModuleQueryEngine),
 	ApplyFuncErr:                   newWarnMessage("applyFuncErr, func=%s, err=%s", ModuleQueryEngine),
+	RateLimited:                    newWarnMessage("query rate limit exceeded for database(%s)", ModuleQueryEngine),
+	MaxConcurrentQueriesExceeded:   newWarnMessage("max-concurrent-queries limit exceeded(%d, %d)", ModuleQueryEngine),
+	NoDatabasePrivilege:            newWarnMessage("user has no %s privilege on database(%s)", ModuleQueryEngine),
+	SelectSourcesLimitExceeded:     newWarnMessage("the sources resolve to %d measurements, exceeding max-select-sources(%d)", ModuleQueryEngine),
+	SelectEmitBytesExceeded:        newWarnMessage("the query emitted %d bytes, exceeding max-select-emit-bytes(%d)", ModuleQueryEngine),
+	SelectIntoTargetNotFound:       newWarnMessage("the target measurement %s.%s.%s of SELECT INTO does not exist", ModuleQueryEngine),
+	SelectIntoPointsExceeded:       newWarnMessage("the INTO query wrote %d points, exceeding max-select-into-points(%d)", ModuleQueryEngine),
+	MeasurementScanLimited:         newWarnMessage("concurrent queries on measurement %s.%s exceeded the limit(%d)", ModuleQueryEngine),
+	SelectLimitRequired:            newWarnMessage("a LIMIT is required to query measurement %s.%s", ModuleQueryEngine),
+	ShardMapperTimeout:             newWarnMessage("shard mapping timed out after %v", ModuleQueryEngine),
+	ShowSourcesLimitExceeded:       newWarnMessage("the sources resolve to %d measurements, exceeding max-show-measurements(%d)", ModuleQueryEngine),
+	ReadAvailabilityUnreachable:    newWarnMessage("replica group %d of database %s has %d readable replicas, availability %s requires %d", ModuleQueryEngine),
+	DrainNodeUnreplicatedPt:        newWarnMessage("pt %d of database %s has no readable replica out of node %d, the node can not be drained", ModuleQueryEngine),
+	DrainNodeTimeout:               newWarnMessage("node %d is drained but still runs %d queries after %v", ModuleQueryEngine),
+	ShowSeriesLimitExceeded:        newWarnMessage("SHOW SERIES collects more than max-show-series(%d) series keys, add a LIMIT or narrow the condition", ModuleQueryEngine),
 
 	// query interface error codes
 	ReverseValueIllegal:     newWarnMessage("reverse value is illegal", ModuleQueryInterface),
@@ -324,6 +339,8 @@ var messageMap = map[Errno]*Message{
 	InValidNodeType:                    newWarnMessage("invalid node type: %s", ModuleMeta),
 	OpsMapInValid:                      newFatalMessage("opsMap invalid begin index: %d", ModuleMeta),
 	OpMarshalErr:                       newFatalMessage("op marshal err: %s", ModuleMeta),
+	StreamSrcMeasurementNotFound:       newWarnMessage("stream source measurement not found: %s.%s.%s", ModuleMeta),
+	ShardGroupDurationExceedsDuration:  newWarnMessage("shard group duration %s exceeds the duration %s of retention policy %s", ModuleMeta),
 
 	// http error codes
 	HttpUnauthorized:          newWarnMessage("authorization failed", ModuleHTTP),
@@ -340,6 +357,7 @@ var messageMap = map[Errno]*Message{
 		"and uppercase letters, lowercase letters, digits, and at least one of "+
 		"the special characters.", ModuleMetaClient),
 	InvalidUsernameLen: newNoticeMessage("the username needs to be between %d and %d characters long", ModuleMetaClient),
+	UserNotFound:       newNoticeMessage("user not found: %s", ModuleMetaClient),
 
 	// index error codes
 	ConvertToBinaryExprFailed:  newWarnMessage("convert to BinaryExpr failed: expr %T is not *influxql.BinaryExpr", ModuleIndex),
@@ -364,6 +382,7 @@ var messageMap = map[Errno]*Message{
 	ErrQuerySchemaUpperBound:   newNoticeMessage("max-select-schema limit exceeded: %d/%d", ModuleQueryEngine),
 	ErrValueTypeFullTextIndex:  newNoticeMessage("compare value of full text index should be string", ModuleQueryEngine),
 	ErrSearchSeriesKey:         newWarnMessage("SearchSeriesKey fail: io.EOF", ModuleIndex),
+	ErrDeletePartialIndex:      newWarnMessage("the time range covers the index %d [%v, %v) only in part, it must cover whole indexes to delete series", ModuleIndex),
 
 	// monitoring and statistics
 	WatchFileTimeout: newWarnMessage("watch file timeout", ModuleStat),
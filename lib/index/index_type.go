@@ -84,3 +84,8 @@ func GetIndexNameByType(id IndexType) (string, error) {
 	}
 	return name, nil
 }
+
+// GetIndexNameById returns the name of the index type of an oid in the index relation of a measurement.
+func GetIndexNameById(oid uint32) (string, error) {
+	return GetIndexNameByType(IndexType(oid))
+}
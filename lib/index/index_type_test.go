@@ -28,3 +28,29 @@ func TestGetIndexOidByName(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestGetIndexNameById(t *testing.T) {
+	for name, typ := range IndexNameToType {
+		oid, err := GetIndexTypeByName(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if oid != typ {
+			t.Fatalf("oid of %s is %d, expect %d", name, oid, typ)
+		}
+		got, err := GetIndexNameById(uint32(oid))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != name {
+			t.Fatalf("name of oid %d is %s, expect %s", oid, got, name)
+		}
+	}
+	if len(IndexNameToType) != int(IndexTypeAll) {
+		t.Fatalf("%d index names for %d index types", len(IndexNameToType), IndexTypeAll)
+	}
+
+	if _, err := GetIndexNameById(uint32(IndexTypeAll)); err == nil {
+		t.Fatal("expect an error for an unknown oid")
+	}
+}
@@ -18,6 +18,7 @@ package logger
 
 import (
 	"fmt"
+	"sync/atomic"
 
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/crypto"
@@ -43,6 +44,54 @@ func SetLevel(lev string) error {
 	return nil
 }
 
+const (
+	FormatAuto    = "auto"
+	FormatJSON    = "json"
+	FormatConsole = "console"
+)
+
+const (
+	jsonEncoding int32 = iota
+	consoleEncoding
+	encodingCount
+)
+
+// encoding is the encoding of the logs in use, it is changed by SetFormat.
+var encoding int32
+
+// format is the logging format in use as it was set, the encoding of auto is json.
+var format atomic.Value
+
+// Format returns the logging format in use, as it was configured or changed by SetFormat.
+func Format() string {
+	if f, ok := format.Load().(string); ok && f != "" {
+		return f
+	}
+	return FormatAuto
+}
+
+// encodingOf returns the encoding of the log format, the logs are written to files so auto is json.
+func encodingOf(format string) (int32, error) {
+	switch format {
+	case "", FormatAuto, FormatJSON:
+		return jsonEncoding, nil
+	case FormatConsole:
+		return consoleEncoding, nil
+	}
+	return 0, fmt.Errorf("unknown logging format %q, expect %s, %s or %s", format, FormatAuto, FormatJSON, FormatConsole)
+}
+
+// SetFormat changes the format of the logs written by every logger, those created before included.
+func SetFormat(f string) error {
+	enc, err := encodingOf(f)
+	if err != nil {
+		return err
+	}
+	atomic.StoreInt32(&encoding, enc)
+	format.Store(f)
+	return nil
+}
+
 var initHandler func(*zap.Logger)
 
 var level zapcore.Level
@@ -86,7 +135,10 @@ func getLogger(conf config.Logger) *zap.Logger {
 	hookError := conf.NewLumberjackLogger(makeErrFileName(conf.GetApp()))
 	hooks = append(hooks, hookNormal, hookError)
 
-	encoder := newEncoder()
+	if enc, err := encodingOf(conf.Format); err == nil {
+		atomic.StoreInt32(&encoding, enc)
+		format.Store(conf.Format)
+	}
 
 	logLevel := rewriteLevel(conf.Level)
 
@@ -97,14 +149,52 @@ func getLogger(conf config.Logger) *zap.Logger {
 	Alevel = zap.NewAtomicLevel()
 	Alevel.SetLevel(logLevel)
 
-	core := zapcore.NewTee(
-		zapcore.NewCore(encoder, zapcore.AddSync(hookNormal), Alevel),
-		zapcore.NewCore(encoder, zapcore.AddSync(hookError), levelError),
-	)
+	core := &switchCore{}
+	for enc := range core.cores {
+		encoder := newEncoder(int32(enc))
+		core.cores[enc] = zapcore.NewTee(
+			zapcore.NewCore(encoder, zapcore.AddSync(hookNormal), Alevel),
+			zapcore.NewCore(encoder, zapcore.AddSync(hookError), levelError),
+		)
+	}
 
 	return zap.New(core, zap.AddCaller(), zap.Development())
 }
 
+// switchCore writes the logs with the core of the encoding in use,
+// so that the format of the logs can be changed after the loggers are created.
+type switchCore struct {
+	cores [encodingCount]zapcore.Core
+}
+
+func (c *switchCore) current() zapcore.Core {
+	return c.cores[atomic.LoadInt32(&encoding)]
+}
+
+func (c *switchCore) Enabled(level zapcore.Level) bool {
+	return c.current().Enabled(level)
+}
+
+func (c *switchCore) With(fields []zapcore.Field) zapcore.Core {
+	clone := &switchCore{}
+	for enc, core := range c.cores {
+		clone.cores[enc] = core.With(fields)
+	}
+	return clone
+}
+
+func (c *switchCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	return c.current().Check(entry, checked)
+}
+
+func (c *switchCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	return c.current().Write(entry, fields)
+}
+
+func (c *switchCore) Sync() error {
+	return c.current().Sync()
+}
+
 func rewriteLevel(level zapcore.Level) zapcore.Level {
 	if level < zap.DebugLevel || level > zap.FatalLevel {
 		level = zap.InfoLevel
@@ -127,7 +217,7 @@ func closeHooks() {
 	hooks = nil
 }
 
-func newEncoder() zapcore.Encoder {
+func newEncoder(enc int32) zapcore.Encoder {
 	// log format
 	encoderConfig := zapcore.EncoderConfig{
 		TimeKey:        "time",
@@ -144,5 +234,8 @@ func newEncoder() zapcore.Encoder {
 		EncodeName:     zapcore.FullNameEncoder,
 	}
 
+	if enc == consoleEncoding {
+		return zapcore.NewConsoleEncoder(encoderConfig)
+	}
 	return zapcore.NewJSONEncoder(encoderConfig)
 }
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -203,6 +204,41 @@ func TestSetLevel(t *testing.T) {
 	}
 }
 
+func TestSetFormat(t *testing.T) {
+	filename, _ := initLogger(t, zapcore.InfoLevel)
+	defer func() {
+		_ = logger.SetFormat(logger.FormatAuto)
+	}()
+
+	// the loggers created before the format is changed write with the new format
+	lg := logger.GetLogger().With(zap.String("color", "red"))
+	lg.Info("json message")
+	assert.NoError(t, logger.SetFormat(logger.FormatConsole))
+	assert.Equal(t, logger.FormatConsole, logger.Format())
+	lg.Info("console message")
+	assert.NoError(t, logger.SetFormat(logger.FormatJSON))
+	lg.Info("json message again")
+	logger.CloseLogger()
+
+	assert.EqualError(t, logger.SetFormat("text"), `unknown logging format "text", expect auto, json or console`)
+	assert.Equal(t, logger.FormatJSON, logger.Format())
+
+	content, err := os.ReadFile(filename)
+	assert.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	if !assert.Len(t, lines, 3) {
+		return
+	}
+	for _, i := range []int{0, 2} {
+		var line map[string]interface{}
+		assert.NoError(t, json.Unmarshal([]byte(lines[i]), &line), lines[i])
+		assert.Equal(t, "red", line["color"])
+	}
+	assert.False(t, json.Valid([]byte(lines[1])), lines[1])
+	assert.Contains(t, lines[1], "console message")
+	assert.Contains(t, lines[1], `{"color": "red"}`)
+}
+
 func TestZapLogger(t *testing.T) {
 	lg := logger.NewLogger(errno.ModuleUnknown)
 
@@ -171,10 +171,12 @@ type MetaClient interface {
 	AliveReadNodes() ([]meta2.DataNode, error)
 	DeleteDataNode(id uint64) error
 	DeleteMetaNode(id uint64) error
+	DrainNode(id uint64, drained bool) error
 	DropShard(id uint64) error
 	DropSubscription(database, rp, name string) error
 	DropUser(name string) error
 	MetaNodes() ([]meta2.NodeInfo, error)
+	MetaLeader() (string, error)
 	RetentionPolicy(database, name string) (rpi *meta2.RetentionPolicyInfo, err error)
 	SetAdminPrivilege(username string, admin bool) error
 	SetPrivilege(username, database string, p originql.Privilege) error
@@ -220,6 +222,7 @@ type MetaClient interface {
 	GetStreamInfos() map[string]*meta2.StreamInfo
 	ShowStreams(database string, showAll bool) (models.Rows, error)
 	DropStream(name string) error
+	ShowDroppedStreamTargets(database string) []meta2.StreamMeasurementInfo
 	GetAllMst(dbName string) []string
 	RetryRegisterQueryIDOffset(host string) (uint64, error)
 	ThermalShards(db string, start, end time.Duration) map[uint64]struct{}
@@ -423,6 +426,7 @@ var applyFunc = map[proto2.Command_Type]func(c *Client, op *proto2.Command) erro
 	proto2.Command_RemoveNodeCommand:                applyRemoveNode,
 	proto2.Command_UpdateReplicationCommand:         applyUpdateReplication,
 	proto2.Command_UpdateMeasurementCommand:         applyUpdateMeasurement,
+	proto2.Command_DrainNodeCommand:                 applyDrainNode,
 }
 
 type authRcd struct {
@@ -600,6 +604,16 @@ func (c *Client) Ping(checkAllMetaServers bool) error {
 	return fmt.Errorf(string(callback.Leader))
 }
 
+// MetaLeader returns the raft address of the meta leader, which is the TCPHost of the leader meta node.
+func (c *Client) MetaLeader() (string, error) {
+	callback := &PingCallback{}
+	msg := message.NewMetaMessage(message.PingRequestMessage, &message.PingRequest{})
+	if err := c.SendRPCMsg(0, msg, callback); err != nil {
+		return "", err
+	}
+	return string(callback.Leader), nil
+}
+
 // ClusterID returns the ID of the cluster it's connected to.
 func (c *Client) ClusterID() uint64 {
 	c.mu.RLock()
@@ -655,6 +669,19 @@ func (c *Client) DataNodes() ([]meta2.DataNode, error) {
 	return c.cacheData.DataNodes, nil
 }
 
+// DrainNode marks a data node drained in meta, the new queries of all the sql nodes are routed away from it,
+// or routes them to it again.
+func (c *Client) DrainNode(id uint64, drained bool) error {
+	if _, err := c.DataNode(id); err != nil {
+		return err
+	}
+	cmd := &proto2.DrainNodeCommand{
+		NodeID:  proto.Uint64(id),
+		Drained: proto.Bool(drained),
+	}
+	return c.retryUntilExec(proto2.Command_DrainNodeCommand, proto2.E_DrainNodeCommand_Command, cmd)
+}
+
 func (c *Client) GetAllMst(dbName string) []string {
 	var mstName []string
 	c.mu.RLock()
@@ -3518,6 +3545,10 @@ func applyUpdateMeasurement(c *Client, cmd *proto2.Command) error {
 	return meta2.ApplyUpdateMeasurement(c.cacheData, cmd)
 }
 
+func applyDrainNode(c *Client, cmd *proto2.Command) error {
+	return meta2.ApplyDrainNode(c.cacheData, cmd)
+}
+
 func (c *Client) RetryDownSampleInfo() ([]byte, error) {
 	startTime := time.Now()
 	currentServer := connectedServer
@@ -3994,7 +4025,7 @@ func (c *Client) CreateStreamMeasurement(info *meta2.StreamInfo, src, dest *infl
 		return err
 	}
 
-	srcInfo, err := c.Measurement(src.Database, src.RetentionPolicy, src.Name)
+	srcInfo, shardKeyInfo, err := c.streamSource(src)
 	if err != nil {
 		return err
 	}
@@ -4017,23 +4048,52 @@ func (c *Client) CreateStreamMeasurement(info *meta2.StreamInfo, src, dest *infl
 		}
 		schemaInfo = meta2.NewSchemaInfo(tags, fields)
 	}
-	var shardKeyInfo *meta2.ShardKeyInfo
-	if len(srcInfo.ShardKeys) > 0 {
-		shardKeyInfo = &srcInfo.ShardKeys[0]
-	}
-	_, err = c.CreateMeasurement(dest.Database, dest.RetentionPolicy, dest.Name, shardKeyInfo, srcInfo.InitNumOfShards, nil, srcInfo.EngineType, colStoreInfo, schemaInfo, nil)
+	_, err = c.CreateMeasurement(dest.Database, dest.RetentionPolicy, dest.Name, shardKeyInfo, srcInfo.InitNumOfShards,
+		streamIndexRelation(srcInfo), srcInfo.EngineType, colStoreInfo, schemaInfo, nil)
 	if err != nil {
 		return err
 	}
 	return c.UpdateStreamMstSchema(dest.Database, dest.RetentionPolicy, dest.Name, stmt)
 }
 
+// streamSource returns the source measurement of a stream and the shard key inherited by its target measurement.
+// The shard key is nil when the source has none, the target then uses the shard key of its database.
+func (c *Client) streamSource(src *influxql.Measurement) (*meta2.MeasurementInfo, *meta2.ShardKeyInfo, error) {
+	srcInfo, err := c.Measurement(src.Database, src.RetentionPolicy, src.Name)
+	if err == meta2.ErrMeasurementNotFound {
+		return nil, nil, errno.NewError(errno.StreamSrcMeasurementNotFound, src.Database, src.RetentionPolicy, src.Name)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(srcInfo.ShardKeys) == 0 {
+		return srcInfo, nil, nil
+	}
+	return srcInfo, &srcInfo.ShardKeys[0], nil
+}
+
+// streamIndexRelation returns a copy of the index relation of the source measurement of a stream, inherited by its
+// target measurement. It is nil when the source has no index.
+func streamIndexRelation(srcInfo *meta2.MeasurementInfo) *influxql.IndexRelation {
+	if len(srcInfo.IndexRelation.Oids) == 0 {
+		return nil
+	}
+	return srcInfo.IndexRelation.Clone()
+}
+
 func (c *Client) ShowStreams(database string, showAll bool) (models.Rows, error) {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 	return c.cacheData.ShowStreams(database, showAll)
 }
 
+// ShowDroppedStreamTargets returns the existing target measurements of database left behind by the dropped streams.
+func (c *Client) ShowDroppedStreamTargets(database string) []meta2.StreamMeasurementInfo {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.cacheData.ShowDroppedStreamTargets(database)
+}
+
 func (c *Client) DropStream(name string) error {
 	cmd := &proto2.DropStreamCommand{
 		Name: proto.String(name),
@@ -25,6 +25,7 @@ import (
 	"github.com/openGemini/openGemini/engine/executor/spdy/transport"
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/index"
 	"github.com/openGemini/openGemini/lib/logger"
 	"github.com/openGemini/openGemini/lib/obs"
 	"github.com/openGemini/openGemini/lib/util/lifted/hashicorp/serf/serf"
@@ -2411,6 +2412,7 @@ var newPbFunc = map[proto2.Command_Type]func() (interface{}, *proto.ExtensionDes
 	proto2.Command_RemoveNodeCommand:                newRemoveNodePb,
 	proto2.Command_UpdateReplicationCommand:         newUpdateReplicationPb,
 	proto2.Command_UpdateMeasurementCommand:         newUpdateMeasurementPb,
+	proto2.Command_DrainNodeCommand:                 newDrainNodePb,
 }
 
 func newCreateDatabasePb() (interface{}, *proto.ExtensionDesc) {
@@ -2714,6 +2716,13 @@ func newUpdateMeasurementPb() (interface{}, *proto.ExtensionDesc) {
 	return &proto2.UpdateMeasurementCommand{}, proto2.E_UpdateMeasurementCommand_Command
 }
 
+func newDrainNodePb() (interface{}, *proto.ExtensionDesc) {
+	return &proto2.DrainNodeCommand{
+		NodeID:  proto.Uint64(1),
+		Drained: proto.Bool(true),
+	}, proto2.E_DrainNodeCommand_Command
+}
+
 func BuildCmd(t proto2.Command_Type) *proto2.Command {
 	cmd1, ext := newPbFunc[t]()
 	cmd2 := &proto2.Command{Type: &t}
@@ -2899,6 +2908,127 @@ func TestCreateStreamMeasurement(t *testing.T) {
 	}
 }
 
+func TestCreateStreamMeasurement_Source(t *testing.T) {
+	shardKey := meta2.ShardKeyInfo{ShardKey: []string{"tag1"}, Type: meta2.HASH}
+	c := &Client{
+		logger: logger.NewLogger(errno.ModuleMetaClient),
+		cacheData: &meta2.Data{
+			Databases: map[string]*meta2.DatabaseInfo{"db0": {
+				Name: "db0",
+				RetentionPolicies: map[string]*meta2.RetentionPolicyInfo{
+					"rp0": {
+						Name: "rp0",
+						Measurements: map[string]*meta2.MeasurementInfo{
+							"mst0": {Name: "mst0"},
+							"mst1": {Name: "mst1", ShardKeys: []meta2.ShardKeyInfo{shardKey}},
+						},
+						MstVersions: map[string]meta2.MeasurementVer{
+							"mst0": {NameWithVersion: "mst0", Version: 1},
+							"mst1": {NameWithVersion: "mst1", Version: 1},
+						},
+					},
+				},
+			}},
+		},
+	}
+	dest := &influxql.Measurement{Database: "db0", RetentionPolicy: "rp0", Name: "dst"}
+	info := &meta2.StreamInfo{Name: "stream0"}
+
+	// the source measurement is missing
+	src := &influxql.Measurement{Database: "db0", RetentionPolicy: "rp0", Name: "mst2"}
+	err := c.CreateStreamMeasurement(info, src, dest, &influxql.SelectStatement{})
+	require.True(t, errno.Equal(err, errno.StreamSrcMeasurementNotFound))
+	require.EqualError(t, err, "stream source measurement not found: db0.rp0.mst2")
+
+	// the retention policy of the source is missing
+	_, _, err = c.streamSource(&influxql.Measurement{Database: "db0", RetentionPolicy: "rp1", Name: "mst0"})
+	require.Error(t, err)
+	require.False(t, errno.Equal(err, errno.StreamSrcMeasurementNotFound))
+
+	// the source has no shard key, the target uses the shard key of the database
+	srcInfo, sk, err := c.streamSource(&influxql.Measurement{Database: "db0", RetentionPolicy: "rp0", Name: "mst0"})
+	require.NoError(t, err)
+	require.Equal(t, "mst0", srcInfo.Name)
+	require.Nil(t, sk)
+
+	srcInfo, sk, err = c.streamSource(&influxql.Measurement{Database: "db0", RetentionPolicy: "rp0", Name: "mst1"})
+	require.NoError(t, err)
+	require.Equal(t, "mst1", srcInfo.Name)
+	require.Equal(t, shardKey, *sk)
+}
+
+// createMeasurementSender keeps the CreateMeasurementCommand sent to the meta node and fails it.
+type createMeasurementSender struct {
+	cmd *proto2.CreateMeasurementCommand
+}
+
+func (s *createMeasurementSender) SendRPCMsg(_ int, msg *message.MetaMessage, callback transport.Callback) error {
+	cmd := &proto2.Command{}
+	if err := proto.Unmarshal(msg.Data().(*message.ExecuteRequest).Body, cmd); err != nil {
+		return err
+	}
+	if ext, err := proto.GetExtension(cmd, proto2.E_CreateMeasurementCommand_Command); err == nil {
+		s.cmd = ext.(*proto2.CreateMeasurementCommand)
+	}
+	callback.(*ExecuteAndReportCallback).ErrCommand = &errCommand{msg: "mock error"}
+	return nil
+}
+
+func TestCreateStreamMeasurement_IndexRelation(t *testing.T) {
+	indexR := influxql.IndexRelation{
+		Rid:        1,
+		Oids:       []uint32{uint32(index.BloomFilter)},
+		IndexNames: []string{index.BloomFilterIndex},
+		IndexList:  []*influxql.IndexList{{IList: []string{"tag1", "field1"}}},
+	}
+	sender := &createMeasurementSender{}
+	c := &Client{
+		logger:         logger.NewLogger(errno.ModuleMetaClient),
+		SendRPCMessage: sender,
+		metaServers:    []string{"127.0.0.1"},
+		closing:        make(chan struct{}),
+		cacheData: &meta2.Data{
+			Databases: map[string]*meta2.DatabaseInfo{"db0": {
+				Name: "db0",
+				RetentionPolicies: map[string]*meta2.RetentionPolicyInfo{
+					"rp0": {
+						Name: "rp0",
+						Measurements: map[string]*meta2.MeasurementInfo{
+							"mst0": {Name: "mst0", IndexRelation: indexR},
+							"mst1": {Name: "mst1"},
+						},
+						MstVersions: map[string]meta2.MeasurementVer{
+							"mst0": {NameWithVersion: "mst0", Version: 1},
+							"mst1": {NameWithVersion: "mst1", Version: 1},
+						},
+					},
+				},
+			}},
+		},
+	}
+	dest := &influxql.Measurement{Database: "db0", RetentionPolicy: "rp0", Name: "dst"}
+	info := &meta2.StreamInfo{Name: "stream0"}
+
+	// the target inherits the indexes of the source
+	src := &influxql.Measurement{Database: "db0", RetentionPolicy: "rp0", Name: "mst0"}
+	require.EqualError(t, c.CreateStreamMeasurement(info, src, dest, &influxql.SelectStatement{}), "mock error")
+	require.NotNil(t, sender.cmd)
+	require.NotNil(t, sender.cmd.GetIR())
+	got := meta2.DecodeIndexRelation(sender.cmd.GetIR())
+	assert.Equal(t, indexR.Oids, got.Oids)
+	assert.Equal(t, indexR.IndexNames, got.IndexNames)
+	assert.Equal(t, indexR.IndexList, got.IndexList)
+	// the index relation of the source is left as it is
+	assert.Equal(t, uint32(1), c.cacheData.Databases["db0"].RetentionPolicies["rp0"].Measurements["mst0"].IndexRelation.Rid)
+
+	// the target of a source without index has none
+	sender.cmd = nil
+	src = &influxql.Measurement{Database: "db0", RetentionPolicy: "rp0", Name: "mst1"}
+	require.EqualError(t, c.CreateStreamMeasurement(info, src, dest, &influxql.SelectStatement{}), "mock error")
+	require.NotNil(t, sender.cmd)
+	assert.Nil(t, sender.cmd.GetIR())
+}
+
 func TestMetaClientLocalExec(t *testing.T) {
 	meta2.DataLogger = logger.GetLogger().With(zap.String("service", "data"))
 	var c *Client = &Client{
@@ -27,6 +27,7 @@ type SeriesKeysRequest struct {
 	PtIDs                []uint32 `protobuf:"varint,2,rep,name=PtIDs" json:"PtIDs,omitempty"`
 	Measurements         []string `protobuf:"bytes,3,rep,name=Measurements" json:"Measurements,omitempty"`
 	Condition            *string  `protobuf:"bytes,4,opt,name=condition" json:"condition,omitempty"`
+	Rp                   *string  `protobuf:"bytes,5,opt,name=Rp" json:"Rp,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -84,6 +85,13 @@ func (m *SeriesKeysRequest) GetCondition() string {
 	return ""
 }
 
+func (m *SeriesKeysRequest) GetRp() string {
+	if m != nil && m.Rp != nil {
+		return *m.Rp
+	}
+	return ""
+}
+
 type SeriesKeysResponse struct {
 	Series               []string `protobuf:"bytes,1,rep,name=Series" json:"Series,omitempty"`
 	Err                  *string  `protobuf:"bytes,2,opt,name=Err" json:"Err,omitempty"`
@@ -1412,71 +1420,72 @@ func init() {
 func init() { proto.RegisterFile("lib/netstorage/data/data.proto", fileDescriptor_2aaddb15866ce618) }
 
 var fileDescriptor_2aaddb15866ce618 = []byte{
-	// 1054 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x06, 0x49, 0xc9, 0x8d, 0x46, 0xb1, 0x62, 0xd3, 0x3f, 0x20, 0xe4, 0xd4, 0x25, 0x78, 0x52,
-	0x03, 0x43, 0x02, 0x0c, 0x14, 0x4d, 0x53, 0x20, 0x68, 0xf4, 0x83, 0x40, 0x48, 0xd5, 0x2a, 0x2b,
-	0xa3, 0x87, 0xa0, 0x28, 0xb0, 0x32, 0xd7, 0xca, 0x22, 0x14, 0xc9, 0xee, 0xae, 0x52, 0x0b, 0xed,
-	0xa1, 0xaf, 0xd0, 0x3e, 0x40, 0x4f, 0x7d, 0x93, 0xde, 0xfa, 0x54, 0xc5, 0xfe, 0x90, 0x5c, 0xc9,
-	0x16, 0x8a, 0xf4, 0xd2, 0x8b, 0xb0, 0x33, 0xdc, 0x99, 0xf9, 0x66, 0xe6, 0x9b, 0x59, 0xc1, 0x79,
-	0x42, 0xe7, 0xbd, 0x94, 0x08, 0x2e, 0x32, 0x86, 0x17, 0xa4, 0x17, 0x63, 0x81, 0xd5, 0x4f, 0x37,
-	0x67, 0x99, 0xc8, 0xfc, 0x47, 0xd5, 0xb7, 0xae, 0x54, 0xb7, 0x2f, 0xa4, 0xc1, 0x4a, 0xd0, 0xa4,
-	0x97, 0xd0, 0x1b, 0x41, 0xe2, 0x1e, 0x4d, 0x6f, 0x92, 0xd5, 0x6d, 0x6f, 0x49, 0x04, 0xee, 0x29,
-	0x1b, 0x75, 0xd4, 0xe6, 0xd1, 0xcf, 0x70, 0x38, 0x23, 0x8c, 0x12, 0xfe, 0x8a, 0xac, 0x39, 0x22,
-	0x3f, 0xae, 0x08, 0x17, 0x7e, 0x0b, 0xdc, 0xe1, 0x3c, 0x70, 0x42, 0xb7, 0xd3, 0x40, 0xee, 0x70,
-	0xee, 0x1f, 0x43, 0x7d, 0x2a, 0xc6, 0x43, 0x1e, 0xb8, 0xa1, 0xd7, 0xd9, 0x47, 0x5a, 0xf0, 0x23,
-	0x78, 0x38, 0x21, 0x98, 0xaf, 0x18, 0x59, 0x92, 0x54, 0xf0, 0xc0, 0x0b, 0xbd, 0x4e, 0x03, 0x6d,
-	0xe8, 0xfc, 0xc7, 0xd0, 0xb8, 0xce, 0xd2, 0x98, 0x0a, 0x9a, 0xa5, 0x41, 0x2d, 0x74, 0x3a, 0x0d,
-	0x54, 0x29, 0xa2, 0xe7, 0xe0, 0xdb, 0xc1, 0x79, 0x9e, 0xa5, 0x9c, 0xf8, 0xa7, 0xb0, 0xa7, 0xb5,
-	0x81, 0xa3, 0x3c, 0x1a, 0xc9, 0x3f, 0x00, 0x6f, 0xc4, 0x58, 0xe0, 0x2a, 0x2f, 0xf2, 0x18, 0xfd,
-	0x02, 0xfe, 0xec, 0x6d, 0xf6, 0xd3, 0x15, 0x5e, 0xfc, 0x1f, 0xe8, 0x5f, 0xc0, 0xd1, 0x46, 0x74,
-	0x03, 0x3f, 0x80, 0x8f, 0x8c, 0xca, 0xe0, 0x2f, 0xc4, 0x7b, 0x12, 0x78, 0x09, 0x27, 0x03, 0x46,
-	0xb0, 0x20, 0x43, 0x2c, 0x70, 0x1f, 0x73, 0xb2, 0x2b, 0x87, 0x16, 0xb8, 0xb9, 0x08, 0xdc, 0xd0,
-	0xed, 0xec, 0x23, 0x37, 0x57, 0xdf, 0x59, 0x1e, 0x78, 0xfa, 0x3b, 0xcb, 0xa3, 0x27, 0x70, 0xba,
-	0xed, 0xc8, 0xc0, 0x31, 0x41, 0x9d, 0x2a, 0xe8, 0x1f, 0x0e, 0xb4, 0x66, 0x6b, 0x3e, 0x10, 0x2c,
-	0x29, 0xc2, 0x1d, 0x80, 0x37, 0xc9, 0x62, 0x13, 0x4f, 0x1e, 0xfd, 0xaf, 0xa0, 0x3e, 0xc5, 0x0c,
-	0x2f, 0x55, 0xd1, 0x9a, 0x97, 0x4f, 0xba, 0x5b, 0x34, 0xeb, 0x6e, 0x7a, 0xe8, 0xaa, 0xcb, 0xa3,
-	0x54, 0xb0, 0x35, 0xd2, 0x86, 0xed, 0xa7, 0x00, 0x95, 0x52, 0x46, 0x78, 0x47, 0xd6, 0x05, 0x8c,
-	0x77, 0x64, 0x2d, 0xdb, 0xf2, 0x1e, 0x27, 0x2b, 0x62, 0xea, 0xa1, 0x85, 0x67, 0xee, 0x53, 0x27,
-	0xfa, 0xd3, 0x81, 0x47, 0xa5, 0xfb, 0xed, 0x34, 0x5c, 0x93, 0x86, 0x3f, 0x84, 0x3d, 0x44, 0xf8,
-	0x2a, 0x11, 0x06, 0xe2, 0xc5, 0x6e, 0x88, 0xda, 0x47, 0x57, 0x5f, 0xd7, 0x20, 0x8d, 0x6d, 0xfb,
-	0x0b, 0x68, 0x5a, 0xea, 0x0f, 0x82, 0x99, 0x43, 0xfb, 0x25, 0x11, 0xb3, 0xb7, 0x98, 0xc5, 0xb3,
-	0x3c, 0xa1, 0x62, 0x9a, 0xd1, 0x54, 0x6c, 0xb0, 0xb0, 0x5f, 0x76, 0xb0, 0xef, 0xfb, 0x50, 0x93,
-	0xc4, 0x33, 0x3d, 0x54, 0x67, 0x49, 0x15, 0x65, 0x3e, 0x1e, 0xaa, 0x56, 0xd6, 0x50, 0x21, 0xca,
-	0xa8, 0xe3, 0xf8, 0x96, 0xf0, 0xa0, 0x16, 0x7a, 0x1d, 0x0f, 0x69, 0x21, 0x7a, 0x0d, 0x67, 0xf7,
-	0x46, 0x34, 0x35, 0x0a, 0xa1, 0x69, 0xa9, 0x0d, 0xfb, 0x6c, 0xd5, 0x3d, 0x0c, 0xfc, 0xcd, 0x81,
-	0xfd, 0x21, 0x49, 0x88, 0x20, 0xbb, 0x80, 0xb7, 0xc0, 0x45, 0xb9, 0x31, 0x71, 0x51, 0xae, 0xb8,
-	0xc2, 0x45, 0xe0, 0x69, 0x1f, 0x13, 0x2e, 0xfc, 0x36, 0x3c, 0x30, 0xb8, 0x35, 0xde, 0x1a, 0x2a,
-	0x65, 0xff, 0x1c, 0x40, 0xbb, 0xbf, 0x5a, 0xe7, 0x24, 0xa8, 0x87, 0x6e, 0xa7, 0x8e, 0x2c, 0x8d,
-	0x29, 0x4b, 0x1c, 0xec, 0x85, 0x8e, 0x29, 0x4b, 0x1c, 0x45, 0xd0, 0x2a, 0x20, 0xed, 0x24, 0xf1,
-	0x5f, 0x0e, 0x1c, 0x9b, 0xe9, 0xfb, 0x4e, 0x76, 0xe4, 0x03, 0xa7, 0xff, 0xb3, 0x6a, 0x48, 0x3d,
-	0xc5, 0x9e, 0xb3, 0x3b, 0xec, 0x99, 0xe0, 0xbc, 0x18, 0xed, 0x72, 0x82, 0x1f, 0x43, 0x63, 0xb0,
-	0xbd, 0x10, 0x4a, 0x85, 0x0c, 0xf5, 0x35, 0x5d, 0x52, 0x11, 0xd4, 0x43, 0xa7, 0x53, 0x47, 0x5a,
-	0x90, 0xd5, 0x19, 0x52, 0x9e, 0xb1, 0x98, 0x30, 0x95, 0xe5, 0x03, 0x54, 0xca, 0xd1, 0x1c, 0x4e,
-	0xb6, 0x92, 0xd8, 0x95, 0xb0, 0xff, 0x39, 0xec, 0xe9, 0x3b, 0x86, 0xee, 0x9f, 0xdc, 0x01, 0x5c,
-	0x7a, 0x99, 0x25, 0xf4, 0x9a, 0x20, 0x73, 0x3d, 0xea, 0x03, 0x54, 0xa9, 0x48, 0x8e, 0x58, 0x2b,
-	0xce, 0xd4, 0xc9, 0x56, 0xc9, 0x8e, 0xa8, 0xba, 0xb8, 0x8a, 0x3e, 0xea, 0x1c, 0xfd, 0x00, 0xad,
-	0x4d, 0xef, 0xff, 0xcd, 0x8f, 0x5c, 0xed, 0x26, 0x09, 0xbd, 0x6e, 0x0b, 0x8c, 0x7f, 0x3b, 0x10,
-	0x8c, 0x6e, 0xf1, 0xb5, 0x18, 0x60, 0x16, 0xd3, 0x14, 0x27, 0x54, 0xac, 0xcb, 0x5a, 0x7c, 0x0f,
-	0x4d, 0x4b, 0xad, 0x68, 0xdd, 0xbc, 0x7c, 0x76, 0x27, 0xfd, 0x5d, 0xf6, 0x5d, 0x4b, 0xa7, 0x67,
-	0xdf, 0x76, 0x77, 0x77, 0x24, 0xda, 0xcf, 0xe1, 0x60, 0xdb, 0xe4, 0xdf, 0xf6, 0x42, 0xcd, 0xde,
-	0x0b, 0xbf, 0x3a, 0xd0, 0x98, 0x8a, 0x82, 0x8f, 0x67, 0xe0, 0x4e, 0x75, 0x7d, 0x9a, 0x97, 0x4d,
-	0xfd, 0xe8, 0x76, 0x87, 0xf3, 0xa9, 0x40, 0xee, 0x54, 0xa8, 0x2a, 0xd2, 0x05, 0xc3, 0x66, 0x3c,
-	0x5c, 0x35, 0x1e, 0xb6, 0x4a, 0x56, 0xf1, 0xdb, 0x7c, 0x1c, 0x9b, 0xfd, 0xa0, 0xce, 0xd2, 0xea,
-	0x45, 0x42, 0xdf, 0x93, 0x41, 0x96, 0xa6, 0xe3, 0x58, 0xf1, 0xb0, 0x86, 0x6c, 0x55, 0x74, 0x0e,
-	0x20, 0x11, 0xec, 0x9c, 0x9e, 0xdf, 0x1d, 0x78, 0xf8, 0x7a, 0x45, 0xd8, 0x7a, 0x74, 0x4b, 0xc6,
-	0xe9, 0x4d, 0x26, 0x37, 0x91, 0x92, 0xc7, 0x43, 0x05, 0xb5, 0x86, 0x0a, 0x51, 0x02, 0x98, 0x89,
-	0xa5, 0x7e, 0x7b, 0x1a, 0x48, 0x9d, 0x15, 0xa5, 0xb1, 0xc0, 0x73, 0xcc, 0x89, 0x79, 0x83, 0x4a,
-	0x59, 0x8e, 0x48, 0x9f, 0x2c, 0x68, 0x7a, 0x45, 0x97, 0x24, 0xa8, 0x85, 0x6e, 0xc7, 0x43, 0x95,
-	0x42, 0x5a, 0xa2, 0x55, 0x3a, 0x13, 0x58, 0x14, 0xcb, 0xa0, 0x94, 0xa3, 0x37, 0xfa, 0x3d, 0x95,
-	0x81, 0xa9, 0x35, 0x0a, 0x03, 0xd8, 0xb7, 0xa1, 0x72, 0x43, 0x80, 0x8f, 0xef, 0x10, 0xc0, 0xbe,
-	0x85, 0x36, 0x6d, 0xa2, 0x0b, 0x38, 0x78, 0x45, 0x93, 0x44, 0x29, 0x8b, 0xce, 0xec, 0xcc, 0x39,
-	0x1a, 0xc1, 0xa1, 0x75, 0xbb, 0x7a, 0xd7, 0x47, 0x8c, 0x0d, 0xb2, 0x98, 0xa8, 0x4a, 0xee, 0xa3,
-	0x42, 0x94, 0xac, 0x1e, 0x31, 0x36, 0xe1, 0x0b, 0xc3, 0x22, 0x23, 0x45, 0x5d, 0x38, 0x9e, 0x91,
-	0x05, 0x23, 0x0b, 0x2c, 0xc8, 0x37, 0x59, 0x5c, 0x6e, 0xd8, 0x53, 0xd8, 0x93, 0xe2, 0x38, 0x36,
-	0x71, 0x8d, 0x14, 0x7d, 0x0a, 0x27, 0x5b, 0xf7, 0x77, 0x36, 0x90, 0xc2, 0x11, 0xc2, 0x37, 0x62,
-	0x42, 0x38, 0xc7, 0x8b, 0x6a, 0xf9, 0xd9, 0x8d, 0xd1, 0xb7, 0xab, 0xc6, 0x14, 0x9b, 0xd6, 0xad,
-	0x36, 0xad, 0xfc, 0x13, 0x64, 0xbb, 0x51, 0x4b, 0xfd, 0x21, 0xda, 0xd0, 0xc9, 0x2c, 0x36, 0x43,
-	0x55, 0x7f, 0xd3, 0x4c, 0xd6, 0x8e, 0x9d, 0x75, 0xff, 0xe8, 0xcd, 0x61, 0xf7, 0xcb, 0xad, 0xde,
-	0xfc, 0x13, 0x00, 0x00, 0xff, 0xff, 0x9a, 0x93, 0x89, 0xca, 0xc6, 0x0a, 0x00, 0x00,
+	// 1059 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x8f, 0xdb, 0x44,
+	0x14, 0x97, 0xed, 0x64, 0x69, 0x5e, 0xba, 0xdb, 0x5d, 0xef, 0x1f, 0x59, 0xd9, 0xb2, 0x44, 0x3e,
+	0x85, 0x6a, 0x95, 0x48, 0x2b, 0x21, 0x4a, 0x91, 0x2a, 0x9a, 0x38, 0xaa, 0xa2, 0x12, 0x48, 0x27,
+	0x2b, 0x0e, 0x15, 0x42, 0x9a, 0xac, 0x67, 0xd3, 0x51, 0x1d, 0xdb, 0xcc, 0x4c, 0xca, 0x46, 0xe2,
+	0xc0, 0x9d, 0x13, 0x7c, 0x00, 0x4e, 0x7c, 0x13, 0x6e, 0x7c, 0x2a, 0x34, 0x7f, 0x6c, 0x4f, 0x92,
+	0x8d, 0x50, 0xb9, 0x70, 0x89, 0xe6, 0xfd, 0x3c, 0xef, 0xff, 0xef, 0xbd, 0x09, 0x5c, 0x24, 0x74,
+	0xd6, 0x4b, 0x89, 0xe0, 0x22, 0x63, 0x78, 0x4e, 0x7a, 0x31, 0x16, 0x58, 0xfd, 0x74, 0x73, 0x96,
+	0x89, 0xcc, 0x7f, 0x54, 0x7d, 0xeb, 0x4a, 0xb8, 0x75, 0x29, 0x15, 0x96, 0x82, 0x26, 0xbd, 0x84,
+	0xde, 0x0a, 0x12, 0xf7, 0x68, 0x7a, 0x9b, 0x2c, 0xef, 0x7a, 0x0b, 0x22, 0x70, 0x4f, 0xe9, 0xa8,
+	0xa3, 0x56, 0x0f, 0x7f, 0x75, 0xe0, 0x68, 0x4a, 0x18, 0x25, 0xfc, 0x15, 0x59, 0x71, 0x44, 0x7e,
+	0x5c, 0x12, 0x2e, 0xfc, 0x03, 0x70, 0xa3, 0x59, 0xe0, 0xb4, 0xdd, 0x4e, 0x03, 0xb9, 0xd1, 0xcc,
+	0x3f, 0x81, 0xfa, 0x44, 0x8c, 0x22, 0x1e, 0xb8, 0x6d, 0xaf, 0xb3, 0x8f, 0xb4, 0xe0, 0x87, 0xf0,
+	0x70, 0x4c, 0x30, 0x5f, 0x32, 0xb2, 0x20, 0xa9, 0xe0, 0x81, 0xd7, 0xf6, 0x3a, 0x0d, 0xb4, 0x86,
+	0xf9, 0x8f, 0xa1, 0x71, 0x93, 0xa5, 0x31, 0x15, 0x34, 0x4b, 0x83, 0x5a, 0xdb, 0xe9, 0x34, 0x50,
+	0x05, 0x48, 0x3f, 0x28, 0x0f, 0xea, 0x0a, 0x76, 0x51, 0x1e, 0x3e, 0x07, 0xdf, 0x0e, 0x86, 0xe7,
+	0x59, 0xca, 0x89, 0x7f, 0x06, 0x7b, 0x1a, 0x0d, 0x1c, 0xe5, 0xc1, 0x48, 0xfe, 0x21, 0x78, 0x43,
+	0xc6, 0x02, 0x57, 0xa9, 0xcb, 0x63, 0xf8, 0x33, 0xf8, 0xd3, 0xb7, 0xd9, 0x4f, 0xd7, 0x78, 0xfe,
+	0x3f, 0x64, 0x13, 0xbe, 0x80, 0xe3, 0x35, 0xef, 0x26, 0xfc, 0x00, 0x3e, 0x32, 0x90, 0x89, 0xbf,
+	0x10, 0xef, 0x49, 0xe0, 0x25, 0x9c, 0x0e, 0x18, 0xc1, 0x82, 0x44, 0x58, 0xe0, 0x3e, 0xe6, 0x64,
+	0x57, 0x0e, 0x07, 0xe0, 0xe6, 0x22, 0x70, 0xdb, 0x6e, 0x67, 0x1f, 0xb9, 0xb9, 0xfa, 0xce, 0xf2,
+	0xc0, 0xd3, 0xdf, 0x59, 0x1e, 0x3e, 0x81, 0xb3, 0x4d, 0x43, 0x26, 0x1c, 0xe3, 0xd4, 0xa9, 0x9c,
+	0xfe, 0xe1, 0xc0, 0xc1, 0x74, 0xc5, 0x07, 0x82, 0x25, 0x85, 0xbb, 0x43, 0xf0, 0xc6, 0x59, 0x6c,
+	0xfc, 0xc9, 0xa3, 0xff, 0x15, 0xd4, 0x27, 0x98, 0xe1, 0x85, 0x2a, 0x5a, 0xf3, 0xea, 0x49, 0x77,
+	0x83, 0x77, 0xdd, 0x75, 0x0b, 0x5d, 0x75, 0x79, 0x98, 0x0a, 0xb6, 0x42, 0x5a, 0xb1, 0xf5, 0x14,
+	0xa0, 0x02, 0xa5, 0x87, 0x77, 0x64, 0x55, 0x84, 0xf1, 0x8e, 0xac, 0x64, 0x5b, 0xde, 0xe3, 0x64,
+	0x49, 0x4c, 0x3d, 0xb4, 0xf0, 0xcc, 0x7d, 0xea, 0x84, 0x7f, 0x3a, 0xf0, 0xa8, 0x34, 0xbf, 0x99,
+	0x86, 0x6b, 0xd2, 0xf0, 0x23, 0xd8, 0x43, 0x84, 0x2f, 0x13, 0x61, 0x42, 0xbc, 0xdc, 0x1d, 0xa2,
+	0xb6, 0xd1, 0xd5, 0xd7, 0x75, 0x90, 0x46, 0xb7, 0xf5, 0x05, 0x34, 0x2d, 0xf8, 0x83, 0xc2, 0xcc,
+	0xa1, 0xf5, 0x92, 0x88, 0xe9, 0x5b, 0xcc, 0xe2, 0x69, 0x9e, 0x50, 0x31, 0xc9, 0x68, 0x2a, 0xd6,
+	0x58, 0xd8, 0x2f, 0x3b, 0xd8, 0xf7, 0x7d, 0xa8, 0x49, 0xe2, 0x99, 0x1e, 0xaa, 0xb3, 0xa4, 0x8a,
+	0x52, 0x1f, 0x45, 0xaa, 0x95, 0x35, 0x54, 0x88, 0xd2, 0xeb, 0x28, 0xbe, 0x23, 0x3c, 0xa8, 0xb5,
+	0xbd, 0x8e, 0x87, 0xb4, 0x10, 0xbe, 0x86, 0xf3, 0x7b, 0x3d, 0x9a, 0x1a, 0xb5, 0xa1, 0x69, 0xc1,
+	0x86, 0x7d, 0x36, 0x74, 0x0f, 0x03, 0x7f, 0x73, 0x60, 0x3f, 0x22, 0x09, 0x11, 0x64, 0x57, 0xe0,
+	0x7a, 0x68, 0xdd, 0x62, 0x68, 0x15, 0x57, 0xb8, 0x08, 0x3c, 0x6d, 0x63, 0xcc, 0x85, 0xdf, 0x82,
+	0x07, 0x26, 0x6e, 0x1d, 0x6f, 0x0d, 0x95, 0xb2, 0x7f, 0x01, 0xa0, 0xcd, 0x5f, 0xaf, 0x72, 0x12,
+	0xd4, 0xdb, 0x6e, 0xa7, 0x8e, 0x2c, 0xc4, 0x94, 0x25, 0x0e, 0xf6, 0xda, 0x8e, 0x29, 0x4b, 0x1c,
+	0x86, 0x70, 0x50, 0x84, 0xb4, 0x93, 0xc4, 0x7f, 0x39, 0x70, 0x62, 0xa6, 0xef, 0x3b, 0xd9, 0x91,
+	0x0f, 0x9c, 0xfe, 0xcf, 0xaa, 0x21, 0xf5, 0x14, 0x7b, 0xce, 0xb7, 0xd8, 0x33, 0xc6, 0x79, 0x31,
+	0xda, 0xe5, 0x04, 0x3f, 0x86, 0xc6, 0x60, 0x73, 0x21, 0x94, 0x80, 0x74, 0xf5, 0x35, 0x5d, 0x50,
+	0xa1, 0x36, 0x5c, 0x1d, 0x69, 0x41, 0x56, 0x27, 0xa2, 0x3c, 0x63, 0x31, 0x61, 0x2a, 0xcb, 0x07,
+	0xa8, 0x94, 0xc3, 0x19, 0x9c, 0x6e, 0x24, 0xb1, 0x2b, 0x61, 0xff, 0x73, 0xd8, 0xd3, 0x77, 0x0c,
+	0xdd, 0x3f, 0xd9, 0x0a, 0xb8, 0xb4, 0x32, 0x4d, 0xe8, 0x0d, 0x41, 0xe6, 0x7a, 0xd8, 0x07, 0xa8,
+	0x52, 0x91, 0x1c, 0xb1, 0x56, 0x9c, 0xa9, 0x93, 0x0d, 0xc9, 0x8e, 0xa8, 0xba, 0xb8, 0x8a, 0x3e,
+	0xea, 0x1c, 0xfe, 0x00, 0x07, 0xeb, 0xd6, 0xff, 0x9b, 0x1d, 0xb9, 0xda, 0x4d, 0x12, 0x7a, 0xdd,
+	0x16, 0x31, 0xfe, 0xed, 0x40, 0x30, 0xbc, 0xc3, 0x37, 0x62, 0x80, 0x59, 0x4c, 0x53, 0x9c, 0x50,
+	0xb1, 0x2a, 0x6b, 0xf1, 0x3d, 0x34, 0x2d, 0x58, 0xd1, 0xba, 0x79, 0xf5, 0x6c, 0x2b, 0xfd, 0x5d,
+	0xfa, 0x5d, 0x0b, 0xd3, 0xb3, 0x6f, 0x9b, 0xdb, 0x1e, 0x89, 0xd6, 0x73, 0x38, 0xdc, 0x54, 0xf9,
+	0xb7, 0xbd, 0x50, 0xb3, 0xf7, 0xc2, 0x2f, 0x0e, 0x34, 0x26, 0xa2, 0xe0, 0xe3, 0x39, 0xb8, 0x13,
+	0x5d, 0x9f, 0xe6, 0x55, 0x53, 0xbf, 0xc2, 0xdd, 0x68, 0x36, 0x11, 0xc8, 0x9d, 0x08, 0x55, 0x45,
+	0x3a, 0x67, 0xd8, 0x8c, 0x87, 0xab, 0xc6, 0xc3, 0x86, 0x64, 0x15, 0xbf, 0xcd, 0x47, 0xb1, 0xd9,
+	0x0f, 0xea, 0x2c, 0xb5, 0x5e, 0x24, 0xf4, 0x3d, 0x19, 0x64, 0x69, 0x3a, 0x8a, 0x15, 0x0f, 0x6b,
+	0xc8, 0x86, 0xc2, 0x0b, 0x00, 0x19, 0xc1, 0xce, 0xe9, 0xf9, 0xdd, 0x81, 0x87, 0xaf, 0x97, 0x84,
+	0xad, 0x86, 0x77, 0x64, 0x94, 0xde, 0x66, 0x72, 0x13, 0x29, 0x79, 0x14, 0xa9, 0x50, 0x6b, 0xa8,
+	0x10, 0x65, 0x00, 0x53, 0xb1, 0xd0, 0x6f, 0x4f, 0x03, 0xa9, 0xb3, 0xa2, 0x34, 0x16, 0x78, 0x86,
+	0x39, 0x31, 0x6f, 0x50, 0x29, 0xcb, 0x11, 0xe9, 0x93, 0x39, 0x4d, 0xaf, 0xe9, 0x82, 0x04, 0xb5,
+	0xb6, 0xdb, 0xf1, 0x50, 0x05, 0x48, 0x4d, 0xb4, 0x4c, 0xa7, 0x02, 0x8b, 0x62, 0x19, 0x94, 0x72,
+	0xf8, 0x46, 0xbf, 0xa7, 0xd2, 0x31, 0xb5, 0x46, 0x61, 0x00, 0xfb, 0x76, 0xa8, 0xdc, 0x10, 0xe0,
+	0xe3, 0x2d, 0x02, 0xd8, 0xb7, 0xd0, 0xba, 0x4e, 0x78, 0x09, 0x87, 0xaf, 0x68, 0x92, 0x28, 0xb0,
+	0xe8, 0xcc, 0xce, 0x9c, 0xc3, 0x21, 0x1c, 0x59, 0xb7, 0xab, 0x77, 0x7d, 0xc8, 0xd8, 0x20, 0x8b,
+	0x89, 0xaa, 0xe4, 0x3e, 0x2a, 0x44, 0xc9, 0xea, 0x21, 0x63, 0x63, 0x3e, 0x37, 0x2c, 0x32, 0x52,
+	0xd8, 0x85, 0x93, 0x29, 0x99, 0x33, 0x32, 0xc7, 0x82, 0x7c, 0x93, 0xc5, 0xe5, 0x86, 0x3d, 0x83,
+	0x3d, 0x29, 0x8e, 0x62, 0xe3, 0xd7, 0x48, 0xe1, 0xa7, 0x70, 0xba, 0x71, 0x7f, 0x67, 0x03, 0x29,
+	0x1c, 0x23, 0x7c, 0x2b, 0xc6, 0x84, 0x73, 0x3c, 0xaf, 0x96, 0x9f, 0xdd, 0x18, 0x7d, 0xbb, 0x6a,
+	0x4c, 0xb1, 0x69, 0xdd, 0x6a, 0xd3, 0xca, 0x3f, 0x41, 0xb6, 0x19, 0xb5, 0xd4, 0x1f, 0xa2, 0x35,
+	0x4c, 0x66, 0xb1, 0xee, 0xaa, 0xfa, 0x9b, 0x66, 0xb2, 0x76, 0xec, 0xac, 0xfb, 0xc7, 0x6f, 0x8e,
+	0xba, 0x5f, 0x6e, 0xf4, 0xe6, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc0, 0x77, 0x9f, 0xe5, 0xd7,
+	0x0a, 0x00, 0x00,
 }
@@ -99,7 +99,8 @@ type Engine interface {
 
 	TagValues(db string, ptId []uint32, tagKeys map[string][][]byte, condition influxql.Expr, tr influxql.TimeRange) (TablesTagSets, error)
 	TagValuesCardinality(db string, ptIDs []uint32, tagKeys map[string][][]byte, condition influxql.Expr, tr influxql.TimeRange) (map[string]uint64, error)
-	DropSeries(database string, sources []influxql.Source, ptId []uint32, condition influxql.Expr) (int, error)
+	DeleteSeries(db, rp string, ptIDs []uint32, measurements [][]byte, condition influxql.Expr, tr influxql.TimeRange) error
+	DropSeries(db string, ptIDs []uint32, measurements [][]byte, condition influxql.Expr) error
 
 	DbPTRef(db string, ptId uint32) error
 	DbPTUnref(db string, ptId uint32)
@@ -62,6 +62,12 @@ const (
 
 	RaftMessagesRequestMessage
 	RaftMessagesResponseMessage
+
+	DeleteSeriesRequestMessage
+	DeleteSeriesResponseMessage
+
+	DropSeriesRequestMessage
+	DropSeriesResponseMessage
 )
 
 var MessageBinaryCodec = make(map[uint8]func() codec.BinaryCodec, 20)
@@ -94,6 +100,10 @@ func init() {
 	MessageBinaryCodec[ShowTagKeysResponseMessage] = func() codec.BinaryCodec { return &ShowTagKeysResponse{} }
 	MessageBinaryCodec[RaftMessagesRequestMessage] = func() codec.BinaryCodec { return &RaftMessagesRequest{} }
 	MessageBinaryCodec[RaftMessagesResponseMessage] = func() codec.BinaryCodec { return &RaftMessagesResponse{} }
+	MessageBinaryCodec[DeleteSeriesRequestMessage] = func() codec.BinaryCodec { return &DeleteSeriesRequest{} }
+	MessageBinaryCodec[DeleteSeriesResponseMessage] = func() codec.BinaryCodec { return &DeleteSeriesResponse{} }
+	MessageBinaryCodec[DropSeriesRequestMessage] = func() codec.BinaryCodec { return &DropSeriesRequest{} }
+	MessageBinaryCodec[DropSeriesResponseMessage] = func() codec.BinaryCodec { return &DropSeriesResponse{} }
 
 	MessageResponseTyp = map[uint8]uint8{
 		SeriesKeysRequestMessage:               SeriesKeysResponseMessage,
@@ -108,5 +118,7 @@ func init() {
 		KillQueryRequestMessage:                KillQueryResponseMessage,
 		ShowTagKeysRequestMessage:              ShowTagKeysResponseMessage,
 		RaftMessagesRequestMessage:             RaftMessagesResponseMessage,
+		DeleteSeriesRequestMessage:             DeleteSeriesResponseMessage,
+		DropSeriesRequestMessage:               DropSeriesResponseMessage,
 	}
 }
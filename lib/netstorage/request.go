@@ -175,6 +175,49 @@ func (r *SeriesKeysResponse) Error() error {
 	return NormalizeError(r.Err)
 }
 
+// DeleteSeriesRequest asks a store node to delete the series of the measurements of the retention policy
+// matching the condition.
+type DeleteSeriesRequest struct {
+	SeriesKeysRequest
+}
+
+type DeleteSeriesResponse struct {
+	internal2.DeleteResponse
+}
+
+func (r *DeleteSeriesResponse) MarshalBinary() ([]byte, error) {
+	return proto.Marshal(&r.DeleteResponse)
+}
+
+func (r *DeleteSeriesResponse) UnmarshalBinary(buf []byte) error {
+	return proto.Unmarshal(buf, &r.DeleteResponse)
+}
+
+func (r *DeleteSeriesResponse) Error() error {
+	return NormalizeError(r.Err)
+}
+
+// DropSeriesRequest asks a store node to drop the series of the measurements matching the condition at any time.
+type DropSeriesRequest struct {
+	SeriesKeysRequest
+}
+
+type DropSeriesResponse struct {
+	internal2.DeleteResponse
+}
+
+func (r *DropSeriesResponse) MarshalBinary() ([]byte, error) {
+	return proto.Marshal(&r.DeleteResponse)
+}
+
+func (r *DropSeriesResponse) UnmarshalBinary(buf []byte) error {
+	return proto.Unmarshal(buf, &r.DeleteResponse)
+}
+
+func (r *DropSeriesResponse) Error() error {
+	return NormalizeError(r.Err)
+}
+
 type SeriesCardinalityRequest struct {
 	SeriesKeysRequest
 }
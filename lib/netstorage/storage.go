@@ -58,6 +58,8 @@ type Storage interface {
 	ShowSeries(nodeID uint64, db string, ptId []uint32, measurements []string, condition influxql.Expr) ([]string, error)
 	SeriesCardinality(nodeID uint64, db string, dbPts []uint32, measurements []string, condition influxql.Expr) ([]meta2.MeasurementCardinalityInfo, error)
 	SeriesExactCardinality(nodeID uint64, db string, dbPts []uint32, measurements []string, condition influxql.Expr) (map[string]uint64, error)
+	DeleteSeries(nodeID uint64, db, rp string, dbPts []uint32, measurements []string, condition influxql.Expr) error
+	DropSeries(nodeID uint64, db string, dbPts []uint32, measurements []string, condition influxql.Expr) error
 
 	SendQueryRequestOnNode(nodeID uint64, req SysCtrlRequest) (map[string]string, error)
 	SendSysCtrlOnNode(nodID uint64, req SysCtrlRequest) (map[string]string, error)
@@ -333,6 +335,51 @@ func (s *NetStorage) SeriesExactCardinality(nodeID uint64, db string, dbPts []ui
 	return resp.Cardinality, resp.Error()
 }
 
+func (s *NetStorage) DeleteSeries(nodeID uint64, db, rp string, dbPts []uint32, measurements []string, condition influxql.Expr) error {
+	req := &DeleteSeriesRequest{}
+	req.Db = proto.String(db)
+	req.Rp = proto.String(rp)
+	req.PtIDs = dbPts
+	req.Measurements = measurements
+	if condition != nil {
+		req.Condition = proto.String(condition.String())
+	}
+
+	v, err := s.ddlRequestWithNodeId(nodeID, DeleteSeriesRequestMessage, req)
+	if err != nil {
+		return err
+	}
+
+	resp, ok := v.(*DeleteSeriesResponse)
+	if !ok {
+		return executor.NewInvalidTypeError("*netstorage.DeleteSeriesResponse", v)
+	}
+
+	return resp.Error()
+}
+
+func (s *NetStorage) DropSeries(nodeID uint64, db string, dbPts []uint32, measurements []string, condition influxql.Expr) error {
+	req := &DropSeriesRequest{}
+	req.Db = proto.String(db)
+	req.PtIDs = dbPts
+	req.Measurements = measurements
+	if condition != nil {
+		req.Condition = proto.String(condition.String())
+	}
+
+	v, err := s.ddlRequestWithNodeId(nodeID, DropSeriesRequestMessage, req)
+	if err != nil {
+		return err
+	}
+
+	resp, ok := v.(*DropSeriesResponse)
+	if !ok {
+		return executor.NewInvalidTypeError("*netstorage.DropSeriesResponse", v)
+	}
+
+	return resp.Error()
+}
+
 func (s *NetStorage) ShowTagKeys(nodeID uint64, db string, ptIDs []uint32, measurements []string, condition influxql.Expr) ([]string, error) {
 	req := &ShowTagKeysRequest{}
 	req.Db = proto.String(db)
@@ -0,0 +1,48 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package netstorage
+
+import (
+	"sync"
+
+	"github.com/openGemini/openGemini/engine/executor/spdy/transport"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+)
+
+// WarmupConnections establishes the connection pools of the queries to the data nodes in advance, so that the first
+// queries after a restart do not wait for the connections to be dialed. The nodes are dialed concurrently, the errors
+// of the nodes which can not be connected are returned by node ID, those nodes are dialed again by the queries.
+func WarmupConnections(nodes []meta2.DataNode) map[uint64]error {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	errs := make(map[uint64]error)
+	for i := range nodes {
+		node := &nodes[i]
+		transport.NewNodeManager().Add(node.ID, node.TCPHost)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := transport.NewNodeManager().Get(node.ID).Warmup(); err != nil {
+				mu.Lock()
+				errs[node.ID] = err
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	return errs
+}
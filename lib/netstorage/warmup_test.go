@@ -0,0 +1,67 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package netstorage
+
+import (
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/engine/executor/spdy"
+	"github.com/openGemini/openGemini/engine/executor/spdy/transport"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWarmupConnections(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+	var accepted int64
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			atomic.AddInt64(&accepted, 1)
+			_ = conn.Close()
+		}
+	}()
+
+	// the port of a closed listener refuses the connections
+	down, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	require.NoError(t, down.Close())
+
+	errs := WarmupConnections(nil)
+	assert.Empty(t, errs)
+
+	errs = WarmupConnections([]meta2.DataNode{*newDataNode(1001, ln.Addr().String()), *newDataNode(1002, down.Addr().String())})
+	require.Len(t, errs, 1)
+	assert.Error(t, errs[1002])
+
+	// every node is attempted, all the connections of the node up are established
+	assert.NotNil(t, transport.NewNodeManager().Get(1001))
+	assert.NotNil(t, transport.NewNodeManager().Get(1002))
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt64(&accepted) == int64(spdy.ConnPoolSize())
+	}, time.Second, 10*time.Millisecond)
+	transport.NewNodeManager().Get(1001).Close()
+}
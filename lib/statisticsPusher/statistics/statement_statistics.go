@@ -0,0 +1,102 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statistics
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics/opsStat"
+)
+
+const statementStatisticsName = "statement"
+
+// StatementStatistics counts the statements executed by the node by statement type, such as select,
+// show_measurements or create_database, so that the composition of the queries can be followed.
+type StatementStatistics struct {
+	mu     sync.RWMutex
+	counts map[string]*int64
+	tags   map[string]string
+}
+
+var StatementStat = NewStatementStatistics()
+
+func NewStatementStatistics() *StatementStatistics {
+	return &StatementStatistics{counts: make(map[string]*int64)}
+}
+
+func InitStatementStatistics(tags map[string]string) {
+	StatementStat = NewStatementStatistics()
+	StatementStat.tags = tags
+}
+
+// Add counts a statement of the type.
+func (s *StatementStatistics) Add(typ string) {
+	s.mu.RLock()
+	n, ok := s.counts[typ]
+	s.mu.RUnlock()
+	if !ok {
+		s.mu.Lock()
+		if n, ok = s.counts[typ]; !ok {
+			n = new(int64)
+			s.counts[typ] = n
+		}
+		s.mu.Unlock()
+	}
+	atomic.AddInt64(n, 1)
+}
+
+// Count returns the number of the statements of the type executed.
+func (s *StatementStatistics) Count(typ string) int64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if n, ok := s.counts[typ]; ok {
+		return atomic.LoadInt64(n)
+	}
+	return 0
+}
+
+// Report returns the number of the statements executed of each statement type.
+func (s *StatementStatistics) Report() map[string]interface{} {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	valueMap := make(map[string]interface{}, len(s.counts))
+	for typ, n := range s.counts {
+		valueMap[typ] = atomic.LoadInt64(n)
+	}
+	return valueMap
+}
+
+func CollectStatementStatistics(buffer []byte) ([]byte, error) {
+	valueMap := StatementStat.Report()
+	if len(valueMap) == 0 {
+		return buffer, nil
+	}
+	return AddPointToBuffer(statementStatisticsName, StatementStat.tags, valueMap, buffer), nil
+}
+
+func CollectOpsStatementStatistics() []opsStat.OpsStatistic {
+	valueMap := StatementStat.Report()
+	if len(valueMap) == 0 {
+		return nil
+	}
+	return []opsStat.OpsStatistic{{
+		Name:   statementStatisticsName,
+		Tags:   StatementStat.tags,
+		Values: valueMap,
+	}}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statistics_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatementStatistics(t *testing.T) {
+	tags := map[string]string{"hostname": "127.0.0.1:8086"}
+	statistics.NewTimestamp().Init(time.Second)
+	statistics.InitStatementStatistics(tags)
+
+	// nothing is collected before a statement is executed
+	buf, err := statistics.CollectStatementStatistics(nil)
+	require.NoError(t, err)
+	assert.Empty(t, buf)
+	assert.Empty(t, statistics.CollectOpsStatementStatistics())
+
+	stat := statistics.StatementStat
+	stat.Add("select")
+	stat.Add("select")
+	stat.Add("show_measurements")
+	assert.Equal(t, int64(2), stat.Count("select"))
+	assert.Equal(t, int64(1), stat.Count("show_measurements"))
+	assert.Equal(t, int64(0), stat.Count("create_database"))
+
+	buf, err = statistics.CollectStatementStatistics(nil)
+	require.NoError(t, err)
+	require.NoError(t, compareBuffer("statement", tags, map[string]interface{}{"select": float64(2), "show_measurements": float64(1)}, buf))
+
+	// the counters are cumulative
+	stats := statistics.CollectOpsStatementStatistics()
+	require.Len(t, stats, 1)
+	assert.Equal(t, tags, stats[0].Tags)
+	assert.Equal(t, map[string]interface{}{"select": int64(2), "show_measurements": int64(1)}, stats[0].Values)
+}
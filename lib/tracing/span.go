@@ -28,6 +28,7 @@ import (
 
 const (
 	nameValuePrefix = "__name__"
+	ppPrefix        = "pp="
 )
 
 type Span struct {
@@ -121,7 +122,7 @@ func (s *Span) EndPP() {
 func (s *Span) Finish() {
 	s.EndPP()
 	if s.elapsed > 0 {
-		s.SetNameValue("pp=" + time.Duration(s.elapsed).String())
+		s.SetNameValue(ppPrefix + time.Duration(s.elapsed).String())
 	}
 
 	if s.counters != nil {
@@ -57,7 +57,27 @@ func (t *Trace) Tree() *tracing.TreeNode {
 	return t.trace.Tree()
 }
 
+// Verbosity controls how much of the span tree is rendered.
+type Verbosity uint8
+
+const (
+	// VerbosityDefault renders every span with its labels and fields.
+	VerbosityDefault Verbosity = iota
+	// VerbosityVerbose renders every span with its labels and fields, as by default.
+	VerbosityVerbose
+	// VerbositySummary renders the top-level spans only, the sub spans are collapsed
+	// into the number of spans and their aggregate duration.
+	VerbositySummary
+	// VerbosityBrief renders every span with its fields only.
+	VerbosityBrief
+)
+
 func (t *Trace) String() string {
+	return t.Render(VerbosityDefault)
+}
+
+// Render returns the span tree rendered with the given verbosity.
+func (t *Trace) Render(verbosity Verbosity) string {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
 
@@ -67,7 +87,14 @@ func (t *Trace) String() string {
 		tracing.Walk(mv, tree)
 	}
 
+	if verbosity == VerbositySummary {
+		sv := newSummaryVisitor()
+		tracing.Walk(sv, tree)
+		return sv.root.String()
+	}
+
 	tv := newTreeVisitor()
+	tv.withLabels = verbosity != VerbosityBrief
 	tracing.Walk(tv, tree)
 	return tv.root.String()
 }
@@ -20,6 +20,7 @@ import (
 	"context"
 	"regexp"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -106,3 +107,33 @@ func makeTrace() (*tracing.Trace, *tracing.Span) {
 
 	return trace, span
 }
+
+func TestTraceRender(t *testing.T) {
+	trace, span := makeTrace()
+	other, _ := makeTrace()
+	trace.AddSub(other, span)
+
+	verbose := trace.Render(tracing.VerbosityVerbose)
+	assert.Equal(t, trace.String(), verbose)
+	assert.Contains(t, verbose, "node_id: 10")
+
+	def := trace.Render(tracing.VerbosityDefault)
+	assert.Equal(t, verbose, def)
+
+	brief := trace.Render(tracing.VerbosityBrief)
+	assert.NotContains(t, brief, "node_id: 10")
+	assert.Contains(t, brief, "pp: 123.45ms")
+
+	summary := trace.Render(tracing.VerbositySummary)
+	assert.Regexp(t, regexp.MustCompile(`.
+└── root
+    └── remote_iterator:pp=[\d\\.]+(ns|µs|ms)
+        ├── collapsed_spans: 2
+        └── collapsed_pp: [\d\\.]+(ns|µs|ms)`), summary)
+
+	lines := func(s string) int {
+		return len(strings.Split(s, "\n"))
+	}
+	assert.Greater(t, lines(def), lines(brief))
+	assert.Greater(t, lines(brief), lines(summary))
+}
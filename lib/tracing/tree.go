@@ -19,8 +19,10 @@ package tracing
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/influxdata/influxdb/pkg/tracing"
+	"github.com/influxdata/influxdb/pkg/tracing/fields"
 	"github.com/xlab/treeprint"
 )
 
@@ -46,8 +48,9 @@ func (v *mergeVisitor) Visit(n *tracing.TreeNode) tracing.Visitor {
 }
 
 type treeVisitor struct {
-	root  treeprint.Tree
-	trees []treeprint.Tree
+	root       treeprint.Tree
+	trees      []treeprint.Tree
+	withLabels bool
 }
 
 func newTreeVisitor() *treeVisitor {
@@ -56,24 +59,12 @@ func newTreeVisitor() *treeVisitor {
 }
 
 func (v *treeVisitor) Visit(n *tracing.TreeNode) tracing.Visitor {
-	name := n.Raw.Name
-	fs := n.Raw.Fields
-
-	i := 0
-	for i < len(fs) {
-		if strings.HasPrefix(fs[i].Key(), nameValuePrefix) {
-			name += fmt.Sprintf(":%v", fs[i].Value())
-			fs = append(fs[:i], fs[i+1:]...)
-			continue
-		}
-
-		i++
-	}
+	name, fs := spanName(n)
 
 	t := v.trees[len(v.trees)-1].AddBranch(name)
 	v.trees = append(v.trees, t)
 
-	if labels := n.Raw.Labels; len(labels) > 0 {
+	if labels := n.Raw.Labels; v.withLabels && len(labels) > 0 {
 		l := t.AddBranch("labels")
 		for _, ll := range n.Raw.Labels {
 			l.AddNode(ll.Key + ": " + ll.Value)
@@ -93,3 +84,73 @@ func (v *treeVisitor) Visit(n *tracing.TreeNode) tracing.Visitor {
 
 	return nil
 }
+
+// summaryVisitor renders the root span and its children, the descendants of each child
+// are collapsed into the number of spans and their aggregate duration.
+type summaryVisitor struct {
+	root treeprint.Tree
+}
+
+func newSummaryVisitor() *summaryVisitor {
+	return &summaryVisitor{root: treeprint.New()}
+}
+
+func (v *summaryVisitor) Visit(n *tracing.TreeNode) tracing.Visitor {
+	name, _ := spanName(n)
+	root := v.root.AddBranch(name)
+
+	for _, cn := range n.Children {
+		name, _ = spanName(cn)
+		t := root.AddBranch(name)
+
+		var spans int
+		var pp time.Duration
+		for _, sub := range cn.Children {
+			collapseSpans(sub, &spans, &pp)
+		}
+		if spans > 0 {
+			t.AddNode(fmt.Sprintf("collapsed_spans: %d", spans))
+			t.AddNode(fmt.Sprintf("collapsed_pp: %v", pp))
+		}
+	}
+
+	return nil
+}
+
+// collapseSpans counts the spans of the tree and sums up their pp durations.
+func collapseSpans(n *tracing.TreeNode, spans *int, pp *time.Duration) {
+	*spans++
+	for _, f := range n.Raw.Fields {
+		if !strings.HasPrefix(f.Key(), nameValuePrefix) {
+			continue
+		}
+		val, ok := f.Value().(string)
+		if !ok || !strings.HasPrefix(val, ppPrefix) {
+			continue
+		}
+		if d, err := time.ParseDuration(strings.TrimPrefix(val, ppPrefix)); err == nil {
+			*pp += d
+		}
+	}
+
+	for _, cn := range n.Children {
+		collapseSpans(cn, spans, pp)
+	}
+}
+
+// spanName returns the name of the span with its name values appended,
+// and the fields which are not name values.
+// The fields of the span are left untouched, so that the trace can be rendered more than once.
+func spanName(n *tracing.TreeNode) (string, fields.Fields) {
+	name := n.Raw.Name
+	fs := make(fields.Fields, 0, len(n.Raw.Fields))
+
+	for _, f := range n.Raw.Fields {
+		if strings.HasPrefix(f.Key(), nameValuePrefix) {
+			name += fmt.Sprintf(":%v", f.Value())
+			continue
+		}
+		fs = append(fs, f)
+	}
+	return name, fs
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"io"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+const (
+	auditStatusSuccess = "success"
+	auditStatusFailed  = "failed"
+)
+
+// AuditLogger writes one JSON entry for every executed statement to the audit log.
+// It is independent of the operational logger, so that its level and rotation never affect the audit trail.
+type AuditLogger struct {
+	logger *zap.Logger
+	closer io.Closer
+}
+
+// NewAuditLogger returns an audit logger writing to the rotated file configured by c.
+func NewAuditLogger(c config.AuditLog) *AuditLogger {
+	w := c.NewLumberjackLogger()
+	l := newAuditLogger(zapcore.AddSync(w))
+	l.closer = w
+	return l
+}
+
+func newAuditLogger(w zapcore.WriteSyncer) *AuditLogger {
+	encoderConfig := zap.NewProductionEncoderConfig()
+	encoderConfig.TimeKey = "time"
+	encoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+	encoderConfig.LevelKey = ""
+	encoderConfig.CallerKey = ""
+	encoderConfig.MessageKey = ""
+	core := zapcore.NewCore(zapcore.NewJSONEncoder(encoderConfig), w, zapcore.InfoLevel)
+	return &AuditLogger{logger: zap.New(core)}
+}
+
+// Log records the statement, the user and source address who executed it, and its outcome.
+// Passwords in the statement are redacted.
+func (l *AuditLogger) Log(stmt influxql.Statement, ctx *query.ExecutionContext, duration time.Duration, err error) {
+	if l == nil {
+		return
+	}
+	status := auditStatusSuccess
+	fields := []zap.Field{
+		zap.String("user", ctx.UserID),
+		zap.String("remote_addr", ctx.RemoteAddr),
+		zap.Uint64("qid", ctx.StatementQueryID()),
+		zap.String("database", statementDatabase(stmt, ctx)),
+		zap.String("statement", influxql.Sanitize(stmt.String())),
+		zap.Duration("duration", duration),
+	}
+	if err != nil {
+		status = auditStatusFailed
+		fields = append(fields, zap.String("error", err.Error()))
+	}
+	fields = append(fields, zap.String("status", status))
+	l.logger.Info("", fields...)
+}
+
+// Close flushes and closes the audit log file.
+func (l *AuditLogger) Close() error {
+	if l == nil {
+		return nil
+	}
+	_ = l.logger.Sync()
+	if l.closer != nil {
+		return l.closer.Close()
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func readAuditEntries(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	var entries []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		entry := make(map[string]interface{})
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func newAuditContext() *query.ExecutionContext {
+	ctx := &query.ExecutionContext{Context: context.Background(), Results: make(chan *query.Result, 1)}
+	ctx.QueryID = []uint64{10}
+	ctx.Database = "db_default"
+	ctx.UserID = "admin"
+	ctx.RemoteAddr = "127.0.0.1:51234"
+	return ctx
+}
+
+func TestStatementExecutor_AuditLog(t *testing.T) {
+	config.SetSubscriptionEnable(true)
+	defer config.SetSubscriptionEnable(false)
+
+	buf := &bytes.Buffer{}
+	e := newMockStatementExecutor()
+	e.AuditLogger = newAuditLogger(zapcore.AddSync(buf))
+	ctx := newAuditContext()
+
+	stmt := &influxql.CreateSubscriptionStatement{
+		Name: "subs0", Database: "db0", RetentionPolicy: "rp0", Mode: "ALL", Destinations: []string{"http://127.0.0.1:8086"},
+	}
+	assert.NoError(t, e.ExecuteStatement(stmt, ctx, 0))
+	// the select statement fails in the shard mapper
+	assert.Error(t, e.ExecuteStatement(newMockSelectStatement("wrongRp", "mst"), ctx, 0))
+
+	entries := readAuditEntries(t, buf)
+	require.Equal(t, 2, len(entries))
+
+	entry := entries[0]
+	assert.NotEmpty(t, entry["time"])
+	assert.Equal(t, "admin", entry["user"])
+	assert.Equal(t, "127.0.0.1:51234", entry["remote_addr"])
+	assert.Equal(t, float64(10), entry["qid"])
+	assert.Equal(t, "db0", entry["database"])
+	assert.Equal(t, stmt.String(), entry["statement"])
+	assert.Equal(t, auditStatusSuccess, entry["status"])
+	assert.Contains(t, entry, "duration")
+	assert.NotContains(t, entry, "error")
+
+	entry = entries[1]
+	assert.Equal(t, "db", entry["database"])
+	assert.Equal(t, auditStatusFailed, entry["status"])
+	assert.NotEmpty(t, entry["error"])
+}
+
+func TestAuditLogger_RedactPassword(t *testing.T) {
+	buf := &bytes.Buffer{}
+	l := newAuditLogger(zapcore.AddSync(buf))
+	ctx := newAuditContext()
+
+	l.Log(&influxql.CreateUserStatement{Name: "user0", Password: "Secret@123"}, ctx, time.Millisecond, nil)
+	l.Log(&influxql.SetPasswordUserStatement{Name: "user0", Password: "Secret@456"}, ctx, time.Millisecond, errors.New("failed"))
+
+	assert.NotContains(t, buf.String(), "Secret@")
+	entries := readAuditEntries(t, buf)
+	require.Equal(t, 2, len(entries))
+	for _, entry := range entries {
+		assert.Contains(t, entry["statement"], "[REDACTED]")
+	}
+
+	// a nil audit logger is disabled
+	var disabled *AuditLogger
+	disabled.Log(&influxql.ShowDatabasesStatement{}, ctx, time.Millisecond, nil)
+	assert.NoError(t, disabled.Close())
+}
+
+func TestNewAuditLogger(t *testing.T) {
+	c := config.NewAuditLog()
+	c.Enabled = true
+	c.Path = t.TempDir()
+	l := NewAuditLogger(c)
+	l.Log(&influxql.ShowDatabasesStatement{}, newAuditContext(), time.Millisecond, nil)
+	require.NoError(t, l.Close())
+
+	content, err := os.ReadFile(filepath.Join(c.Path, config.AuditLogFileName+".log"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), `"statement":"SHOW DATABASES"`)
+}
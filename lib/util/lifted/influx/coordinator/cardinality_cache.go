@@ -0,0 +1,122 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"go.uber.org/zap"
+)
+
+// CardinalityCache keeps the series cardinality of each database computed by the background job,
+// so that SHOW SERIES CARDINALITY returns it without asking the store nodes.
+type CardinalityCache struct {
+	mu      sync.RWMutex
+	entries map[string]cachedCardinality
+}
+
+type cachedCardinality struct {
+	rows       models.Rows
+	computedAt time.Time
+}
+
+// NewCardinalityCache returns an empty cache.
+func NewCardinalityCache() *CardinalityCache {
+	return &CardinalityCache{entries: make(map[string]cachedCardinality)}
+}
+
+// Get returns the cached cardinality of a database and the time it was computed at.
+func (c *CardinalityCache) Get(database string) (models.Rows, time.Time, bool) {
+	if c == nil {
+		return nil, time.Time{}, false
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	entry, ok := c.entries[database]
+	return entry.rows, entry.computedAt, ok
+}
+
+// Set caches the cardinality of a database computed at the given time.
+func (c *CardinalityCache) Set(database string, rows models.Rows, computedAt time.Time) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[database] = cachedCardinality{rows: rows, computedAt: computedAt}
+}
+
+// retain forgets the cardinality of the databases which are not in databases.
+func (c *CardinalityCache) retain(databases map[string]struct{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for db := range c.entries {
+		if _, ok := databases[db]; !ok {
+			delete(c.entries, db)
+		}
+	}
+}
+
+// cachedSeriesCardinality returns the cached result of SHOW SERIES CARDINALITY and a message telling when it was
+// computed. Only the estimated cardinality of a whole database is cached, EXACT always computes the cardinality.
+func (e *StatementExecutor) cachedSeriesCardinality(stmt *influxql.ShowSeriesCardinalityStatement) (models.Rows, *query.Message, bool) {
+	if stmt.Exact || stmt.Condition != nil || len(stmt.Sources) > 0 {
+		return nil, nil, false
+	}
+	rows, computedAt, ok := e.CardinalityCache.Get(stmt.Database)
+	if !ok {
+		return nil, nil, false
+	}
+	return rows, &query.Message{Level: query.InfoLevel, Text: "cardinality computed at " + computedAt.UTC().Format(time.RFC3339)}, true
+}
+
+// PrecomputeCardinality computes the series cardinality of each database into the cache. The previous cardinality
+// of a database is kept if the computation fails.
+func (e *StatementExecutor) PrecomputeCardinality() {
+	databases := make(map[string]struct{})
+	for _, db := range e.MetaClient.Databases() {
+		databases[db.Name] = struct{}{}
+		rows, err := e.executeShowSeriesCardinality(&influxql.ShowSeriesCardinalityStatement{Database: db.Name})
+		if err != nil {
+			e.StmtExecLogger.Warn("failed to precompute series cardinality", zap.String("db", db.Name), zap.Error(err))
+			continue
+		}
+		e.CardinalityCache.Set(db.Name, rows, time.Now())
+	}
+	e.CardinalityCache.retain(databases)
+}
+
+// RunCardinalityPrecompute precomputes the series cardinality at once, then every interval until ctx is done.
+func (e *StatementExecutor) RunCardinalityPrecompute(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	e.PrecomputeCardinality()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			e.PrecomputeCardinality()
+		}
+	}
+}
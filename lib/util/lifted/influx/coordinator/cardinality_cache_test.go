@@ -0,0 +1,163 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+	"github.com/openGemini/openGemini/coordinator"
+	"github.com/openGemini/openGemini/lib/errno"
+	Logger "github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockCardinalityNS returns the current cardinality of the series on each node and counts the calls.
+type mockCardinalityNS struct {
+	netstorage.NetStorage
+	cardinality uint64
+	calls       int64
+	err         error
+}
+
+func (s *mockCardinalityNS) SeriesCardinality(_ uint64, _ string, _ []uint32, _ []string, _ influxql.Expr) ([]meta2.MeasurementCardinalityInfo, error) {
+	atomic.AddInt64(&s.calls, 1)
+	day := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	return []meta2.MeasurementCardinalityInfo{{
+		Name: "cpu",
+		CardinalityInfos: []meta2.CardinalityInfo{
+			{TimeRange: meta2.TimeRangeInfo{StartTime: day, EndTime: day.Add(24 * time.Hour)}, Cardinality: s.cardinality},
+		},
+	}}, s.err
+}
+
+func (s *mockCardinalityNS) SeriesExactCardinality(_ uint64, _ string, _ []uint32, _ []string, _ influxql.Expr) (map[string]uint64, error) {
+	atomic.AddInt64(&s.calls, 1)
+	return map[string]uint64{"cpu": s.cardinality}, s.err
+}
+
+func TestStatementExecutor_CardinalityCache(t *testing.T) {
+	mc := &mockDatabasesMetaClient{databases: map[string]*meta2.DatabaseInfo{"db0": {Name: "db0"}}}
+	ns := &mockCardinalityNS{cardinality: 5}
+	e := &StatementExecutor{
+		MetaClient:       mc,
+		NetStorage:       ns,
+		MetaExecutor:     &coordinator.MetaExecutor{MetaClient: mc, Logger: Logger.NewLogger(errno.ModuleUnknown)},
+		StmtExecLogger:   Logger.NewLogger(errno.ModuleUnknown),
+		CardinalityCache: NewCardinalityCache(),
+	}
+	show := func(sql string) *query.Result {
+		YyParser := &influxql.YyParser{Query: influxql.Query{}}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(sql))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		require.NoError(t, err)
+		ctx := &query.ExecutionContext{Context: context.Background(), Results: make(chan *query.Result, 1)}
+		require.NoError(t, e.ExecuteStatement(q.Statements[0], ctx, 0))
+		return <-ctx.Results
+	}
+	count := func(rows models.Rows) interface{} {
+		require.NotEmpty(t, rows)
+		return rows[0].Values[0][len(rows[0].Columns)-1]
+	}
+
+	// nothing is cached before the job runs
+	result := show("SHOW SERIES CARDINALITY ON db0")
+	assert.Equal(t, uint64(10), count(result.Series))
+	assert.Empty(t, result.Messages)
+	assert.Equal(t, int64(2), ns.calls)
+
+	e.PrecomputeCardinality()
+	_, computedAt, ok := e.CardinalityCache.Get("db0")
+	require.True(t, ok)
+	ns.cardinality = 7
+	ns.calls = 0
+
+	// the cached cardinality is returned with the time it was computed at
+	result = show("SHOW SERIES CARDINALITY ON db0")
+	assert.Equal(t, uint64(10), count(result.Series))
+	assert.Equal(t, []*query.Message{{Level: query.InfoLevel, Text: "cardinality computed at " + computedAt.UTC().Format(time.RFC3339)}}, result.Messages)
+	assert.Equal(t, int64(0), ns.calls)
+
+	// EXACT always computes the cardinality
+	result = show("SHOW SERIES EXACT CARDINALITY ON db0")
+	assert.Equal(t, uint64(14), count(result.Series))
+	assert.Empty(t, result.Messages)
+	assert.Equal(t, int64(2), ns.calls)
+
+	// the cardinality of some measurements is not cached
+	result = show("SHOW SERIES CARDINALITY ON db0 FROM cpu")
+	assert.Equal(t, uint64(14), count(result.Series))
+	assert.Empty(t, result.Messages)
+
+	// a failed computation keeps the previous cardinality
+	ns.err = errors.New("store unavailable")
+	e.PrecomputeCardinality()
+	rows, _, ok := e.CardinalityCache.Get("db0")
+	require.True(t, ok)
+	assert.Equal(t, uint64(10), count(rows))
+
+	// the cardinality of a dropped database is forgotten
+	ns.err = nil
+	mc.databases = map[string]*meta2.DatabaseInfo{"db1": {Name: "db1"}}
+	e.PrecomputeCardinality()
+	_, _, ok = e.CardinalityCache.Get("db0")
+	assert.False(t, ok)
+	rows, _, ok = e.CardinalityCache.Get("db1")
+	require.True(t, ok)
+	assert.Equal(t, uint64(14), count(rows))
+}
+
+func TestStatementExecutor_RunCardinalityPrecompute(t *testing.T) {
+	mc := &mockDatabasesMetaClient{databases: map[string]*meta2.DatabaseInfo{"db0": {Name: "db0"}}}
+	e := &StatementExecutor{
+		MetaClient:       mc,
+		NetStorage:       &mockCardinalityNS{cardinality: 5},
+		MetaExecutor:     &coordinator.MetaExecutor{MetaClient: mc, Logger: Logger.NewLogger(errno.ModuleUnknown)},
+		StmtExecLogger:   Logger.NewLogger(errno.ModuleUnknown),
+		CardinalityCache: NewCardinalityCache(),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		e.RunCardinalityPrecompute(ctx, time.Hour)
+		close(done)
+	}()
+	require.Eventually(t, func() bool {
+		_, _, ok := e.CardinalityCache.Get("db0")
+		return ok
+	}, time.Second, 10*time.Millisecond)
+	cancel()
+	<-done
+
+	// a nil cache caches nothing
+	var c *CardinalityCache
+	c.Set("db0", nil, time.Now())
+	_, _, ok := c.Get("db0")
+	assert.False(t, ok)
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+)
+
+// drainNodeInterval is the interval DRAIN NODE checks the queries running on the drained node at.
+var drainNodeInterval = time.Second
+
+// drainNodeTimeout bounds the time DRAIN NODE waits for the queries running on the drained node to finish.
+var drainNodeTimeout = 10 * time.Minute
+
+// executeDrainNode marks a data node drained in meta, all the sql nodes route the new queries away from it, then
+// waits for the queries running on it to finish and reports the node drained. A node hosting a pt without a readable
+// replica on another node can not be drained. DRAIN NODE OFF routes the new queries to the node again.
+func (e *StatementExecutor) executeDrainNode(stmt *influxql.DrainNodeStatement, ctx *query.ExecutionContext) (models.Rows, error) {
+	nodes, err := e.MetaClient.DataNodes()
+	if err != nil {
+		return nil, err
+	}
+	var node *meta2.DataNode
+	for i := range nodes {
+		if nodes[i].ID == stmt.NodeID {
+			node = &nodes[i]
+			break
+		}
+	}
+	if node == nil {
+		return nil, meta2.ErrNodeNotFound
+	}
+
+	if stmt.Off {
+		return nil, e.MetaClient.DrainNode(node.ID, false)
+	}
+	if err = e.checkNodeDrainable(node.ID, nodes); err != nil {
+		return nil, err
+	}
+	if err = e.MetaClient.DrainNode(node.ID, true); err != nil {
+		return nil, err
+	}
+
+	var done <-chan struct{}
+	if ctx.Context != nil {
+		done = ctx.Context.Done()
+	}
+	ticker := time.NewTicker(drainNodeInterval)
+	defer ticker.Stop()
+	timer := time.NewTimer(drainNodeTimeout)
+	defer timer.Stop()
+	for {
+		running := runningQueries(e.getQueryExeInfoOnNode(node.ID))
+		if running == 0 {
+			break
+		}
+		select {
+		case <-done:
+			return nil, ctx.Context.Err()
+		case <-timer.C:
+			return nil, errno.NewError(errno.DrainNodeTimeout, node.ID, running, drainNodeTimeout)
+		case <-ticker.C:
+		}
+	}
+
+	row := &models.Row{Columns: []string{"node_id", "host", "status"}}
+	row.Values = [][]interface{}{{node.ID, node.Host, "drained"}}
+	return models.Rows{row}, nil
+}
+
+// checkNodeDrainable returns an error if a pt of the node has no readable replica on another node which is not
+// drained, the queries on the pt could not be routed away from the node.
+func (e *StatementExecutor) checkNodeDrainable(nodeID uint64, nodes []meta2.DataNode) error {
+	drained := make(map[uint64]bool, len(nodes))
+	for i := range nodes {
+		drained[nodes[i].ID] = nodes[i].Drained || nodes[i].ID == nodeID
+	}
+	for db := range e.MetaClient.Databases() {
+		ptView, err := e.MetaClient.DBPtView(db)
+		if err != nil {
+			return err
+		}
+		var repGroups []meta2.ReplicaGroup
+		if config.IsReplication() {
+			repGroups = e.MetaClient.DBRepGroups(db)
+		}
+		isReadable := func(ptID uint32) bool {
+			return int(ptID) < len(ptView) && ptView[ptID].Status == meta2.Online && !drained[ptView[ptID].Owner.NodeID]
+		}
+		for i := range ptView {
+			if ptView[i].Owner.NodeID != nodeID {
+				continue
+			}
+			replicated := false
+			for j := range repGroups {
+				rg := &repGroups[j]
+				if rg.ID != ptView[i].RGID {
+					continue
+				}
+				replicated = isReadable(rg.MasterPtID)
+				for _, peer := range rg.Peers {
+					replicated = replicated || (peer.PtRole != meta2.Catcher && isReadable(peer.ID))
+				}
+			}
+			if !replicated {
+				return errno.NewError(errno.DrainNodeUnreplicatedPt, ptView[i].PtId, db, nodeID)
+			}
+		}
+	}
+	return nil
+}
+
+// runningQueries returns the number of the queries which are still running.
+func runningQueries(infos []*netstorage.QueryExeInfo) int64 {
+	var running int64
+	for _, info := range infos {
+		if info.RunState == netstorage.Running {
+			running++
+		}
+	}
+	return running
+}
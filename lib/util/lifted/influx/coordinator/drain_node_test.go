@@ -0,0 +1,229 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockDrainNS returns the queries running on each node, they can finish while a node is drained.
+type mockDrainNS struct {
+	netstorage.NetStorage
+	mu    sync.Mutex
+	infos map[uint64][]*netstorage.QueryExeInfo
+}
+
+func (s *mockDrainNS) GetQueriesOnNode(nodeID uint64) ([]*netstorage.QueryExeInfo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.infos[nodeID], nil
+}
+
+func (s *mockDrainNS) finish(nodeID uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.infos, nodeID)
+}
+
+// mockDrainMetaClient keeps the drain state of the nodes as meta does. The pt 0 of db0 is on node 1 and its
+// replica pt 1 on node 2, the pt 2 on node 3 has no replica.
+type mockDrainMetaClient struct {
+	*MockMetaClient
+	mu      sync.Mutex
+	drained map[uint64]bool
+}
+
+func newMockDrainMetaClient() *mockDrainMetaClient {
+	return &mockDrainMetaClient{MockMetaClient: &MockMetaClient{}, drained: make(map[uint64]bool)}
+}
+
+func (m *mockDrainMetaClient) DataNodes() ([]meta2.DataNode, error) {
+	nodes, err := m.MockMetaClient.DataNodes()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i := range nodes {
+		nodes[i].Drained = m.drained[nodes[i].ID]
+	}
+	return nodes, err
+}
+
+func (m *mockDrainMetaClient) DrainNode(id uint64, drained bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.drained[id] = drained
+	return nil
+}
+
+func (m *mockDrainMetaClient) isDrained(id uint64) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.drained[id]
+}
+
+func (m *mockDrainMetaClient) Databases() map[string]*meta2.DatabaseInfo {
+	return map[string]*meta2.DatabaseInfo{"db0": {Name: "db0"}}
+}
+
+func (m *mockDrainMetaClient) DBPtView(database string) (meta2.DBPtInfos, error) {
+	return meta2.DBPtInfos{
+		{PtId: 0, Status: meta2.Online, RGID: 0, Owner: meta2.PtOwner{NodeID: 1}},
+		{PtId: 1, Status: meta2.Online, RGID: 0, Owner: meta2.PtOwner{NodeID: 2}},
+		{PtId: 2, Status: meta2.Online, RGID: 1, Owner: meta2.PtOwner{NodeID: 3}},
+	}, nil
+}
+
+func (m *mockDrainMetaClient) DBRepGroups(database string) []meta2.ReplicaGroup {
+	return []meta2.ReplicaGroup{
+		{ID: 0, MasterPtID: 0, Peers: []meta2.Peer{{ID: 1, PtRole: meta2.Slave}}},
+		{ID: 1, MasterPtID: 2},
+	}
+}
+
+func TestStatementExecutor_DrainNode(t *testing.T) {
+	require.NoError(t, config.SetHaPolicy(config.RepPolicy))
+	interval := drainNodeInterval
+	drainNodeInterval = 10 * time.Millisecond
+	defer func() {
+		drainNodeInterval = interval
+		_ = config.SetHaPolicy(config.WAFPolicy)
+	}()
+	ns := &mockDrainNS{infos: map[uint64][]*netstorage.QueryExeInfo{
+		2: {{QueryID: 1, Stmt: "select * from mst", RunState: netstorage.Running}},
+	}}
+	mc := newMockDrainMetaClient()
+	e := &StatementExecutor{MetaClient: mc, NetStorage: ns}
+	ctx := &query.ExecutionContext{Context: context.Background()}
+
+	type drainResult struct {
+		values [][]interface{}
+		err    error
+	}
+	done := make(chan drainResult, 1)
+	go func() {
+		rows, err := e.executeDrainNode(&influxql.DrainNodeStatement{NodeID: 2}, ctx)
+		res := drainResult{err: err}
+		if len(rows) > 0 {
+			res.values = rows[0].Values
+		}
+		done <- res
+	}()
+
+	// the node is drained in meta at once, the command waits for its running query
+	require.Eventually(t, func() bool { return mc.isDrained(2) }, time.Second, time.Millisecond)
+	assert.False(t, mc.isDrained(1))
+	select {
+	case <-done:
+		t.Fatal("the node is reported drained while a query is running on it")
+	case <-time.After(5 * drainNodeInterval):
+	}
+
+	// the command completes when the query finishes
+	ns.finish(2)
+	select {
+	case res := <-done:
+		require.NoError(t, res.err)
+		assert.Equal(t, [][]interface{}{{uint64(2), "192.168.1.8081", "drained"}}, res.values)
+	case <-time.After(time.Second):
+		t.Fatal("the node is not reported drained after its queries finished")
+	}
+
+	// the only readable replica of the pt 0 is on the drained node 2
+	_, err := e.executeDrainNode(&influxql.DrainNodeStatement{NodeID: 1}, ctx)
+	assert.True(t, errno.Equal(err, errno.DrainNodeUnreplicatedPt))
+	assert.False(t, mc.isDrained(1))
+
+	rows, err := e.executeDrainNode(&influxql.DrainNodeStatement{NodeID: 2, Off: true}, ctx)
+	require.NoError(t, err)
+	assert.Empty(t, rows)
+	assert.False(t, mc.isDrained(2))
+
+	// the node does not exist
+	_, err = e.executeDrainNode(&influxql.DrainNodeStatement{NodeID: 4}, ctx)
+	assert.Equal(t, meta2.ErrNodeNotFound, err)
+}
+
+func TestStatementExecutor_DrainNodeUnreplicated(t *testing.T) {
+	require.NoError(t, config.SetHaPolicy(config.RepPolicy))
+	defer func() {
+		_ = config.SetHaPolicy(config.WAFPolicy)
+	}()
+	mc := newMockDrainMetaClient()
+	e := &StatementExecutor{MetaClient: mc, NetStorage: &mockDrainNS{}}
+	ctx := &query.ExecutionContext{Context: context.Background()}
+
+	// the pt 2 on node 3 has no replica, its queries can not be routed away from the node
+	_, err := e.executeDrainNode(&influxql.DrainNodeStatement{NodeID: 3}, ctx)
+	assert.True(t, errno.Equal(err, errno.DrainNodeUnreplicatedPt))
+	assert.False(t, mc.isDrained(3))
+
+	// without replication no pt has a replica
+	require.NoError(t, config.SetHaPolicy(config.WAFPolicy))
+	_, err = e.executeDrainNode(&influxql.DrainNodeStatement{NodeID: 1}, ctx)
+	assert.True(t, errno.Equal(err, errno.DrainNodeUnreplicatedPt))
+	assert.False(t, mc.isDrained(1))
+}
+
+func TestStatementExecutor_DrainNodeTimeout(t *testing.T) {
+	require.NoError(t, config.SetHaPolicy(config.RepPolicy))
+	interval, timeout := drainNodeInterval, drainNodeTimeout
+	drainNodeInterval, drainNodeTimeout = 10*time.Millisecond, 50*time.Millisecond
+	defer func() {
+		drainNodeInterval, drainNodeTimeout = interval, timeout
+		_ = config.SetHaPolicy(config.WAFPolicy)
+	}()
+	ns := &mockDrainNS{infos: map[uint64][]*netstorage.QueryExeInfo{
+		1: {{QueryID: 1, Stmt: "select * from mst", RunState: netstorage.Running}},
+	}}
+	mc := newMockDrainMetaClient()
+	e := &StatementExecutor{MetaClient: mc, NetStorage: ns}
+
+	// the node stays drained when its queries outlast the wait
+	_, err := e.executeDrainNode(&influxql.DrainNodeStatement{NodeID: 1}, &query.ExecutionContext{Context: context.Background()})
+	assert.True(t, errno.Equal(err, errno.DrainNodeTimeout))
+	assert.True(t, mc.isDrained(1))
+}
+
+func TestStatementExecutor_DrainNodeCanceled(t *testing.T) {
+	require.NoError(t, config.SetHaPolicy(config.RepPolicy))
+	defer func() {
+		_ = config.SetHaPolicy(config.WAFPolicy)
+	}()
+	ns := &mockDrainNS{infos: map[uint64][]*netstorage.QueryExeInfo{
+		1: {{QueryID: 1, Stmt: "select * from mst", RunState: netstorage.Running}},
+	}}
+	mc := newMockDrainMetaClient()
+	e := &StatementExecutor{MetaClient: mc, NetStorage: ns}
+	c, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// the node stays drained when the client stops waiting
+	_, err := e.executeDrainNode(&influxql.DrainNodeStatement{NodeID: 1}, &query.ExecutionContext{Context: c})
+	assert.Equal(t, context.Canceled, err)
+	assert.True(t, mc.isDrained(1))
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"go.uber.org/zap"
+)
+
+const internalErrorMessage = "internal error"
+
+// internalErrorModules are the modules whose errors describe the internals of the cluster,
+// such as the nodes, the shards and the files, rather than the statement of the client.
+var internalErrorModules = map[errno.Module]struct{}{
+	errno.ModuleIndex:         {},
+	errno.ModuleMetaRaft:      {},
+	errno.ModuleNetwork:       {},
+	errno.ModuleCompact:       {},
+	errno.ModuleMerge:         {},
+	errno.ModuleStorageEngine: {},
+	errno.ModuleHA:            {},
+	errno.ModuleTssp:          {},
+	errno.ModuleWal:           {},
+	errno.ModuleShard:         {},
+	errno.ModuleLogStore:      {},
+	errno.ModuleHierarchical:  {},
+}
+
+// clientError returns the error of the statement to be returned to the client. In the external verbosity,
+// the internal errors are replaced by a message without detail, and the full error is only logged.
+func (e *StatementExecutor) clientError(stmt influxql.Statement, err error) error {
+	if err == nil || e.ErrorVerbosity != config.ErrorVerbosityExternal {
+		return err
+	}
+	sanitized := sanitizeError(err)
+	if sanitized != err {
+		e.StmtExecLogger.Error("internal error hidden from the client", zap.String("stmt", stmt.String()), zap.Error(err))
+	}
+	return sanitized
+}
+
+// sanitizeError replaces the internal errors by a message without detail, the code of an errno error is kept
+// so that the client can still tell the errors apart and the operators can find the error in the log.
+func sanitizeError(err error) error {
+	var errnoErr *errno.Error
+	if errors.As(err, &errnoErr) {
+		if _, ok := internalErrorModules[errnoErr.Module()]; !ok && !errnoErr.Level().LogStack() {
+			return err
+		}
+		sanitized := *errnoErr
+		sanitized.SetMessage(fmt.Sprintf("%s (errno %d)", internalErrorMessage, errnoErr.Errno()))
+		return &sanitized
+	}
+
+	var pathErr *fs.PathError
+	var netErr *net.OpError
+	if errors.As(err, &pathErr) || errors.As(err, &netErr) {
+		return errors.New(internalErrorMessage)
+	}
+	return err
+}
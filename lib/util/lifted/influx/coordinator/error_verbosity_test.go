@@ -0,0 +1,69 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockErrShardMapper struct {
+	MockShardMapper
+	err error
+}
+
+func (m *mockErrShardMapper) MapShards(_ influxql.Sources, _ influxql.TimeRange, _ query.SelectOptions, _ influxql.Expr) (query.ShardGroup, error) {
+	return nil, m.err
+}
+
+func TestStatementExecutor_ErrorVerbosity(t *testing.T) {
+	internalErr := errno.NewError(errno.ShardNotFound, "/data/openGemini/data/db0/0/rp0/12_1700000000_1700604800_12")
+	sm := &mockErrShardMapper{err: internalErr}
+	e := newMockStatementExecutor()
+	e.ShardMapper = sm
+	execute := func() error {
+		return e.ExecuteStatement(newMockSelectStatement("rp", "mst"), &query.ExecutionContext{}, 0)
+	}
+
+	// internal: the full detail is returned
+	err := execute()
+	assert.EqualError(t, err, internalErr.Error())
+
+	// external: the detail is hidden, the code is kept
+	e.ErrorVerbosity = config.ErrorVerbosityExternal
+	err = execute()
+	assert.EqualError(t, err, "internal error (errno 2130)")
+	assert.True(t, errno.Equal(err, errno.ShardNotFound))
+	assert.Contains(t, internalErr.Error(), "/data/openGemini", "the original error is not modified")
+
+	// external: the errors of the statement are returned as they are
+	sm.err = errno.NewError(errno.ErrMeasurementNotFound)
+	assert.Equal(t, sm.err, execute())
+	sm.err = errors.New("retention policy not found")
+	assert.Equal(t, sm.err, execute())
+
+	// external: the file and network errors are hidden
+	sm.err = &os.PathError{Op: "open", Path: "/data/openGemini/wal/1.wal", Err: os.ErrNotExist}
+	assert.EqualError(t, execute(), "internal error")
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"sync"
+	"time"
+
+	originql "github.com/influxdata/influxql"
+)
+
+// privilegeCache keeps the privileges of the users loaded from the meta data for a short time,
+// so that the authorization of every statement does not query the meta client.
+type privilegeCache struct {
+	mu      sync.Mutex
+	entries map[string]privilegeCacheEntry
+	// version is increased by every invalidation, a load started before an invalidation is not cached.
+	version uint64
+}
+
+type privilegeCacheEntry struct {
+	privileges map[string]originql.Privilege
+	expire     time.Time
+}
+
+// get returns the privileges of user, they are loaded by load if they are not cached or older than ttl.
+func (c *privilegeCache) get(user string, ttl time.Duration, load func() (map[string]originql.Privilege, error)) (map[string]originql.Privilege, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[user]
+	version := c.version
+	c.mu.Unlock()
+	if ok && time.Now().Before(entry.expire) {
+		return entry.privileges, nil
+	}
+
+	loaded, err := load()
+	if err != nil {
+		return nil, err
+	}
+	privileges := make(map[string]originql.Privilege, len(loaded))
+	for db, p := range loaded {
+		privileges[db] = p
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.version == version {
+		if c.entries == nil {
+			c.entries = make(map[string]privilegeCacheEntry)
+		}
+		c.entries[user] = privilegeCacheEntry{privileges: privileges, expire: time.Now().Add(ttl)}
+	}
+	return privileges, nil
+}
+
+// invalidate drops the cached privileges of user after they are changed.
+func (c *privilegeCache) invalidate(user string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, user)
+	c.version++
+}
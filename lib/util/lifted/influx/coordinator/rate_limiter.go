@@ -0,0 +1,121 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// DatabaseQueryLimiter limits the query rate of each database with a token bucket per database.
+// The buckets are independent, throttling one database never affects the others.
+type DatabaseQueryLimiter struct {
+	limiters map[string]*rate.Limiter
+}
+
+// NewDatabaseQueryLimiter returns a limiter allowing limits[db] queries per second for each database.
+// Databases without a positive limit are not limited.
+func NewDatabaseQueryLimiter(limits map[string]int) *DatabaseQueryLimiter {
+	l := &DatabaseQueryLimiter{limiters: make(map[string]*rate.Limiter, len(limits))}
+	for db, n := range limits {
+		if n > 0 {
+			l.limiters[db] = rate.NewLimiter(rate.Limit(n), n)
+		}
+	}
+	return l
+}
+
+// Allow reports whether a query on the database may be executed now.
+func (l *DatabaseQueryLimiter) Allow(db string) bool {
+	if l == nil {
+		return true
+	}
+	limiter, ok := l.limiters[db]
+	if !ok {
+		return true
+	}
+	return limiter.Allow()
+}
+
+// AllowAll reports whether a query on all the databases may be executed now, a database listed n times
+// takes n tokens. The tokens of each database are consumed only if every database allows the query,
+// otherwise the first database rejecting it is returned and no token is consumed.
+func (l *DatabaseQueryLimiter) AllowAll(dbs []string) (string, bool) {
+	if l == nil {
+		return "", true
+	}
+	counts := make(map[string]int, len(dbs))
+	for _, db := range dbs {
+		counts[db]++
+	}
+	now := time.Now()
+	reserved := make([]*rate.Reservation, 0, len(counts))
+	for _, db := range dbs {
+		n, ok := counts[db]
+		if !ok {
+			continue
+		}
+		delete(counts, db)
+		limiter, ok := l.limiters[db]
+		if !ok {
+			continue
+		}
+		r := limiter.ReserveN(now, n)
+		if r.OK() && r.DelayFrom(now) == 0 {
+			reserved = append(reserved, r)
+			continue
+		}
+		r.CancelAt(now)
+		for _, prev := range reserved {
+			prev.CancelAt(now)
+		}
+		return db, false
+	}
+	return "", true
+}
+
+// Limit returns the queries per second allowed on the database, 0 if it is not limited.
+func (l *DatabaseQueryLimiter) Limit(db string) int {
+	if l == nil {
+		return 0
+	}
+	limiter, ok := l.limiters[db]
+	if !ok {
+		return 0
+	}
+	return limiter.Burst()
+}
+
+// RetryAfter returns how long a rejected query on the database should wait for the next token.
+// The returned delay is at least one millisecond so that it is never mistaken for no suggestion.
+func (l *DatabaseQueryLimiter) RetryAfter(db string) time.Duration {
+	if l == nil {
+		return 0
+	}
+	limiter, ok := l.limiters[db]
+	if !ok {
+		return 0
+	}
+	r := limiter.Reserve()
+	d := r.Delay()
+	r.Cancel()
+	if d < time.Millisecond {
+		d = time.Millisecond
+	}
+	return d
+}
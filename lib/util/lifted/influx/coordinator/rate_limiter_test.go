@@ -0,0 +1,139 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatabaseQueryLimiter_Allow(t *testing.T) {
+	l := NewDatabaseQueryLimiter(map[string]int{"db0": 2, "db1": 1, "db2": 0})
+
+	assert.True(t, l.Allow("db0"))
+	assert.True(t, l.Allow("db0"))
+	assert.False(t, l.Allow("db0"))
+
+	// buckets are independent
+	assert.True(t, l.Allow("db1"))
+	assert.False(t, l.Allow("db1"))
+
+	// databases without a limit are never throttled
+	for i := 0; i < 10; i++ {
+		assert.True(t, l.Allow("db2"))
+		assert.True(t, l.Allow("db3"))
+	}
+
+	var nilLimiter *DatabaseQueryLimiter
+	assert.True(t, nilLimiter.Allow("db0"))
+
+	assert.Equal(t, 2, l.Limit("db0"))
+	assert.Equal(t, 0, l.Limit("db2"))
+	assert.Equal(t, 0, nilLimiter.Limit("db0"))
+}
+
+func TestDatabaseQueryLimiter_AllowAll(t *testing.T) {
+	l := NewDatabaseQueryLimiter(map[string]int{"db0": 1, "db1": 1})
+	assert.True(t, l.Allow("db1"))
+
+	// a throttled database does not consume the tokens of the others
+	db, ok := l.AllowAll([]string{"db0", "db2", "db1"})
+	assert.False(t, ok)
+	assert.Equal(t, "db1", db)
+	assert.True(t, l.Allow("db0"))
+
+	_, ok = l.AllowAll([]string{"db2", "db3"})
+	assert.True(t, ok)
+
+	// a database listed twice takes two tokens
+	l = NewDatabaseQueryLimiter(map[string]int{"db0": 2})
+	_, ok = l.AllowAll([]string{"db0", "db0"})
+	assert.True(t, ok)
+	assert.False(t, l.Allow("db0"))
+
+	var nilLimiter *DatabaseQueryLimiter
+	_, ok = nilLimiter.AllowAll([]string{"db0"})
+	assert.True(t, ok)
+}
+
+func TestDatabaseQueryLimiter_RetryAfter(t *testing.T) {
+	l := NewDatabaseQueryLimiter(map[string]int{"db0": 1})
+	assert.True(t, l.Allow("db0"))
+	assert.False(t, l.Allow("db0"))
+
+	d := l.RetryAfter("db0")
+	assert.True(t, d > 0 && d <= time.Second, d)
+	// asking for the delay does not consume a token
+	assert.Equal(t, d.Round(100*time.Millisecond), l.RetryAfter("db0").Round(100*time.Millisecond))
+
+	assert.Equal(t, time.Duration(0), l.RetryAfter("db1"))
+	var nilLimiter *DatabaseQueryLimiter
+	assert.Equal(t, time.Duration(0), nilLimiter.RetryAfter("db0"))
+}
+
+func TestStatementExecutor_QueryRateLimit(t *testing.T) {
+	e := newMockStatementExecutor()
+	e.QueryRateLimiter = NewDatabaseQueryLimiter(map[string]int{"db": 1, "db0": 1})
+	ctx := &query.ExecutionContext{}
+
+	// the first query consumes the only token, then reaches the shard mapper
+	err := e.ExecuteStatement(newMockSelectStatement("wrongRp", "mst"), ctx, 0)
+	assert.True(t, strings.Contains(err.Error(), "retention policy not found"))
+
+	err = e.ExecuteStatement(newMockSelectStatement("wrongRp", "mst"), ctx, 0)
+	assert.True(t, errno.Equal(err, errno.RateLimited))
+	retryAfter := errno.RetryAfter(err)
+	assert.True(t, retryAfter > 0 && retryAfter <= time.Second, retryAfter)
+
+	// SHOW statements are limited by their database as well
+	show := &influxql.ShowTagKeysStatement{Database: "db0"}
+	assert.NoError(t, e.checkQueryRateLimit(show, ctx))
+	assert.True(t, errno.Equal(e.checkQueryRateLimit(show, ctx), errno.RateLimited))
+
+	// statements without a database are not limited
+	assert.NoError(t, e.checkQueryRateLimit(&influxql.ShowDatabasesStatement{}, ctx))
+
+	// statements of a query whose limits were checked consume nothing
+	assert.NoError(t, e.checkQueryRateLimit(show, &query.ExecutionContext{ExecutionOptions: query.ExecutionOptions{LimitsChecked: true}}))
+
+	// statements on other databases are not affected
+	other := newMockSelectStatement("wrongRp", "mst")
+	other.Sources[0].(*influxql.Measurement).Database = "db1"
+	err = e.ExecuteStatement(other, ctx, 0)
+	assert.False(t, errno.Equal(err, errno.RateLimited))
+}
+
+func TestStatementExecutor_CheckQueryLimits(t *testing.T) {
+	e := newMockStatementExecutor()
+	assert.NoError(t, e.CheckQueryLimits(&influxql.Query{}, query.ExecutionOptions{}))
+
+	e.QueryRateLimiter = NewDatabaseQueryLimiter(map[string]int{"db0": 2})
+	q := &influxql.Query{Statements: influxql.Statements{
+		&influxql.ShowTagKeysStatement{},
+		&influxql.ShowFieldKeysStatement{Database: "db0"},
+		&influxql.ShowDatabasesStatement{},
+	}}
+	// the statements without a database query the default one, and take a token each
+	assert.NoError(t, e.CheckQueryLimits(q, query.ExecutionOptions{Database: "db0"}))
+	assert.True(t, errno.Equal(e.CheckQueryLimits(q, query.ExecutionOptions{Database: "db0"}), errno.RateLimited))
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"sync"
+)
+
+type scanKey struct {
+	database    string
+	measurement string
+}
+
+// MeasurementScanLimiter limits the number of queries scanning a measurement concurrently.
+// Measurements without a limit are never throttled.
+type MeasurementScanLimiter struct {
+	limits map[scanKey]int
+
+	mu      sync.Mutex
+	running map[scanKey]int
+}
+
+// NewMeasurementScanLimiter returns a limiter allowing limits[db][mst] concurrent queries for each measurement.
+// Measurements without a positive limit are not limited.
+func NewMeasurementScanLimiter(limits map[string]map[string]int) *MeasurementScanLimiter {
+	l := &MeasurementScanLimiter{
+		limits:  make(map[scanKey]int),
+		running: make(map[scanKey]int),
+	}
+	for db, msts := range limits {
+		for mst, n := range msts {
+			if n > 0 {
+				l.limits[scanKey{database: db, measurement: mst}] = n
+			}
+		}
+	}
+	return l
+}
+
+// Limited reports whether any measurement of the database is limited.
+func (l *MeasurementScanLimiter) Limited(db string) bool {
+	if l == nil {
+		return false
+	}
+	for k := range l.limits {
+		if k.database == db {
+			return true
+		}
+	}
+	return false
+}
+
+// Limit returns the concurrency limit of the measurement, 0 if it is not limited.
+func (l *MeasurementScanLimiter) Limit(db, mst string) int {
+	if l == nil {
+		return 0
+	}
+	return l.limits[scanKey{database: db, measurement: mst}]
+}
+
+// Limits returns the concurrency limits of the limited measurements of the database.
+func (l *MeasurementScanLimiter) Limits(db string) map[string]int {
+	limits := make(map[string]int)
+	if l == nil {
+		return limits
+	}
+	for k, n := range l.limits {
+		if k.database == db {
+			limits[k.measurement] = n
+		}
+	}
+	return limits
+}
+
+// Acquire reports whether a query may scan the measurement now, it must be followed by Release if true.
+func (l *MeasurementScanLimiter) Acquire(db, mst string) bool {
+	if l == nil {
+		return true
+	}
+	k := scanKey{database: db, measurement: mst}
+	limit, ok := l.limits[k]
+	if !ok {
+		return true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.running[k] >= limit {
+		return false
+	}
+	l.running[k]++
+	return true
+}
+
+// Release ends a scan of the measurement admitted by Acquire.
+func (l *MeasurementScanLimiter) Release(db, mst string) {
+	if l == nil {
+		return
+	}
+	k := scanKey{database: db, measurement: mst}
+	if _, ok := l.limits[k]; !ok {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.running[k] > 0 {
+		l.running[k]--
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMeasurementScanLimiter(t *testing.T) {
+	l := NewMeasurementScanLimiter(map[string]map[string]int{"db0": {"cpu": 2, "mem": 0}})
+
+	assert.True(t, l.Acquire("db0", "cpu"))
+	assert.True(t, l.Acquire("db0", "cpu"))
+	assert.False(t, l.Acquire("db0", "cpu"))
+	l.Release("db0", "cpu")
+	assert.True(t, l.Acquire("db0", "cpu"))
+
+	// measurements without a limit are never throttled
+	for i := 0; i < 10; i++ {
+		assert.True(t, l.Acquire("db0", "mem"))
+		assert.True(t, l.Acquire("db1", "cpu"))
+	}
+	assert.True(t, l.Limited("db0"))
+	assert.False(t, l.Limited("db1"))
+	assert.Equal(t, 2, l.Limit("db0", "cpu"))
+	assert.Equal(t, 0, l.Limit("db0", "mem"))
+
+	var nilLimiter *MeasurementScanLimiter
+	assert.True(t, nilLimiter.Acquire("db0", "cpu"))
+	assert.False(t, nilLimiter.Limited("db0"))
+	nilLimiter.Release("db0", "cpu")
+}
+
+func TestStatementExecutor_MeasurementScanLimit(t *testing.T) {
+	e := &StatementExecutor{
+		MetaClient:             &mockMeasurementsMetaClient{names: []string{"cpu0", "cpu1", "mem"}},
+		MeasurementScanLimiter: NewMeasurementScanLimiter(map[string]map[string]int{"db0": {"cpu0": 1}}),
+	}
+	newStmt := func(sources ...influxql.Source) *influxql.SelectStatement {
+		stmt := newMockSelectStatement("", "")
+		stmt.Sources = sources
+		return stmt
+	}
+	cpu0 := &influxql.Measurement{Database: "db0", Name: "cpu0"}
+	mem := &influxql.Measurement{Database: "db0", Name: "mem"}
+
+	release, err := e.acquireMeasurementScans(newStmt(cpu0), "db0")
+	require.NoError(t, err)
+
+	// the second concurrent scan of the limited measurement is rejected, including by a regex or a subquery
+	_, err = e.acquireMeasurementScans(newStmt(cpu0), "db0")
+	assert.True(t, errno.Equal(err, errno.MeasurementScanLimited))
+	assert.EqualError(t, err, "concurrent queries on measurement db0.cpu0 exceeded the limit(1)")
+	regex := &influxql.Measurement{Regex: &influxql.RegexLiteral{Val: regexp.MustCompile("^cpu")}}
+	_, err = e.acquireMeasurementScans(newStmt(regex), "db0")
+	assert.True(t, errno.Equal(err, errno.MeasurementScanLimited))
+	_, err = e.acquireMeasurementScans(newStmt(mem, &influxql.SubQuery{Statement: newStmt(cpu0)}), "db0")
+	assert.True(t, errno.Equal(err, errno.MeasurementScanLimited))
+
+	// the other measurements are not affected
+	for i := 0; i < 3; i++ {
+		r, err := e.acquireMeasurementScans(newStmt(mem, &influxql.Measurement{Database: "db1", Name: "cpu0"}), "db0")
+		require.NoError(t, err)
+		defer r()
+	}
+
+	// the measurement can be scanned again once the scan is released
+	release()
+	release, err = e.acquireMeasurementScans(newStmt(regex), "db0")
+	require.NoError(t, err)
+	release()
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+)
+
+type shardRecorderKey struct{}
+
+// shardIDLister is implemented by the shard groups which know the ids of their shards.
+type shardIDLister interface {
+	ShardIDs() []uint64
+}
+
+// shardRecorder records the shards mapped for a SELECT statement and its subqueries,
+// so that they can be reported to the client with the result.
+type shardRecorder struct {
+	mu     sync.Mutex
+	shards map[uint64]struct{}
+}
+
+func newContextWithShardRecorder(ctx context.Context, r *shardRecorder) context.Context {
+	return context.WithValue(ctx, shardRecorderKey{}, r)
+}
+
+func shardRecorderFromContext(ctx context.Context) *shardRecorder {
+	r, _ := ctx.Value(shardRecorderKey{}).(*shardRecorder)
+	return r
+}
+
+// mapper returns a shard mapper recording the shards mapped by m. The shards recorded before are
+// forgotten, they were mapped by a previous attempt to create the executor of the statement.
+func (r *shardRecorder) mapper(m query.ShardMapper) query.ShardMapper {
+	r.mu.Lock()
+	r.shards = make(map[uint64]struct{})
+	r.mu.Unlock()
+	return &recordingShardMapper{ShardMapper: m, recorder: r}
+}
+
+func (r *shardRecorder) add(ids []uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.shards == nil {
+		r.shards = make(map[uint64]struct{})
+	}
+	for _, id := range ids {
+		r.shards[id] = struct{}{}
+	}
+}
+
+// ShardIDs returns the ids of the recorded shards in ascending order.
+func (r *shardRecorder) ShardIDs() []uint64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ids := make([]uint64, 0, len(r.shards))
+	for id := range r.shards {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// messages returns the result message listing the recorded shards, such as "shards: 1,2,5",
+// nothing if the shards are not recorded.
+func (r *shardRecorder) messages() []*query.Message {
+	if r == nil {
+		return nil
+	}
+	ids := r.ShardIDs()
+	s := make([]string, len(ids))
+	for i, id := range ids {
+		s[i] = strconv.FormatUint(id, 10)
+	}
+	return []*query.Message{{Level: query.InfoLevel, Text: "shards: " + strings.Join(s, ",")}}
+}
+
+type recordingShardMapper struct {
+	query.ShardMapper
+	recorder *shardRecorder
+}
+
+func (m *recordingShardMapper) MapShards(sources influxql.Sources, t influxql.TimeRange, opt query.SelectOptions, condition influxql.Expr) (query.ShardGroup, error) {
+	sg, err := m.ShardMapper.MapShards(sources, t, opt, condition)
+	if err != nil {
+		return nil, err
+	}
+	if l, ok := sg.(shardIDLister); ok {
+		m.recorder.add(l.ShardIDs())
+	}
+	return sg, nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockShardIDsGroup struct {
+	query.ShardGroup
+	ids []uint64
+}
+
+func (g *mockShardIDsGroup) ShardIDs() []uint64 {
+	return g.ids
+}
+
+// mockDailyShardMapper maps a shard per day of January 2024, the shard of the nth day has id n.
+type mockDailyShardMapper struct {
+	query.ShardMapper
+}
+
+func (m *mockDailyShardMapper) MapShards(_ influxql.Sources, t influxql.TimeRange, _ query.SelectOptions, _ influxql.Expr) (query.ShardGroup, error) {
+	if t.Min.After(t.Max) {
+		return nil, errors.New("invalid time range")
+	}
+	g := &mockShardIDsGroup{}
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for day := 1; day <= 31; day++ {
+		end := start.Add(24 * time.Hour)
+		if !start.After(t.Max) && end.After(t.Min) {
+			g.ids = append(g.ids, uint64(day))
+		}
+		start = end
+	}
+	return g, nil
+}
+
+func TestShardRecorder(t *testing.T) {
+	r := &shardRecorder{}
+	ctx := newContextWithShardRecorder(context.Background(), r)
+	require.Equal(t, r, shardRecorderFromContext(ctx))
+	assert.Nil(t, shardRecorderFromContext(context.Background()))
+
+	mapShards := func(m query.ShardMapper, min, max time.Time) error {
+		_, err := m.MapShards(nil, influxql.TimeRange{Min: min, Max: max}, query.SelectOptions{}, nil)
+		return err
+	}
+	day := func(d int, h int) time.Time {
+		return time.Date(2024, 1, d, h, 0, 0, 0, time.UTC)
+	}
+
+	// the shards of the days in the time range
+	m := r.mapper(&mockDailyShardMapper{})
+	require.NoError(t, mapShards(m, day(3, 12), day(5, 1)))
+	assert.Equal(t, []uint64{3, 4, 5}, r.ShardIDs())
+
+	// the shards of a subquery are added to the shards of the query
+	require.NoError(t, mapShards(m, day(10, 0), day(10, 23)))
+	require.NoError(t, mapShards(m, day(4, 0), day(4, 1)))
+	assert.Equal(t, []uint64{3, 4, 5, 10}, r.ShardIDs())
+	assert.Equal(t, []*query.Message{{Level: query.InfoLevel, Text: "shards: 3,4,5,10"}}, r.messages())
+
+	// a failed mapping records nothing
+	require.Error(t, mapShards(m, day(9, 0), day(8, 0)))
+	assert.Equal(t, []uint64{3, 4, 5, 10}, r.ShardIDs())
+
+	// a new attempt forgets the shards of the previous one
+	m = r.mapper(&mockDailyShardMapper{})
+	require.NoError(t, mapShards(m, day(20, 6), day(20, 7)))
+	assert.Equal(t, []uint64{20}, r.ShardIDs())
+
+	// the shards are not reported if not recorded
+	var none *shardRecorder
+	assert.Nil(t, none.messages())
+	assert.Equal(t, []*query.Message{{Level: query.InfoLevel, Text: "shards: "}}, (&shardRecorder{}).messages())
+}
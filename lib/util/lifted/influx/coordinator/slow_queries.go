@@ -0,0 +1,100 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"sync"
+	"time"
+)
+
+// SlowQuery is a SELECT statement which ran longer than the slow query threshold.
+type SlowQuery struct {
+	Time      time.Time
+	Database  string
+	Statement string
+	Duration  time.Duration
+	// PlanDuration is the part of IteratorDuration taken to compile the statement and map its shards.
+	PlanDuration time.Duration
+	// IteratorDuration is the time taken to map the shards and build the executor of the statement.
+	IteratorDuration time.Duration
+	// EmitDuration is the time taken to execute the statement and emit its results.
+	EmitDuration time.Duration
+}
+
+// SlowQueryLog keeps the most recent slow queries for SHOW SLOW QUERIES in a ring buffer,
+// the oldest query is evicted once the buffer is full.
+type SlowQueryLog struct {
+	mu      sync.Mutex
+	queries []SlowQuery
+	next    int
+	full    bool
+}
+
+// NewSlowQueryLog returns a log keeping the last size slow queries, nil if size is not positive.
+func NewSlowQueryLog(size int) *SlowQueryLog {
+	if size <= 0 {
+		return nil
+	}
+	return &SlowQueryLog{queries: make([]SlowQuery, size)}
+}
+
+// Add records a slow query, evicting the oldest one if the log is full.
+func (l *SlowQueryLog) Add(q SlowQuery) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.queries[l.next] = q
+	l.next++
+	if l.next == len(l.queries) {
+		l.next = 0
+		l.full = true
+	}
+}
+
+// Clear forgets the recorded slow queries.
+func (l *SlowQueryLog) Clear() {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for i := range l.queries {
+		l.queries[i] = SlowQuery{}
+	}
+	l.next = 0
+	l.full = false
+}
+
+// Recent returns the recorded slow queries, the most recent first.
+func (l *SlowQueryLog) Recent() []SlowQuery {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	n := l.next
+	if l.full {
+		n = len(l.queries)
+	}
+	queries := make([]SlowQuery, 0, n)
+	for i := 1; i <= n; i++ {
+		queries = append(queries, l.queries[(l.next-i+len(l.queries))%len(l.queries)])
+	}
+	return queries
+}
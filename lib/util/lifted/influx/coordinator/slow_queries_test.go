@@ -0,0 +1,60 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlowQueryLog(t *testing.T) {
+	statements := func(queries []SlowQuery) []string {
+		s := make([]string, 0, len(queries))
+		for _, q := range queries {
+			s = append(s, q.Statement)
+		}
+		return s
+	}
+
+	l := NewSlowQueryLog(3)
+	assert.Empty(t, l.Recent())
+	l.Add(SlowQuery{Statement: "q1"})
+	l.Add(SlowQuery{Statement: "q2"})
+	assert.Equal(t, []string{"q2", "q1"}, statements(l.Recent()))
+
+	// the oldest queries are evicted once the log is full
+	l.Add(SlowQuery{Statement: "q3"})
+	assert.Equal(t, []string{"q3", "q2", "q1"}, statements(l.Recent()))
+	l.Add(SlowQuery{Statement: "q4"})
+	l.Add(SlowQuery{Statement: "q5"})
+	assert.Equal(t, []string{"q5", "q4", "q3"}, statements(l.Recent()))
+
+	// the log is empty after clearing, and keeps the next queries
+	l.Clear()
+	assert.Empty(t, l.Recent())
+	l.Add(SlowQuery{Statement: "q6"})
+	assert.Equal(t, []string{"q6"}, statements(l.Recent()))
+
+	// no log
+	l = NewSlowQueryLog(0)
+	assert.Nil(t, l)
+	l.Add(SlowQuery{Statement: "q1", Duration: time.Second})
+	assert.Empty(t, l.Recent())
+	l.Clear()
+}
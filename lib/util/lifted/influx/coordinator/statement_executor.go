@@ -19,10 +19,15 @@ Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
 
 import (
 	"bytes"
+	"container/heap"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/rand"
+	"os"
+	"reflect"
+	"runtime"
 	"runtime/debug"
 	"sort"
 	"strconv"
@@ -30,10 +35,13 @@ import (
 	"sync"
 	"sync/atomic"
 	"time"
+	"unicode"
 
 	set "github.com/deckarep/golang-set"
 	"github.com/influxdata/influxdb/models"
+	"github.com/influxdata/influxdb/toml"
 	originql "github.com/influxdata/influxql"
+	"github.com/openGemini/openGemini/app"
 	"github.com/openGemini/openGemini/coordinator"
 	"github.com/openGemini/openGemini/engine/executor"
 	"github.com/openGemini/openGemini/lib/config"
@@ -43,13 +51,16 @@ import (
 	meta "github.com/openGemini/openGemini/lib/metaclient"
 	"github.com/openGemini/openGemini/lib/netstorage"
 	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/sysconfig"
 	"github.com/openGemini/openGemini/lib/syscontrol"
 	"github.com/openGemini/openGemini/lib/tokenizer"
 	"github.com/openGemini/openGemini/lib/tracing"
+	"github.com/openGemini/openGemini/lib/util"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
 	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/openGemini/openGemini/services/continuousquery"
 	"go.uber.org/zap"
 )
 
@@ -60,10 +71,53 @@ const (
 	retrySelectInterval = time.Millisecond * 100
 
 	// SHOW CONFIGS parameters
-	sqlConfig    = "sql"
-	loggingLevel = "logging.level"
+	sqlConfig     = "sql"
+	storeConfig   = "store"
+	metaConfig    = "meta"
+	loggingLevel  = "logging.level"
+	loggingFormat = "logging.format"
+
+	maskedConfigValue = "***"
+)
+
+// The budget of retrying a SELECT statement while the meta service is unavailable, such as during
+// the election of a new meta leader. It is separate from the retries of the pt view changes.
+var (
+	maxMetaRetrySelectCount = 5
+	metaRetrySelectInterval = time.Millisecond * 500
 )
 
+// metaUnavailableErrStrs are the messages of the meta errors which lost their code on the wire.
+var metaUnavailableErrStrs = []string{
+	"node is not the leader",
+	"raft is not open",
+	meta2.ErrCommandTimeout.Error(),
+}
+
+// isMetaUnavailableError returns true if the meta service can not serve the request for now,
+// so that the request may succeed once a meta leader is elected.
+func isMetaUnavailableError(err error) bool {
+	if errno.Equal(err, errno.MetaIsNotLeader, errno.RaftIsNotOpen) {
+		return true
+	}
+	str := err.Error()
+	for _, s := range metaUnavailableErrStrs {
+		if strings.Contains(str, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// sensitiveConfigs are the configs only shown to the admin users by SHOW CONFIGS.
+var sensitiveConfigs = map[string]struct{}{
+	"http.shared-secret":          {},
+	"http.https-private-key":      {},
+	"spdy.tls-private-key":        {},
+	"spdy.tls-client-private-key": {},
+	"spdy.tls-ca-root":            {},
+}
+
 var streamSupportMap = map[string]bool{"min": true, "max": true, "sum": true, "count": true}
 
 // StatementExecutor executes a statement in the query.
@@ -81,6 +135,17 @@ type StatementExecutor struct {
 	// Holds monitoring data for SHOW STATS and SHOW DIAGNOSTICS.
 	MetaExecutor *coordinator.MetaExecutor
 
+	// SubscriberManager holds the delivery state for SHOW SUBSCRIPTIONS DETAIL.
+	SubscriberManager *coordinator.SubscriberManager
+
+	// ContinuousQueryService runs the continuous queries leased to this node, nil if the service is disabled.
+	// SHOW CONTINUOUS QUERIES reports the last run of each continuous query from it.
+	ContinuousQueryService *continuousquery.Service
+
+	// Databases on which subscriptions can or can not be created.
+	SubscriptionAllowDatabases []string
+	SubscriptionDenyDatabases  []string
+
 	//Node *meta.Node
 
 	// Select statement limits
@@ -93,11 +158,100 @@ type StatementExecutor struct {
 	RetentionPolicyLimit    int
 	MaxQueryParallel        int
 
+	// MaxSelectSeriesHardLimit is the upper bound of the series cap a statement can request
+	// by the max_series_n hint, the hint is ignored if it is not greater than MaxSelectSeriesN.
+	MaxSelectSeriesHardLimit int
+
+	// MaxSelectSources is the maximum number of measurements the sources of a SELECT statement
+	// can resolve to, regex sources are resolved against the meta data. Unlimited if 0.
+	MaxSelectSources int
+
+	// MaxShowMeasurements is the maximum number of measurements the sources of SHOW FIELD KEYS and SHOW TAG KEYS
+	// can resolve to, regex sources are resolved against the meta data. Unlimited if 0.
+	MaxShowMeasurements int
+
+	// MaxShowSeries is the maximum number of series keys SHOW SERIES collects from the nodes, the statement
+	// fails once they return more. With a LIMIT only offset+limit keys of each node are kept. Unlimited if 0.
+	MaxShowSeries int
+
+	// MaxSelectEmitBytes is the maximum number of serialized bytes a SELECT statement can return
+	// to the client, the statement is aborted once its results exceed it. Unlimited if 0.
+	MaxSelectEmitBytes int64
+
+	// SelectIntoAutoCreate creates the absent target measurement of SELECT INTO with the shard key
+	// and engine type of its source, like the target of a stream. The statement fails if false.
+	SelectIntoAutoCreate bool
+
+	// MaxSelectIntoPoints is the maximum number of points a SELECT INTO statement can write,
+	// the statement is aborted before the write exceeding it. Unlimited if 0.
+	MaxSelectIntoPoints int64
+
+	// ErrorVerbosity is the verbosity of the errors returned to the clients. The internal detail
+	// of the errors is only logged if it is config.ErrorVerbosityExternal.
+	ErrorVerbosity string
+
+	// NameValidation is the strictness of the validation of the names of the databases and measurements
+	// created by statements, see config.NameValidationDefault.
+	NameValidation string
+
+	// LogStatementsAfter is the elapsed time, retries included, after which a DDL or SHOW statement
+	// executed with retries is logged as slow. Disabled if 0.
+	LogStatementsAfter time.Duration
+
+	// PrivilegeCacheTTL is how long the privileges of a user are cached for the authorization of statements.
+	// The privileges are taken from the authenticated user if 0.
+	PrivilegeCacheTTL time.Duration
+
+	// QueryTraceSampleRate is the fraction of the SELECT statements traced and logged as with SET QUERY TRACING ON.
+	QueryTraceSampleRate float64
+
+	// SlowQueryThreshold is the elapsed time after which a SELECT statement is recorded in SlowQueries.
+	// No statement is recorded if 0.
+	SlowQueryThreshold time.Duration
+
+	// SlowQueries keeps the most recent slow SELECT statements for SHOW SLOW QUERIES.
+	SlowQueries *SlowQueryLog
+
+	// CardinalityCache keeps the series cardinality of each database precomputed for SHOW SERIES CARDINALITY,
+	// nil if the cardinality is not precomputed.
+	CardinalityCache *CardinalityCache
+
+	// QueryEventBus publishes the start and completion of every statement.
+	QueryEventBus *query.QueryEventBus
+
+	// AuditLogger records every executed statement, nil when the audit log is disabled.
+	AuditLogger *AuditLogger
+
+	// QueryRateLimiter limits the rate of SELECT and SHOW statements per database.
+	QueryRateLimiter *DatabaseQueryLimiter
+
+	// MeasurementScanLimiter limits the concurrent SELECT statements scanning each measurement.
+	MeasurementScanLimiter *MeasurementScanLimiter
+
+	// LimitRequiredMeasurements are the measurements of each database which can only be queried with a LIMIT.
+	LimitRequiredMeasurements map[string][]string
+
+	// privileges caches the privileges of the users for PrivilegeCacheTTL.
+	privileges privilegeCache
+
+	// queryTracing is set by SET QUERY TRACING ON, every SELECT statement is traced and its trace logged while it is set.
+	queryTracing int32
+
 	StmtExecLogger *logger.Logger
 
 	// hostname for show configs statement
 	Hostname   string
 	SqlConfigs map[string]interface{}
+
+	// SqlConfig is the running config checked by CHECK CONFIG.
+	SqlConfig *config.TSSql
+
+	// ServerInfo and BuildType describe the running server for SHOW VERSION and SHOW DIAGNOSTICS.
+	ServerInfo app.ServerInfo
+	BuildType  string
+
+	// StartTime is when the server started, the uptime of SHOW DIAGNOSTICS is measured from it.
+	StartTime time.Time
 }
 
 type combinedRunState uint8
@@ -144,10 +298,21 @@ func (q *combinedQueryExeInfo) getCombinedRunState() combinedRunState {
 	}
 }
 
-// getDurationString return the query running time until now, without decimal point. ie. 3.456s --> 3s
-func (q *combinedQueryExeInfo) getDurationString() string {
-	begin := q.beginTime
-	d := time.Duration(time.Now().UnixNano() - begin)
+// getDuration return the query running time until now.
+func (q *combinedQueryExeInfo) getDuration() time.Duration {
+	return time.Duration(time.Now().UnixNano() - q.beginTime)
+}
+
+// getDurationString return the query running time until now, without decimal point if not precise. ie. 3.456s --> 3s
+func (q *combinedQueryExeInfo) getDurationString(precise bool) string {
+	return formatQueryDuration(q.getDuration(), precise)
+}
+
+// formatQueryDuration truncates d to its largest unit among s, ms and µs, unless precise is set.
+func formatQueryDuration(d time.Duration, precise bool) string {
+	if precise {
+		return d.String()
+	}
 	switch {
 	case d >= time.Second:
 		d = d - (d % time.Second)
@@ -159,7 +324,7 @@ func (q *combinedQueryExeInfo) getDurationString() string {
 	return d.String()
 }
 
-func (q *combinedQueryExeInfo) toOutputRow(colNum int, isKilledPart bool) []interface{} {
+func (q *combinedQueryExeInfo) toOutputRow(colNum int, isKilledPart bool, precise bool) []interface{} {
 	res := make([]interface{}, 0, colNum)
 
 	var hostsJoined = func(hostsKV map[string]struct{}) string {
@@ -170,12 +335,15 @@ func (q *combinedQueryExeInfo) toOutputRow(colNum int, isKilledPart bool) []inte
 		return strings.Join(hosts, ", ")
 	}
 
-	res = append(res, q.qid, q.stmt, q.database, q.getDurationString())
+	d := q.getDuration()
+	res = append(res, q.qid, q.stmt, q.database, formatQueryDuration(d, precise))
 	if isKilledPart {
 		res = append(res, "killed", hostsJoined(q.killedHosts))
 	} else {
 		res = append(res, "running", hostsJoined(q.runningHosts))
 	}
+	// the raw duration lets the clients sort and filter the queries, followed by the number of nodes it is spread across
+	res = append(res, d.Nanoseconds(), len(q.runningHosts)+len(q.killedHosts))
 
 	return res
 }
@@ -198,11 +366,100 @@ func (e *StatementExecutor) Close() error {
 	return e.ShardMapper.Close()
 }
 
+// statementTypes caches the statement type of each statement struct, see statementType.
+var statementTypes sync.Map
+
+// statementType returns the type of a statement counted by the statement statistics, the snake case name of its struct
+// without the Statement suffix, e.g. show_measurements for *influxql.ShowMeasurementsStatement.
+func statementType(stmt influxql.Statement) string {
+	t := reflect.TypeOf(stmt)
+	if typ, ok := statementTypes.Load(t); ok {
+		return typ.(string)
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	name := strings.TrimSuffix(t.Name(), "Statement")
+	typ := make([]rune, 0, len(name)+4)
+	var prev rune
+	for _, r := range name {
+		if unicode.IsUpper(r) && unicode.IsLower(prev) {
+			typ = append(typ, '_')
+		}
+		prev = r
+		typ = append(typ, unicode.ToLower(r))
+	}
+	statementTypes.Store(reflect.TypeOf(stmt), string(typ))
+	return string(typ)
+}
+
 // ExecuteStatement executes the given statement with the given execution context.
 func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query.ExecutionContext, seq int) error {
+	statistics.StatementStat.Add(statementType(stmt))
+	publish := e.QueryEventBus.HasSubscribers()
+	if !publish && e.AuditLogger == nil {
+		return e.clientError(stmt, e.executeStatement(stmt, ctx, seq))
+	}
+
+	begin := time.Now()
+	var ev *query.QueryEvent
+	if publish {
+		ev = &query.QueryEvent{
+			Type:      query.QueryEventStart,
+			QueryID:   ctx.StatementQueryID(),
+			Database:  statementDatabase(stmt, ctx),
+			Statement: stmt.String(),
+			Time:      begin,
+			Status:    query.QueryStatusRunning,
+		}
+		e.QueryEventBus.Publish(ev)
+	}
+
+	err := e.executeStatement(stmt, ctx, seq)
+
+	end := time.Now()
+	e.AuditLogger.Log(stmt, ctx, end.Sub(begin), err)
+	if publish {
+		completed := *ev
+		completed.Type = query.QueryEventComplete
+		completed.Time = end
+		completed.Duration = end.Sub(begin)
+		completed.Status = query.QueryStatusSuccess
+		if err != nil {
+			completed.Status = query.QueryStatusFailed
+			completed.Error = err.Error()
+		}
+		e.QueryEventBus.Publish(&completed)
+	}
+	return e.clientError(stmt, err)
+}
+
+// statementDatabase returns the database the statement is executed on, falls back to the database of the request.
+func statementDatabase(stmt influxql.Statement, ctx *query.ExecutionContext) string {
+	switch stmt := stmt.(type) {
+	case *influxql.SelectStatement:
+		for _, m := range stmt.Sources.Measurements() {
+			if m.Database != "" {
+				return m.Database
+			}
+		}
+	case influxql.HasDefaultDatabase:
+		if db := stmt.DefaultDatabase(); db != "" {
+			return db
+		}
+	}
+	return ctx.Database
+}
+
+func (e *StatementExecutor) executeStatement(stmt influxql.Statement, ctx *query.ExecutionContext, seq int) error {
 	e.MaxQueryParallel = int(atomic.LoadInt32(&syscontrol.QueryParallel))
 	stmtString := stmt.String()
 
+	if err := e.checkQueryRateLimit(stmt, ctx); err != nil {
+		e.StmtExecLogger.Warn("statement rejected by query rate limit", zap.String("stmt", stmtString), zap.Error(err))
+		return err
+	}
+
 	// Select statements are handled separately so that they can be streamed.
 	if stmt, ok := stmt.(*influxql.SelectStatement); ok {
 		begin := time.Now()
@@ -279,7 +536,9 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 		}
 		err = e.executeCreateUserStatement(stmt)
 	case *influxql.DeleteSeriesStatement:
-		return meta2.ErrUnsupportCommand
+		if ctx.ReadOnly {
+			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
+		}
 		_, err = e.retryExecuteStatement(stmt, ctx, seq)
 	case *influxql.DropDatabaseStatement:
 		if ctx.ReadOnly {
@@ -292,11 +551,10 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 		}
 		_, err = e.retryExecuteStatement(stmt, ctx, seq)
 	case *influxql.DropSeriesStatement:
-		return meta2.ErrUnsupportCommand
 		if ctx.ReadOnly {
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
 		}
-		_, err = e.retryExecuteStatement(stmt, ctx, seq)
+		rows, err = e.executeDropSeriesStatement(stmt, ctx.Database)
 	case *influxql.DropRetentionPolicyStatement:
 		if ctx.ReadOnly {
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
@@ -313,7 +571,7 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 		if ctx.ReadOnly {
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
 		}
-		err = e.executeDropUserStatement(stmt)
+		err = e.executeDropUserStatement(stmt, ctx.UserID)
 	case *influxql.ExplainStatement:
 		rows, err = e.retryExecuteStatement(stmt, ctx, seq)
 	case *influxql.GrantStatement:
@@ -342,33 +600,38 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
 		}
 		err = e.executeRevokeAdminStatement(stmt)
+	case *influxql.RevokeAllStatement:
+		if ctx.ReadOnly {
+			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
+		}
+		err = e.executeRevokeAllStatement(stmt)
 	case *influxql.ShowDatabasesStatement:
 		rows, err = e.executeShowDatabasesStatement(stmt, ctx)
 	case *influxql.ShowDiagnosticsStatement:
-		return meta2.ErrUnsupportCommand
+		rows, err = e.executeShowDiagnosticsStatement(stmt)
 	case *influxql.ShowGrantsForUserStatement:
 		rows, err = e.executeShowGrantsForUserStatement(stmt)
 	case *influxql.ShowMeasurementKeysStatement:
 		rows, err = e.executeShowMeasurementKeysStatement(stmt)
 	case *influxql.ShowMeasurementsStatement:
-		if stmt.Condition != nil {
-			return meta2.ErrUnsupportCommand
-		}
-		_, err = e.retryExecuteStatement(stmt, ctx, seq)
-		return err
+		return e.executeShowMeasurementsOnce(stmt, ctx, seq)
 	case *influxql.ShowMeasurementCardinalityStatement:
-		if stmt.Condition != nil {
-			return meta2.ErrUnsupportCommand
-		}
 		rows, err = e.retryExecuteStatement(stmt, ctx, seq)
 	case *influxql.ShowRetentionPoliciesStatement:
 		rows, err = e.executeShowRetentionPoliciesStatement(stmt)
 	case *influxql.ShowSeriesCardinalityStatement:
-		rows, err = e.retryExecuteStatement(stmt, ctx, seq)
+		if cached, message, ok := e.cachedSeriesCardinality(stmt); ok {
+			rows = cached
+			messages = append(messages, message)
+		} else {
+			rows, err = e.retryExecuteStatement(stmt, ctx, seq)
+		}
 	case *influxql.ShowShardsStatement:
 		rows, err = e.executeShowShardsStatement(stmt)
 	case *influxql.ShowShardGroupsStatement:
 		rows, err = e.executeShowShardGroupsStatement(stmt)
+	case *influxql.ShowShardMappingStatement:
+		rows, err = e.executeShowShardMappingStatement(stmt)
 	case *influxql.ShowSubscriptionsStatement:
 		rows, err = e.executeShowSubscriptionsStatement(stmt)
 	case *influxql.ShowFieldKeysStatement:
@@ -397,7 +660,7 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 		}
 		err = e.executeSetPasswordUserStatement(stmt)
 	case *influxql.ShowQueriesStatement:
-		rows, err = e.executeShowQueriesStatement()
+		rows, err = e.executeShowQueriesStatement(stmt)
 	case *influxql.KillQueryStatement:
 		err = e.executeKillQuery(stmt)
 	case *influxql.PrepareSnapshotStatement:
@@ -433,12 +696,41 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 		rows, err = e.executeShowStreamsStatement(stmt)
 	case *influxql.DropStreamsStatement:
 		err = e.executeDropStream(stmt)
+	case *influxql.ShowStreamTargetsStatement:
+		rows, err = e.executeShowStreamTargets(stmt)
+	case *influxql.DropStreamTargetsStatement:
+		if ctx.ReadOnly {
+			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
+		}
+		err = e.executeDropStreamTargets(stmt)
 	case *influxql.ShowConfigsStatement:
-		rows, err = e.executeShowConfigs(stmt)
+		rows, err = e.executeShowConfigs(stmt, ctx)
+	case *influxql.CheckConfigStatement:
+		rows, err = e.executeCheckConfig()
+	case *influxql.ShowQueryLimitsStatement:
+		rows, err = e.executeShowQueryLimits(stmt)
+	case *influxql.ShowExecutorLimitsStatement:
+		rows = e.executeShowExecutorLimits()
+	case *influxql.ShowVersionStatement:
+		rows, err = e.executeShowVersion()
 	case *influxql.SetConfigStatement:
 		err = e.executeSetConfig(stmt)
+	case *influxql.SetQueryTracingStatement:
+		e.executeSetQueryTracing(stmt)
+	case *influxql.ShowSlowQueriesStatement:
+		rows, err = e.executeShowSlowQueries()
+	case *influxql.ClearSlowQueriesStatement:
+		e.executeClearSlowQueries()
 	case *influxql.ShowClusterStatement:
 		rows, err = e.executeShowCluster(stmt)
+	case *influxql.ShowDataNodesStatement:
+		rows, err = e.executeShowDataNodes(stmt)
+	case *influxql.ShowMetaNodesStatement:
+		rows, err = e.executeShowMetaNodes()
+	case *influxql.RebalanceDatabaseStatement:
+		rows, err = e.executeRebalanceDatabase(stmt)
+	case *influxql.DrainNodeStatement:
+		rows, err = e.executeDrainNode(stmt, ctx)
 	default:
 		return query.ErrInvalidQuery
 	}
@@ -453,18 +745,95 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 	}, seq)
 }
 
+// CheckQueryLimits consumes the query rate limit tokens of all the statements of the query at once before
+// it is executed, or none of them if one of the databases is throttled.
+func (e *StatementExecutor) CheckQueryLimits(q *influxql.Query, opt query.ExecutionOptions) error {
+	if e.QueryRateLimiter == nil {
+		return nil
+	}
+	var dbs []string
+	for _, stmt := range q.Statements {
+		dbs = append(dbs, rateLimitedDatabases(stmt, opt.Database)...)
+	}
+	return e.allowQueries(dbs)
+}
+
+// checkQueryRateLimit consumes a token of every database queried by the SELECT or SHOW statement, or none of
+// them if one of the databases is throttled. Nothing is consumed if the query limits were checked before.
+func (e *StatementExecutor) checkQueryRateLimit(stmt influxql.Statement, ctx *query.ExecutionContext) error {
+	if e.QueryRateLimiter == nil {
+		return nil
+	}
+	var defaultDB string
+	if ctx != nil {
+		if ctx.LimitsChecked {
+			return nil
+		}
+		defaultDB = ctx.Database
+	}
+	return e.allowQueries(rateLimitedDatabases(stmt, defaultDB))
+}
+
+func (e *StatementExecutor) allowQueries(dbs []string) error {
+	if db, ok := e.QueryRateLimiter.AllowAll(dbs); !ok {
+		return errno.NewError(errno.RateLimited, db).SetRetryAfter(e.QueryRateLimiter.RetryAfter(db))
+	}
+	return nil
+}
+
+// rateLimitedDatabases returns the databases queried by the SELECT or SHOW statement once each, defaultDB
+// for the sources without a database.
+func rateLimitedDatabases(stmt influxql.Statement, defaultDB string) []string {
+	var databases []string
+	switch stmt := stmt.(type) {
+	case *influxql.SelectStatement:
+		for _, m := range stmt.Sources.Measurements() {
+			databases = append(databases, m.Database)
+		}
+	case *influxql.ShowSeriesStatement, *influxql.ShowSeriesCardinalityStatement, *influxql.ShowMeasurementsStatement,
+		*influxql.ShowMeasurementCardinalityStatement, *influxql.ShowRetentionPoliciesStatement,
+		*influxql.ShowTagKeysStatement, *influxql.ShowTagKeyCardinalityStatement, *influxql.ShowTagValuesStatement,
+		*influxql.ShowTagValuesCardinalityStatement, *influxql.ShowFieldKeysStatement, *influxql.ShowFieldKeyCardinalityStatement:
+		databases = append(databases, stmt.(influxql.HasDefaultDatabase).DefaultDatabase())
+	default:
+		return nil
+	}
+
+	checked := make(map[string]struct{}, len(databases))
+	dbs := make([]string, 0, len(databases))
+	for _, db := range databases {
+		if db == "" {
+			db = defaultDB
+		}
+		if _, ok := checked[db]; ok {
+			continue
+		}
+		checked[db] = struct{}{}
+		dbs = append(dbs, db)
+	}
+	return dbs
+}
+
 func (e *StatementExecutor) retryExecuteStatement(stmt influxql.Statement, ctx *query.ExecutionContext, seq int) (models.Rows, error) {
 	startTime := time.Now()
 	var retryNum uint32 = 0
 	var err error
 	var rows models.Rows
+	defer func() {
+		e.logSlowStatement(stmt, time.Since(startTime), retryNum)
+	}()
 	for time.Now().Sub(startTime).Seconds() < coordinator.DMLTimeOutSecond {
 		if retryNum > 0 {
-			time.Sleep(coordinator.DMLRetryInternalMillisecond * time.Millisecond)
+			if cerr := waitRetry(ctx, coordinator.DMLRetryInternalMillisecond*time.Millisecond); cerr != nil {
+				e.StmtExecLogger.Info("ExecuteStatement canceled ", zap.Error(err), zap.Uint32("retryNum", retryNum), zap.Any("stmt", stmt))
+				return rows, cerr
+			}
 		}
 		retryNum++
 
 		switch stmt := stmt.(type) {
+		case *influxql.DeleteSeriesStatement:
+			err = e.executeDeleteSeriesStatement(stmt, ctx.Database)
 		case *influxql.DropDatabaseStatement:
 			err = e.executeDropDatabaseStatement(stmt)
 		case *influxql.DropMeasurementStatement:
@@ -476,7 +845,7 @@ func (e *StatementExecutor) retryExecuteStatement(stmt influxql.Statement, ctx *
 		case *influxql.ShowTagKeyCardinalityStatement:
 			err = e.executeShowTagKeyCardinality(stmt, ctx, seq)
 		case *influxql.ShowTagValuesStatement:
-			rows, err = e.executeShowTagValues(stmt)
+			rows, err = e.executeShowTagValues(stmt, ctx)
 		case *influxql.ShowSeriesStatement:
 			err = e.executeShowSeries(stmt, ctx, seq)
 		case *influxql.ShowMeasurementsStatement:
@@ -494,6 +863,8 @@ func (e *StatementExecutor) retryExecuteStatement(stmt influxql.Statement, ctx *
 		case *influxql.ExplainStatement:
 			if stmt.Analyze {
 				rows, err = e.executeExplainAnalyzeStatement(stmt, ctx)
+			} else if stmt.Cost {
+				rows, err = e.executeExplainCostStatement(stmt, ctx)
 			} else {
 				rows, err = e.executeExplainStatement(stmt, ctx)
 			}
@@ -503,7 +874,7 @@ func (e *StatementExecutor) retryExecuteStatement(stmt influxql.Statement, ctx *
 			return rows, err
 		}
 
-		if coordinator.IsRetriedError(err) || strings.Contains(err.Error(), "repeat mark delete") {
+		if isRetriedStatementError(err) {
 			e.StmtExecLogger.Warn("retry ExecuteStatement ", zap.Error(err), zap.Uint32("retryNum", retryNum), zap.Any("stmt", stmt))
 			continue
 		} else {
@@ -514,6 +885,58 @@ func (e *StatementExecutor) retryExecuteStatement(stmt influxql.Statement, ctx *
 	return rows, err
 }
 
+// isRetriedStatementError tells whether the statement failing with err is retried by retryExecuteStatement.
+func isRetriedStatementError(err error) bool {
+	return coordinator.IsRetriedError(err) || strings.Contains(err.Error(), "repeat mark delete")
+}
+
+// executeShowMeasurementsOnce executes SHOW MEASUREMENTS once, the statement is so common that the setup of the
+// retry loop is only paid if it fails with an error which is retried.
+func (e *StatementExecutor) executeShowMeasurementsOnce(stmt *influxql.ShowMeasurementsStatement, ctx *query.ExecutionContext, seq int) error {
+	startTime := time.Now()
+	err := e.executeShowMeasurementsStatement(stmt, ctx, seq)
+	if err == nil || !isRetriedStatementError(err) {
+		e.logSlowStatement(stmt, time.Since(startTime), 1)
+		if err != nil {
+			e.StmtExecLogger.Error("ExecuteStatement error ", zap.Error(err), zap.Any("stmt", stmt))
+		}
+		return err
+	}
+	e.StmtExecLogger.Warn("retry ExecuteStatement ", zap.Error(err), zap.Uint32("retryNum", 1), zap.Any("stmt", stmt))
+	_, err = e.retryExecuteStatement(stmt, ctx, seq)
+	return err
+}
+
+// waitRetry waits for the interval between two tries of a statement,
+// it returns the error of the context as soon as the client cancels the statement.
+func waitRetry(ctx *query.ExecutionContext, interval time.Duration) error {
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+	if ctx.Context == nil {
+		<-timer.C
+		return nil
+	}
+	select {
+	case <-ctx.Context.Done():
+		return ctx.Context.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// logSlowStatement logs the statement if it took longer than LogStatementsAfter, retries included.
+func (e *StatementExecutor) logSlowStatement(stmt influxql.Statement, elapsed time.Duration, retryNum uint32) {
+	if e.LogStatementsAfter <= 0 || elapsed <= e.LogStatementsAfter {
+		return
+	}
+	e.StmtExecLogger.GetZapLogger().Warn("slow statement",
+		zap.String("type", strings.TrimPrefix(fmt.Sprintf("%T", stmt), "*influxql.")),
+		zap.String("stmt", influxql.Sanitize(stmt.String())),
+		zap.Uint32("retry", retryNum),
+		zap.Duration("duration", elapsed),
+		zap.Duration("threshold", e.LogStatementsAfter))
+}
+
 func (e *StatementExecutor) executeCreateDownSamplingStmt(stmt *influxql.CreateDownSampleStatement) error {
 	if !meta2.ValidName(stmt.DbName) {
 		return errno.NewError(errno.InvalidName)
@@ -584,6 +1007,12 @@ func (e *StatementExecutor) executeAlterRetentionPolicyStatement(stmt *influxql.
 	if rpi.HasDownSamplePolicy() && stmt.Duration != nil && rpi.Duration != *stmt.Duration {
 		return errno.NewError(errno.DownSamplePolicyExists)
 	}
+	// an infinite duration (0) or a shard group duration left to the default (0) is compatible with any other
+	duration := *meta2.LoadDurationOrDefault(stmt.Duration, &rpi.Duration)
+	sgDuration := *meta2.LoadDurationOrDefault(stmt.ShardGroupDuration, &rpi.ShardGroupDuration)
+	if duration > 0 && sgDuration > duration {
+		return errno.NewError(errno.ShardGroupDurationExceedsDuration, sgDuration, duration, stmt.Name)
+	}
 	oneReplication := 1
 	rpu := &meta2.RetentionPolicyUpdate{
 		Duration:           stmt.Duration,
@@ -648,7 +1077,7 @@ func (e *StatementExecutor) getIndexRelation(stmt *influxql.CreateMeasurementSta
 }
 
 func (e *StatementExecutor) executeCreateMeasurementStatement(stmt *influxql.CreateMeasurementStatement) error {
-	if !meta2.ValidMeasurementName(stmt.Name) {
+	if !meta2.ValidMeasurementName(stmt.Name) || !meta2.ValidNameStrictness(stmt.Name, e.NameValidation) {
 		return meta2.ErrInvalidName
 	}
 
@@ -680,7 +1109,7 @@ func (e *StatementExecutor) executeAlterShardKeyStatement(stmt *influxql.AlterSh
 }
 
 func (e *StatementExecutor) executeCreateDatabaseStatement(stmt *influxql.CreateDatabaseStatement) error {
-	if !meta2.ValidName(stmt.Name) {
+	if !meta2.ValidName(stmt.Name) || !meta2.ValidNameStrictness(stmt.Name, e.NameValidation) {
 		// TODO This should probably be in `(*meta.Data).CreateDatabase`
 		// but can't go there until 1.1 is used everywhere
 		return meta2.ErrInvalidName
@@ -815,9 +1244,33 @@ func (e *StatementExecutor) executeCreateContinuousQueryStatement(stmt *influxql
 	if err = isValidContinuousQueryStatement(cqQuery); err != nil {
 		return err
 	}
+	if err = e.checkContinuousQueryTarget(stmt); err != nil {
+		return err
+	}
 	return e.MetaClient.CreateContinuousQuery(stmt.Database, stmt.Name, cqQuery)
 }
 
+// checkContinuousQueryTarget makes sure the database and retention policy written by the continuous query exist,
+// otherwise every run of the continuous query fails to write.
+func (e *StatementExecutor) checkContinuousQueryTarget(stmt *influxql.CreateContinuousQueryStatement) error {
+	target := stmt.Source.Target.Measurement
+	db := target.Database
+	if db == "" {
+		db = stmt.Database
+	}
+	rp, err := e.MetaClient.RetentionPolicy(db, target.RetentionPolicy)
+	if err != nil {
+		return err
+	}
+	if rp == nil {
+		if target.RetentionPolicy == "" {
+			return fmt.Errorf("default retention policy not found for the INTO target database: %s", db)
+		}
+		return meta2.ErrRetentionPolicyNotFound(db + "." + target.RetentionPolicy)
+	}
+	return nil
+}
+
 // executeDropContinuousQueryStatement drops a continuous query from the cluster.
 func (e *StatementExecutor) executeDropContinuousQueryStatement(stmt *influxql.DropContinuousQueryStatement) error {
 	e.StmtExecLogger.Info("delete continuous query start", zap.String("cq name", stmt.Name), zap.String("database", stmt.Database))
@@ -828,16 +1281,53 @@ func (e *StatementExecutor) executeDropContinuousQueryStatement(stmt *influxql.D
 	return nil
 }
 
+// subscriptionAllowed returns whether subscriptions can be created on the database,
+// the deny list takes precedence over the allow list, and an empty allow list allows all databases.
+func (e *StatementExecutor) subscriptionAllowed(db string) bool {
+	for _, name := range e.SubscriptionDenyDatabases {
+		if name == db {
+			return false
+		}
+	}
+	if len(e.SubscriptionAllowDatabases) == 0 {
+		return true
+	}
+	for _, name := range e.SubscriptionAllowDatabases {
+		if name == db {
+			return true
+		}
+	}
+	return false
+}
+
 func (e *StatementExecutor) executeCreateSubscriptionStatement(q *influxql.CreateSubscriptionStatement) error {
 	if !config.GetSubscriptionEnable() {
 		return errors.New("subscription is not enabled")
 	}
+	if !e.subscriptionAllowed(q.Database) {
+		return fmt.Errorf("subscription is not enabled on database %s", q.Database)
+	}
 	return e.MetaClient.CreateSubscription(q.Database, q.RetentionPolicy, q.Name, q.Mode, q.Destinations)
 }
 
 func (e *StatementExecutor) executeCreateUserStatement(q *influxql.CreateUserStatement) error {
 	_, err := e.MetaClient.CreateUser(q.Name, q.Password, q.Admin, q.Rwuser)
-	return err
+	if err != nil || len(q.Grants) == 0 {
+		return err
+	}
+
+	// the user is dropped if any of its privileges can not be granted, so that it is created with all of them or not at all
+	defer e.privileges.invalidate(q.Name)
+	for _, grant := range q.Grants {
+		if err = e.MetaClient.SetPrivilege(q.Name, grant.On, originql.Privilege(grant.Privilege)); err == nil {
+			continue
+		}
+		if dropErr := e.MetaClient.DropUser(q.Name); dropErr != nil {
+			e.StmtExecLogger.Error("failed to drop the user whose privileges can not be granted", zap.String("user", q.Name), zap.Error(dropErr))
+		}
+		return fmt.Errorf("grant %s on %s: %v", grant.Privilege, grant.On, err)
+	}
+	return nil
 }
 
 // executeDropDatabaseStatement drops a database from the cluster.
@@ -868,6 +1358,164 @@ func (e *StatementExecutor) executeDropMeasurementStatement(stmt *influxql.DropM
 	return e.MetaClient.MarkMeasurementDelete(database, stmt.Name)
 }
 
+// executeDeleteSeriesStatement deletes the series matching the condition on the store nodes, from all the
+// measurements of the default database if the statement has no source.
+func (e *StatementExecutor) executeDeleteSeriesStatement(stmt *influxql.DeleteSeriesStatement, database string) error {
+	sources := make(map[string]influxql.Measurements)
+	if len(stmt.Sources) == 0 {
+		sources[database] = nil
+	}
+	for _, m := range stmt.Sources.Measurements() {
+		db := m.Database
+		if db == "" {
+			db = database
+		}
+		sources[db] = append(sources[db], m)
+	}
+
+	for db, ms := range sources {
+		if db == "" {
+			return coordinator.ErrDatabaseNameRequired
+		}
+		if err := e.deleteSeries(db, ms, stmt.Condition); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *StatementExecutor) deleteSeries(database string, ms influxql.Measurements, condition influxql.Expr) error {
+	mis, err := e.MetaClient.MatchMeasurements(database, ms)
+	if err != nil {
+		return err
+	}
+	if len(mis) == 0 {
+		return nil
+	}
+
+	if err = e.checkSeriesCondition(database, ms, condition); err != nil {
+		return err
+	}
+
+	// the measurements are matched as rp.mst, the series are deleted from the indexes of their retention policy
+	names := make(map[string][]string)
+	for key, m := range mis {
+		rp := strings.TrimSuffix(key, "."+m.Name)
+		names[rp] = append(names[rp], m.Name)
+	}
+	for rp := range names {
+		e.StmtExecLogger.Info("delete series", zap.String("db", database), zap.String("rp", rp), zap.Strings("measurements", names[rp]))
+	}
+	return e.MetaExecutor.EachDBNodes(database, func(nodeID uint64, pts []uint32) error {
+		for rp := range names {
+			if err := e.NetStorage.DeleteSeries(nodeID, database, rp, pts, names[rp], condition); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// checkSeriesCondition rejects a condition referring to fields, as the series are deleted from the index,
+// the condition can only filter on tags and time.
+func (e *StatementExecutor) checkSeriesCondition(database string, ms influxql.Measurements, condition influxql.Expr) error {
+	if condition == nil {
+		return nil
+	}
+	fields, err := e.MetaClient.FieldKeys(database, ms)
+	if err != nil {
+		return err
+	}
+	for _, ref := range influxql.ExprNames(condition) {
+		for _, mstFields := range fields {
+			if _, ok := mstFields[ref.Val]; ok {
+				return fmt.Errorf("fields not supported in WHERE clause during deletion: %s", ref.Val)
+			}
+		}
+	}
+	return nil
+}
+
+// executeDropSeriesStatement drops the series matching the condition on the store nodes, from all the
+// measurements of the default database if the statement has no source. The result holds the number of nodes
+// of each database on which the series are dropped, so that a partial drop can be detected.
+func (e *StatementExecutor) executeDropSeriesStatement(stmt *influxql.DropSeriesStatement, database string) (models.Rows, error) {
+	if influxql.HasTimeExpr(stmt.Condition) {
+		return nil, errors.New("DROP SERIES doesn't support time in WHERE clause")
+	}
+
+	sources := make(map[string]influxql.Measurements)
+	if len(stmt.Sources) == 0 {
+		sources[database] = nil
+	}
+	for _, m := range stmt.Sources.Measurements() {
+		db := m.Database
+		if db == "" {
+			db = database
+		}
+		sources[db] = append(sources[db], m)
+	}
+
+	databases := make([]string, 0, len(sources))
+	for db := range sources {
+		if db == "" {
+			return nil, coordinator.ErrDatabaseNameRequired
+		}
+		databases = append(databases, db)
+	}
+	sort.Strings(databases)
+
+	row := &models.Row{Columns: []string{"database", "nodes", "succeeded"}}
+	for _, db := range databases {
+		nodes, succeeded, err := e.dropSeries(db, sources[db], stmt.Condition)
+		if err != nil {
+			return nil, err
+		}
+		row.Values = append(row.Values, []interface{}{db, nodes, succeeded})
+	}
+	return models.Rows{row}, nil
+}
+
+// dropSeries drops the series of the database on each of its nodes, it returns the number of nodes and the
+// number of nodes on which the series are dropped.
+func (e *StatementExecutor) dropSeries(database string, ms influxql.Measurements, condition influxql.Expr) (int, int, error) {
+	mis, err := e.MetaClient.MatchMeasurements(database, ms)
+	if err != nil || len(mis) == 0 {
+		return 0, 0, err
+	}
+	if err = e.checkSeriesCondition(database, ms, condition); err != nil {
+		return 0, 0, err
+	}
+
+	names := make([]string, 0, len(mis))
+	for _, m := range mis {
+		names = append(names, m.Name)
+	}
+	e.StmtExecLogger.Info("drop series", zap.String("db", database), zap.Strings("measurements", names))
+
+	var mu sync.Mutex
+	nodes := make(map[uint64]bool)
+	err = e.MetaExecutor.EachDBNodes(database, func(nodeID uint64, pts []uint32) error {
+		err := e.NetStorage.DropSeries(nodeID, database, pts, names, condition)
+		mu.Lock()
+		defer mu.Unlock()
+		// a node failed before may succeed when EachDBNodes retries
+		nodes[nodeID] = nodes[nodeID] || err == nil
+		return err
+	})
+
+	succeeded := 0
+	for _, ok := range nodes {
+		if ok {
+			succeeded++
+		}
+	}
+	if err != nil {
+		return 0, 0, fmt.Errorf("drop series on database %s succeeded on %d of %d nodes: %v", database, succeeded, len(nodes), err)
+	}
+	return len(nodes), succeeded, nil
+}
+
 func (e *StatementExecutor) executeDropRetentionPolicyStatement(stmt *influxql.DropRetentionPolicyStatement) error {
 	e.StmtExecLogger.Info("start delete rp ", zap.String("db", stmt.Database), zap.String("rp", stmt.Name))
 	dbi, _ := e.MetaClient.Database(stmt.Database)
@@ -896,21 +1544,111 @@ func (e *StatementExecutor) executeDropSubscriptionStatement(q *influxql.DropSub
 	return e.MetaClient.DropSubscription(q.Database, q.RetentionPolicy, q.Name)
 }
 
-func (e *StatementExecutor) executeDropUserStatement(q *influxql.DropUserStatement) error {
-	return e.MetaClient.DropUser(q.Name)
-}
+func (e *StatementExecutor) executeDropUserStatement(q *influxql.DropUserStatement, userID string) error {
+	if len(q.Names) == 0 {
+		defer e.privileges.invalidate(q.Name)
+		return e.MetaClient.DropUser(q.Name)
+	}
+
+	// A dropped user can not be recreated with its password and privileges, so all the users are checked
+	// before any of them is dropped. The admin user, the only and so the last admin, is rejected with the
+	// error the meta nodes return to DROP USER, as is the user running the statement.
+	users := make(map[string]meta2.UserInfo)
+	for _, u := range e.MetaClient.Users() {
+		users[u.Name] = u
+	}
+	names := make([]string, 0, len(q.Names))
+	seen := make(map[string]struct{}, len(q.Names))
+	for _, name := range q.Names {
+		u, ok := users[name]
+		if !ok {
+			return fmt.Errorf("%w: %s", meta2.ErrUserNotFound, name)
+		}
+		if u.AuthorizeUnrestricted() || name == userID {
+			return meta2.ErrUserDropSelf
+		}
+		if _, ok := seen[name]; !ok {
+			seen[name] = struct{}{}
+			names = append(names, name)
+		}
+	}
 
-func (e *StatementExecutor) executeExplainStatement(q *influxql.ExplainStatement, ctx *query.ExecutionContext) (models.Rows, error) {
-	panic("impl me")
+	for _, name := range names {
+		err := e.MetaClient.DropUser(name)
+		e.privileges.invalidate(name)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func (e *StatementExecutor) executeExplainAnalyzeStatement(q *influxql.ExplainStatement, ectx *query.ExecutionContext) (models.Rows, error) {
+// executeExplainStatement builds the pipeline executor of the select statement like EXPLAIN ANALYZE,
+// and renders its transforms instead of executing it.
+func (e *StatementExecutor) executeExplainStatement(q *influxql.ExplainStatement, ectx *query.ExecutionContext) (models.Rows, error) {
 	stmt := q.Statement
-	trace, span := tracing.NewTrace("SELECT")
+	stmt.OmitTime = true
+	shards := &shardRecorder{}
+	ctx := newContextWithShardRecorder(ectx.Context, shards)
+	opt := ectx.ExecutionOptions
+	opt.ReportShards = true
+
+	proxy := newRowChanProxy()
+	pipelineExecutor, err := e.createPipelineExecutor(ctx, stmt, opt, proxy.rc)
+	if err == influxql.ErrDeclareEmptyCollection {
+		err = nil
+		pipelineExecutor = nil
+	}
+	if err != nil {
+		proxy.close()
+		return nil, err
+	}
+	if pipelineExecutor == nil {
+		proxy.close()
+		return models.Rows{}, nil
+	}
+	defer func() {
+		pipelineExecutor.Release()
+		proxy.close()
+	}()
+
+	select {
+	case <-ectx.Done():
+		return nil, ectx.Err()
+	default:
+	}
+	return explainRows(stmt, pipelineExecutor, len(shards.ShardIDs())), nil
+}
+
+// explainRows renders the plan of EXPLAIN, the number of shards, fields and dimensions of the statement
+// are followed by the transforms of the executor, one row value per line.
+func explainRows(stmt *influxql.SelectStatement, pipelineExecutor *executor.PipelineExecutor, shards int) models.Rows {
+	fields, dimensions := len(stmt.Fields), len(stmt.Dimensions)
+	if root := pipelineExecutor.GetRoot(); root != nil && root.GetNode() != nil && root.GetNode().Schema() != nil {
+		schema := root.GetNode().Schema()
+		fields, dimensions = len(schema.GetQueryFields()), len(schema.Options().GetDimensions())
+	}
+
+	row := &models.Row{
+		Columns: []string{"EXPLAIN"},
+	}
+	row.Values = append(row.Values,
+		[]interface{}{fmt.Sprintf("shards: %d", shards)},
+		[]interface{}{fmt.Sprintf("fields: %d, dimensions: %d", fields, dimensions)})
+	for _, s := range pipelineExecutor.Explain() {
+		row.Values = append(row.Values, []interface{}{s})
+	}
+	return models.Rows{row}
+}
+
+func (e *StatementExecutor) executeExplainAnalyzeStatement(q *influxql.ExplainStatement, ectx *query.ExecutionContext) (models.Rows, error) {
+	stmt := q.Statement
+	trace, span := tracing.NewTrace("SELECT")
 	stmt.OmitTime = true
 	ctx := tracing.NewContextWithTrace(ectx.Context, trace)
 	ctx = tracing.NewContextWithSpan(ctx, span)
 	span.AppendNameValue("statement", q.String())
+	appendQueryLabels(span, stmt, ectx)
 	span.Finish()
 
 	proxy := newRowChanProxy()
@@ -965,25 +1703,176 @@ func (e *StatementExecutor) executeExplainAnalyzeStatement(q *influxql.ExplainSt
 	emSpan.AppendNameValue("row_count", rowCount)
 	emSpan.Finish()
 
+	return explainAnalyzeRows(trace, q.Verbosity), nil
+}
+
+// appendQueryLabels tags the span with the database, the measurements and the query id of the statement,
+// so that the distributed traces can be filtered and correlated with SHOW QUERIES.
+func appendQueryLabels(span *tracing.Span, stmt *influxql.SelectStatement, ectx *query.ExecutionContext) {
+	database := ectx.Database
+	var names []string
+	for _, m := range stmt.Sources.Measurements() {
+		if m.Database != "" {
+			database = m.Database
+		}
+		if m.Regex != nil {
+			names = append(names, m.Regex.String())
+			continue
+		}
+		names = append(names, m.Name)
+	}
+	span.AppendNameValue("db", database)
+	span.AppendNameValue("measurement", strings.Join(names, ","))
+	span.AppendNameValue("qid", ectx.StatementQueryID())
+}
+
+// explainAnalyzeRows renders the trace with the verbosity of the EXPLAIN ANALYZE statement, one row value per line.
+func explainAnalyzeRows(trace *tracing.Trace, verbosity influxql.ExplainVerbosity) models.Rows {
+	v := tracing.VerbosityDefault
+	switch verbosity {
+	case influxql.ExplainVerbose:
+		v = tracing.VerbosityVerbose
+	case influxql.ExplainSummary:
+		v = tracing.VerbositySummary
+	case influxql.ExplainBrief:
+		v = tracing.VerbosityBrief
+	}
+
 	row := &models.Row{
 		Columns: []string{"EXPLAIN ANALYZE"},
 	}
-	for _, s := range strings.Split(trace.String(), "\n") {
+	for _, s := range strings.Split(trace.Render(v), "\n") {
 		row.Values = append(row.Values, []interface{}{s})
 	}
+	return models.Rows{row}
+}
+
+// executeExplainCostStatement estimates the scan cost of the select statement from the meta data
+// and the shards mapped for it, the statement itself is not executed.
+func (e *StatementExecutor) executeExplainCostStatement(q *influxql.ExplainStatement, ctx *query.ExecutionContext) (models.Rows, error) {
+	stmt := q.Statement
+	if err := e.authorizeSelect(stmt, ctx); err != nil {
+		return nil, err
+	}
+	_, tr, err := influxql.ConditionExpr(stmt.Condition, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// the shards are mapped with the options of the statement as if it was executed
+	sopt := e.selectOptions(stmt, ctx.ExecutionOptions, nil)
+	sopt.SetStatementHints(stmt)
+	shards, err := e.ShardMapper.MapShards(stmt.Sources, tr, sopt, stmt.Condition)
+	if err != nil {
+		return nil, err
+	}
+	defer util.MustClose(shards)
+
+	opt := query.ProcessorOptions{
+		StartTime: tr.MinTimeNano(),
+		EndTime:   tr.MaxTimeNano(),
+	}
+	var shardN, seriesN int64
+	// shards are shared by all the measurements of a retention policy, count them only once
+	sourceSeen := make(map[string]struct{})
+	for _, source := range stmt.Sources {
+		mst, ok := source.(*influxql.Measurement)
+		if !ok {
+			continue
+		}
+
+		key := mst.Database + "." + mst.RetentionPolicy
+		if _, ok := sourceSeen[key]; !ok {
+			sourceSeen[key] = struct{}{}
+			cost, err := shards.LogicalPlanCost(mst, opt)
+			if err != nil {
+				return nil, err
+			}
+			shardN += cost.NumShards
+		}
+
+		n, err := e.estimateSeriesN(mst, tr)
+		if err != nil {
+			return nil, err
+		}
+		seriesN += n
+	}
+
+	// without a GROUP BY time() every series is expected to emit at least one point
+	pointN := seriesN
+	interval, err := stmt.GroupByInterval()
+	if err != nil {
+		return nil, err
+	}
+	if interval > 0 && !tr.Min.IsZero() && !tr.Max.IsZero() {
+		if buckets := int64(tr.Max.Sub(tr.Min)/interval) + 1; buckets > 1 {
+			pointN = seriesN * buckets
+		}
+	}
 
+	row := &models.Row{
+		Columns: []string{"metric", "estimate"},
+		Values: [][]interface{}{
+			{"shards", shardN},
+			{"series", seriesN},
+			{"points", pointN},
+		},
+	}
 	return models.Rows{row}, nil
 }
 
+// estimateSeriesN sums the series cardinality of the shard groups overlapping the time range.
+// The result counts the series once per shard group, which matches the number of cursors to be created.
+func (e *StatementExecutor) estimateSeriesN(mst *influxql.Measurement, tr influxql.TimeRange) (int64, error) {
+	mis, err := e.MetaClient.MatchMeasurements(mst.Database, influxql.Measurements{mst})
+	if err != nil {
+		return 0, err
+	}
+	if len(mis) == 0 {
+		return 0, nil
+	}
+	names := make([]string, 0, len(mis))
+	for _, m := range mis {
+		names = append(names, m.Name)
+	}
+
+	var seriesN int64
+	lock := new(sync.Mutex)
+	err = e.MetaExecutor.EachDBNodes(mst.Database, func(nodeID uint64, pts []uint32) error {
+		mstCardinality, err := e.NetStorage.SeriesCardinality(nodeID, mst.Database, pts, names, nil)
+		if err != nil {
+			return err
+		}
+		lock.Lock()
+		defer lock.Unlock()
+		for i := range mstCardinality {
+			for _, info := range mstCardinality[i].CardinalityInfos {
+				if info.TimeRange.EndTime.Before(tr.MinTime()) || info.TimeRange.StartTime.After(tr.MaxTime()) {
+					continue
+				}
+				seriesN += int64(info.Cardinality)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return seriesN, nil
+}
+
 func (e *StatementExecutor) executeGrantStatement(stmt *influxql.GrantStatement) error {
+	defer e.privileges.invalidate(stmt.User)
 	return e.MetaClient.SetPrivilege(stmt.User, stmt.On, originql.Privilege(stmt.Privilege))
 }
 
 func (e *StatementExecutor) executeGrantAdminStatement(stmt *influxql.GrantAdminStatement) error {
+	defer e.privileges.invalidate(stmt.User)
 	return e.MetaClient.SetAdminPrivilege(stmt.User, true)
 }
 
 func (e *StatementExecutor) executeRevokeStatement(stmt *originql.RevokeStatement) error {
+	defer e.privileges.invalidate(stmt.User)
 	priv := originql.NoPrivileges
 
 	// Revoking all privileges means there's no need to look at existing user privileges.
@@ -1000,22 +1889,84 @@ func (e *StatementExecutor) executeRevokeStatement(stmt *originql.RevokeStatemen
 }
 
 func (e *StatementExecutor) executeRevokeAdminStatement(stmt *influxql.RevokeAdminStatement) error {
+	defer e.privileges.invalidate(stmt.User)
 	return e.MetaClient.SetAdminPrivilege(stmt.User, false)
 }
 
+// executeRevokeAllStatement revokes the privileges of the user on every database, then its admin privilege.
+// The privileges already revoked are skipped, so the statement can be run again after a failure.
+func (e *StatementExecutor) executeRevokeAllStatement(stmt *influxql.RevokeAllStatement) error {
+	defer e.privileges.invalidate(stmt.User)
+	privs, err := e.MetaClient.UserPrivileges(stmt.User)
+	if err != nil {
+		return err
+	}
+	dbs := make([]string, 0, len(privs))
+	for db, p := range privs {
+		if p != originql.NoPrivileges {
+			dbs = append(dbs, db)
+		}
+	}
+	sort.Strings(dbs)
+	for _, db := range dbs {
+		if err = e.MetaClient.SetPrivilege(stmt.User, db, originql.NoPrivileges); err != nil {
+			return err
+		}
+	}
+
+	for _, u := range e.MetaClient.Users() {
+		if u.Name == stmt.User && u.Admin {
+			return e.MetaClient.SetAdminPrivilege(stmt.User, false)
+		}
+	}
+	return nil
+}
+
 func (e *StatementExecutor) executeSetPasswordUserStatement(q *influxql.SetPasswordUserStatement) error {
+	if !e.userExists(q.Name) {
+		return errno.NewError(errno.UserNotFound, q.Name)
+	}
 	return e.MetaClient.UpdateUser(q.Name, q.Password)
 }
 
+func (e *StatementExecutor) userExists(name string) bool {
+	for _, u := range e.MetaClient.Users() {
+		if u.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 func (e *StatementExecutor) retryExecuteSelectStatement(stmt *influxql.SelectStatement, ctx *query.ExecutionContext, seq int) error {
 	var err error
 
-	for i := 0; i < maxRetrySelectCount; i++ {
+	metaRetries := 0
+	for i := 0; i < maxRetrySelectCount; {
 		err = e.executeSelectStatement(stmt, ctx, seq)
-		if err == nil || !coordinator.IsRetryErrorForPtView(err) {
+		if err == nil {
 			break
 		}
-		time.Sleep(retrySelectInterval * (1 << i))
+		if isMetaUnavailableError(err) {
+			if metaRetries >= maxMetaRetrySelectCount {
+				break
+			}
+			metaRetries++
+			e.StmtExecLogger.Warn("retry select statement, meta is unavailable", zap.Error(err), zap.Int("retryNum", metaRetries))
+			if cerr := waitRetry(ctx, metaRetrySelectInterval); cerr != nil {
+				return cerr
+			}
+			continue
+		}
+		if errno.Equal(err, errno.ShardMapperTimeout) {
+			e.StmtExecLogger.Warn("retry select statement, shard mapping timed out", zap.Error(err), zap.Int("retryNum", i+1))
+		} else if !coordinator.IsRetryErrorForPtView(err) {
+			break
+		}
+		if cerr := waitRetry(ctx, retrySelectInterval*(1<<i)); cerr != nil {
+			return cerr
+		}
+		i++
 	}
 	return err
 }
@@ -1038,7 +1989,8 @@ func (e *StatementExecutor) retryCreatePipelineExecutor(ctx context.Context, stm
 				retryNum++
 				continue
 			} else {
-				return nil, err
+				// tell the caller how many times and how long it was retried before giving up
+				return nil, errno.NewBuiltIn(err, errno.ModuleCoordinator).WithRetries(retryNum, time.Since(startTime))
 			}
 		} else {
 			if strings.Contains(err.Error(), "declare empty collection") {
@@ -1052,11 +2004,48 @@ func (e *StatementExecutor) retryCreatePipelineExecutor(ctx context.Context, stm
 }
 
 func (e *StatementExecutor) executeSelectStatement(stmt *influxql.SelectStatement, ctx *query.ExecutionContext, seq int) error {
+	if err := e.authorizeSelect(stmt, ctx); err != nil {
+		return err
+	}
+	if err := e.prepareSelectTarget(stmt); err != nil {
+		return err
+	}
 	start := time.Now()
 	proxy := newRowChanProxy()
 	// omit Time field for stmt
 	stmt.OmitTime = true
-	pipelineExecutor, err := e.retryCreatePipelineExecutor(ctx, stmt, ctx.ExecutionOptions, proxy.rc)
+	var trace *tracing.Trace
+	var traceSpan *tracing.Span
+	var pipCtx context.Context = ctx
+	if ctx.Context != nil {
+		if span := tracing.SpanFromContext(ctx); span != nil {
+			appendQueryLabels(span, stmt, ctx)
+		} else if e.traceSelect() {
+			trace, traceSpan = newSelectTrace(stmt, ctx)
+			pipCtx = tracing.NewContextWithSpan(tracing.NewContextWithTrace(ctx, trace), traceSpan)
+			defer e.logQueryTrace(stmt, trace)
+		}
+	}
+	var shards *shardRecorder
+	if ctx.ReportShards && ctx.Context != nil {
+		shards = &shardRecorder{}
+		pipCtx = newContextWithShardRecorder(pipCtx, shards)
+	}
+	// stmtStat measures the planning of this statement alone, the statistics of the query only keep the slowest one.
+	var qStat, stmtStat *statistics.SQLSlowQueryStatistics
+	if ctx.Context != nil {
+		qStat, _ = ctx.Value(query.QueryDurationKey).(*statistics.SQLSlowQueryStatistics)
+	}
+	if qStat != nil {
+		stmtStat = statistics.NewSqlSlowQueryStatistics(qStat.DB)
+		stmtStat.SetQueryAndLocs(qStat.Query, qStat.QueryLocs)
+		pipCtx = context.WithValue(pipCtx, query.QueryDurationKey, stmtStat)
+	}
+	pipelineExecutor, err := e.retryCreatePipelineExecutor(pipCtx, stmt, ctx.ExecutionOptions, proxy.rc)
+	plan := planDuration(stmtStat)
+	if qStat != nil {
+		qStat.AddDuration("PrepareDuration", plan.Nanoseconds())
+	}
 	if err == influxql.ErrDeclareEmptyCollection {
 		// skip empty collection err and return empty result set
 		err = nil
@@ -1069,7 +2058,8 @@ func (e *StatementExecutor) executeSelectStatement(stmt *influxql.SelectStatemen
 	if pipelineExecutor == nil {
 		proxy.close()
 		return ctx.Send(&query.Result{
-			Series: make([]*models.Row, 0),
+			Series:   make([]*models.Row, 0),
+			Messages: append(queryIDMessages(ctx), shards.messages()...),
 		}, seq)
 	}
 
@@ -1082,20 +2072,25 @@ func (e *StatementExecutor) executeSelectStatement(stmt *influxql.SelectStatemen
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		ctxWithWriter := context.WithValue(context.Background(), executor.WRITER_CONTEXT, ctx.PointsWriter)
+		execCtx := context.Background()
+		if trace != nil {
+			execCtx = tracing.NewContextWithSpan(tracing.NewContextWithTrace(execCtx, trace), traceSpan)
+		}
+		ctxWithWriter := context.WithValue(execCtx, executor.WRITER_CONTEXT, ctx.PointsWriter)
 		ec <- pipelineExecutor.ExecuteExecutor(ctxWithWriter)
 		close(ec)
 		proxy.close()
 	}()
 
 	defer func() {
-		qStat, _ := ctx.Value(query.QueryDurationKey).(*statistics.SQLSlowQueryStatistics)
 		if qStat != nil {
 			qStat.AddDuration("SqlIteratorDuration", end.Sub(start).Nanoseconds())
 			qStat.AddDuration("EmitDuration", time.Now().Sub(end).Nanoseconds())
 		}
+		e.recordSlowQuery(stmt, ctx.Database, plan, end.Sub(start), time.Since(end))
 	}()
 
+	budget := emitBudget{limit: e.MaxSelectEmitBytes}
 	var rowsChan query.RowsChan
 	var ok bool
 	for {
@@ -1105,10 +2100,22 @@ func (e *StatementExecutor) executeSelectStatement(stmt *influxql.SelectStatemen
 				closed = true
 				break
 			}
+			if err := budget.add(rowsChan.Rows); err != nil {
+				e.StmtExecLogger.Info("aborted by emit bytes limit", zap.String("stmt", stmt.String()), zap.Error(err))
+				pipelineExecutor.Abort()
+				go proxy.wait()
+				return err
+			}
 			result := &query.Result{
 				Series:  rowsChan.Rows,
 				Partial: rowsChan.Partial,
 			}
+			if ctx.ReportColumnTypes {
+				result.ColumnTypes = rowsChan.ColumnTypes
+			}
+			if !emitted {
+				result.Messages = queryIDMessages(ctx)
+			}
 			// Send results or exit if closing.
 			if err := ctx.Send(result, seq); err != nil {
 				pipelineExecutor.Abort()
@@ -1136,21 +2143,163 @@ func (e *StatementExecutor) executeSelectStatement(stmt *influxql.SelectStatemen
 	// Always emit at least one result.
 	if !emitted {
 		return ctx.Send(&query.Result{
-			Series: make([]*models.Row, 0),
+			Series:   make([]*models.Row, 0),
+			Messages: append(queryIDMessages(ctx), shards.messages()...),
 		}, seq)
 	}
+	if shards != nil {
+		return ctx.Send(&query.Result{Messages: shards.messages()}, seq)
+	}
 	return nil
 }
 
+// queryIDMessages returns the message telling the client the ID of the query if it is reported,
+// it is sent with the first result of a SELECT statement. Nothing is returned if the query is not registered.
+func queryIDMessages(ctx *query.ExecutionContext) []*query.Message {
+	if !ctx.ReportQueryID {
+		return nil
+	}
+	qid := ctx.StatementQueryID()
+	if qid == 0 {
+		return nil
+	}
+	return []*query.Message{query.QueryIDMessage(qid)}
+}
+
+// QueryTracing reports whether the SELECT statements are traced, see SET QUERY TRACING.
+func (e *StatementExecutor) QueryTracing() bool {
+	return atomic.LoadInt32(&e.queryTracing) == 1
+}
+
+// traceSelect decides whether a SELECT statement is traced before it runs,
+// so that the sampled statements are not biased towards the fast or the slow ones.
+func (e *StatementExecutor) traceSelect() bool {
+	return e.QueryTracing() || sampleQueryTrace(e.QueryTraceSampleRate)
+}
+
+func sampleQueryTrace(rate float64) bool {
+	if rate <= 0 {
+		return false
+	}
+	return rate >= 1 || rand.Float64() < rate
+}
+
+func (e *StatementExecutor) executeSetQueryTracing(stmt *influxql.SetQueryTracingStatement) {
+	e.StmtExecLogger.Info("change query tracing by ddl", zap.Bool("enabled", stmt.Enabled))
+	if stmt.Enabled {
+		atomic.StoreInt32(&e.queryTracing, 1)
+	} else {
+		atomic.StoreInt32(&e.queryTracing, 0)
+	}
+}
+
+// planDuration returns the time taken to compile the statement measured by stmtStat.
+func planDuration(stmtStat *statistics.SQLSlowQueryStatistics) time.Duration {
+	if stmtStat == nil {
+		return 0
+	}
+	return time.Duration(atomic.LoadInt64(&stmtStat.PrepareDuration))
+}
+
+// recordSlowQuery records the statement in SlowQueries if it ran longer than SlowQueryThreshold.
+// The planning is a part of the iteration, the statement ran for iterator + emit.
+func (e *StatementExecutor) recordSlowQuery(stmt *influxql.SelectStatement, database string, plan, iterator, emit time.Duration) {
+	d := iterator + emit
+	if e.SlowQueries == nil || e.SlowQueryThreshold <= 0 || d <= e.SlowQueryThreshold {
+		return
+	}
+	e.SlowQueries.Add(SlowQuery{
+		Time:             time.Now(),
+		Database:         database,
+		Statement:        stmt.String(),
+		Duration:         d,
+		PlanDuration:     plan,
+		IteratorDuration: iterator,
+		EmitDuration:     emit,
+	})
+}
+
+func (e *StatementExecutor) executeShowSlowQueries() (models.Rows, error) {
+	row := &models.Row{Columns: []string{"time", "database", "statement", "duration", "plan_duration", "iterator_duration", "emit_duration"}}
+	for _, q := range e.SlowQueries.Recent() {
+		row.Values = append(row.Values, []interface{}{q.Time.UTC().Format(time.RFC3339Nano), q.Database, q.Statement,
+			q.Duration.String(), q.PlanDuration.String(), q.IteratorDuration.String(), q.EmitDuration.String()})
+	}
+	return models.Rows{row}, nil
+}
+
+func (e *StatementExecutor) executeClearSlowQueries() {
+	e.StmtExecLogger.Info("clear slow queries by ddl")
+	e.SlowQueries.Clear()
+}
+
+// newSelectTrace starts the trace of a SELECT statement traced because of SET QUERY TRACING ON,
+// like the trace of EXPLAIN ANALYZE.
+func newSelectTrace(stmt *influxql.SelectStatement, ectx *query.ExecutionContext) (*tracing.Trace, *tracing.Span) {
+	trace, span := tracing.NewTrace("SELECT")
+	span.AppendNameValue("statement", stmt.String())
+	appendQueryLabels(span, stmt, ectx)
+	span.Finish()
+	return trace, span
+}
+
+func (e *StatementExecutor) logQueryTrace(stmt *influxql.SelectStatement, trace *tracing.Trace) {
+	e.StmtExecLogger.GetZapLogger().Info("query trace", zap.String("stmt", stmt.String()),
+		zap.String("trace", trace.Render(tracing.VerbosityDefault)))
+}
+
+// prepareSelectTarget makes sure the target measurement of a SELECT INTO exists before the query runs.
+// A target taking the names of the sources is left to the writes.
+func (e *StatementExecutor) prepareSelectTarget(stmt *influxql.SelectStatement) error {
+	if stmt.Target == nil || stmt.Target.Measurement == nil || stmt.Target.Measurement.Name == "" {
+		return nil
+	}
+	dst := stmt.Target.Measurement
+	_, err := e.MetaClient.Measurement(dst.Database, dst.RetentionPolicy, dst.Name)
+	if err != meta2.ErrMeasurementNotFound {
+		return err
+	}
+	if !e.SelectIntoAutoCreate {
+		return errno.NewError(errno.SelectIntoTargetNotFound, dst.Database, dst.RetentionPolicy, dst.Name)
+	}
+
+	// the target is created by the writes with the defaults of its database if the source is ambiguous
+	if len(stmt.Sources) != 1 {
+		return nil
+	}
+	src, ok := stmt.Sources[0].(*influxql.Measurement)
+	if !ok || src.Regex != nil || src.Name == "" {
+		return nil
+	}
+	srcInfo, err := e.MetaClient.Measurement(src.Database, src.RetentionPolicy, src.Name)
+	if err != nil {
+		return err
+	}
+	var shardKey *meta2.ShardKeyInfo
+	if len(srcInfo.ShardKeys) > 0 {
+		shardKey = &srcInfo.ShardKeys[0]
+	}
+	var colStoreInfo *meta2.ColStoreInfo
+	if srcInfo.EngineType == config.COLUMNSTORE {
+		_, tags := stmt.Dimensions.Normalize()
+		keys := append(tags, "time")
+		colStoreInfo = meta2.NewColStoreInfo(keys, keys, nil, 0, "")
+	}
+	_, err = e.MetaClient.CreateMeasurement(dst.Database, dst.RetentionPolicy, dst.Name, shardKey, srcInfo.InitNumOfShards, nil,
+		srcInfo.EngineType, colStoreInfo, nil, nil)
+	return err
+}
+
 func (e *StatementExecutor) GetOptions(opt query.ExecutionOptions, rowsChan chan query.RowsChan) query.SelectOptions {
 	return query.SelectOptions{
 		NodeID:                  opt.NodeID,
-		MaxSeriesN:              e.MaxSelectSeriesN,
-		MaxFieldsN:              e.MaxSelectFieldsN,
-		MaxPointN:               e.MaxSelectPointN,
-		MaxBucketsN:             e.MaxSelectBucketsN,
+		MaxSeriesN:              nonNegative(e.MaxSelectSeriesN),
+		MaxFieldsN:              nonNegative(e.MaxSelectFieldsN),
+		MaxPointN:               nonNegative(e.MaxSelectPointN),
+		MaxBucketsN:             nonNegative(e.MaxSelectBucketsN),
 		Authorizer:              opt.Authorizer,
-		MaxQueryMem:             e.MaxQueryMem,
+		MaxQueryMem:             nonNegative64(e.MaxQueryMem),
+		MaxIntoPoints:           nonNegative64(e.MaxSelectIntoPoints),
 		MaxQueryParallel:        e.MaxQueryParallel,
 		QueryTimeCompareEnabled: e.QueryTimeCompareEnabled,
 		Chunked:                 opt.Chunked,
@@ -1165,8 +2314,284 @@ func (e *StatementExecutor) GetOptions(opt query.ExecutionOptions, rowsChan chan
 	}
 }
 
-func (e *StatementExecutor) createPipelineExecutor(ctx context.Context, stmt *influxql.SelectStatement, opt query.ExecutionOptions, rowsChan chan query.RowsChan) (pipelineExecutor *executor.PipelineExecutor, err error) {
+// nonNegative returns 0, which means unlimited, for a negative limit.
+func nonNegative(n int) int {
+	if n < 0 {
+		return 0
+	}
+	return n
+}
+
+// nonNegative64 returns 0, which means unlimited, for a negative limit.
+func nonNegative64(n int64) int64 {
+	if n < 0 {
+		return 0
+	}
+	return n
+}
+
+// NormalizeLimits clamps the SELECT limits configured negative to 0, which means unlimited,
+// and warns about them. It is called once at startup.
+func (e *StatementExecutor) NormalizeLimits() {
+	var negative []string
+	limits := []struct {
+		name  string
+		value *int
+	}{
+		{"max-select-point-n", &e.MaxSelectPointN},
+		{"max-select-series-n", &e.MaxSelectSeriesN},
+		{"max-select-fields-n", &e.MaxSelectFieldsN},
+		{"max-select-buckets-n", &e.MaxSelectBucketsN},
+	}
+	for _, l := range limits {
+		if *l.value < 0 {
+			negative = append(negative, fmt.Sprintf("%s=%d", l.name, *l.value))
+			*l.value = 0
+		}
+	}
+
+	limits64 := []struct {
+		name  string
+		value *int64
+	}{
+		{"max-query-mem", &e.MaxQueryMem},
+		{"max-select-emit-bytes", &e.MaxSelectEmitBytes},
+		{"max-select-into-points", &e.MaxSelectIntoPoints},
+	}
+	for _, l := range limits64 {
+		if *l.value < 0 {
+			negative = append(negative, fmt.Sprintf("%s=%d", l.name, *l.value))
+			*l.value = 0
+		}
+	}
+
+	if len(negative) > 0 {
+		e.StmtExecLogger.GetZapLogger().Warn("negative limits are unlimited", zap.Strings("limits", negative))
+	}
+}
+
+// emitBudget sums the estimated serialized size of the rows a query emits and fails once they exceed limit.
+type emitBudget struct {
+	limit   int64
+	emitted int64
+}
+
+func (b *emitBudget) add(rows models.Rows) error {
+	if b.limit <= 0 {
+		return nil
+	}
+	b.emitted += emittedSize(rows)
+	if b.emitted > b.limit {
+		return errno.NewError(errno.SelectEmitBytesExceeded, b.emitted, b.limit)
+	}
+	return nil
+}
+
+// The sizes used to estimate the JSON response of the rows without serializing them.
+const (
+	emitRowSize    = 36 // {"name":"","columns":[],"values":[]}
+	emitNumberSize = 17 // the significant digits of a float64
+	emitTimeSize   = 32 // a quoted RFC3339Nano time
+)
+
+// emittedSize estimates the size of the rows in the JSON response from the counts of the rows and the
+// values, only the strings are counted by their length.
+func emittedSize(rows models.Rows) int64 {
+	var n int64
+	for _, row := range rows {
+		n += emitRowSize + int64(len(row.Name))
+		for k, v := range row.Tags {
+			n += int64(len(k)+len(v)) + 6
+		}
+		for _, c := range row.Columns {
+			n += int64(len(c)) + 3
+		}
+		for _, values := range row.Values {
+			n += 3
+			for _, v := range values {
+				n += emitValueSize(v) + 1
+			}
+		}
+	}
+	return n
+}
+
+func emitValueSize(v interface{}) int64 {
+	switch v := v.(type) {
+	case string:
+		return int64(len(v)) + 2
+	case nil:
+		return 4
+	case bool:
+		return 5
+	case time.Time:
+		return emitTimeSize
+	default:
+		return emitNumberSize
+	}
+}
+
+// selectOptions returns the options of the SELECT statement, with the series cap of its max_series_n hint.
+func (e *StatementExecutor) selectOptions(stmt *influxql.SelectStatement, opt query.ExecutionOptions, rowsChan chan query.RowsChan) query.SelectOptions {
 	sopt := e.GetOptions(opt, rowsChan)
+	sopt.MaxSeriesN = e.maxSelectSeriesN(stmt)
+	return sopt
+}
+
+// maxSelectSeriesN returns the series cap of the statement. The max_series_n hint can raise
+// the server default for one statement, but never beyond MaxSelectSeriesHardLimit.
+func (e *StatementExecutor) maxSelectSeriesN(stmt *influxql.SelectStatement) int {
+	if e.MaxSelectSeriesN == 0 || e.MaxSelectSeriesHardLimit <= e.MaxSelectSeriesN {
+		return e.MaxSelectSeriesN
+	}
+
+	for _, hint := range stmt.Hints {
+		n, ok := influxql.ParseMaxSeriesNHint(hint.String())
+		if !ok || n <= e.MaxSelectSeriesN {
+			continue
+		}
+		if n > e.MaxSelectSeriesHardLimit {
+			n = e.MaxSelectSeriesHardLimit
+		}
+		return n
+	}
+	return e.MaxSelectSeriesN
+}
+
+// checkSelectSourcesLimit rejects the statement before planning when its sources, including the sources
+// of subqueries, resolve to more than MaxSelectSources measurements.
+func (e *StatementExecutor) checkSelectSourcesLimit(stmt *influxql.SelectStatement, defaultDatabase string) error {
+	if e.MaxSelectSources <= 0 {
+		return nil
+	}
+
+	n := 0
+	for _, m := range stmt.Sources.Measurements() {
+		if m.Regex == nil {
+			n++
+			continue
+		}
+		database := m.Database
+		if database == "" {
+			database = defaultDatabase
+		}
+		msts, err := e.MetaClient.MatchMeasurements(database, influxql.Measurements{m})
+		if err != nil {
+			return err
+		}
+		n += len(msts)
+	}
+	if n > e.MaxSelectSources {
+		return errno.NewError(errno.SelectSourcesLimitExceeded, n, e.MaxSelectSources)
+	}
+	return nil
+}
+
+// checkShowSourcesLimit rejects SHOW FIELD KEYS and SHOW TAG KEYS before querying the meta data when their sources
+// resolve to more than MaxShowMeasurements measurements, so that a broad regex can not overload the node.
+// Statements without sources query all the measurements of the database.
+func (e *StatementExecutor) checkShowSourcesLimit(database string, sources influxql.Sources) error {
+	if e.MaxShowMeasurements <= 0 {
+		return nil
+	}
+
+	n := 0
+	if len(sources) == 0 {
+		msts, err := e.MetaClient.MatchMeasurements(database, nil)
+		if err != nil {
+			return err
+		}
+		n = len(msts)
+	}
+	for _, m := range sources.Measurements() {
+		if m.Regex == nil {
+			n++
+			continue
+		}
+		msts, err := e.MetaClient.MatchMeasurements(database, influxql.Measurements{m})
+		if err != nil {
+			return err
+		}
+		n += len(msts)
+	}
+	if n > e.MaxShowMeasurements {
+		return errno.NewError(errno.ShowSourcesLimitExceeded, n, e.MaxShowMeasurements)
+	}
+	return nil
+}
+
+// acquireMeasurementScans admits the statement to scan each limited measurement of its sources, including the sources
+// of subqueries and the measurements matched by regex. The returned function releases the admitted scans.
+func (e *StatementExecutor) acquireMeasurementScans(stmt *influxql.SelectStatement, defaultDatabase string) (func(), error) {
+	type scan struct{ db, mst string }
+	var acquired []scan
+	release := func() {
+		for _, s := range acquired {
+			e.MeasurementScanLimiter.Release(s.db, s.mst)
+		}
+	}
+	if e.MeasurementScanLimiter == nil {
+		return release, nil
+	}
+
+	seen := make(map[scan]struct{})
+	for _, m := range stmt.Sources.Measurements() {
+		database := m.Database
+		if database == "" {
+			database = defaultDatabase
+		}
+		if !e.MeasurementScanLimiter.Limited(database) {
+			continue
+		}
+
+		names := []string{m.Name}
+		if m.Regex != nil {
+			msts, err := e.MetaClient.MatchMeasurements(database, influxql.Measurements{m})
+			if err != nil {
+				release()
+				return nil, err
+			}
+			names = names[:0]
+			for _, mst := range msts {
+				names = append(names, mst.OriginName())
+			}
+		}
+
+		for _, name := range names {
+			s := scan{db: database, mst: name}
+			if _, ok := seen[s]; ok {
+				continue
+			}
+			seen[s] = struct{}{}
+			if !e.MeasurementScanLimiter.Acquire(database, name) {
+				release()
+				return nil, errno.NewError(errno.MeasurementScanLimited, database, name, e.MeasurementScanLimiter.Limit(database, name))
+			}
+			acquired = append(acquired, s)
+		}
+	}
+	return release, nil
+}
+
+func (e *StatementExecutor) createPipelineExecutor(ctx context.Context, stmt *influxql.SelectStatement, opt query.ExecutionOptions, rowsChan chan query.RowsChan) (pipelineExecutor *executor.PipelineExecutor, err error) {
+	sopt := e.selectOptions(stmt, opt, rowsChan)
+
+	if err := e.checkSelectSourcesLimit(stmt, opt.Database); err != nil {
+		return nil, err
+	}
+
+	release, err := e.acquireMeasurementScans(stmt, opt.Database)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		// the scans are held by the executor until it is released
+		if pipelineExecutor == nil {
+			release()
+			return
+		}
+		pipelineExecutor.AddReleaseFunc(release)
+	}()
 
 	defer func() {
 		if e := recover(); e != nil {
@@ -1182,7 +2607,13 @@ func (e *StatementExecutor) createPipelineExecutor(ctx context.Context, stmt *in
 	}()
 
 	// Create a pipelineExecutor from a selection.
-	p, e_tmp := executor.Select(ctx, stmt, e.ShardMapper, sopt)
+	shardMapper := e.ShardMapper
+	if opt.ReportShards {
+		if r := shardRecorderFromContext(ctx); r != nil {
+			shardMapper = r.mapper(e.ShardMapper)
+		}
+	}
+	p, e_tmp := executor.Select(ctx, stmt, shardMapper, sopt)
 	if e_tmp != nil || p == nil {
 		return nil, e_tmp
 	}
@@ -1191,7 +2622,66 @@ func (e *StatementExecutor) createPipelineExecutor(ctx context.Context, stmt *in
 	return pipelineExecutor, err
 }
 
+// authorize returns an error if the user executing the statement lacks the privilege on the database.
+// Statements executed without an authorizer, such as internal queries, are always authorized.
+func (e *StatementExecutor) authorize(ctx *query.ExecutionContext, database string, p originql.Privilege) error {
+	if ctx == nil || ctx.Authorizer == nil {
+		return nil
+	}
+	authorized, err := e.authorizeDatabase(ctx.Authorizer, database, p)
+	if err != nil {
+		return err
+	}
+	if !authorized {
+		return errno.NewError(errno.NoDatabasePrivilege, p, database)
+	}
+	return nil
+}
+
+// authorizeSelect checks the read privilege on the databases of the sources, including those of the subqueries,
+// and the write privilege on the database of the INTO target.
+func (e *StatementExecutor) authorizeSelect(stmt *influxql.SelectStatement, ctx *query.ExecutionContext) error {
+	var err error
+	seen := make(map[string]bool)
+	influxql.WalkFunc(stmt.Sources, func(n influxql.Node) {
+		m, ok := n.(*influxql.Measurement)
+		if !ok || err != nil || seen[m.Database] {
+			return
+		}
+		seen[m.Database] = true
+		err = e.authorize(ctx, m.Database, originql.ReadPrivilege)
+	})
+	if err != nil {
+		return err
+	}
+	if stmt.Target != nil && stmt.Target.Measurement != nil {
+		return e.authorize(ctx, stmt.Target.Measurement.Database, originql.WritePrivilege)
+	}
+	return nil
+}
+
+// authorizeDatabase checks the privilege of a user against its cached privileges when the privilege cache is enabled,
+// so that the grants and revokes made after the user was authenticated are taken into account.
+func (e *StatementExecutor) authorizeDatabase(a query.FineAuthorizer, database string, p originql.Privilege) (bool, error) {
+	u, ok := a.(*meta2.UserInfo)
+	if e.PrivilegeCacheTTL <= 0 || !ok || u.Admin || u.Rwuser || p == originql.NoPrivileges {
+		return a.AuthorizeDatabase(p, database), nil
+	}
+
+	privileges, err := e.privileges.get(u.Name, e.PrivilegeCacheTTL, func() (map[string]originql.Privilege, error) {
+		return e.MetaClient.UserPrivileges(u.Name)
+	})
+	if err != nil {
+		return false, err
+	}
+	granted, ok := privileges[database]
+	return ok && (granted == p || granted == originql.AllPrivileges), nil
+}
+
 func (e *StatementExecutor) executeShowDatabasesStatement(q *influxql.ShowDatabasesStatement, ctx *query.ExecutionContext) (models.Rows, error) {
+	if err := validateShowDatabasesCondition(q.Condition); err != nil {
+		return nil, err
+	}
 	dis := e.MetaClient.Databases()
 	a := ctx.ExecutionOptions.Authorizer
 
@@ -1199,10 +2689,14 @@ func (e *StatementExecutor) executeShowDatabasesStatement(q *influxql.ShowDataba
 	if q.ShowDetail {
 		row.Columns = append(row.Columns, "ReplicaN")
 		row.Columns = append(row.Columns, "Tag Attribute")
+		row.Columns = append(row.Columns, "deleting")
 	}
 
 	var tagAttr string
 	for _, di := range dis {
+		if q.Condition != nil && !influxql.EvalBool(q.Condition, map[string]interface{}{"name": di.Name, "deleting": di.MarkDeleted}) {
+			continue
+		}
 		// Only include databases that the user is authorized to read or write.
 		if a.AuthorizeDatabase(originql.ReadPrivilege, di.Name) || a.AuthorizeDatabase(originql.WritePrivilege, di.Name) {
 			if !q.ShowDetail {
@@ -1213,7 +2707,7 @@ func (e *StatementExecutor) executeShowDatabasesStatement(q *influxql.ShowDataba
 				} else {
 					tagAttr = "default"
 				}
-				row.Values = append(row.Values, []interface{}{di.Name, strconv.Itoa(di.ReplicaN), tagAttr})
+				row.Values = append(row.Values, []interface{}{di.Name, strconv.Itoa(di.ReplicaN), tagAttr, di.MarkDeleted})
 			}
 		}
 	}
@@ -1223,6 +2717,17 @@ func (e *StatementExecutor) executeShowDatabasesStatement(q *influxql.ShowDataba
 	return []*models.Row{row}, nil
 }
 
+// validateShowDatabasesCondition checks that the condition of SHOW DATABASES only filters on the name
+// and the deletion state of the databases.
+func validateShowDatabasesCondition(cond influxql.Expr) error {
+	for _, ref := range influxql.ExprNames(cond) {
+		if ref.Val != "name" && ref.Val != "deleting" {
+			return fmt.Errorf("SHOW DATABASES can only filter on name and deleting, not %s", ref.Val)
+		}
+	}
+	return nil
+}
+
 func (e *StatementExecutor) executeShowMeasurementKeysStatement(stmt *influxql.ShowMeasurementKeysStatement) (models.Rows, error) {
 	db, err := e.MetaClient.Database(stmt.Database)
 	if err != nil {
@@ -1268,6 +2773,10 @@ func (e *StatementExecutor) executeShowMeasurementKeysStatement(stmt *influxql.S
 		return []*models.Row{getEngineType(mst)}, nil
 	case "INDEXES":
 		return []*models.Row{getIndex(mst)}, nil
+	case "INDEX":
+		return []*models.Row{getIndexRelation(mst)}, nil
+	case "VERSION":
+		return []*models.Row{getSchemaVersion(mstVersion)}, nil
 	case "SCHEMA":
 		var rows []*models.Row
 		rows = append(rows, getShardKey(mst), getEngineType(mst), getIndex(mst))
@@ -1284,7 +2793,7 @@ func getIndex(mst *meta2.MeasurementInfo) *models.Row {
 	row := &models.Row{Columns: []string{"INDEXES"}}
 	res := make([][]interface{}, len(mst.IndexRelation.Oids))
 	for i, id := range mst.IndexRelation.Oids {
-		indexName, _ := index.GetIndexNameByType(index.IndexType(id))
+		indexName, _ := index.GetIndexNameById(id)
 		var indexList string
 		for _, col := range mst.IndexRelation.IndexList[i].IList {
 			indexList += col + ","
@@ -1299,6 +2808,32 @@ func getIndex(mst *meta2.MeasurementInfo) *models.Row {
 	return row
 }
 
+// getIndexRelation returns the type name and the columns of each secondary index of the measurement,
+// the columns of a time cluster index is its duration.
+func getIndexRelation(mst *meta2.MeasurementInfo) *models.Row {
+	row := &models.Row{Columns: []string{"INDEX", "COLUMNS"}}
+	for i, id := range mst.IndexRelation.Oids {
+		indexName, err := index.GetIndexNameById(id)
+		if err != nil {
+			indexName = strconv.FormatUint(uint64(id), 10)
+		}
+		var columns string
+		if id == uint32(index.TimeCluster) && mst.ColStoreInfo != nil {
+			columns = mst.ColStoreInfo.TimeClusterDuration.String()
+		} else if i < len(mst.IndexRelation.IndexList) {
+			columns = strings.Join(mst.IndexRelation.IndexList[i].IList, ",")
+		}
+		row.Values = append(row.Values, []interface{}{indexName, columns})
+	}
+	return row
+}
+
+func getSchemaVersion(mstVersion meta2.MeasurementVer) *models.Row {
+	row := &models.Row{Columns: []string{"VERSION", "NAME_WITH_VERSION"}}
+	row.Values = [][]interface{}{{mstVersion.Version, mstVersion.NameWithVersion}}
+	return row
+}
+
 func getEngineType(mst *meta2.MeasurementInfo) *models.Row {
 	row := &models.Row{Columns: []string{"ENGINETYPE"}}
 	row.Values = [][]interface{}{{config.EngineType2String[mst.EngineType]}}
@@ -1368,70 +2903,273 @@ func (e *StatementExecutor) executeShowGrantsForUserStatement(q *influxql.ShowGr
 	for d, p := range priv {
 		row.Values = append(row.Values, []interface{}{d, p.String()})
 	}
-	return []*models.Row{row}, nil
+	return []*models.Row{row}, nil
+}
+
+func (e *StatementExecutor) executeShowMeasurementsStatement(q *influxql.ShowMeasurementsStatement, ctx *query.ExecutionContext, seq int) error {
+	if q.Database == "" {
+		return coordinator.ErrDatabaseNameRequired
+	}
+	if err := e.authorize(ctx, q.Database, originql.ReadPrivilege); err != nil {
+		return err
+	}
+	var mms influxql.Measurements
+	if q.Source != nil {
+		mms = influxql.Measurements{q.Source.(*influxql.Measurement)}
+	} else if q.LikePattern != "" {
+		re, err := influxql.CompileLikePattern(q.LikePattern, q.LikeCaseInsensitive)
+		if err != nil {
+			return err
+		}
+		mms = influxql.Measurements{{Regex: &influxql.RegexLiteral{Val: re}}}
+	}
+
+	measurements, err := e.MetaClient.Measurements(q.Database, mms)
+	if err != nil {
+		return err
+	}
+	if q.Condition != nil && len(measurements) > 0 {
+		if measurements, err = e.measurementsMatchingTags(q.Database, mms, measurements, q.Condition); err != nil {
+			return err
+		}
+	}
+	if len(measurements) == 0 {
+		return ctx.Send(&query.Result{}, seq)
+	}
+	return sendMeasurements(ctx, seq, measurements)
+}
+
+// sendMeasurements sends the names of the measurements in one result, or in results of ChunkSize names if the
+// client asked for chunked responses, so that a huge list of measurements is not buffered at once.
+func sendMeasurements(ctx *query.ExecutionContext, seq int, measurements []string) error {
+	size := len(measurements)
+	if ctx.Chunked && ctx.ChunkSize > 0 {
+		size = ctx.ChunkSize
+	}
+	for start := 0; start < len(measurements); start += size {
+		end := start + size
+		if end > len(measurements) {
+			end = len(measurements)
+		}
+		values := make([][]interface{}, 0, end-start)
+		for _, name := range measurements[start:end] {
+			values = append(values, []interface{}{name})
+		}
+		err := ctx.Send(&query.Result{
+			Series: []*models.Row{{
+				Name:    "measurements",
+				Columns: []string{"name"},
+				Values:  values,
+				Partial: end < len(measurements),
+			}},
+			Partial: end < len(measurements),
+		}, seq)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkTagCondition rejects a condition of the statement named stmt referring to a field of the measurements.
+func (e *StatementExecutor) checkTagCondition(database string, mms influxql.Measurements, cond influxql.Expr, stmt string) error {
+	fields, err := e.MetaClient.FieldKeys(database, mms)
+	if err != nil {
+		return err
+	}
+	for _, ref := range influxql.ExprNames(cond) {
+		for _, mstFields := range fields {
+			if _, ok := mstFields[ref.Val]; ok {
+				return fmt.Errorf("only tag predicates are supported in the WHERE clause of %s, %s is a field", stmt, ref.Val)
+			}
+		}
+	}
+	return nil
+}
+
+// measurementsMatchingTags keeps the measurements which have at least one series matching the tag predicates of cond.
+func (e *StatementExecutor) measurementsMatchingTags(database string, mms influxql.Measurements, measurements []string, cond influxql.Expr) ([]string, error) {
+	if err := e.checkTagCondition(database, mms, cond, "SHOW MEASUREMENTS"); err != nil {
+		return nil, err
+	}
+
+	matched, err := e.measurementsWithSeries(database, mms, cond)
+	if err != nil {
+		return nil, err
+	}
+	ret := measurements[:0]
+	for _, name := range measurements {
+		if _, ok := matched[name]; ok {
+			ret = append(ret, name)
+		}
+	}
+	return ret, nil
+}
+
+func (e *StatementExecutor) executeShowMeasurementCardinalityStatement(stmt *influxql.ShowMeasurementCardinalityStatement) (models.Rows, error) {
+	if stmt.Database == "" {
+		return nil, coordinator.ErrDatabaseNameRequired
+	}
+
+	var mms influxql.Measurements
+	if stmt.Sources != nil {
+		mms = stmt.Sources.Measurements()
+	}
+
+	var n int
+	if stmt.Condition != nil {
+		var err error
+		if n, err = e.measurementCardinalityWithCondition(stmt, mms); err != nil {
+			return nil, err
+		}
+	} else {
+		measurements, err := e.MetaClient.MatchMeasurements(stmt.Database, mms)
+		if err != nil {
+			return nil, err
+		}
+		n = len(measurements)
+	}
+	return []*models.Row{{
+		Columns: []string{"count"},
+		Values:  [][]interface{}{{n}},
+	}}, nil
+}
+
+// measurementCardinalityWithCondition counts the measurements with series matching the tag predicates of the condition,
+// from the exact series cardinality of the store nodes if the statement is EXACT, or from the estimated one.
+func (e *StatementExecutor) measurementCardinalityWithCondition(stmt *influxql.ShowMeasurementCardinalityStatement, mms influxql.Measurements) (int, error) {
+	if err := e.checkTagCondition(stmt.Database, mms, stmt.Condition, "SHOW MEASUREMENT CARDINALITY"); err != nil {
+		return 0, err
+	}
+	if stmt.Exact {
+		matched, err := e.measurementsWithSeries(stmt.Database, mms, stmt.Condition)
+		return len(matched), err
+	}
+
+	mis, err := e.MetaClient.MatchMeasurements(stmt.Database, mms)
+	if err != nil || len(mis) == 0 {
+		return 0, err
+	}
+	names := make([]string, 0, len(mis))
+	for _, m := range mis {
+		names = append(names, m.Name)
+	}
+
+	matched := make(map[string]struct{}, len(names))
+	lock := new(sync.Mutex)
+	err = e.MetaExecutor.EachDBNodes(stmt.Database, func(nodeID uint64, pts []uint32) error {
+		mstCardinality, err := e.NetStorage.SeriesCardinality(nodeID, stmt.Database, pts, names, stmt.Condition)
+		if err != nil {
+			return err
+		}
+		lock.Lock()
+		defer lock.Unlock()
+		for i := range mstCardinality {
+			for _, info := range mstCardinality[i].CardinalityInfos {
+				if info.Cardinality > 0 {
+					matched[mstCardinality[i].Name] = struct{}{}
+					break
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		e.StmtExecLogger.Error("fail to show measurement cardinality with condition", zap.Error(err))
+		return 0, err
+	}
+	return len(matched), nil
 }
 
-func (e *StatementExecutor) executeShowMeasurementsStatement(q *influxql.ShowMeasurementsStatement, ctx *query.ExecutionContext, seq int) error {
+func (e *StatementExecutor) executeShowRetentionPoliciesStatement(q *influxql.ShowRetentionPoliciesStatement) (models.Rows, error) {
 	if q.Database == "" {
-		return coordinator.ErrDatabaseNameRequired
+		return nil, coordinator.ErrDatabaseNameRequired
 	}
-	var mms influxql.Measurements
-	if q.Source != nil {
-		mms = influxql.Measurements{q.Source.(*influxql.Measurement)}
+
+	rows, err := e.MetaClient.ShowRetentionPolicies(q.Database)
+	if err != nil || !q.ShowDetail {
+		return rows, err
 	}
 
-	measurements, err := e.MetaClient.Measurements(q.Database, mms)
+	dbi, err := e.MetaClient.Database(q.Database)
 	if err != nil {
-		return err
-	}
-	if len(measurements) == 0 {
-		return ctx.Send(&query.Result{}, seq)
+		return nil, err
 	}
-
-	values := make([][]interface{}, len(measurements))
-	for i := range measurements {
-		values[i] = []interface{}{measurements[i]}
+	for _, row := range rows {
+		row.Columns = append(row.Columns, "shard_groups")
+		for i, v := range row.Values {
+			name, _ := v[0].(string)
+			row.Values[i] = append(v, shardGroupCount(dbi.RetentionPolicies[name]))
+		}
 	}
-
-	return ctx.Send(&query.Result{
-		Series: []*models.Row{{
-			Name:    "measurements",
-			Columns: []string{"name"},
-			Values:  values,
-		}},
-	}, seq)
+	return rows, nil
 }
 
-func (e *StatementExecutor) executeShowMeasurementCardinalityStatement(stmt *influxql.ShowMeasurementCardinalityStatement) (models.Rows, error) {
-	if stmt.Database == "" {
-		return nil, coordinator.ErrDatabaseNameRequired
+// shardGroupCount returns the number of shard groups of the retention policy which are not deleted.
+func shardGroupCount(rpi *meta2.RetentionPolicyInfo) int {
+	if rpi == nil {
+		return 0
 	}
-
-	var mms influxql.Measurements
-	if stmt.Sources != nil {
-		mms = stmt.Sources.Measurements()
+	n := 0
+	for i := range rpi.ShardGroups {
+		if !rpi.ShardGroups[i].Deleted() {
+			n++
+		}
 	}
+	return n
+}
 
-	measurements, err := e.MetaClient.MatchMeasurements(stmt.Database, mms)
+// executeShowContinuousQueriesStatement lists the continuous queries of the database of the statement,
+// or of all the databases if it has none.
+func (e *StatementExecutor) executeShowContinuousQueriesStatement(q *influxql.ShowContinuousQueriesStatement) (models.Rows, error) {
+	rows, err := e.MetaClient.ShowContinuousQueries()
 	if err != nil {
 		return nil, err
 	}
-	return []*models.Row{{
-		Columns: []string{"count"},
-		Values:  [][]interface{}{{len(measurements)}},
-	}}, nil
-}
-
-func (e *StatementExecutor) executeShowRetentionPoliciesStatement(q *influxql.ShowRetentionPoliciesStatement) (models.Rows, error) {
-	if q.Database == "" {
-		return nil, coordinator.ErrDatabaseNameRequired
+	if q.Database != "" {
+		var dbRows models.Rows
+		for _, row := range rows {
+			if row.Name == q.Database {
+				dbRows = models.Rows{row}
+				break
+			}
+		}
+		rows = dbRows
 	}
-
-	return e.MetaClient.ShowRetentionPolicies(q.Database)
+	for _, row := range rows {
+		e.appendContinuousQueryLastRuns(row)
+	}
+	return rows, nil
 }
 
-func (e *StatementExecutor) executeShowContinuousQueriesStatement(q *influxql.ShowContinuousQueriesStatement) (models.Rows, error) {
-	return e.MetaClient.ShowContinuousQueries()
+// appendContinuousQueryLastRuns appends the time and the status of the last run of each continuous query to its row.
+// The last successful run is reported to meta by the sql node holding the lease of the continuous query, a later run
+// by this node, failed or not, takes its place. Both are empty if the continuous query has never run.
+func (e *StatementExecutor) appendContinuousQueryLastRuns(row *models.Row) {
+	var cqInfos map[string]*meta2.ContinuousQueryInfo
+	if dbi, err := e.MetaClient.Database(row.Name); err == nil && dbi != nil {
+		cqInfos = dbi.ContinuousQueries
+	}
+	row.Columns = append(row.Columns, "last_run", "last_run_status")
+	for i, values := range row.Values {
+		var lastRun time.Time
+		status := ""
+		name, _ := values[0].(string)
+		if cqi, ok := cqInfos[name]; ok && cqi.LastRunTime.UnixNano() > 0 {
+			lastRun, status = cqi.LastRunTime, "success"
+		}
+		if run, ok := e.ContinuousQueryService.LastRun(name); ok && !run.Time.Before(lastRun) {
+			lastRun, status = run.Time, "success"
+			if run.Err != nil {
+				status = "failed: " + run.Err.Error()
+			}
+		}
+		lastRunTime := ""
+		if status != "" {
+			lastRunTime = lastRun.UTC().Format(time.RFC3339Nano)
+		}
+		row.Values[i] = append(values, lastRunTime, status)
+	}
 }
 
 func (e *StatementExecutor) executeShowShardsStatement(stmt *influxql.ShowShardsStatement) (models.Rows, error) {
@@ -1445,18 +3183,63 @@ func (e *StatementExecutor) executeShowShardGroupsStatement(stmt *influxql.ShowS
 	return e.MetaClient.ShowShardGroups(), nil
 }
 
+// executeShowShardMappingStatement lists the shard, the pt and the node the queries of each shard of the database
+// are routed to by the shard mapper now.
+func (e *StatementExecutor) executeShowShardMappingStatement(stmt *influxql.ShowShardMappingStatement) (models.Rows, error) {
+	if stmt.Database == "" {
+		return nil, coordinator.ErrDatabaseNameRequired
+	}
+	csm, ok := e.ShardMapper.(*coordinator.ClusterShardMapper)
+	if !ok {
+		return nil, errors.New("the shard mapper does not support SHOW SHARD MAPPING")
+	}
+	routes, err := csm.ShardRoutes(stmt.Database)
+	if err != nil {
+		return nil, err
+	}
+
+	row := &models.Row{Name: stmt.Database, Columns: []string{"retention_policy", "shard_group", "shard", "read_shard", "pt", "node", "read_nodes"}}
+	for _, r := range routes {
+		readNodes := make([]string, 0, len(r.ReadNodes))
+		for _, id := range r.ReadNodes {
+			readNodes = append(readNodes, strconv.FormatUint(id, 10))
+		}
+		row.Values = append(row.Values, []interface{}{r.RetentionPolicy, r.ShardGroupID, r.ShardID, r.ReadShardID, r.PtID, r.NodeID, strings.Join(readNodes, ",")})
+	}
+	return []*models.Row{row}, nil
+}
+
 func (e *StatementExecutor) executeShowSubscriptionsStatement(stmt *influxql.ShowSubscriptionsStatement) (models.Rows, error) {
 	if !config.GetSubscriptionEnable() {
 		return nil, errors.New("subscription is not enabled")
 	}
+	if stmt.ShowDetail {
+		if e.SubscriberManager == nil {
+			return nil, errors.New("subscriber manager is not initialized")
+		}
+		return e.SubscriberManager.ShowSubscriptionsDetail(), nil
+	}
 	return e.MetaClient.ShowSubscriptions(), nil
 }
 
-func (e *StatementExecutor) FieldKeys(database string, measurements influxql.Measurements) (netstorage.TableColumnKeys, error) {
+// FieldKeys returns the field keys of the measurements. If cond is not nil, only the field keys
+// written by the series matching cond are kept.
+func (e *StatementExecutor) FieldKeys(ctx *query.ExecutionContext, database string, measurements influxql.Measurements, cond influxql.Expr) (netstorage.TableColumnKeys, error) {
 	fieldKeysMap, err := e.MetaClient.FieldKeys(database, measurements)
 	if err != nil {
 		return nil, err
 	}
+	if cond != nil && len(fieldKeysMap) > 0 {
+		matched, err := e.measurementsWithSeries(database, measurements, cond)
+		if err != nil {
+			return nil, err
+		}
+		for mstName := range fieldKeysMap {
+			if _, ok := matched[mstName]; !ok {
+				delete(fieldKeysMap, mstName)
+			}
+		}
+	}
 
 	var fieldKeys netstorage.TableColumnKeys
 	for mstName := range fieldKeysMap {
@@ -1469,15 +3252,175 @@ func (e *StatementExecutor) FieldKeys(database string, measurements influxql.Mea
 	}
 
 	sort.Stable(fieldKeys)
-	return fieldKeys, nil
+	if cond == nil {
+		return fieldKeys, nil
+	}
+	return e.fieldKeysOfSeries(ctx, measurements, fieldKeys, cond)
+}
+
+// fieldKeysProbeWindow is the time range probed for the fields of the series matching the condition of
+// SHOW FIELD KEYS and SHOW FIELD KEY CARDINALITY if the condition has no time range.
+const fieldKeysProbeWindow = 7 * 24 * time.Hour
+
+// fieldKeysOfSeries keeps the field keys written by the series matching cond. The index does not record the
+// fields of each series, so each field is probed with SELECT field FROM measurement WHERE cond LIMIT 1 over the
+// time range of cond, or the last fieldKeysProbeWindow, a field without point is not written by the series.
+func (e *StatementExecutor) fieldKeysOfSeries(ctx *query.ExecutionContext, measurements influxql.Measurements, fieldKeys netstorage.TableColumnKeys, cond influxql.Expr) (netstorage.TableColumnKeys, error) {
+	cond = fieldProbeCondition(cond, time.Now())
+	kept := fieldKeys[:0]
+	for i := range fieldKeys {
+		mst := sourceOfMeasurement(measurements, fieldKeys[i].Name)
+		keys := fieldKeys[i].Keys[:0]
+		for _, key := range fieldKeys[i].Keys {
+			rows, err := e.selectRows(ctx, fieldProbeStatement(mst, key, cond))
+			if err != nil {
+				return nil, err
+			}
+			if hasValues(rows) {
+				keys = append(keys, key)
+			}
+		}
+		fieldKeys[i].Keys = keys
+		if len(keys) > 0 {
+			kept = append(kept, fieldKeys[i])
+		}
+	}
+	return kept, nil
+}
+
+// sourceOfMeasurement returns the source of the statement which selects the measurement name.
+func sourceOfMeasurement(measurements influxql.Measurements, name string) *influxql.Measurement {
+	for _, m := range measurements {
+		if m.Name == name || (m.Regex != nil && m.Regex.Val.MatchString(name)) {
+			return &influxql.Measurement{Database: m.Database, RetentionPolicy: m.RetentionPolicy, Name: name}
+		}
+	}
+	return &influxql.Measurement{Name: name}
+}
+
+// fieldProbeCondition bounds cond to the last fieldKeysProbeWindow before now if it has no time range.
+func fieldProbeCondition(cond influxql.Expr, now time.Time) influxql.Expr {
+	if influxql.HasTimeExpr(cond) {
+		return cond
+	}
+	return &influxql.BinaryExpr{
+		Op:  influxql.AND,
+		LHS: &influxql.ParenExpr{Expr: influxql.CloneExpr(cond)},
+		RHS: &influxql.BinaryExpr{
+			Op:  influxql.GTE,
+			LHS: &influxql.VarRef{Val: "time"},
+			RHS: &influxql.TimeLiteral{Val: now.Add(-fieldKeysProbeWindow)},
+		},
+	}
+}
+
+// fieldProbeStatement builds the statement selecting one point of the field of the measurement over the series matching cond.
+func fieldProbeStatement(mst *influxql.Measurement, key meta.FieldKey, cond influxql.Expr) *influxql.SelectStatement {
+	return &influxql.SelectStatement{
+		Fields:    influxql.Fields{{Expr: &influxql.VarRef{Val: key.Field}}},
+		Sources:   influxql.Sources{mst},
+		Condition: influxql.CloneExpr(cond),
+		Limit:     1,
+		OmitTime:  true,
+	}
+}
+
+// hasValues reports whether rows, the result of fieldProbeStatement, have a point.
+func hasValues(rows models.Rows) bool {
+	for _, row := range rows {
+		for _, value := range row.Values {
+			for _, v := range value {
+				if v != nil {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// selectRows executes the select statement built by the executor itself, and returns all the rows.
+func (e *StatementExecutor) selectRows(ctx *query.ExecutionContext, stmt *influxql.SelectStatement) (models.Rows, error) {
+	proxy := newRowChanProxy()
+	pipelineExecutor, err := e.createPipelineExecutor(ctx, stmt, ctx.ExecutionOptions, proxy.rc)
+	if err == influxql.ErrDeclareEmptyCollection {
+		err = nil
+		pipelineExecutor = nil
+	}
+	if err != nil || pipelineExecutor == nil {
+		proxy.close()
+		return nil, err
+	}
+
+	ec := make(chan error, 1)
+	go func() {
+		ec <- pipelineExecutor.ExecuteExecutor(ctx)
+		close(ec)
+		proxy.close()
+	}()
+
+	var rows models.Rows
+	for {
+		select {
+		case rowsChan, ok := <-proxy.rc:
+			if !ok {
+				return rows, <-ec
+			}
+			rows = append(rows, rowsChan.Rows...)
+		case <-ctx.Done():
+			pipelineExecutor.Abort()
+			go proxy.wait()
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// measurementsWithSeries returns the original names of the measurements which have at least one series matching cond.
+func (e *StatementExecutor) measurementsWithSeries(database string, measurements influxql.Measurements, cond influxql.Expr) (map[string]struct{}, error) {
+	mis, err := e.MetaClient.MatchMeasurements(database, measurements)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(mis))
+	for _, m := range mis {
+		names = append(names, m.Name)
+	}
+
+	matched := make(map[string]struct{}, len(names))
+	lock := new(sync.Mutex)
+	err = e.MetaExecutor.EachDBNodes(database, func(nodeID uint64, pts []uint32) error {
+		cardinality, err := e.NetStorage.SeriesExactCardinality(nodeID, database, pts, names, cond)
+		if err != nil {
+			return err
+		}
+		lock.Lock()
+		defer lock.Unlock()
+		for name, n := range cardinality {
+			if n > 0 {
+				matched[name] = struct{}{}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		e.StmtExecLogger.Error("failed to match series of measurements", zap.String("db", database), zap.Error(err))
+		return nil, err
+	}
+	return matched, nil
 }
 
 func (e *StatementExecutor) executeShowFieldKeys(q *influxql.ShowFieldKeysStatement, ctx *query.ExecutionContext, seq int) error {
 	if q.Database == "" {
 		return coordinator.ErrDatabaseNameRequired
 	}
+	if err := e.authorize(ctx, q.Database, originql.ReadPrivilege); err != nil {
+		return err
+	}
+	if err := e.checkShowSourcesLimit(q.Database, q.Sources); err != nil {
+		return err
+	}
 
-	fieldKeys, err := e.FieldKeys(q.Database, q.Sources.Measurements())
+	fieldKeys, err := e.FieldKeys(ctx, q.Database, q.Sources.Measurements(), q.Condition)
 	if err != nil {
 		return err
 	}
@@ -1511,14 +3454,17 @@ func (e *StatementExecutor) executeShowFieldKeys(q *influxql.ShowFieldKeysStatem
 }
 
 func (e *StatementExecutor) executeShowFieldKeyCardinality(q *influxql.ShowFieldKeyCardinalityStatement, ctx *query.ExecutionContext, seq int) error {
-	if q.Condition != nil {
-		return meta2.ErrUnsupportCommand
-	}
 	if q.Database == "" {
 		return coordinator.ErrDatabaseNameRequired
 	}
+	if err := e.authorize(ctx, q.Database, originql.ReadPrivilege); err != nil {
+		return err
+	}
+	if err := e.checkShowSourcesLimit(q.Database, q.Sources); err != nil {
+		return err
+	}
 
-	fieldKeys, err := e.FieldKeys(q.Database, q.Sources.Measurements())
+	fieldKeys, err := e.FieldKeys(ctx, q.Database, q.Sources.Measurements(), q.Condition)
 	if err != nil {
 		return err
 	}
@@ -1565,6 +3511,12 @@ func (e *StatementExecutor) TagKeys(database string, measurements influxql.Measu
 }
 
 func (e *StatementExecutor) executeShowTagKeys(q *influxql.ShowTagKeysStatement, ctx *query.ExecutionContext, seq int) error {
+	if err := e.authorize(ctx, q.Database, originql.ReadPrivilege); err != nil {
+		return err
+	}
+	if err := e.checkShowSourcesLimit(q.Database, q.Sources); err != nil {
+		return err
+	}
 	var tagKeys netstorage.TableTagKeys
 	var err error
 	if q.Condition != nil {
@@ -1622,15 +3574,25 @@ func (e *StatementExecutor) executeShowTagKeys(q *influxql.ShowTagKeysStatement,
 }
 
 func (e *StatementExecutor) executeShowTagKeyCardinality(q *influxql.ShowTagKeyCardinalityStatement, ctx *query.ExecutionContext, seq int) error {
-	if q.Condition != nil {
-		return meta2.ErrUnsupportCommand
-	}
-
 	if q.Database == "" {
 		return coordinator.ErrDatabaseNameRequired
 	}
+	if err := e.authorize(ctx, q.Database, originql.ReadPrivilege); err != nil {
+		return err
+	}
+	if err := e.checkShowSourcesLimit(q.Database, q.Sources); err != nil {
+		return err
+	}
 
-	tagKeys, err := e.TagKeys(q.Database, q.Sources.Measurements(), q.Condition)
+	var tagKeys netstorage.TableTagKeys
+	var err error
+	if q.Condition != nil {
+		// only the tag keys of the series matching the condition are counted, which the store knows
+		exec := coordinator.NewShowTagKeysExecutor(e.StmtExecLogger, e.MetaClient, e.MetaExecutor, e.NetStorage)
+		tagKeys, err = exec.Execute(&influxql.ShowTagKeysStatement{Database: q.Database, Sources: q.Sources, Condition: q.Condition})
+	} else {
+		tagKeys, err = e.TagKeys(q.Database, q.Sources.Measurements(), q.Condition)
+	}
 	if err != nil {
 		return err
 	}
@@ -1657,7 +3619,10 @@ func (e *StatementExecutor) executeShowTagKeyCardinality(q *influxql.ShowTagKeyC
 	return nil
 }
 
-func (e *StatementExecutor) executeShowTagValues(stmt *influxql.ShowTagValuesStatement) (models.Rows, error) {
+func (e *StatementExecutor) executeShowTagValues(stmt *influxql.ShowTagValuesStatement, ctx *query.ExecutionContext) (models.Rows, error) {
+	if err := e.authorize(ctx, stmt.Database, originql.ReadPrivilege); err != nil {
+		return nil, err
+	}
 	exec := coordinator.NewShowTagValuesExecutor(e.StmtExecLogger, e.MetaClient, e.MetaExecutor, e.NetStorage)
 	return exec.Execute(stmt)
 }
@@ -1682,6 +3647,9 @@ func (e *StatementExecutor) executeShowTagValuesCardinality(stmt *influxql.ShowT
 }
 
 func (e *StatementExecutor) executeShowSeries(q *influxql.ShowSeriesStatement, ctx *query.ExecutionContext, seq int) error {
+	if err := e.authorize(ctx, q.Database, originql.ReadPrivilege); err != nil {
+		return err
+	}
 	mis, err := e.MetaClient.MatchMeasurements(q.Database, q.Sources.Measurements())
 	if err != nil {
 		return err
@@ -1691,44 +3659,135 @@ func (e *StatementExecutor) executeShowSeries(q *influxql.ShowSeriesStatement, c
 		names = append(names, m.Name)
 	}
 
-	var series []string
+	// only the first offset+limit keys of the merged series keys can be sent, so are the ones of each node
+	maxKeys := 0
+	if q.Limit > 0 {
+		maxKeys = q.Offset + q.Limit
+	}
+	nodeSeries := make(map[uint64][]string)
+	collected := 0
 	lock := new(sync.Mutex)
 
 	err = e.MetaExecutor.EachDBNodes(q.Database, func(nodeID uint64, pts []uint32) error {
 		arr, err := e.NetStorage.ShowSeries(nodeID, q.Database, pts, names, q.Condition)
+		if err != nil {
+			return err
+		}
+		sort.Strings(arr)
+		if maxKeys > 0 && len(arr) > maxKeys {
+			arr = arr[:maxKeys]
+		}
 		lock.Lock()
 		defer lock.Unlock()
-		if err != nil {
-			series = series[:0] // if execute command failed reset res
-		} else {
-			series = append(series, arr...)
+		// the keys of a node replace its keys of a retried command
+		collected += len(arr) - len(nodeSeries[nodeID])
+		nodeSeries[nodeID] = arr
+		if e.MaxShowSeries > 0 && collected > e.MaxShowSeries {
+			return errno.NewError(errno.ShowSeriesLimitExceeded, e.MaxShowSeries)
 		}
-		return err
+		return nil
 	})
 	if err != nil {
 		e.StmtExecLogger.Error("failed to show series", zap.Error(err))
 		return err
 	}
 
-	sort.Strings(series)
-	series = limitStringSlice(series, q.Offset, q.Limit)
-
-	if len(series) == 0 {
-		return nil
+	m := newSeriesMerger(nodeSeries)
+	for i := 0; i < q.Offset; i++ {
+		if _, ok := m.next(); !ok {
+			return nil
+		}
 	}
-	row := &models.Row{
-		Name:    "",
-		Columns: []string{"key"},
-		Values:  make([][]interface{}, 0, len(series)),
+	return sendSeries(ctx, seq, m, q.Limit)
+}
+
+// showSeriesBatchSize is the number of series keys sent in one result of SHOW SERIES
+// if the client did not ask for chunked responses.
+const showSeriesBatchSize = 10000
+
+// seriesMerger merges the sorted series keys of the nodes into one sorted stream without duplicate keys.
+type seriesMerger struct {
+	heads [][]string // the keys of each node not merged yet, by their first key
+	last  string
+	first bool
+}
+
+func newSeriesMerger(nodeSeries map[uint64][]string) *seriesMerger {
+	m := &seriesMerger{heads: make([][]string, 0, len(nodeSeries)), first: true}
+	for _, arr := range nodeSeries {
+		if len(arr) > 0 {
+			m.heads = append(m.heads, arr)
+		}
 	}
+	heap.Init(m)
+	return m
+}
+
+func (m *seriesMerger) Len() int           { return len(m.heads) }
+func (m *seriesMerger) Less(i, j int) bool { return m.heads[i][0] < m.heads[j][0] }
+func (m *seriesMerger) Swap(i, j int)      { m.heads[i], m.heads[j] = m.heads[j], m.heads[i] }
+func (m *seriesMerger) Push(x interface{}) { m.heads = append(m.heads, x.([]string)) }
+func (m *seriesMerger) Pop() interface{} {
+	n := len(m.heads)
+	x := m.heads[n-1]
+	m.heads = m.heads[:n-1]
+	return x
+}
 
-	for _, item := range series {
-		row.Values = append(row.Values, []interface{}{item})
+// next returns the smallest series key not returned yet, false if all the keys are returned.
+func (m *seriesMerger) next() (string, bool) {
+	for len(m.heads) > 0 {
+		key := m.heads[0][0]
+		if m.heads[0] = m.heads[0][1:]; len(m.heads[0]) == 0 {
+			heap.Pop(m)
+		} else {
+			heap.Fix(m, 0)
+		}
+		// the same series key may be returned by several nodes
+		if m.first || key != m.last {
+			m.first = false
+			m.last = key
+			return key, true
+		}
 	}
+	return "", false
+}
 
-	return ctx.Send(&query.Result{
-		Series: []*models.Row{row},
-	}, seq)
+// sendSeries sends the merged series keys up to limit as they are merged, in results of ChunkSize keys if the client
+// asked for chunked responses, of showSeriesBatchSize keys otherwise. Nothing is sent if there is no series key.
+func sendSeries(ctx *query.ExecutionContext, seq int, m *seriesMerger, limit int) error {
+	size := showSeriesBatchSize
+	if ctx.Chunked && ctx.ChunkSize > 0 {
+		size = ctx.ChunkSize
+	}
+	var values [][]interface{}
+	sent := 0
+	for {
+		key, ok := m.next()
+		if ok && limit > 0 && sent == limit {
+			ok = false
+		}
+		if len(values) == size || (!ok && len(values) > 0) {
+			err := ctx.Send(&query.Result{
+				Series: []*models.Row{{
+					Name:    "",
+					Columns: []string{"key"},
+					Values:  values,
+					Partial: ok,
+				}},
+				Partial: ok,
+			}, seq)
+			if err != nil {
+				return err
+			}
+			values = nil
+		}
+		if !ok {
+			return nil
+		}
+		values = append(values, []interface{}{key})
+		sent++
+	}
 }
 
 func (e *StatementExecutor) executeShowSeriesCardinality(stmt *influxql.ShowSeriesCardinalityStatement) (models.Rows, error) {
@@ -1886,13 +3945,44 @@ func (e *StatementExecutor) executeShowUsersStatement(q *influxql.ShowUsersState
 	return []*models.Row{row}, nil
 }
 
-func (e *StatementExecutor) executeShowQueriesStatement() (models.Rows, error) {
-	nodes, err := e.MetaClient.DataNodes()
+func (e *StatementExecutor) executeShowQueriesStatement(stmt *influxql.ShowQueriesStatement) (models.Rows, error) {
+	if stmt.CountByNode {
+		return e.showQueriesCountByNode()
+	}
+	sortedResult, err := e.combinedQueries()
 	if err != nil {
 		return nil, err
 	}
+	if stmt.Format == "json" {
+		return showQueriesJSON(sortedResult, stmt.Precise)
+	}
+
+	row := models.Row{Columns: []string{"qid", "query", "database", "duration", "status", "host", "duration_ns", "nodes"}}
+	values := make([][]interface{}, 0, len(sortedResult))
+
+	// Generate output row for every query
+	for _, cmbInfo := range sortedResult {
+		switch cmbInfo.getCombinedRunState() {
+		case allKilled:
+			continue
+		case partiallyKilled:
+			// If this query was killed on a part of store nodes, split hosts to 2 part of "killed" and "running"
+			values = append(values, cmbInfo.toOutputRow(len(row.Columns), true, stmt.Precise))
+		case allRunning:
+		}
+		values = append(values, cmbInfo.toOutputRow(len(row.Columns), false, stmt.Precise))
+	}
+	row.Values = values
+	return models.Rows{&row}, nil
+}
+
+// queriesOnNodes collects the queries on every store node, in the order of the nodes.
+func (e *StatementExecutor) queriesOnNodes() ([]meta2.DataNode, [][]*netstorage.QueryExeInfo, error) {
+	nodes, err := e.MetaClient.DataNodes()
+	if err != nil {
+		return nil, nil, err
+	}
 
-	resMap := make(map[uint64]*combinedQueryExeInfo)
 	infosOnAllStore := make([][]*netstorage.QueryExeInfo, len(nodes))
 
 	// Concurrent access to all store nodes.
@@ -1909,8 +3999,18 @@ func (e *StatementExecutor) executeShowQueriesStatement() (models.Rows, error) {
 		}(i, node.ID)
 	}
 	wg.Wait()
+	return nodes, infosOnAllStore, nil
+}
+
+// combinedQueries collects the queries running on all store nodes, sorted by begin time.
+func (e *StatementExecutor) combinedQueries() (combinedInfos, error) {
+	nodes, infosOnAllStore, err := e.queriesOnNodes()
+	if err != nil {
+		return nil, err
+	}
 
 	// Combine all results from all store nodes into resMap.
+	resMap := make(map[uint64]*combinedQueryExeInfo)
 	for i, infos := range infosOnAllStore {
 		combineQueryExeInfos(resMap, infos, nodes[i].Host)
 	}
@@ -1921,24 +4021,66 @@ func (e *StatementExecutor) executeShowQueriesStatement() (models.Rows, error) {
 		sortedResult = append(sortedResult, val)
 	}
 	sort.Sort(sortedResult)
+	return sortedResult, nil
+}
 
-	row := models.Row{Columns: []string{"qid", "query", "database", "duration", "status", "host"}}
-	values := make([][]interface{}, 0, len(resMap))
+// showQueriesCountByNode returns a row per store node with the number of queries running on it.
+func (e *StatementExecutor) showQueriesCountByNode() (models.Rows, error) {
+	nodes, infosOnAllStore, err := e.queriesOnNodes()
+	if err != nil {
+		return nil, err
+	}
 
-	// Generate output row for every query
-	for _, cmbInfo := range sortedResult {
-		switch cmbInfo.getCombinedRunState() {
-		case allKilled:
+	row := &models.Row{Columns: []string{"node_id", "host", "queries"}}
+	row.Values = make([][]interface{}, 0, len(nodes))
+	for i, infos := range infosOnAllStore {
+		row.Values = append(row.Values, []interface{}{nodes[i].ID, nodes[i].Host, runningQueries(infos)})
+	}
+	return models.Rows{row}, nil
+}
+
+// showQueryJSON is the JSON document of a query returned by SHOW QUERIES FORMAT JSON.
+type showQueryJSON struct {
+	QID          uint64   `json:"qid"`
+	Query        string   `json:"query"`
+	Database     string   `json:"database"`
+	Duration     string   `json:"duration"`
+	BeginTime    int64    `json:"begin_time"`
+	RunningHosts []string `json:"running_hosts"`
+	KilledHosts  []string `json:"killed_hosts"`
+}
+
+// showQueriesJSON returns a single row holding a JSON document for every query which is still running on any host.
+func showQueriesJSON(infos combinedInfos, precise bool) (models.Rows, error) {
+	row := &models.Row{Columns: []string{"query"}}
+	for _, cmbInfo := range infos {
+		if cmbInfo.getCombinedRunState() == allKilled {
 			continue
-		case partiallyKilled:
-			// If this query was killed on a part of store nodes, split hosts to 2 part of "killed" and "running"
-			values = append(values, cmbInfo.toOutputRow(len(row.Columns), true))
-		case allRunning:
 		}
-		values = append(values, cmbInfo.toOutputRow(len(row.Columns), false))
+		doc, err := json.Marshal(&showQueryJSON{
+			QID:          cmbInfo.qid,
+			Query:        cmbInfo.stmt,
+			Database:     cmbInfo.database,
+			Duration:     cmbInfo.getDurationString(precise),
+			BeginTime:    cmbInfo.beginTime,
+			RunningHosts: sortedHosts(cmbInfo.runningHosts),
+			KilledHosts:  sortedHosts(cmbInfo.killedHosts),
+		})
+		if err != nil {
+			return nil, err
+		}
+		row.Values = append(row.Values, []interface{}{string(doc)})
 	}
-	row.Values = values
-	return models.Rows{&row}, nil
+	return models.Rows{row}, nil
+}
+
+func sortedHosts(hostsKV map[string]struct{}) []string {
+	hosts := make([]string, 0, len(hostsKV))
+	for host := range hostsKV {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+	return hosts
 }
 
 func (e *StatementExecutor) getQueryExeInfoOnNode(nodeID uint64) []*netstorage.QueryExeInfo {
@@ -1982,13 +4124,13 @@ func combineQueryExeInfos(dstMap map[uint64]*combinedQueryExeInfo, exeInfosOnSto
 }
 
 func (e *StatementExecutor) executeKillQuery(stmt *influxql.KillQueryStatement) error {
-	if stmt.Host != "" {
-		return meta2.ErrUnsupportCommand
-	}
 	nodes, err := e.MetaClient.DataNodes()
 	if err != nil {
 		return err
 	}
+	if stmt.Host != "" {
+		return e.killQueryOnHost(stmt, nodes)
+	}
 
 	notFoundCount := 0
 
@@ -2014,6 +4156,22 @@ func (e *StatementExecutor) executeKillQuery(stmt *influxql.KillQueryStatement)
 	return nil
 }
 
+// killQueryOnHost kills the query only on the data node whose host or TCP host is the host of the statement.
+func (e *StatementExecutor) killQueryOnHost(stmt *influxql.KillQueryStatement, nodes []meta2.DataNode) error {
+	for _, n := range nodes {
+		if n.Host != stmt.Host && n.TCPHost != stmt.Host {
+			continue
+		}
+		err := e.NetStorage.KillQueryOnNode(n.ID, stmt.QueryID)
+		var wrapErr *errno.Error
+		if errors.As(err, &wrapErr) && errno.Equal(wrapErr, errno.ErrQueryNotFound) {
+			return errno.NewError(errno.ErrQueryNotFound, stmt.QueryID)
+		}
+		return err
+	}
+	return fmt.Errorf("host %s is not a data node", stmt.Host)
+}
+
 func (e *StatementExecutor) Statistics(buffer []byte) ([]byte, error) {
 	// Statistics() period is 10
 	// do db stats period 1 minute
@@ -2078,10 +4236,24 @@ func (e *StatementExecutor) NormalizeStatement(stmt influxql.Statement, defaultD
 			return
 		}
 		switch node := node.(type) {
+		case *influxql.SelectStatement:
+			err = e.checkSelectLimit(node, defaultDatabase)
 		case *influxql.ShowRetentionPoliciesStatement:
 			if node.Database == "" {
 				node.Database = defaultDatabase
 			}
+		case *influxql.ShowQueryLimitsStatement:
+			if node.Database == "" {
+				node.Database = defaultDatabase
+			}
+		case *influxql.ShowShardMappingStatement:
+			if node.Database == "" {
+				node.Database = defaultDatabase
+			}
+		case *influxql.ShowContinuousQueriesStatement:
+			if node.Database == "" {
+				node.Database = defaultDatabase
+			}
 		case *influxql.ShowMeasurementsStatement:
 			if node.Database == "" {
 				node.Database = defaultDatabase
@@ -2150,7 +4322,7 @@ func (e *StatementExecutor) NormalizeStatement(stmt influxql.Statement, defaultD
 			err = e.NormalizeStatement(node.Query, defaultDatabase, defaultRetentionPolicy)
 		case *influxql.Measurement:
 			switch stmt.(type) {
-			case *influxql.DropSeriesStatement, *influxql.DeleteSeriesStatement:
+			case *influxql.DropSeriesStatement:
 				// DB and RP not supported by these statements so don't rewrite into invalid
 				// statements
 			default:
@@ -2165,6 +4337,30 @@ func (e *StatementExecutor) NormalizeStatement(stmt influxql.Statement, defaultD
 	return
 }
 
+// checkSelectLimit rejects a SELECT statement without LIMIT on a measurement listed in LimitRequiredMeasurements.
+// The sources are not normalized yet, a measurement without database is in defaultDatabase.
+func (e *StatementExecutor) checkSelectLimit(stmt *influxql.SelectStatement, defaultDatabase string) error {
+	if stmt.Limit > 0 || len(e.LimitRequiredMeasurements) == 0 {
+		return nil
+	}
+	for _, src := range stmt.Sources {
+		m, ok := src.(*influxql.Measurement)
+		if !ok || m.SystemIterator != "" {
+			continue
+		}
+		database := m.Database
+		if database == "" {
+			database = defaultDatabase
+		}
+		for _, name := range e.LimitRequiredMeasurements[database] {
+			if (m.Regex == nil && m.Name == name) || (m.Regex != nil && m.Regex.Val.MatchString(name)) {
+				return errno.NewError(errno.SelectLimitRequired, database, name)
+			}
+		}
+	}
+	return nil
+}
+
 func (e *StatementExecutor) normalizeMeasurement(m *influxql.Measurement, defaultDatabase, defaultRetentionPolicy string) error {
 	// Targets (measurements in an INTO clause) can have blank names, which means it will be
 	// the same as the measurement name it came from in the FROM clause.
@@ -2218,9 +4414,12 @@ func (e *StatementExecutor) executeCreateStreamStatement(stmt *influxql.CreateSt
 	if !ok {
 		return errors.New("create stream query must be select statement")
 	}
+	if err := checkStreamCalls(selectStmt); err != nil {
+		return err
+	}
 	proxy := newRowChanProxy()
 	opt := e.GetOptions(ctx.ExecutionOptions, proxy.rc)
-	s, er := query.Prepare(selectStmt, e.ShardMapper, opt)
+	s, er := query.PrepareContext(ctx, selectStmt, e.ShardMapper, opt)
 	if er != nil {
 		return er
 	}
@@ -2228,6 +4427,9 @@ func (e *StatementExecutor) executeCreateStreamStatement(stmt *influxql.CreateSt
 	if err := stmt.Check(selectStmt, streamSupportMap); err != nil {
 		return err
 	}
+	if err := e.checkStreamInterval(selectStmt, stmt.Target.Measurement); err != nil {
+		return err
+	}
 	srcMst, ok := selectStmt.Sources[0].(*influxql.Measurement)
 	if !ok {
 		return errors.New("streamTask don't have source measurement")
@@ -2237,15 +4439,75 @@ func (e *StatementExecutor) executeCreateStreamStatement(stmt *influxql.CreateSt
 		Database:        stmt.Target.Measurement.Database,
 		RetentionPolicy: stmt.Target.Measurement.RetentionPolicy,
 	}, selectStmt)
-	mstInfo := stmt.Target.Measurement
 	if len(selectStmt.Sources) == 0 {
 		return errors.New("streamTask don't have source measurement")
 	}
-	err := e.MetaClient.CreateStreamMeasurement(info, srcMst, mstInfo, selectStmt)
+	return e.createStream(ctx, info, srcMst, stmt.Target.Measurement, selectStmt)
+}
+
+// checkStreamCalls rejects a stream calling a function which is not in streamSupportMap,
+// the error names the function and the supported ones.
+func checkStreamCalls(selectStmt *influxql.SelectStatement) error {
+	for _, f := range selectStmt.Fields {
+		c, ok := f.Expr.(*influxql.Call)
+		if !ok || streamSupportMap[c.Name] {
+			continue
+		}
+		supported := make([]string, 0, len(streamSupportMap))
+		for name := range streamSupportMap {
+			supported = append(supported, name)
+		}
+		sort.Strings(supported)
+		return fmt.Errorf("unsupported call function %s in stream, the supported functions are %s", c.Name, strings.Join(supported, ", "))
+	}
+	return nil
+}
+
+// checkStreamInterval rejects a stream whose GROUP BY time interval does not divide evenly the shard group duration
+// of the retention policy of its target, the windows of such a stream would be flushed across the shard groups.
+func (e *StatementExecutor) checkStreamInterval(selectStmt *influxql.SelectStatement, dstMst *influxql.Measurement) error {
+	interval, err := selectStmt.GroupByInterval()
+	if err != nil || interval <= 0 {
+		return err
+	}
+	rp, err := e.MetaClient.RetentionPolicy(dstMst.Database, dstMst.RetentionPolicy)
 	if err != nil {
 		return err
 	}
-	return e.MetaClient.CreateStreamPolicy(info)
+	if rp == nil || rp.ShardGroupDuration <= 0 {
+		return nil
+	}
+	if rp.ShardGroupDuration%interval != 0 {
+		return fmt.Errorf("stream group by time interval %s does not divide evenly the shard group duration %s of retention policy %s.%s",
+			interval, rp.ShardGroupDuration, dstMst.Database, dstMst.RetentionPolicy)
+	}
+	return nil
+}
+
+// createStream creates the target measurement of the stream if it does not exist, then the stream policy.
+// The measurement created here is rolled back if the client is gone or the policy fails,
+// so that no measurement is left without its stream.
+func (e *StatementExecutor) createStream(ctx context.Context, info *meta2.StreamInfo, srcMst, dstMst *influxql.Measurement, selectStmt *influxql.SelectStatement) error {
+	if err := query.ContextError(ctx); err != nil {
+		return err
+	}
+	_, err := e.MetaClient.Measurement(dstMst.Database, dstMst.RetentionPolicy, dstMst.Name)
+	created := err == meta2.ErrMeasurementNotFound
+
+	if err = e.MetaClient.CreateStreamMeasurement(info, srcMst, dstMst, selectStmt); err != nil {
+		return err
+	}
+	err = query.ContextError(ctx)
+	if err == nil {
+		err = e.MetaClient.CreateStreamPolicy(info)
+	}
+	if err != nil && created {
+		if rbErr := e.MetaClient.MarkMeasurementDelete(dstMst.Database, dstMst.Name); rbErr != nil {
+			e.StmtExecLogger.Error("failed to roll back the measurement of stream", zap.String("stream", info.Name),
+				zap.String("db", dstMst.Database), zap.String("measurement", dstMst.Name), zap.Error(rbErr))
+		}
+	}
+	return err
 }
 
 func (e *StatementExecutor) executeShowStreamsStatement(stmt *influxql.ShowStreamsStatement) (models.Rows, error) {
@@ -2253,21 +4515,296 @@ func (e *StatementExecutor) executeShowStreamsStatement(stmt *influxql.ShowStrea
 	if stmt.Database == "" {
 		showAll = true
 	}
-	return e.MetaClient.ShowStreams(stmt.Database, showAll)
+	rows, err := e.MetaClient.ShowStreams(stmt.Database, showAll)
+	if err != nil {
+		return rows, err
+	}
+	if stmt.RetentionPolicy != "" {
+		rows = streamsInRetentionPolicy(rows, stmt.RetentionPolicy)
+	}
+	return rows, nil
+}
+
+// streamsInRetentionPolicy keeps the streams listed in rows whose target measurement is in the retention policy rp.
+func streamsInRetentionPolicy(rows models.Rows, rp string) models.Rows {
+	filtered := make(models.Rows, 0, len(rows))
+	for _, row := range rows {
+		idx := -1
+		for i, col := range row.Columns {
+			if col == "retention" {
+				idx = i
+				break
+			}
+		}
+		r := &models.Row{Name: row.Name, Tags: row.Tags, Columns: row.Columns}
+		for _, value := range row.Values {
+			if idx >= 0 && value[idx] == rp {
+				r.Values = append(r.Values, value)
+			}
+		}
+		filtered = append(filtered, r)
+	}
+	return filtered
 }
 
 func (e *StatementExecutor) executeDropStream(stmt *influxql.DropStreamsStatement) error {
 	return e.MetaClient.DropStream(stmt.Name)
 }
 
-func (e *StatementExecutor) executeShowConfigs(stmt *influxql.ShowConfigsStatement) (models.Rows, error) {
-	row := &models.Row{Columns: []string{"component", "instance", "name", "value"}}
-	e.SqlConfigs[loggingLevel] = logger.Alevel
+// executeShowStreamTargets lists the target measurements left behind by the dropped streams.
+// The meta records the target of a stream when the stream is dropped.
+func (e *StatementExecutor) executeShowStreamTargets(stmt *influxql.ShowStreamTargetsStatement) (models.Rows, error) {
+	orphans := e.MetaClient.ShowDroppedStreamTargets(stmt.Database)
+	row := &models.Row{Columns: []string{"database", "retention", "measurement"}}
+	for _, t := range orphans {
+		row.Values = append(row.Values, []interface{}{t.Database, t.RetentionPolicy, t.Name})
+	}
+	return models.Rows{row}, nil
+}
+
+// executeDropStreamTargets drops the target measurements of a database left behind by the dropped streams.
+func (e *StatementExecutor) executeDropStreamTargets(stmt *influxql.DropStreamTargetsStatement) error {
+	for _, t := range e.MetaClient.ShowDroppedStreamTargets(stmt.Database) {
+		if err := e.MetaClient.MarkMeasurementDelete(t.Database, t.Name); err != nil {
+			return err
+		}
+		e.StmtExecLogger.Info("drop stream target", zap.String("db", t.Database), zap.String("rp", t.RetentionPolicy), zap.String("measurement", t.Name))
+	}
+	return nil
+}
+
+// executeShowConfigs lists the configs of the component selected by the statement, or of all the components.
+// Only the configs of this sql node are known, selecting the store or the meta nodes is an error.
+// The sensitive configs which are set are masked unless the user is an admin.
+// The configs can be filtered on their component and name by the condition of the statement.
+func (e *StatementExecutor) executeShowConfigs(stmt *influxql.ShowConfigsStatement, ctx *query.ExecutionContext) (models.Rows, error) {
+	switch stmt.Component {
+	case "", sqlConfig:
+	case storeConfig, metaConfig:
+		return nil, fmt.Errorf("the configs of the %s nodes are not available from a sql node", stmt.Component)
+	default:
+		return nil, fmt.Errorf("unknown config component: %s", stmt.Component)
+	}
+	if err := validateShowConfigsCondition(stmt.Condition); err != nil {
+		return nil, err
+	}
 
-	keys := sortConfigs(e.SqlConfigs)
+	row := &models.Row{Columns: []string{"component", "instance", "name", "value"}}
+	configs := make(map[string]interface{}, len(e.SqlConfigs))
+	for key, value := range e.SqlConfigs {
+		configs[key] = value
+	}
+	// the logging configs can be changed by SET CONFIG, so they are read from the logger
+	configs[loggingLevel] = logger.Alevel
+	if _, ok := configs[loggingFormat]; ok {
+		configs[loggingFormat] = logger.Format()
+	}
+	for key, value := range e.selectSpecConfigs() {
+		configs[key] = value
+	}
+	keys := sortConfigs(configs)
 
+	mask := !isAdmin(ctx)
 	for _, key := range keys {
-		row.Values = append(row.Values, []interface{}{sqlConfig, e.Hostname, key, e.SqlConfigs[key]})
+		if stmt.Condition != nil && !influxql.EvalBool(stmt.Condition, map[string]interface{}{"component": sqlConfig, "name": key}) {
+			continue
+		}
+		value := configValue(configs[key])
+		if _, ok := sensitiveConfigs[key]; ok && mask && value != "" {
+			value = maskedConfigValue
+		}
+		row.Values = append(row.Values, []interface{}{sqlConfig, e.Hostname, key, value})
+	}
+	return []*models.Row{row}, nil
+}
+
+// selectSpecConfigs returns the limits enforced on the SELECT statements, which are not kept in the sql config.
+func (e *StatementExecutor) selectSpecConfigs() map[string]interface{} {
+	return map[string]interface{}{
+		"spec-limit.max-select-point-n":   e.MaxSelectPointN,
+		"spec-limit.max-select-series-n":  e.MaxSelectSeriesN,
+		"spec-limit.max-select-fields-n":  e.MaxSelectFieldsN,
+		"spec-limit.max-select-buckets-n": e.MaxSelectBucketsN,
+		"spec-limit.query-schema-limit":   sysconfig.GetQuerySchemaLimit(),
+	}
+}
+
+// validateShowConfigsCondition checks that the condition of SHOW CONFIGS only filters on the component
+// and the name of the configs.
+func validateShowConfigsCondition(cond influxql.Expr) error {
+	for _, ref := range influxql.ExprNames(cond) {
+		if ref.Val != "component" && ref.Val != "name" {
+			return fmt.Errorf("SHOW CONFIGS can only filter on component and name, not %s", ref.Val)
+		}
+	}
+	return nil
+}
+
+// executeCheckConfig runs the checks of the running config, one row per check.
+func (e *StatementExecutor) executeCheckConfig() (models.Rows, error) {
+	if e.SqlConfig == nil {
+		return nil, errors.New("the running config is not available")
+	}
+
+	row := &models.Row{Columns: []string{"check", "status", "detail"}}
+	for _, check := range e.SqlConfig.Check() {
+		if check.Err != nil {
+			row.Values = append(row.Values, []interface{}{check.Name, "fail", check.Err.Error()})
+			continue
+		}
+		row.Values = append(row.Values, []interface{}{check.Name, "pass", ""})
+	}
+	return []*models.Row{row}, nil
+}
+
+// executeShowVersion returns the version of the server in one row.
+func (e *StatementExecutor) executeShowVersion() (models.Rows, error) {
+	row := &models.Row{
+		Columns: []string{"version", "build_type", "commit", "go_version"},
+		Values:  [][]interface{}{{e.ServerInfo.Version, e.BuildType, e.ServerInfo.Commit, runtime.Version()}},
+	}
+	return []*models.Row{row}, nil
+}
+
+const diagnosticStatusOK = "ok"
+
+// executeShowDiagnosticsStatement returns a row per category of diagnostics like InfluxDB: the build, the runtime,
+// the system and the network of the server, and the status of its connections to the meta and data nodes.
+// A failed connection is reported in the status of the category, not as an error of the statement.
+// Only the category named by the module is returned by SHOW DIAGNOSTICS FOR 'module'.
+func (e *StatementExecutor) executeShowDiagnosticsStatement(stmt *influxql.ShowDiagnosticsStatement) (models.Rows, error) {
+	now := time.Now()
+	uptime := ""
+	if !e.StartTime.IsZero() {
+		uptime = now.Sub(e.StartTime).Truncate(time.Second).String()
+	}
+	rows := models.Rows{
+		{
+			Name:    "build",
+			Columns: []string{"Version", "Commit", "Branch", "Build Time", "Build Type"},
+			Values:  [][]interface{}{{e.ServerInfo.Version, e.ServerInfo.Commit, e.ServerInfo.Branch, e.ServerInfo.BuildTime, e.BuildType}},
+		},
+		{
+			Name:    "runtime",
+			Columns: []string{"GOARCH", "GOMAXPROCS", "GOOS", "goroutines", "version"},
+			Values:  [][]interface{}{{runtime.GOARCH, runtime.GOMAXPROCS(0), runtime.GOOS, runtime.NumGoroutine(), runtime.Version()}},
+		},
+		{
+			Name:    "system",
+			Columns: []string{"PID", "currentTime", "started", "uptime"},
+			Values:  [][]interface{}{{os.Getpid(), now.UTC(), e.StartTime.UTC(), uptime}},
+		},
+		{
+			Name:    "network",
+			Columns: []string{"hostname"},
+			Values:  [][]interface{}{{e.Hostname}},
+		},
+		e.metaDiagnostics(),
+		e.storageDiagnostics(),
+	}
+	if stmt.Module == "" {
+		return rows, nil
+	}
+	for _, row := range rows {
+		if strings.EqualFold(row.Name, stmt.Module) {
+			return models.Rows{row}, nil
+		}
+	}
+	return models.Rows{}, nil
+}
+
+// metaDiagnostics reports the meta nodes known by the meta client and whether their leader is reachable.
+func (e *StatementExecutor) metaDiagnostics() *models.Row {
+	row := &models.Row{Name: "meta", Columns: []string{"leader", "nodes", "status"}}
+	leader, err := e.MetaClient.MetaLeader()
+	if err != nil {
+		row.Values = [][]interface{}{{"", 0, err.Error()}}
+		return row
+	}
+	nodes, err := e.MetaClient.MetaNodes()
+	status := diagnosticStatusOK
+	if err != nil {
+		status = err.Error()
+	}
+	row.Values = [][]interface{}{{leader, len(nodes), status}}
+	return row
+}
+
+// storageDiagnostics reports the data nodes the queries are sent to by the net storage, the row has no values
+// until a data node is registered.
+func (e *StatementExecutor) storageDiagnostics() *models.Row {
+	row := &models.Row{Name: "storage", Columns: []string{"id", "host", "tcp_host", "status"}}
+	nodes, err := e.MetaClient.DataNodes()
+	if err != nil {
+		row.Values = [][]interface{}{{0, "", "", err.Error()}}
+		return row
+	}
+	for _, n := range nodes {
+		row.Values = append(row.Values, []interface{}{n.ID, n.Host, n.TCPHost, n.Status.String()})
+	}
+	return row
+}
+
+const (
+	limitScopeGlobal   = "global"
+	limitScopeDatabase = "database"
+)
+
+// executeShowExecutorLimits lists the limits the statement executor enforces on the queries, for support triage.
+func (e *StatementExecutor) executeShowExecutorLimits() models.Rows {
+	row := &models.Row{Columns: []string{"limit", "value"}}
+	row.Values = [][]interface{}{
+		{"max-select-point-n", e.MaxSelectPointN},
+		{"max-select-series-n", e.MaxSelectSeriesN},
+		{"max-select-fields-n", e.MaxSelectFieldsN},
+		{"max-select-buckets-n", e.MaxSelectBucketsN},
+		{"max-query-mem", e.MaxQueryMem},
+		{"retention-policy-limit", e.RetentionPolicyLimit},
+		{"max-query-parallel", e.MaxQueryParallel},
+	}
+	return models.Rows{row}
+}
+
+// executeShowQueryLimits lists the query limits effective on the database, the limits configured
+// for the database take precedence over the global ones.
+func (e *StatementExecutor) executeShowQueryLimits(stmt *influxql.ShowQueryLimitsStatement) (models.Rows, error) {
+	if stmt.Database == "" {
+		return nil, coordinator.ErrDatabaseNameRequired
+	}
+	if _, err := e.MetaClient.Database(stmt.Database); err != nil {
+		return nil, err
+	}
+
+	maxConcurrentQueries := 0
+	if e.SqlConfig != nil {
+		maxConcurrentQueries = e.SqlConfig.Coordinator.MaxConcurrentQueries
+	}
+	row := &models.Row{Columns: []string{"limit", "value", "scope"}}
+	row.Values = [][]interface{}{
+		{"max-select-series-n", e.MaxSelectSeriesN, limitScopeGlobal},
+		{"max-select-series-hard-limit", e.MaxSelectSeriesHardLimit, limitScopeGlobal},
+		{"max-select-point-n", e.MaxSelectPointN, limitScopeGlobal},
+		{"max-select-buckets-n", e.MaxSelectBucketsN, limitScopeGlobal},
+		{"max-select-sources", e.MaxSelectSources, limitScopeGlobal},
+		{"max-show-measurements", e.MaxShowMeasurements, limitScopeGlobal},
+		{"max-show-series", e.MaxShowSeries, limitScopeGlobal},
+		{"max-concurrent-queries", maxConcurrentQueries, limitScopeGlobal},
+	}
+
+	if n := e.QueryRateLimiter.Limit(stmt.Database); n > 0 {
+		row.Values = append(row.Values, []interface{}{"database-query-rate-limit", n, limitScopeDatabase})
+	} else {
+		row.Values = append(row.Values, []interface{}{"database-query-rate-limit", 0, limitScopeGlobal})
+	}
+
+	scanLimits := e.MeasurementScanLimiter.Limits(stmt.Database)
+	msts := make([]string, 0, len(scanLimits))
+	for mst := range scanLimits {
+		msts = append(msts, mst)
+	}
+	sort.Strings(msts)
+	for _, mst := range msts {
+		row.Values = append(row.Values, []interface{}{"measurement-scan-limit." + mst, scanLimits[mst], limitScopeDatabase})
 	}
 	return []*models.Row{row}, nil
 }
@@ -2282,6 +4819,12 @@ func (e *StatementExecutor) executeSetConfig(stmt *influxql.SetConfigStatement)
 				return logger.SetLevel(levelString)
 			}
 			return fmt.Errorf("illegal type of logging level input")
+		case loggingFormat:
+			format, ok := stmt.Value.(string)
+			if !ok {
+				return fmt.Errorf("illegal type of logging format input")
+			}
+			return logger.SetFormat(format)
 		default:
 		}
 	default:
@@ -2289,6 +4832,63 @@ func (e *StatementExecutor) executeSetConfig(stmt *influxql.SetConfigStatement)
 	return fmt.Errorf("unsupported config command")
 }
 
+// isAdmin reports whether the statement is executed by an admin user, which is the case of every user
+// when the authentication is disabled.
+func isAdmin(ctx *query.ExecutionContext) bool {
+	if ctx == nil {
+		return true
+	}
+	u, ok := ctx.Authorizer.(*meta2.UserInfo)
+	return !ok || u.Admin
+}
+
+// configValue converts a config value to a type with a stable JSON representation: numbers and booleans are kept,
+// durations and the other values implementing fmt.Stringer become strings, lists and maps are converted item by item.
+func configValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case toml.Size:
+		return uint64(v)
+	case fmt.Stringer:
+		return v.String()
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Bool:
+		return rv.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return rv.Uint()
+	case reflect.Float32, reflect.Float64:
+		return rv.Float()
+	case reflect.String:
+		return rv.String()
+	case reflect.Slice, reflect.Array:
+		values := make([]interface{}, rv.Len())
+		for i := range values {
+			values[i] = configValue(rv.Index(i).Interface())
+		}
+		return values
+	case reflect.Map:
+		values := make(map[string]interface{}, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			values[fmt.Sprint(iter.Key().Interface())] = configValue(iter.Value().Interface())
+		}
+		return values
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return nil
+		}
+		return configValue(rv.Elem().Interface())
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
 func sortConfigs(configs map[string]interface{}) []string {
 	keys := make([]string, 0, len(configs))
 	for key := range configs {
@@ -2314,6 +4914,101 @@ func (e *StatementExecutor) executeShowClusterWithCondition(stmt *influxql.ShowC
 	return e.MetaClient.ShowClusterWithCondition(stmt.NodeType, ID)
 }
 
+// executeShowDataNodes lists the data nodes with the number of pts they own over all databases.
+// The detail form also lists the owned pt ids of each database, formatted as "db0:0,1 db1:2".
+func (e *StatementExecutor) executeShowDataNodes(stmt *influxql.ShowDataNodesStatement) (models.Rows, error) {
+	nodes, err := e.MetaClient.DataNodes()
+	if err != nil {
+		return nil, err
+	}
+
+	dbs := make([]string, 0)
+	for name, db := range e.MetaClient.Databases() {
+		if !db.MarkDeleted {
+			dbs = append(dbs, name)
+		}
+	}
+	sort.Strings(dbs)
+
+	ptIds := make(map[uint64][]string, len(nodes))
+	ptCount := make(map[uint64]int, len(nodes))
+	for _, db := range dbs {
+		pts, err := e.MetaClient.DBPtView(db)
+		if err != nil {
+			return nil, err
+		}
+		owned := make(map[uint64][]string, len(nodes))
+		for i := range pts {
+			owned[pts[i].Owner.NodeID] = append(owned[pts[i].Owner.NodeID], strconv.FormatUint(uint64(pts[i].PtId), 10))
+		}
+		for id, ids := range owned {
+			ptCount[id] += len(ids)
+			ptIds[id] = append(ptIds[id], db+":"+strings.Join(ids, ","))
+		}
+	}
+
+	row := &models.Row{Columns: []string{"id", "host", "status", "pt_count"}}
+	if stmt.Detail {
+		row.Columns = append(row.Columns, "pt_ids")
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].ID < nodes[j].ID })
+	for i := range nodes {
+		value := []interface{}{nodes[i].ID, nodes[i].Host, nodes[i].Status.String(), ptCount[nodes[i].ID]}
+		if stmt.Detail {
+			value = append(value, strings.Join(ptIds[nodes[i].ID], " "))
+		}
+		row.Values = append(row.Values, value)
+	}
+	return models.Rows{row}, nil
+}
+
+// executeShowMetaNodes lists the meta nodes with their raft roles.
+// The roles are reported as unknown when the meta leader can not be reached.
+func (e *StatementExecutor) executeShowMetaNodes() (models.Rows, error) {
+	nodes, err := e.MetaClient.MetaNodes()
+	if err != nil {
+		return nil, err
+	}
+	leader, err := e.MetaClient.MetaLeader()
+	if err != nil {
+		e.StmtExecLogger.Warn("failed to get the meta leader", zap.Error(err))
+	}
+
+	row := &models.Row{Columns: []string{"id", "host", "rpc_addr", "raft_addr", "status", "role"}}
+	for i := range nodes {
+		role := "follower"
+		if err != nil || leader == "" {
+			role = "unknown"
+		} else if nodes[i].TCPHost == leader {
+			role = "leader"
+		}
+		row.Values = append(row.Values, []interface{}{nodes[i].ID, nodes[i].Host, nodes[i].RPCAddr, nodes[i].TCPHost, nodes[i].Status.String(), role})
+	}
+	return models.Rows{row}, nil
+}
+
+// executeRebalanceDatabase plans the pt moves which balance the database over the data nodes.
+// Only the dry-run is supported for now, moving the pts is not implemented yet.
+func (e *StatementExecutor) executeRebalanceDatabase(stmt *influxql.RebalanceDatabaseStatement) (models.Rows, error) {
+	if !stmt.DryRun {
+		return nil, errors.New("only REBALANCE DATABASE DRYRUN is supported")
+	}
+	pts, err := e.MetaClient.DBPtView(stmt.Database)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := e.MetaClient.DataNodes()
+	if err != nil {
+		return nil, err
+	}
+
+	row := &models.Row{Name: stmt.Database, Columns: []string{"pt_id", "from_node", "to_node"}}
+	for _, move := range coordinator.PlanRebalance(pts, nodes) {
+		row.Values = append(row.Values, []interface{}{move.PtId, move.From, move.To})
+	}
+	return models.Rows{row}, nil
+}
+
 type ByteStringSlice [][]byte
 
 func (s ByteStringSlice) Len() int {
@@ -2565,19 +5260,6 @@ func RemoveFiltered(result [][]byte, filetered [][]byte) [][]byte {
 	return last
 }
 
-func limitStringSlice(s []string, offset, limit int) []string {
-	l := len(s)
-	if offset >= l {
-		return nil
-	}
-
-	end := offset + limit
-	if limit == 0 || end >= l {
-		end = l
-	}
-	return s[offset:end]
-}
-
 type rowChanProxy struct {
 	rc       chan query.RowsChan
 	finished chan struct{}
@@ -1,39 +1,136 @@
 package coordinator
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"regexp"
+	"runtime"
+	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/influxdata/influxdb/models"
+	originql "github.com/influxdata/influxql"
+	"github.com/openGemini/openGemini/app"
+	"github.com/openGemini/openGemini/coordinator"
+	"github.com/openGemini/openGemini/engine/executor"
+	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/index"
 	Logger "github.com/openGemini/openGemini/lib/logger"
 	meta "github.com/openGemini/openGemini/lib/metaclient"
 	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/sysconfig"
+	"github.com/openGemini/openGemini/lib/syscontrol"
+	"github.com/openGemini/openGemini/lib/tracing"
+	"github.com/openGemini/openGemini/lib/util/lifted/hashicorp/serf/serf"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	proto2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/openGemini/openGemini/services/continuousquery"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
 )
 
-func TestLimitStringSlice(t *testing.T) {
-	originSeries := []string{"a", "b", "c", "d", "e", "f", "g"}
-	series := make([]string, len(originSeries))
-	copy(series, originSeries)
-	res := limitStringSlice(series, 0, 0)
-	assert.Equal(t, originSeries, res)
-	series = make([]string, len(originSeries))
-	copy(series, originSeries)
-	res = limitStringSlice(series, 1, 2)
-	assert.Equal(t, []string{"b", "c"}, res)
-	copy(series, originSeries)
-	res = limitStringSlice(series, 5, 10)
-	assert.Equal(t, []string{"f", "g"}, res)
-	copy(series, originSeries)
-	res = limitStringSlice(series, 7, 0)
-	assert.Equal(t, true, res == nil)
+// mockShowSeriesNS returns the unsorted series keys of each node.
+type mockShowSeriesNS struct {
+	netstorage.NetStorage
+	series map[uint64][]string
+}
+
+func (s *mockShowSeriesNS) ShowSeries(nodeID uint64, _ string, _ []uint32, _ []string, _ influxql.Expr) ([]string, error) {
+	return append([]string(nil), s.series[nodeID]...), nil
+}
+
+func TestStatementExecutor_ShowSeriesStream(t *testing.T) {
+	mc := &MockMetaClient{}
+	e := &StatementExecutor{
+		MetaClient:     mc,
+		MetaExecutor:   &coordinator.MetaExecutor{MetaClient: mc, Logger: Logger.NewLogger(errno.ModuleUnknown)},
+		StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown),
+		NetStorage: &mockShowSeriesNS{series: map[uint64][]string{
+			1: {"cpu,host=e", "cpu,host=a", "cpu,host=c"},
+			2: {"cpu,host=d", "cpu,host=b", "cpu,host=f", "cpu,host=g"},
+		}},
+	}
+	show := func(offset, limit, chunkSize int) []*query.Result {
+		ctx := &query.ExecutionContext{Context: context.Background(), Results: make(chan *query.Result, 10)}
+		ctx.Chunked = chunkSize > 0
+		ctx.ChunkSize = chunkSize
+		stmt := &influxql.ShowSeriesStatement{Database: "db0", Sources: influxql.Sources{&influxql.Measurement{Name: "cpu"}}, Offset: offset, Limit: limit}
+		require.NoError(t, e.executeShowSeries(stmt, ctx, 0))
+		close(ctx.Results)
+		var results []*query.Result
+		for r := range ctx.Results {
+			results = append(results, r)
+		}
+		return results
+	}
+	keys := func(results []*query.Result) []string {
+		var ret []string
+		for _, r := range results {
+			for _, v := range r.Series[0].Values {
+				ret = append(ret, v[0].(string))
+			}
+		}
+		return ret
+	}
+
+	// the series keys of the nodes are merged in order
+	results := show(0, 0, 0)
+	require.Len(t, results, 1)
+	assert.False(t, results[0].Partial)
+	assert.Equal(t, []string{"key"}, results[0].Series[0].Columns)
+	assert.Equal(t, []string{"cpu,host=a", "cpu,host=b", "cpu,host=c", "cpu,host=d", "cpu,host=e", "cpu,host=f", "cpu,host=g"}, keys(results))
+
+	// the offset and the limit apply to the merged series keys
+	assert.Equal(t, []string{"cpu,host=b", "cpu,host=c"}, keys(show(1, 2, 0)))
+	assert.Equal(t, []string{"cpu,host=f", "cpu,host=g"}, keys(show(5, 10, 0)))
+
+	// the series keys are sent in chunks, all but the last one are partial
+	results = show(1, 5, 2)
+	require.Len(t, results, 3)
+	assert.True(t, results[0].Partial)
+	assert.True(t, results[1].Series[0].Partial)
+	assert.False(t, results[2].Partial)
+	assert.Equal(t, []string{"cpu,host=b", "cpu,host=c", "cpu,host=d", "cpu,host=e", "cpu,host=f"}, keys(results))
+	assert.Len(t, show(3, 4, 2), 2)
+
+	// nothing is sent if there is no series key
+	assert.Empty(t, show(7, 0, 0))
+
+	// the series keys collected from the nodes are capped, those kept for a LIMIT are counted
+	e.MaxShowSeries = 6
+	ctx := &query.ExecutionContext{Context: context.Background(), Results: make(chan *query.Result, 10)}
+	err := e.executeShowSeries(&influxql.ShowSeriesStatement{Database: "db0", Sources: influxql.Sources{&influxql.Measurement{Name: "cpu"}}}, ctx, 0)
+	assert.True(t, errno.Equal(err, errno.ShowSeriesLimitExceeded))
+	assert.Equal(t, []string{"cpu,host=a", "cpu,host=b", "cpu,host=c"}, keys(show(0, 3, 0)))
+}
+
+func TestSeriesMerger(t *testing.T) {
+	merged := func(nodeSeries map[uint64][]string) []string {
+		var keys []string
+		m := newSeriesMerger(nodeSeries)
+		for key, ok := m.next(); ok; key, ok = m.next() {
+			keys = append(keys, key)
+		}
+		return keys
+	}
+	assert.Equal(t, []string{"a", "b", "c", "d", "e", "f"}, merged(map[uint64][]string{1: {"a", "c", "e"}, 2: {"b", "c", "d", "f"}, 3: nil}))
+	// the keys returned by several nodes are not duplicated
+	assert.Equal(t, []string{"a", "c", "e"}, merged(map[uint64][]string{1: {"a", "c", "e"}, 2: {"a", "c", "e"}}))
+	assert.Empty(t, merged(nil))
 }
 
 type MockMetaClient struct {
@@ -44,6 +141,20 @@ func (m *MockMetaClient) CreateContinuousQuery(database, name, query string) err
 	return nil
 }
 
+func (m *MockMetaClient) RetentionPolicy(database, name string) (*meta2.RetentionPolicyInfo, error) {
+	if database != "db0" && database != "db1" {
+		return nil, errno.NewError(errno.DatabaseNotFound, database)
+	}
+	if name == "" || name == "rp0" {
+		return &meta2.RetentionPolicyInfo{Name: "rp0"}, nil
+	}
+	return nil, nil
+}
+
+func (m *MockMetaClient) CreateSubscription(database, rp, name, mode string, destinations []string) error {
+	return nil
+}
+
 type MockShardMapper struct {
 	query.ShardMapper
 }
@@ -59,6 +170,15 @@ func (csm *MockShardMapper) MapShards(source influxql.Sources, _ influxql.TimeRa
 	return nil, errno.NewError(errno.NoConnectionAvailable)
 }
 
+func parseScript(t *testing.T, script string) []influxql.Statement {
+	YyParser := &influxql.YyParser{Query: influxql.Query{}}
+	YyParser.Scanner = influxql.NewScanner(strings.NewReader(script))
+	YyParser.ParseTokens()
+	q, err := YyParser.GetQuery()
+	require.NoError(t, err)
+	return q.Statements
+}
+
 func newMockStatementExecutor() *StatementExecutor {
 	//MetaClient meta.MetaClient
 	client := &MockMetaClient{}
@@ -199,12 +319,156 @@ func (s *mockNS) KillQueryOnNode(nodeID, queryID uint64) error {
 
 func TestStatementExecutor_executeShowQueriesStatement(t *testing.T) {
 	e := StatementExecutor{MetaClient: &MockMetaClient{}, NetStorage: &mockNS{}}
-	rows, err := e.executeShowQueriesStatement()
+	rows, err := e.executeShowQueriesStatement(&influxql.ShowQueriesStatement{})
 	assert.NoError(t, err)
 	// there is a one has been killed in all hosts
 	assert.Equal(t, mockInfosNum-1, len(rows[0].Values))
 }
 
+type mockQueriesNS struct {
+	netstorage.NetStorage
+	infos map[uint64][]*netstorage.QueryExeInfo
+}
+
+func (s *mockQueriesNS) GetQueriesOnNode(nodeID uint64) ([]*netstorage.QueryExeInfo, error) {
+	return s.infos[nodeID], nil
+}
+
+func TestStatementExecutor_executeShowQueriesStatement_JSON(t *testing.T) {
+	newInfo := func(qid uint64, state netstorage.RunStateType) *netstorage.QueryExeInfo {
+		return &netstorage.QueryExeInfo{
+			QueryID:   qid,
+			Stmt:      fmt.Sprintf("select * from mst%d", qid),
+			Database:  "db0",
+			BeginTime: int64(qid),
+			RunState:  state,
+		}
+	}
+	ns := &mockQueriesNS{infos: map[uint64][]*netstorage.QueryExeInfo{
+		1: {newInfo(1, netstorage.Running), newInfo(2, netstorage.Running), newInfo(3, netstorage.Killed)},
+		2: {newInfo(1, netstorage.Running), newInfo(2, netstorage.Killed)},
+		3: {newInfo(2, netstorage.Killed)},
+	}}
+	e := StatementExecutor{MetaClient: &MockMetaClient{}, NetStorage: ns}
+
+	rows, err := e.executeShowQueriesStatement(&influxql.ShowQueriesStatement{Format: "json"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(rows))
+	assert.Equal(t, []string{"query"}, rows[0].Columns)
+	// the query 3 is killed on all hosts
+	assert.Equal(t, 2, len(rows[0].Values))
+
+	var docs []showQueryJSON
+	for _, value := range rows[0].Values {
+		var doc showQueryJSON
+		assert.NoError(t, json.Unmarshal([]byte(value[0].(string)), &doc))
+		docs = append(docs, doc)
+	}
+	assert.Equal(t, uint64(1), docs[0].QID)
+	assert.Equal(t, "select * from mst1", docs[0].Query)
+	assert.Equal(t, "db0", docs[0].Database)
+	assert.Equal(t, []string{"192.168.1.8080", "192.168.1.8081"}, docs[0].RunningHosts)
+	assert.Equal(t, []string{}, docs[0].KilledHosts)
+
+	assert.Equal(t, uint64(2), docs[1].QID)
+	assert.Equal(t, []string{"192.168.1.8080"}, docs[1].RunningHosts)
+	assert.Equal(t, []string{"192.168.1.8081", "192.168.1.8082"}, docs[1].KilledHosts)
+}
+
+func TestStatementExecutor_executeShowQueriesStatement_Nodes(t *testing.T) {
+	newInfo := func(qid uint64, state netstorage.RunStateType) *netstorage.QueryExeInfo {
+		return &netstorage.QueryExeInfo{QueryID: qid, Stmt: fmt.Sprintf("select * from mst%d", qid), Database: "db0", BeginTime: int64(qid), RunState: state}
+	}
+	ns := &mockQueriesNS{infos: map[uint64][]*netstorage.QueryExeInfo{
+		1: {newInfo(1, netstorage.Running), newInfo(2, netstorage.Running)},
+		2: {newInfo(2, netstorage.Killed)},
+		3: {newInfo(2, netstorage.Running)},
+	}}
+	e := StatementExecutor{MetaClient: &MockMetaClient{}, NetStorage: ns}
+
+	rows, err := e.executeShowQueriesStatement(&influxql.ShowQueriesStatement{})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(rows[0].Values))
+	nodes := len(rows[0].Columns) - 1
+	assert.Equal(t, "nodes", rows[0].Columns[nodes])
+	assert.Equal(t, 1, rows[0].Values[0][nodes])
+	// the query killed on a part of the nodes is spread across all of them
+	assert.Equal(t, "killed", rows[0].Values[1][4])
+	assert.Equal(t, 3, rows[0].Values[1][nodes])
+	assert.Equal(t, "running", rows[0].Values[2][4])
+	assert.Equal(t, 3, rows[0].Values[2][nodes])
+}
+
+func TestStatementExecutor_executeShowQueriesStatement_Precise(t *testing.T) {
+	ns := &mockQueriesNS{infos: map[uint64][]*netstorage.QueryExeInfo{
+		1: {{QueryID: 1, Stmt: "select * from mst1", Database: "db0", BeginTime: time.Now().Add(-1500 * time.Millisecond).UnixNano(), RunState: netstorage.Running}},
+	}}
+	e := StatementExecutor{MetaClient: &MockMetaClient{}, NetStorage: ns}
+
+	rows, err := e.executeShowQueriesStatement(&influxql.ShowQueriesStatement{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rows[0].Values))
+	assert.Equal(t, []string{"qid", "query", "database", "duration", "status", "host", "duration_ns", "nodes"}, rows[0].Columns)
+	assert.Equal(t, "1s", rows[0].Values[0][3])
+	// the raw duration is not truncated
+	raw := time.Duration(rows[0].Values[0][6].(int64))
+	assert.True(t, raw >= 1500*time.Millisecond && raw < 2*time.Second, raw.String())
+
+	rows, err = e.executeShowQueriesStatement(&influxql.ShowQueriesStatement{Precise: true})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rows[0].Values))
+	d, err := time.ParseDuration(rows[0].Values[0][3].(string))
+	require.NoError(t, err)
+	assert.True(t, d >= 1500*time.Millisecond && d < 2*time.Second, d.String())
+
+	rows, err = e.executeShowQueriesStatement(&influxql.ShowQueriesStatement{Format: "json", Precise: true})
+	require.NoError(t, err)
+	var doc showQueryJSON
+	require.NoError(t, json.Unmarshal([]byte(rows[0].Values[0][0].(string)), &doc))
+	d, err = time.ParseDuration(doc.Duration)
+	require.NoError(t, err)
+	assert.True(t, d >= 1500*time.Millisecond && d < 2*time.Second, d.String())
+}
+
+func TestStatementExecutor_executeShowQueriesStatement_CountByNode(t *testing.T) {
+	newInfo := func(qid uint64, state netstorage.RunStateType) *netstorage.QueryExeInfo {
+		return &netstorage.QueryExeInfo{QueryID: qid, Stmt: "select * from mst", Database: "db0", RunState: state}
+	}
+	ns := &mockQueriesNS{infos: map[uint64][]*netstorage.QueryExeInfo{
+		1: {newInfo(1, netstorage.Running), newInfo(2, netstorage.Running), newInfo(3, netstorage.Killed)},
+		2: {newInfo(1, netstorage.Running), newInfo(2, netstorage.Killed)},
+	}}
+	e := StatementExecutor{MetaClient: &MockMetaClient{}, NetStorage: ns}
+
+	rows, err := e.executeShowQueriesStatement(&influxql.ShowQueriesStatement{CountByNode: true})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rows))
+	assert.Equal(t, []string{"node_id", "host", "queries"}, rows[0].Columns)
+	// the killed queries are not counted, a node serving no query is listed
+	assert.Equal(t, [][]interface{}{
+		{uint64(1), "192.168.1.8080", int64(2)},
+		{uint64(2), "192.168.1.8081", int64(1)},
+		{uint64(3), "192.168.1.8082", int64(0)},
+	}, rows[0].Values)
+}
+
+func Test_formatQueryDuration(t *testing.T) {
+	tests := []struct {
+		d         time.Duration
+		truncated string
+		precise   string
+	}{
+		{d: 3456 * time.Millisecond, truncated: "3s", precise: "3.456s"},
+		{d: 12345 * time.Microsecond, truncated: "12ms", precise: "12.345ms"},
+		{d: 1500 * time.Nanosecond, truncated: "1µs", precise: "1.5µs"},
+		{d: 800 * time.Nanosecond, truncated: "800ns", precise: "800ns"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.truncated, formatQueryDuration(tt.d, false))
+		assert.Equal(t, tt.precise, formatQueryDuration(tt.d, true))
+	}
+}
+
 func Test_combinedQueryExeInfo_getCombinedRunState(t *testing.T) {
 	type fields struct {
 		runningHosts map[string]struct{}
@@ -262,12 +526,50 @@ func Test_combinedQueryExeInfo_getCombinedRunState(t *testing.T) {
 func TestStatementExecutor_executeKillQuery(t *testing.T) {
 	e := StatementExecutor{MetaClient: &MockMetaClient{}, NetStorage: &mockNS{}, StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown)}
 	err1 := e.executeKillQuery(&influxql.KillQueryStatement{QueryID: uint64(1), Host: "127.0.0.1:8400"})
-	assert.EqualError(t, err1, meta2.ErrUnsupportCommand.Error())
+	assert.EqualError(t, err1, "host 127.0.0.1:8400 is not a data node")
 
 	err2 := e.executeKillQuery(&influxql.KillQueryStatement{QueryID: uint64(1)})
 	assert.NoError(t, err2)
 }
 
+// mockKillQueryNS records the nodes the queries are killed on, the queries in running are running on every node.
+type mockKillQueryNS struct {
+	netstorage.NetStorage
+	running map[uint64]map[uint64]bool
+	killed  []uint64
+}
+
+func (s *mockKillQueryNS) KillQueryOnNode(nodeID, queryID uint64) error {
+	s.killed = append(s.killed, nodeID)
+	if !s.running[nodeID][queryID] {
+		return errno.NewError(errno.ErrQueryNotFound, queryID)
+	}
+	return nil
+}
+
+func TestStatementExecutor_executeKillQuery_Host(t *testing.T) {
+	ns := &mockKillQueryNS{running: map[uint64]map[uint64]bool{1: {1: true}, 2: {2: true}}}
+	e := StatementExecutor{MetaClient: &MockMetaClient{}, NetStorage: ns, StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown)}
+
+	YyParser := &influxql.YyParser{Query: influxql.Query{}}
+	YyParser.Scanner = influxql.NewScanner(strings.NewReader("KILL QUERY 1 ON '192.168.1.8080'"))
+	YyParser.ParseTokens()
+	q, err := YyParser.GetQuery()
+	require.NoError(t, err)
+	stmt := q.Statements[0].(*influxql.KillQueryStatement)
+	assert.Equal(t, &influxql.KillQueryStatement{QueryID: 1, Host: "192.168.1.8080"}, stmt)
+
+	// the query is only killed on the node of the host
+	require.NoError(t, e.executeKillQuery(stmt))
+	assert.Equal(t, []uint64{1}, ns.killed)
+
+	// the query is not running on the node of the host
+	ns.killed = nil
+	err = e.executeKillQuery(&influxql.KillQueryStatement{QueryID: 1, Host: "192.168.1.8081"})
+	assert.True(t, errno.Equal(err, errno.ErrQueryNotFound))
+	assert.Equal(t, []uint64{2}, ns.killed)
+}
+
 func TestStatementExecutor_executeCreateContinuousQueryStatement(t *testing.T) {
 	e := StatementExecutor{MetaClient: &MockMetaClient{}, NetStorage: &mockNS{}, StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown)}
 	err := e.executeCreateContinuousQueryStatement(
@@ -365,4 +667,3025 @@ func TestStatementExecutor_executeCreateContinuousQueryStatement(t *testing.T) {
 	assert.NoError(t, err)
 	cqQuery := stmt.String()
 	assert.Equal(t, `CREATE CONTINUOUS QUERY cq0 ON db0 RESAMPLE EVERY 10m FOR 1h BEGIN SELECT "field"::integer INTO db1..mst1 FROM db0.rp0.mst0 GROUP BY time(1m) END`, cqQuery)
+
+	// case: the INTO target retention policy exists
+	stmt.Source = selectStatement2()
+	stmt.Source.Target.Measurement.RetentionPolicy = "rp0"
+	assert.NoError(t, e.executeCreateContinuousQueryStatement(stmt))
+
+	// case: the INTO target retention policy does not exist
+	stmt.Source = selectStatement2()
+	stmt.Source.Target.Measurement.RetentionPolicy = "rp1"
+	assert.EqualError(t, e.executeCreateContinuousQueryStatement(stmt), "retention policy not found: db1.rp1")
+
+	// case: the INTO target database does not exist
+	stmt.Source = selectStatement2()
+	stmt.Source.Target.Measurement.Database = "db2"
+	err = e.executeCreateContinuousQueryStatement(stmt)
+	assert.True(t, errno.Equal(err, errno.DatabaseNotFound))
+
+	// case: the INTO target database has no default retention policy
+	e.MetaClient = &noDefaultRPMetaClient{}
+	stmt.Source = selectStatement2()
+	assert.EqualError(t, e.executeCreateContinuousQueryStatement(stmt), "default retention policy not found for the INTO target database: db1")
+}
+
+// noDefaultRPMetaClient has databases without a default retention policy.
+type noDefaultRPMetaClient struct {
+	MockMetaClient
+}
+
+func (m *noDefaultRPMetaClient) RetentionPolicy(database, name string) (*meta2.RetentionPolicyInfo, error) {
+	if name == "" {
+		return nil, nil
+	}
+	return m.MockMetaClient.RetentionPolicy(database, name)
+}
+
+type mockCostShardMapper struct {
+	query.ShardMapper
+	opt query.SelectOptions
+}
+
+func (m *mockCostShardMapper) MapShards(_ influxql.Sources, _ influxql.TimeRange, opt query.SelectOptions, _ influxql.Expr) (query.ShardGroup, error) {
+	m.opt = opt
+	return &mockCostShardGroup{}, nil
+}
+
+type mockCostShardGroup struct {
+	query.ShardGroup
+}
+
+func (m *mockCostShardGroup) LogicalPlanCost(_ *influxql.Measurement, _ query.ProcessorOptions) (hybridqp.LogicalPlanCost, error) {
+	return hybridqp.LogicalPlanCost{NumShards: 4}, nil
+}
+
+func (m *mockCostShardGroup) Close() error {
+	return nil
+}
+
+func (m *MockMetaClient) Database(name string) (*meta2.DatabaseInfo, error) {
+	return &meta2.DatabaseInfo{Name: name}, nil
+}
+
+func (m *MockMetaClient) GetNodePtsMap(_ string) (map[uint64][]uint32, error) {
+	return map[uint64][]uint32{1: {0}, 2: {1}}, nil
+}
+
+func (m *MockMetaClient) MatchMeasurements(_ string, ms influxql.Measurements) (map[string]*meta2.MeasurementInfo, error) {
+	ret := make(map[string]*meta2.MeasurementInfo, len(ms))
+	for _, mst := range ms {
+		rp := mst.RetentionPolicy
+		if rp == "" {
+			rp = "rp0"
+		}
+		ret[rp+"."+mst.Name+"_0000"] = &meta2.MeasurementInfo{Name: mst.Name + "_0000"}
+	}
+	return ret, nil
+}
+
+func (s *mockNS) SeriesCardinality(_ uint64, _ string, _ []uint32, measurements []string, _ influxql.Expr) ([]meta2.MeasurementCardinalityInfo, error) {
+	day := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ret := make([]meta2.MeasurementCardinalityInfo, 0, len(measurements))
+	for _, name := range measurements {
+		ret = append(ret, meta2.MeasurementCardinalityInfo{
+			Name: name,
+			CardinalityInfos: []meta2.CardinalityInfo{
+				{TimeRange: meta2.TimeRangeInfo{StartTime: day, EndTime: day.Add(24 * time.Hour)}, Cardinality: 10},
+				{TimeRange: meta2.TimeRangeInfo{StartTime: day.Add(-24 * time.Hour), EndTime: day}, Cardinality: 100},
+			},
+		})
+	}
+	return ret, nil
+}
+
+func TestStatementExecutor_executeExplainCostStatement(t *testing.T) {
+	client := &MockMetaClient{}
+	shardMapper := &mockCostShardMapper{}
+	e := &StatementExecutor{
+		MetaClient:               client,
+		NetStorage:               &mockNS{},
+		ShardMapper:              shardMapper,
+		MetaExecutor:             &coordinator.MetaExecutor{MetaClient: client, Logger: Logger.NewLogger(errno.ModuleUnknown)},
+		StmtExecLogger:           Logger.NewLogger(errno.ModuleUnknown),
+		MaxSelectSeriesN:         1000,
+		MaxSelectSeriesHardLimit: 5000,
+	}
+	ctx := &query.ExecutionContext{}
+
+	stmt := newMockSelectStatement("rp0", "mst0")
+	stmt.Condition = &influxql.BinaryExpr{
+		Op: influxql.AND,
+		LHS: &influxql.BinaryExpr{
+			Op:  influxql.GTE,
+			LHS: &influxql.VarRef{Val: "time"},
+			RHS: &influxql.StringLiteral{Val: "2023-01-01T01:00:00Z"},
+		},
+		RHS: &influxql.BinaryExpr{
+			Op:  influxql.LT,
+			LHS: &influxql.VarRef{Val: "time"},
+			RHS: &influxql.StringLiteral{Val: "2023-01-01T03:00:00Z"},
+		},
+	}
+	rows, err := e.executeExplainCostStatement(&influxql.ExplainStatement{Statement: stmt, Cost: true}, ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(rows))
+	assert.Equal(t, []string{"metric", "estimate"}, rows[0].Columns)
+	assert.Equal(t, [][]interface{}{{"shards", int64(4)}, {"series", int64(20)}, {"points", int64(20)}}, rows[0].Values)
+	assert.Equal(t, 1000, shardMapper.opt.MaxSeriesN)
+
+	stmt.Dimensions = influxql.Dimensions{{Expr: &influxql.Call{Name: "time", Args: []influxql.Expr{&influxql.DurationLiteral{Val: time.Hour}}}}}
+	rows, err = e.executeExplainCostStatement(&influxql.ExplainStatement{Statement: stmt, Cost: true}, ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]interface{}{{"shards", int64(4)}, {"series", int64(20)}, {"points", int64(40)}}, rows[0].Values)
+
+	// the shards are mapped with the hints of the statement like SELECT
+	stmt.Hints = influxql.Hints{{Expr: &influxql.StringLiteral{Val: "max_series_n(3000)"}}, {Expr: &influxql.StringLiteral{Val: influxql.ForceBroadcastQuery}}}
+	stmt.Availability = influxql.QuorumAvailability
+	_, err = e.executeExplainCostStatement(&influxql.ExplainStatement{Statement: stmt, Cost: true}, ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 3000, shardMapper.opt.MaxSeriesN)
+	assert.True(t, shardMapper.opt.ForceBroadcast)
+	assert.Equal(t, influxql.QuorumAvailability, shardMapper.opt.Availability)
+
+	// the statement is authorized like SELECT
+	_, err = e.executeExplainCostStatement(&influxql.ExplainStatement{Statement: stmt, Cost: true}, newAuthorizedContext("db0"))
+	assert.True(t, errno.Equal(err, errno.NoDatabasePrivilege))
+}
+
+func TestExplainAnalyzeRows(t *testing.T) {
+	trace, root := tracing.NewTrace("SELECT")
+	root.SetLabels("node_id", "1")
+	for _, name := range []string{"create_pipeline_executor", "emit"} {
+		span := root.StartSpan(name).StartPP()
+		for _, shardID := range []string{"1", "2"} {
+			sub := span.StartSpan("shard_scan").StartPP()
+			sub.SetLabels("shard_id", shardID)
+			sub.AddIntField("rows", 10)
+			sub.Finish()
+		}
+		span.Finish()
+	}
+	root.Finish()
+
+	verbose := explainAnalyzeRows(trace, influxql.ExplainVerbose)
+	def := explainAnalyzeRows(trace, influxql.ExplainDefault)
+	brief := explainAnalyzeRows(trace, influxql.ExplainBrief)
+	summary := explainAnalyzeRows(trace, influxql.ExplainSummary)
+
+	assert.Equal(t, []string{"EXPLAIN ANALYZE"}, summary[0].Columns)
+	assert.Equal(t, len(verbose[0].Values), len(def[0].Values))
+	assert.Greater(t, len(def[0].Values), len(brief[0].Values))
+	assert.Greater(t, len(brief[0].Values), len(summary[0].Values))
+}
+
+func TestStatementExecutor_maxSelectSeriesN(t *testing.T) {
+	e := &StatementExecutor{MaxSelectSeriesN: 1000, MaxSelectSeriesHardLimit: 5000}
+	stmt := &influxql.SelectStatement{}
+	assert.Equal(t, 1000, e.maxSelectSeriesN(stmt))
+
+	stmt.Hints = influxql.Hints{{Expr: &influxql.StringLiteral{Val: "max_series_n(3000)"}}}
+	assert.Equal(t, 3000, e.maxSelectSeriesN(stmt))
+
+	// clamped to the hard limit
+	stmt.Hints = influxql.Hints{{Expr: &influxql.StringLiteral{Val: "max_series_n(100000)"}}}
+	assert.Equal(t, 5000, e.maxSelectSeriesN(stmt))
+
+	// the hint can not lower the server default
+	stmt.Hints = influxql.Hints{{Expr: &influxql.StringLiteral{Val: "max_series_n(10)"}}}
+	assert.Equal(t, 1000, e.maxSelectSeriesN(stmt))
+
+	// the hint is ignored without a hard limit
+	e.MaxSelectSeriesHardLimit = 0
+	stmt.Hints = influxql.Hints{{Expr: &influxql.StringLiteral{Val: "max_series_n(3000)"}}}
+	assert.Equal(t, 1000, e.maxSelectSeriesN(stmt))
+}
+
+func TestStatementExecutor_executeCreateSubscriptionStatement(t *testing.T) {
+	config.SetSubscriptionEnable(true)
+	defer config.SetSubscriptionEnable(false)
+
+	e := StatementExecutor{
+		MetaClient:                 &MockMetaClient{},
+		SubscriptionAllowDatabases: []string{"db0", "db1"},
+		SubscriptionDenyDatabases:  []string{"db1"},
+	}
+	stmt := func(db string) *influxql.CreateSubscriptionStatement {
+		return &influxql.CreateSubscriptionStatement{
+			Name: "subs0", Database: db, RetentionPolicy: "rp0", Mode: "ALL", Destinations: []string{"http://127.0.0.1:8086"},
+		}
+	}
+
+	assert.NoError(t, e.executeCreateSubscriptionStatement(stmt("db0")))
+	// the deny list takes precedence over the allow list
+	assert.EqualError(t, e.executeCreateSubscriptionStatement(stmt("db1")), "subscription is not enabled on database db1")
+	assert.EqualError(t, e.executeCreateSubscriptionStatement(stmt("db2")), "subscription is not enabled on database db2")
+
+	// all databases except the denied ones are allowed without an allow list
+	e.SubscriptionAllowDatabases = nil
+	assert.NoError(t, e.executeCreateSubscriptionStatement(stmt("db2")))
+	assert.Error(t, e.executeCreateSubscriptionStatement(stmt("db1")))
+}
+
+func TestStatementExecutor_QueryEvents(t *testing.T) {
+	config.SetSubscriptionEnable(true)
+	defer config.SetSubscriptionEnable(false)
+
+	e := newMockStatementExecutor()
+	e.QueryEventBus = query.NewQueryEventBus(query.DefaultQueryEventBufferSize)
+	sub := e.QueryEventBus.Subscribe()
+	defer e.QueryEventBus.Unsubscribe(sub)
+
+	ctx := &query.ExecutionContext{Context: context.Background(), Results: make(chan *query.Result, 1)}
+	ctx.QueryID = []uint64{10}
+	ctx.Database = "db_default"
+
+	stmt := &influxql.CreateSubscriptionStatement{
+		Name: "subs0", Database: "db0", RetentionPolicy: "rp0", Mode: "ALL", Destinations: []string{"http://127.0.0.1:8086"},
+	}
+	assert.NoError(t, e.ExecuteStatement(stmt, ctx, 0))
+
+	start := <-sub.C
+	assert.Equal(t, query.QueryEventStart, start.Type)
+	assert.Equal(t, uint64(10), start.QueryID)
+	assert.Equal(t, "db0", start.Database)
+	assert.Equal(t, stmt.String(), start.Statement)
+	assert.Equal(t, query.QueryStatusRunning, start.Status)
+
+	complete := <-sub.C
+	assert.Equal(t, query.QueryEventComplete, complete.Type)
+	assert.Equal(t, uint64(10), complete.QueryID)
+	assert.Equal(t, "db0", complete.Database)
+	assert.Equal(t, query.QueryStatusSuccess, complete.Status)
+	assert.True(t, complete.Duration >= 0)
+	assert.Empty(t, complete.Error)
+
+	// the select statement fails in the shard mapper
+	selectStmt := newMockSelectStatement("wrongRp", "mst")
+	assert.Error(t, e.ExecuteStatement(selectStmt, ctx, 0))
+
+	start = <-sub.C
+	assert.Equal(t, query.QueryEventStart, start.Type)
+	assert.Equal(t, "db", start.Database)
+	complete = <-sub.C
+	assert.Equal(t, query.QueryEventComplete, complete.Type)
+	assert.Equal(t, query.QueryStatusFailed, complete.Status)
+	assert.Contains(t, complete.Error, "retention policy not found")
+}
+
+type mockSchemaMetaClient struct {
+	MockMetaClient
+	db *meta2.DatabaseInfo
+}
+
+func (m *mockSchemaMetaClient) Database(_ string) (*meta2.DatabaseInfo, error) {
+	return m.db, nil
+}
+
+func TestStatementExecutor_ShowMeasurementVersion(t *testing.T) {
+	// the measurement has been re-created after the first version was dropped
+	rp := &meta2.RetentionPolicyInfo{
+		Name:         "rp0",
+		Measurements: map[string]*meta2.MeasurementInfo{"cpu_0002": {Name: "cpu_0002"}},
+		MstVersions:  map[string]meta2.MeasurementVer{"cpu": {NameWithVersion: "cpu_0002", Version: 2}},
+	}
+	db := &meta2.DatabaseInfo{
+		Name:                   "db0",
+		DefaultRetentionPolicy: "rp0",
+		RetentionPolicies:      map[string]*meta2.RetentionPolicyInfo{"rp0": rp},
+	}
+	e := StatementExecutor{MetaClient: &mockSchemaMetaClient{db: db}}
+
+	rows, err := e.executeShowMeasurementKeysStatement(&influxql.ShowMeasurementKeysStatement{Name: "VERSION", Database: "db0", Measurement: "cpu"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(rows))
+	assert.Equal(t, []string{"VERSION", "NAME_WITH_VERSION"}, rows[0].Columns)
+	assert.Equal(t, [][]interface{}{{uint32(2), "cpu_0002"}}, rows[0].Values)
+
+	_, err = e.executeShowMeasurementKeysStatement(&influxql.ShowMeasurementKeysStatement{Name: "VERSION", Database: "db0", Measurement: "mem"})
+	assert.EqualError(t, err, "measurement not found")
+}
+
+func TestStatementExecutor_ShowMeasurementIndex(t *testing.T) {
+	rp := &meta2.RetentionPolicyInfo{
+		Name: "rp0",
+		Measurements: map[string]*meta2.MeasurementInfo{
+			"cpu_0000": {Name: "cpu_0000", IndexRelation: influxql.IndexRelation{
+				Oids:      []uint32{uint32(index.BloomFilter)},
+				IndexList: []*influxql.IndexList{{IList: []string{"host", "region"}}},
+			}},
+			"mem_0000": {Name: "mem_0000"},
+		},
+		MstVersions: map[string]meta2.MeasurementVer{
+			"cpu": {NameWithVersion: "cpu_0000"},
+			"mem": {NameWithVersion: "mem_0000"},
+		},
+	}
+	db := &meta2.DatabaseInfo{
+		Name:                   "db0",
+		DefaultRetentionPolicy: "rp0",
+		RetentionPolicies:      map[string]*meta2.RetentionPolicyInfo{"rp0": rp},
+	}
+	e := StatementExecutor{MetaClient: &mockSchemaMetaClient{db: db}}
+
+	rows, err := e.executeShowMeasurementKeysStatement(&influxql.ShowMeasurementKeysStatement{Name: "INDEX", Database: "db0", Measurement: "cpu"})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rows))
+	assert.Equal(t, []string{"INDEX", "COLUMNS"}, rows[0].Columns)
+	assert.Equal(t, [][]interface{}{{"bloomfilter", "host,region"}}, rows[0].Values)
+
+	// a measurement without index
+	rows, err = e.executeShowMeasurementKeysStatement(&influxql.ShowMeasurementKeysStatement{Name: "INDEX", Database: "db0", Measurement: "mem"})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rows))
+	assert.Equal(t, []string{"INDEX", "COLUMNS"}, rows[0].Columns)
+	assert.Empty(t, rows[0].Values)
+}
+
+type mockMeasurementsMetaClient struct {
+	MockMetaClient
+	names []string
+}
+
+func (m *mockMeasurementsMetaClient) Measurements(_ string, ms influxql.Measurements) ([]string, error) {
+	var ret []string
+	for _, name := range m.names {
+		if len(ms) == 0 || ms[0].Regex.Val.MatchString(name) {
+			ret = append(ret, name)
+		}
+	}
+	return ret, nil
+}
+
+func TestStatementExecutor_ShowMeasurementsLike(t *testing.T) {
+	e := StatementExecutor{MetaClient: &mockMeasurementsMetaClient{names: []string{"CPU_idle", "cpu0", "cpu_load", "cpu10", "mem_cpu"}}}
+	show := func(pattern string, caseInsensitive bool) []string {
+		ctx := &query.ExecutionContext{Context: context.Background(), Results: make(chan *query.Result, 1)}
+		stmt := &influxql.ShowMeasurementsStatement{Database: "db0", LikePattern: pattern, LikeCaseInsensitive: caseInsensitive}
+		assert.NoError(t, e.executeShowMeasurementsStatement(stmt, ctx, 0))
+		var names []string
+		for _, row := range (<-ctx.Results).Series {
+			for _, v := range row.Values {
+				names = append(names, v[0].(string))
+			}
+		}
+		return names
+	}
+
+	// prefix
+	assert.Equal(t, []string{"cpu0", "cpu_load", "cpu10"}, show("cpu%", false))
+	assert.Equal(t, []string{"CPU_idle", "cpu0", "cpu_load", "cpu10"}, show("cpu%", true))
+	// contains
+	assert.Equal(t, []string{"cpu0", "cpu_load", "cpu10", "mem_cpu"}, show("%cpu%", false))
+	// single character
+	assert.Equal(t, []string{"cpu0"}, show("cpu_", false))
+	assert.Empty(t, show("gpu_", false))
+}
+
+type mockDatabaseAuthorizer struct {
+	query.FineAuthorizer
+	readable map[string]bool
+}
+
+func (a *mockDatabaseAuthorizer) AuthorizeDatabase(p originql.Privilege, name string) bool {
+	return p == originql.ReadPrivilege && a.readable[name]
+}
+
+func newAuthorizedContext(readable ...string) *query.ExecutionContext {
+	a := &mockDatabaseAuthorizer{readable: make(map[string]bool)}
+	for _, db := range readable {
+		a.readable[db] = true
+	}
+	ctx := &query.ExecutionContext{Context: context.Background(), Results: make(chan *query.Result, 1)}
+	ctx.Authorizer = a
+	return ctx
+}
+
+func TestStatementExecutor_authorize(t *testing.T) {
+	e := &StatementExecutor{}
+	ctx := newAuthorizedContext("db0")
+
+	assert.NoError(t, e.authorize(ctx, "db0", originql.ReadPrivilege))
+	err := e.authorize(ctx, "db1", originql.ReadPrivilege)
+	assert.True(t, errno.Equal(err, errno.NoDatabasePrivilege))
+	assert.EqualError(t, err, "user has no READ privilege on database(db1)")
+	assert.True(t, errno.Equal(e.authorize(ctx, "db0", originql.WritePrivilege), errno.NoDatabasePrivilege))
+
+	// statements without an authorizer are always authorized
+	assert.NoError(t, e.authorize(&query.ExecutionContext{}, "db1", originql.ReadPrivilege))
+	assert.NoError(t, e.authorize(nil, "db1", originql.ReadPrivilege))
+}
+
+func TestStatementExecutor_ShowAuthorized(t *testing.T) {
+	e := &StatementExecutor{MetaClient: &mockMeasurementsMetaClient{names: []string{"cpu"}}}
+	ctx := newAuthorizedContext("db0")
+
+	assert.NoError(t, e.executeShowMeasurementsStatement(&influxql.ShowMeasurementsStatement{Database: "db0"}, ctx, 0))
+	err := e.executeShowMeasurementsStatement(&influxql.ShowMeasurementsStatement{Database: "db1"}, ctx, 0)
+	assert.True(t, errno.Equal(err, errno.NoDatabasePrivilege))
+
+	err = e.executeShowFieldKeys(&influxql.ShowFieldKeysStatement{Database: "db1"}, ctx, 0)
+	assert.True(t, errno.Equal(err, errno.NoDatabasePrivilege))
+
+	err = e.executeShowSeries(&influxql.ShowSeriesStatement{Database: "db1"}, ctx, 0)
+	assert.True(t, errno.Equal(err, errno.NoDatabasePrivilege))
+
+	_, err = e.executeShowTagValues(&influxql.ShowTagValuesStatement{Database: "db1"}, ctx)
+	assert.True(t, errno.Equal(err, errno.NoDatabasePrivilege))
+}
+
+func TestStatementExecutor_SelectAuthorized(t *testing.T) {
+	e := &StatementExecutor{}
+	ctx := newAuthorizedContext("db0")
+
+	stmt := influxql.MustParseStatement("SELECT * FROM db0..cpu").(*influxql.SelectStatement)
+	assert.NoError(t, e.authorizeSelect(stmt, ctx))
+
+	for _, q := range []string{
+		"SELECT * FROM db1..cpu",
+		"SELECT * FROM db0..cpu, db1..mem",
+		"SELECT max(v) FROM (SELECT * FROM db1..cpu)",
+		"SELECT * INTO db0..cpu_copy FROM db0..cpu",
+	} {
+		stmt = influxql.MustParseStatement(q).(*influxql.SelectStatement)
+		err := e.authorizeSelect(stmt, ctx)
+		assert.True(t, errno.Equal(err, errno.NoDatabasePrivilege), q)
+	}
+}
+
+type mockRebalanceMetaClient struct {
+	MockMetaClient
+	pts   meta2.DBPtInfos
+	nodes []meta2.DataNode
+}
+
+func (m *mockRebalanceMetaClient) DBPtView(string) (meta2.DBPtInfos, error) {
+	return m.pts, nil
+}
+
+func (m *mockRebalanceMetaClient) DataNodes() ([]meta2.DataNode, error) {
+	return m.nodes, nil
+}
+
+func TestStatementExecutor_RebalanceDatabase(t *testing.T) {
+	mc := &mockRebalanceMetaClient{}
+	for id := uint64(1); id <= 2; id++ {
+		mc.nodes = append(mc.nodes, meta2.DataNode{NodeInfo: meta2.NodeInfo{ID: id, Status: serf.StatusAlive}})
+	}
+	for pt := uint32(0); pt < 4; pt++ {
+		mc.pts = append(mc.pts, meta2.PtInfo{PtId: pt, Owner: meta2.PtOwner{NodeID: 1}, Status: meta2.Online})
+	}
+	e := StatementExecutor{MetaClient: mc}
+
+	rows, err := e.executeRebalanceDatabase(&influxql.RebalanceDatabaseStatement{Database: "db0", DryRun: true})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(rows))
+	assert.Equal(t, []string{"pt_id", "from_node", "to_node"}, rows[0].Columns)
+	assert.Equal(t, [][]interface{}{{uint32(3), uint64(1), uint64(2)}, {uint32(2), uint64(1), uint64(2)}}, rows[0].Values)
+
+	_, err = e.executeRebalanceDatabase(&influxql.RebalanceDatabaseStatement{Database: "db0"})
+	assert.EqualError(t, err, "only REBALANCE DATABASE DRYRUN is supported")
+}
+
+type mockShardMappingMetaClient struct {
+	mockRebalanceMetaClient
+	database *meta2.DatabaseInfo
+}
+
+func (m *mockShardMappingMetaClient) Database(string) (*meta2.DatabaseInfo, error) {
+	return m.database, nil
+}
+
+func (m *mockShardMappingMetaClient) GetAliveShards(_ string, sgi *meta2.ShardGroupInfo) []int {
+	idxes := make([]int, 0, len(sgi.Shards))
+	for i := range sgi.Shards {
+		idxes = append(idxes, i)
+	}
+	return idxes
+}
+
+func TestStatementExecutor_ShowShardMapping(t *testing.T) {
+	mc := &mockShardMappingMetaClient{database: &meta2.DatabaseInfo{Name: "db0", RetentionPolicies: map[string]*meta2.RetentionPolicyInfo{
+		"rp0": {Name: "rp0", ShardGroups: []meta2.ShardGroupInfo{{ID: 1, Shards: []meta2.ShardInfo{{ID: 2, Owners: []uint32{1}}, {ID: 1, Owners: []uint32{0}}}}}},
+	}}}
+	mc.pts = meta2.DBPtInfos{{PtId: 0, Owner: meta2.PtOwner{NodeID: 1}}, {PtId: 1, Owner: meta2.PtOwner{NodeID: 2}}}
+	e := &StatementExecutor{
+		MetaClient:     mc,
+		ShardMapper:    &coordinator.ClusterShardMapper{MetaClient: mc, Logger: Logger.NewLogger(errno.ModuleUnknown)},
+		StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown),
+	}
+
+	stmt := parseScript(t, "SHOW SHARD MAPPING")[0]
+	require.NoError(t, e.NormalizeStatement(stmt, "db0", ""))
+	ctx := &query.ExecutionContext{Context: context.Background(), Results: make(chan *query.Result, 1)}
+	require.NoError(t, e.ExecuteStatement(stmt, ctx, 0))
+	result := <-ctx.Results
+	require.Len(t, result.Series, 1)
+	assert.Equal(t, "db0", result.Series[0].Name)
+	assert.Equal(t, []string{"retention_policy", "shard_group", "shard", "read_shard", "pt", "node", "read_nodes"}, result.Series[0].Columns)
+	assert.Equal(t, [][]interface{}{
+		{"rp0", uint64(1), uint64(1), uint64(1), uint32(0), uint64(1), ""},
+		{"rp0", uint64(1), uint64(2), uint64(2), uint32(1), uint64(2), ""},
+	}, result.Series[0].Values)
+
+	_, err := e.executeShowShardMappingStatement(&influxql.ShowShardMappingStatement{})
+	assert.Equal(t, coordinator.ErrDatabaseNameRequired, err)
+
+	e.ShardMapper = nil
+	_, err = e.executeShowShardMappingStatement(&influxql.ShowShardMappingStatement{Database: "db0"})
+	assert.EqualError(t, err, "the shard mapper does not support SHOW SHARD MAPPING")
+}
+
+type mockDataNodesMetaClient struct {
+	MockMetaClient
+	pts map[string]meta2.DBPtInfos
+}
+
+func (m *mockDataNodesMetaClient) Databases() map[string]*meta2.DatabaseInfo {
+	dbs := make(map[string]*meta2.DatabaseInfo, len(m.pts))
+	for name := range m.pts {
+		dbs[name] = &meta2.DatabaseInfo{Name: name}
+	}
+	return dbs
+}
+
+func (m *mockDataNodesMetaClient) DBPtView(database string) (meta2.DBPtInfos, error) {
+	return m.pts[database], nil
+}
+
+func TestStatementExecutor_ShowDataNodes(t *testing.T) {
+	mc := &mockDataNodesMetaClient{pts: map[string]meta2.DBPtInfos{
+		"db0": {{PtId: 0, Owner: meta2.PtOwner{NodeID: 1}}, {PtId: 1, Owner: meta2.PtOwner{NodeID: 2}}, {PtId: 2, Owner: meta2.PtOwner{NodeID: 1}}},
+		"db1": {{PtId: 0, Owner: meta2.PtOwner{NodeID: 2}}},
+	}}
+	e := StatementExecutor{MetaClient: mc}
+
+	rows, err := e.executeShowDataNodes(&influxql.ShowDataNodesStatement{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(rows))
+	assert.Equal(t, []string{"id", "host", "status", "pt_count"}, rows[0].Columns)
+	assert.Equal(t, [][]interface{}{
+		{uint64(1), "192.168.1.8080", "none", 2},
+		{uint64(2), "192.168.1.8081", "none", 2},
+		{uint64(3), "192.168.1.8082", "none", 0},
+	}, rows[0].Values)
+
+	rows, err = e.executeShowDataNodes(&influxql.ShowDataNodesStatement{Detail: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"id", "host", "status", "pt_count", "pt_ids"}, rows[0].Columns)
+	assert.Equal(t, [][]interface{}{
+		{uint64(1), "192.168.1.8080", "none", 2, "db0:0,2"},
+		{uint64(2), "192.168.1.8081", "none", 2, "db0:1 db1:0"},
+		{uint64(3), "192.168.1.8082", "none", 0, ""},
+	}, rows[0].Values)
+}
+
+type mockMetaNodesMetaClient struct {
+	MockMetaClient
+	leader string
+	err    error
+}
+
+func (m *mockMetaNodesMetaClient) MetaNodes() ([]meta2.NodeInfo, error) {
+	nodes := make([]meta2.NodeInfo, 0, 3)
+	for i := 1; i <= 3; i++ {
+		nodes = append(nodes, meta2.NodeInfo{
+			ID:      uint64(i),
+			Host:    fmt.Sprintf("127.0.0.%d:8091", i),
+			RPCAddr: fmt.Sprintf("127.0.0.%d:8092", i),
+			TCPHost: fmt.Sprintf("127.0.0.%d:8088", i),
+			Status:  serf.StatusAlive,
+		})
+	}
+	return nodes, nil
+}
+
+func (m *mockMetaNodesMetaClient) MetaLeader() (string, error) {
+	return m.leader, m.err
+}
+
+func TestStatementExecutor_ShowMetaNodes(t *testing.T) {
+	mc := &mockMetaNodesMetaClient{leader: "127.0.0.2:8088"}
+	e := StatementExecutor{MetaClient: mc, StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown)}
+
+	rows, err := e.executeShowMetaNodes()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(rows))
+	assert.Equal(t, []string{"id", "host", "rpc_addr", "raft_addr", "status", "role"}, rows[0].Columns)
+	assert.Equal(t, [][]interface{}{
+		{uint64(1), "127.0.0.1:8091", "127.0.0.1:8092", "127.0.0.1:8088", "alive", "follower"},
+		{uint64(2), "127.0.0.2:8091", "127.0.0.2:8092", "127.0.0.2:8088", "alive", "leader"},
+		{uint64(3), "127.0.0.3:8091", "127.0.0.3:8092", "127.0.0.3:8088", "alive", "follower"},
+	}, rows[0].Values)
+
+	// the members are still listed when the leader is unreachable
+	mc.leader, mc.err = "", errors.New("no meta leader")
+	rows, err = e.executeShowMetaNodes()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(rows[0].Values))
+	for _, value := range rows[0].Values {
+		assert.Equal(t, "unknown", value[5])
+	}
+}
+
+func (m *mockMeasurementsMetaClient) MatchMeasurements(database string, ms influxql.Measurements) (map[string]*meta2.MeasurementInfo, error) {
+	names, err := m.Measurements(database, ms)
+	if err != nil {
+		return nil, err
+	}
+	ret := make(map[string]*meta2.MeasurementInfo, len(names))
+	for _, name := range names {
+		ret[name] = &meta2.MeasurementInfo{Name: name + "_0000"}
+		ret[name].SetoriginName(name)
+	}
+	return ret, nil
+}
+
+func TestStatementExecutor_SelectSourcesLimit(t *testing.T) {
+	e := &StatementExecutor{
+		MetaClient:       &mockMeasurementsMetaClient{names: []string{"cpu0", "cpu1", "cpu2", "mem"}},
+		MaxSelectSources: 3,
+	}
+	regexSource := func(expr string) *influxql.Measurement {
+		return &influxql.Measurement{Regex: &influxql.RegexLiteral{Val: regexp.MustCompile(expr)}}
+	}
+	newStmt := func(sources ...influxql.Source) *influxql.SelectStatement {
+		stmt := newMockSelectStatement("", "")
+		stmt.Sources = sources
+		return stmt
+	}
+
+	// the regex resolves to 3 measurements
+	assert.NoError(t, e.checkSelectSourcesLimit(newStmt(regexSource("^cpu")), "db0"))
+
+	// the regex resolves to 4 measurements
+	err := e.checkSelectSourcesLimit(newStmt(regexSource(".*")), "db0")
+	assert.True(t, errno.Equal(err, errno.SelectSourcesLimitExceeded))
+	assert.EqualError(t, err, "the sources resolve to 4 measurements, exceeding max-select-sources(3)")
+
+	// the plain measurements and the sources of subqueries are counted too
+	err = e.checkSelectSourcesLimit(newStmt(&influxql.Measurement{Name: "mem"}, &influxql.SubQuery{Statement: newStmt(regexSource("^cpu"))}), "db0")
+	assert.True(t, errno.Equal(err, errno.SelectSourcesLimitExceeded))
+
+	// the statement is rejected before planning
+	_, err = e.createPipelineExecutor(context.Background(), newStmt(regexSource(".*")), query.ExecutionOptions{Database: "db0"}, nil)
+	assert.True(t, errno.Equal(err, errno.SelectSourcesLimitExceeded))
+
+	e.MaxSelectSources = 0
+	assert.NoError(t, e.checkSelectSourcesLimit(newStmt(regexSource(".*")), "db0"))
+}
+
+func TestStatementExecutor_ShowSourcesLimit(t *testing.T) {
+	e := &StatementExecutor{
+		MetaClient:          &mockMeasurementsMetaClient{names: []string{"cpu0", "cpu1", "cpu2", "mem"}},
+		MaxShowMeasurements: 3,
+	}
+	sources := func(expr string) influxql.Sources {
+		return influxql.Sources{&influxql.Measurement{Regex: &influxql.RegexLiteral{Val: regexp.MustCompile(expr)}}}
+	}
+
+	// the regex resolves to 3 measurements
+	assert.NoError(t, e.checkShowSourcesLimit("db0", sources("^cpu")))
+
+	// the regex resolves to 4 measurements
+	err := e.checkShowSourcesLimit("db0", sources(".*"))
+	assert.True(t, errno.Equal(err, errno.ShowSourcesLimitExceeded))
+	assert.EqualError(t, err, "the sources resolve to 4 measurements, exceeding max-show-measurements(3)")
+
+	// no sources resolve to all the 4 measurements
+	err = e.checkShowSourcesLimit("db0", nil)
+	assert.True(t, errno.Equal(err, errno.ShowSourcesLimitExceeded))
+	err = e.executeShowTagKeys(&influxql.ShowTagKeysStatement{Database: "db0"}, &query.ExecutionContext{}, 0)
+	assert.True(t, errno.Equal(err, errno.ShowSourcesLimitExceeded))
+
+	// the statements are rejected before the meta data is queried
+	ctx := &query.ExecutionContext{}
+	err = e.executeShowFieldKeys(&influxql.ShowFieldKeysStatement{Database: "db0", Sources: sources(".*")}, ctx, 0)
+	assert.True(t, errno.Equal(err, errno.ShowSourcesLimitExceeded))
+	err = e.executeShowFieldKeyCardinality(&influxql.ShowFieldKeyCardinalityStatement{Database: "db0", Sources: sources(".*")}, ctx, 0)
+	assert.True(t, errno.Equal(err, errno.ShowSourcesLimitExceeded))
+	err = e.executeShowTagKeys(&influxql.ShowTagKeysStatement{Database: "db0", Sources: sources(".*")}, ctx, 0)
+	assert.True(t, errno.Equal(err, errno.ShowSourcesLimitExceeded))
+	err = e.executeShowTagKeyCardinality(&influxql.ShowTagKeyCardinalityStatement{Database: "db0", Sources: sources(".*")}, ctx, 0)
+	assert.True(t, errno.Equal(err, errno.ShowSourcesLimitExceeded))
+
+	e.MaxShowMeasurements = 0
+	assert.NoError(t, e.checkShowSourcesLimit("db0", sources(".*")))
+}
+
+func TestStatementExecutor_NegativeLimits(t *testing.T) {
+	core, logs := observer.New(zap.WarnLevel)
+	lg := Logger.NewLogger(errno.ModuleUnknown)
+	orig := lg.GetZapLogger()
+	lg.SetZapLogger(zap.New(core))
+	defer lg.SetZapLogger(orig)
+
+	newExecutor := func() *StatementExecutor {
+		return &StatementExecutor{
+			StmtExecLogger:      lg,
+			MaxSelectPointN:     -1,
+			MaxSelectSeriesN:    -2,
+			MaxSelectFieldsN:    10,
+			MaxSelectBucketsN:   -3,
+			MaxQueryMem:         -4,
+			MaxSelectEmitBytes:  -5,
+			MaxSelectIntoPoints: 20,
+		}
+	}
+	assertLimits := func(opt query.SelectOptions) {
+		assert.Equal(t, 0, opt.MaxPointN)
+		assert.Equal(t, 0, opt.MaxSeriesN)
+		assert.Equal(t, 10, opt.MaxFieldsN)
+		assert.Equal(t, 0, opt.MaxBucketsN)
+		assert.Equal(t, int64(0), opt.MaxQueryMem)
+		assert.Equal(t, int64(20), opt.MaxIntoPoints)
+	}
+
+	// the negative limits are unlimited even if they are not normalized
+	e := newExecutor()
+	assertLimits(e.GetOptions(query.ExecutionOptions{}, nil))
+	assert.Equal(t, 0, logs.Len())
+
+	// the negative limits are warned about once
+	e.NormalizeLimits()
+	e.NormalizeLimits()
+	entries := logs.FilterMessage("negative limits are unlimited").All()
+	require.Len(t, entries, 1)
+	assert.Equal(t, []interface{}{"max-select-point-n=-1", "max-select-series-n=-2", "max-select-buckets-n=-3",
+		"max-query-mem=-4", "max-select-emit-bytes=-5"}, entries[0].ContextMap()["limits"])
+	assert.Equal(t, 0, e.MaxSelectPointN)
+	assert.Equal(t, int64(0), e.MaxQueryMem)
+	assertLimits(e.GetOptions(query.ExecutionOptions{}, nil))
+}
+
+func TestStatementExecutor_SelectEmitBytesLimit(t *testing.T) {
+	rows := models.Rows{{Name: "cpu", Columns: []string{"time", "value"}, Values: [][]interface{}{{int64(1), 1.5}}}}
+	size := emittedSize(rows)
+
+	// the budget is not hit by two batches
+	budget := emitBudget{limit: 2 * size}
+	assert.NoError(t, budget.add(rows))
+	assert.NoError(t, budget.add(rows))
+
+	// the third batch exceeds the budget
+	err := budget.add(rows)
+	assert.True(t, errno.Equal(err, errno.SelectEmitBytesExceeded))
+	assert.EqualError(t, err, fmt.Sprintf("the query emitted %d bytes, exceeding max-select-emit-bytes(%d)", 3*size, 2*size))
+
+	// unlimited if 0 or negative
+	for _, limit := range []int64{0, -1} {
+		budget = emitBudget{limit: limit}
+		for i := 0; i < 10; i++ {
+			assert.NoError(t, budget.add(rows))
+		}
+	}
+}
+
+func TestEmittedSize(t *testing.T) {
+	rows := models.Rows{{
+		Name:    "cpu",
+		Tags:    map[string]string{"host": "server01"},
+		Columns: []string{"time", "value", "region", "ok"},
+		Values: [][]interface{}{
+			{time.Unix(0, 1).UTC(), 1.5, "us-west", true},
+			{time.Unix(0, 2).UTC(), int64(10), nil, false},
+		},
+	}}
+	buf, err := json.Marshal(rows)
+	require.NoError(t, err)
+
+	// the estimate is of the order of the serialized size, the numbers are counted at their widest
+	n := emittedSize(rows)
+	assert.True(t, n >= int64(len(buf)) && n < 2*int64(len(buf)), "estimated %d, serialized %d", n, len(buf))
+
+	// the strings are counted by their length
+	rows[0].Values[0][2] = "us-west-1234567890"
+	assert.Equal(t, n+11, emittedSize(rows))
+	assert.Equal(t, int64(0), emittedSize(nil))
+}
+
+type mockSelectTargetMetaClient struct {
+	MockMetaClient
+	msts    map[string]*meta2.MeasurementInfo
+	created []string
+}
+
+func (m *mockSelectTargetMetaClient) Measurement(_, _, mst string) (*meta2.MeasurementInfo, error) {
+	if info, ok := m.msts[mst]; ok {
+		return info, nil
+	}
+	return nil, meta2.ErrMeasurementNotFound
+}
+
+func (m *mockSelectTargetMetaClient) CreateMeasurement(_, _, mst string, shardKey *meta2.ShardKeyInfo, numOfShards int32, _ *influxql.IndexRelation, engineType config.EngineType,
+	colStoreInfo *meta2.ColStoreInfo, _ []*proto2.FieldSchema, _ *meta2.Options) (*meta2.MeasurementInfo, error) {
+	info := &meta2.MeasurementInfo{Name: mst, InitNumOfShards: numOfShards, EngineType: engineType, ColStoreInfo: colStoreInfo}
+	if shardKey != nil {
+		info.ShardKeys = []meta2.ShardKeyInfo{*shardKey}
+	}
+	m.msts[mst] = info
+	m.created = append(m.created, mst)
+	return info, nil
+}
+
+func TestStatementExecutor_prepareSelectTarget(t *testing.T) {
+	shardKey := meta2.ShardKeyInfo{ShardKey: []string{"host"}, Type: influxql.HASH}
+	mc := &mockSelectTargetMetaClient{msts: map[string]*meta2.MeasurementInfo{
+		"src":     {Name: "src", ShardKeys: []meta2.ShardKeyInfo{shardKey}, InitNumOfShards: 2, EngineType: config.TSSTORE},
+		"src_col": {Name: "src_col", EngineType: config.COLUMNSTORE},
+	}}
+	e := &StatementExecutor{MetaClient: mc}
+	newStmt := func(src, dst string) *influxql.SelectStatement {
+		stmt := newMockSelectStatement("", "")
+		stmt.Sources = influxql.Sources{&influxql.Measurement{Database: "db0", RetentionPolicy: "rp0", Name: src}}
+		stmt.Target = &influxql.Target{Measurement: &influxql.Measurement{Database: "db0", RetentionPolicy: "rp0", Name: dst}}
+		return stmt
+	}
+
+	// disabled: the absent target is an error
+	err := e.prepareSelectTarget(newStmt("src", "dst"))
+	assert.True(t, errno.Equal(err, errno.SelectIntoTargetNotFound))
+	assert.EqualError(t, err, "the target measurement db0.rp0.dst of SELECT INTO does not exist")
+	assert.Empty(t, mc.created)
+
+	// enabled: the target inherits the shard key and engine type of the source
+	e.SelectIntoAutoCreate = true
+	require.NoError(t, e.prepareSelectTarget(newStmt("src", "dst")))
+	assert.Equal(t, []string{"dst"}, mc.created)
+	assert.Equal(t, []meta2.ShardKeyInfo{shardKey}, mc.msts["dst"].ShardKeys)
+	assert.Equal(t, int32(2), mc.msts["dst"].InitNumOfShards)
+	assert.Equal(t, config.TSSTORE, mc.msts["dst"].EngineType)
+
+	stmt := newStmt("src_col", "dst_col")
+	stmt.Dimensions = influxql.Dimensions{{Expr: &influxql.VarRef{Val: "host"}}}
+	require.NoError(t, e.prepareSelectTarget(stmt))
+	assert.Equal(t, config.COLUMNSTORE, mc.msts["dst_col"].EngineType)
+	assert.Equal(t, []string{"host", "time"}, mc.msts["dst_col"].ColStoreInfo.PrimaryKey)
+
+	// an existing target is kept whether enabled or not
+	require.NoError(t, e.prepareSelectTarget(newStmt("src", "dst")))
+	e.SelectIntoAutoCreate = false
+	require.NoError(t, e.prepareSelectTarget(newStmt("src", "dst")))
+	assert.Equal(t, []string{"dst", "dst_col"}, mc.created)
+}
+
+func TestStatementExecutor_executeShowQueryLimits(t *testing.T) {
+	c := config.NewTSSql(false)
+	c.Coordinator.MaxConcurrentQueries = 8
+	e := &StatementExecutor{
+		MetaClient:             &MockMetaClient{},
+		SqlConfig:              c,
+		MaxSelectSeriesN:       1000,
+		MaxSelectPointN:        2000,
+		MaxSelectBucketsN:      100,
+		QueryRateLimiter:       NewDatabaseQueryLimiter(map[string]int{"db0": 10}),
+		MeasurementScanLimiter: NewMeasurementScanLimiter(map[string]map[string]int{"db0": {"mem": 1, "cpu": 2}, "db1": {"cpu": 3}}),
+	}
+	globals := [][]interface{}{
+		{"max-select-series-n", 1000, "global"},
+		{"max-select-series-hard-limit", 0, "global"},
+		{"max-select-point-n", 2000, "global"},
+		{"max-select-buckets-n", 100, "global"},
+		{"max-select-sources", 0, "global"},
+		{"max-show-measurements", 0, "global"},
+		{"max-show-series", 0, "global"},
+		{"max-concurrent-queries", 8, "global"},
+	}
+
+	// the limits of db0 override the global ones
+	rows, err := e.executeShowQueryLimits(&influxql.ShowQueryLimitsStatement{Database: "db0"})
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, []string{"limit", "value", "scope"}, rows[0].Columns)
+	assert.Equal(t, append(append([][]interface{}{}, globals...),
+		[]interface{}{"database-query-rate-limit", 10, "database"},
+		[]interface{}{"measurement-scan-limit.cpu", 2, "database"},
+		[]interface{}{"measurement-scan-limit.mem", 1, "database"},
+	), rows[0].Values)
+
+	// the limits unset for db2 are the global ones
+	rows, err = e.executeShowQueryLimits(&influxql.ShowQueryLimitsStatement{Database: "db2"})
+	require.NoError(t, err)
+	assert.Equal(t, append(append([][]interface{}{}, globals...),
+		[]interface{}{"database-query-rate-limit", 0, "global"},
+	), rows[0].Values)
+
+	_, err = e.executeShowQueryLimits(&influxql.ShowQueryLimitsStatement{})
+	assert.Error(t, err)
+}
+
+func TestStatementExecutor_executeShowExecutorLimits(t *testing.T) {
+	e := &StatementExecutor{
+		MetaClient:           &MockMetaClient{},
+		StmtExecLogger:       Logger.NewLogger(errno.ModuleUnknown),
+		MaxSelectPointN:      2000,
+		MaxSelectSeriesN:     1000,
+		MaxSelectFieldsN:     50,
+		MaxSelectBucketsN:    100,
+		MaxQueryMem:          1 << 30,
+		RetentionPolicyLimit: 5,
+	}
+	YyParser := &influxql.YyParser{Query: influxql.Query{}}
+	YyParser.Scanner = influxql.NewScanner(strings.NewReader("SHOW EXECUTOR LIMITS"))
+	YyParser.ParseTokens()
+	q, err := YyParser.GetQuery()
+	require.NoError(t, err)
+
+	ctx := &query.ExecutionContext{Context: context.Background(), Results: make(chan *query.Result, 1)}
+	require.NoError(t, e.ExecuteStatement(q.Statements[0], ctx, 0))
+	rows := collectResults(ctx)
+	require.Len(t, rows, 1)
+	assert.Equal(t, []string{"limit", "value"}, rows[0].Columns)
+	assert.Equal(t, [][]interface{}{
+		{"max-select-point-n", 2000},
+		{"max-select-series-n", 1000},
+		{"max-select-fields-n", 50},
+		{"max-select-buckets-n", 100},
+		{"max-query-mem", int64(1 << 30)},
+		{"retention-policy-limit", 5},
+		// the parallelism in effect is refreshed before every statement
+		{"max-query-parallel", int(atomic.LoadInt32(&syscontrol.QueryParallel))},
+	}, rows[0].Values)
+}
+
+// mockMetaUnavailableShardMapper fails the shard mapping as if the meta leader was lost for the first failures calls.
+type mockMetaUnavailableShardMapper struct {
+	MockShardMapper
+	failures int
+	calls    int
+}
+
+func (m *mockMetaUnavailableShardMapper) MapShards(source influxql.Sources, t influxql.TimeRange, opt query.SelectOptions, cond influxql.Expr) (query.ShardGroup, error) {
+	m.calls++
+	if m.failures < 0 || m.calls <= m.failures {
+		return nil, errno.NewError(errno.MetaIsNotLeader)
+	}
+	return m.MockShardMapper.MapShards(source, t, opt, cond)
+}
+
+func TestStatementExecutor_retryExecuteSelectStatement_MetaUnavailable(t *testing.T) {
+	interval := metaRetrySelectInterval
+	metaRetrySelectInterval = time.Millisecond
+	defer func() {
+		metaRetrySelectInterval = interval
+	}()
+
+	// the meta leader is back after two failed attempts, the statement then fails for its own reason
+	sm := &mockMetaUnavailableShardMapper{failures: 2}
+	e := newMockStatementExecutor()
+	e.ShardMapper = sm
+	err := e.retryExecuteSelectStatement(newMockSelectStatement("wrongRp", "mst"), &query.ExecutionContext{}, 0)
+	assert.EqualError(t, err, "retention policy not found")
+	assert.Equal(t, 3, sm.calls)
+
+	// the meta service stays unavailable past the retry budget
+	sm = &mockMetaUnavailableShardMapper{failures: -1}
+	e.ShardMapper = sm
+	err = e.retryExecuteSelectStatement(newMockSelectStatement("wrongRp", "mst"), &query.ExecutionContext{}, 0)
+	assert.True(t, errno.Equal(err, errno.MetaIsNotLeader))
+	assert.Equal(t, maxMetaRetrySelectCount+1, sm.calls)
+
+	// the client cancels the statement while it waits for the meta service
+	metaRetrySelectInterval = time.Hour
+	cancelCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	sm = &mockMetaUnavailableShardMapper{failures: -1}
+	e.ShardMapper = sm
+	err = e.retryExecuteSelectStatement(newMockSelectStatement("wrongRp", "mst"), &query.ExecutionContext{Context: cancelCtx}, 0)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, sm.calls)
+
+	assert.True(t, isMetaUnavailableError(errors.New("node is not the leader")))
+	assert.True(t, isMetaUnavailableError(meta2.ErrCommandTimeout))
+	assert.False(t, isMetaUnavailableError(errors.New("retention policy not found")))
+}
+
+// mockSlowShardMapper fails the shard mapping with a timeout for the first failures calls.
+type mockSlowShardMapper struct {
+	MockShardMapper
+	failures int
+	calls    int
+}
+
+func (m *mockSlowShardMapper) MapShards(source influxql.Sources, t influxql.TimeRange, opt query.SelectOptions, cond influxql.Expr) (query.ShardGroup, error) {
+	m.calls++
+	if m.failures < 0 || m.calls <= m.failures {
+		return nil, errno.NewError(errno.ShardMapperTimeout, time.Second)
+	}
+	return m.MockShardMapper.MapShards(source, t, opt, cond)
+}
+
+func TestStatementExecutor_retryExecuteSelectStatement_ShardMapperTimeout(t *testing.T) {
+	// the shard mapping succeeds on the second attempt, the statement then fails for its own reason
+	sm := &mockSlowShardMapper{failures: 1}
+	e := newMockStatementExecutor()
+	e.ShardMapper = sm
+	err := e.retryExecuteSelectStatement(newMockSelectStatement("wrongRp", "mst"), &query.ExecutionContext{}, 0)
+	assert.EqualError(t, err, "retention policy not found")
+	assert.Equal(t, 2, sm.calls)
+}
+
+func TestStatementExecutor_executeShowVersion(t *testing.T) {
+	e := &StatementExecutor{
+		ServerInfo: app.ServerInfo{App: config.AppSql, Version: "v1.3.0", Commit: "5a7e9c1"},
+		BuildType:  "OSS",
+	}
+	rows, err := e.executeShowVersion()
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, []string{"version", "build_type", "commit", "go_version"}, rows[0].Columns)
+	assert.Equal(t, [][]interface{}{{"v1.3.0", "OSS", "5a7e9c1", runtime.Version()}}, rows[0].Values)
+}
+
+func TestStatementExecutor_QueryTracing(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	lg := Logger.NewLogger(errno.ModuleUnknown)
+	orig := lg.GetZapLogger()
+	lg.SetZapLogger(zap.New(core))
+	defer lg.SetZapLogger(orig)
+
+	e := newMockStatementExecutor()
+	e.StmtExecLogger = lg
+	ctx := &query.ExecutionContext{Context: context.Background()}
+
+	// not traced by default
+	assert.False(t, e.QueryTracing())
+	err := e.executeSelectStatement(newMockSelectStatement("wrongRp", "mst"), ctx, 0)
+	assert.EqualError(t, err, "retention policy not found")
+	assert.Equal(t, 0, logs.FilterMessage("query trace").Len())
+
+	e.executeSetQueryTracing(&influxql.SetQueryTracingStatement{Enabled: true})
+	assert.True(t, e.QueryTracing())
+	err = e.executeSelectStatement(newMockSelectStatement("wrongRp", "mst"), ctx, 0)
+	assert.EqualError(t, err, "retention policy not found")
+	entries := logs.FilterMessage("query trace").All()
+	require.Len(t, entries, 1)
+	fields := entries[0].ContextMap()
+	assert.Contains(t, fields["trace"], "SELECT")
+	assert.Equal(t, `SELECT "field"::integer FROM db.wrongRp.mst`, fields["stmt"])
+
+	e.executeSetQueryTracing(&influxql.SetQueryTracingStatement{Enabled: false})
+	assert.False(t, e.QueryTracing())
+	err = e.executeSelectStatement(newMockSelectStatement("wrongRp", "mst"), ctx, 0)
+	assert.EqualError(t, err, "retention policy not found")
+	assert.Equal(t, 1, logs.FilterMessage("query trace").Len())
+
+	stmt := &influxql.SetQueryTracingStatement{Enabled: true}
+	assert.Equal(t, "SET QUERY TRACING ON", stmt.String())
+	privileges, err := stmt.RequiredPrivileges()
+	require.NoError(t, err)
+	assert.True(t, privileges[0].Admin)
+}
+
+func TestStatementExecutor_QueryTraceSampleRate(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	lg := Logger.NewLogger(errno.ModuleUnknown)
+	orig := lg.GetZapLogger()
+	lg.SetZapLogger(zap.New(core))
+	defer lg.SetZapLogger(orig)
+
+	e := newMockStatementExecutor()
+	e.StmtExecLogger = lg
+	ctx := &query.ExecutionContext{Context: context.Background()}
+	run := func(n int) int {
+		before := logs.FilterMessage("query trace").Len()
+		for i := 0; i < n; i++ {
+			err := e.executeSelectStatement(newMockSelectStatement("wrongRp", "mst"), ctx, 0)
+			require.EqualError(t, err, "retention policy not found")
+		}
+		return logs.FilterMessage("query trace").Len() - before
+	}
+
+	assert.Equal(t, 0, run(100))
+	e.QueryTraceSampleRate = 1
+	assert.Equal(t, 100, run(100))
+	e.QueryTraceSampleRate = 0.2
+	traced := run(2000)
+	assert.InDelta(t, 400, traced, 100)
+
+	// SET QUERY TRACING ON traces every statement whatever the sample rate
+	e.QueryTraceSampleRate = 0
+	e.executeSetQueryTracing(&influxql.SetQueryTracingStatement{Enabled: true})
+	assert.Equal(t, 100, run(100))
+}
+
+type mockRetentionPoliciesMetaClient struct {
+	MockMetaClient
+	dbi *meta2.DatabaseInfo
+}
+
+func (m *mockRetentionPoliciesMetaClient) Database(_ string) (*meta2.DatabaseInfo, error) {
+	return m.dbi, nil
+}
+
+func (m *mockRetentionPoliciesMetaClient) ShowRetentionPolicies(_ string) (models.Rows, error) {
+	row := &models.Row{Columns: []string{"name", "duration", "default"}}
+	for _, name := range []string{"rp0", "rp1", "rp2"} {
+		row.Values = append(row.Values, []interface{}{name, "0s", name == m.dbi.DefaultRetentionPolicy})
+	}
+	return models.Rows{row}, nil
+}
+
+func TestStatementExecutor_executeShowRetentionPoliciesDetail(t *testing.T) {
+	rp0 := meta2.NewRetentionPolicyInfo("rp0")
+	rp1 := meta2.NewRetentionPolicyInfo("rp1")
+	rp2 := meta2.NewRetentionPolicyInfo("rp2")
+	for i := 0; i < 3; i++ {
+		rp0.ShardGroups = append(rp0.ShardGroups, meta2.ShardGroupInfo{ID: uint64(i + 1)})
+	}
+	rp1.ShardGroups = []meta2.ShardGroupInfo{{ID: 4}, {ID: 5, DeletedAt: time.Now()}}
+	mc := &mockRetentionPoliciesMetaClient{dbi: &meta2.DatabaseInfo{
+		Name:                   "db0",
+		DefaultRetentionPolicy: "rp0",
+		RetentionPolicies:      map[string]*meta2.RetentionPolicyInfo{"rp0": rp0, "rp1": rp1, "rp2": rp2},
+	}}
+	e := &StatementExecutor{MetaClient: mc}
+
+	rows, err := e.executeShowRetentionPoliciesStatement(&influxql.ShowRetentionPoliciesStatement{Database: "db0"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"name", "duration", "default"}, rows[0].Columns)
+
+	rows, err = e.executeShowRetentionPoliciesStatement(&influxql.ShowRetentionPoliciesStatement{Database: "db0", ShowDetail: true})
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, []string{"name", "duration", "default", "shard_groups"}, rows[0].Columns)
+	assert.Equal(t, [][]interface{}{
+		{"rp0", "0s", true, 3},
+		{"rp1", "0s", false, 1},
+		{"rp2", "0s", false, 0},
+	}, rows[0].Values)
+}
+
+type mockAlterRetentionPolicyMetaClient struct {
+	MockMetaClient
+	rpi     *meta2.RetentionPolicyInfo
+	updates []*meta2.RetentionPolicyUpdate
+}
+
+func (m *mockAlterRetentionPolicyMetaClient) RetentionPolicy(_, _ string) (*meta2.RetentionPolicyInfo, error) {
+	return m.rpi, nil
+}
+
+func (m *mockAlterRetentionPolicyMetaClient) UpdateRetentionPolicy(_, _ string, rpu *meta2.RetentionPolicyUpdate, _ bool) error {
+	m.updates = append(m.updates, rpu)
+	return nil
+}
+
+func TestStatementExecutor_executeAlterRetentionPolicyStatement_ShardGroupDuration(t *testing.T) {
+	mc := &mockAlterRetentionPolicyMetaClient{rpi: &meta2.RetentionPolicyInfo{
+		Name: "rp0", Duration: 7 * 24 * time.Hour, ShardGroupDuration: 24 * time.Hour,
+	}}
+	e := &StatementExecutor{MetaClient: mc}
+	duration := func(d time.Duration) *time.Duration {
+		return &d
+	}
+
+	// a shard group duration shorter than the duration
+	err := e.executeAlterRetentionPolicyStatement(&influxql.AlterRetentionPolicyStatement{
+		Name: "rp0", Database: "db0", ShardGroupDuration: duration(2 * 24 * time.Hour),
+	})
+	require.NoError(t, err)
+	require.Len(t, mc.updates, 1)
+	assert.Equal(t, 2*24*time.Hour, *mc.updates[0].ShardGroupDuration)
+
+	// a shard group duration longer than the current duration
+	err = e.executeAlterRetentionPolicyStatement(&influxql.AlterRetentionPolicyStatement{
+		Name: "rp0", Database: "db0", ShardGroupDuration: duration(14 * 24 * time.Hour),
+	})
+	assert.True(t, errno.Equal(err, errno.ShardGroupDurationExceedsDuration))
+	assert.EqualError(t, err, "shard group duration 336h0m0s exceeds the duration 168h0m0s of retention policy rp0")
+
+	// a duration shorter than the current shard group duration
+	err = e.executeAlterRetentionPolicyStatement(&influxql.AlterRetentionPolicyStatement{
+		Name: "rp0", Database: "db0", Duration: duration(12 * time.Hour),
+	})
+	assert.True(t, errno.Equal(err, errno.ShardGroupDurationExceedsDuration))
+
+	// an infinite duration
+	err = e.executeAlterRetentionPolicyStatement(&influxql.AlterRetentionPolicyStatement{
+		Name: "rp0", Database: "db0", Duration: duration(0), ShardGroupDuration: duration(14 * 24 * time.Hour),
+	})
+	require.NoError(t, err)
+	assert.Len(t, mc.updates, 2)
+}
+
+func TestStatementExecutor_NormalizeStatement_LimitRequired(t *testing.T) {
+	e := newMockStatementExecutor()
+	e.LimitRequiredMeasurements = map[string][]string{"db0": {"cpu"}}
+	normalize := func(s string) error {
+		stmt, err := influxql.ParseStatement(s)
+		require.NoError(t, err)
+		return e.NormalizeStatement(stmt, "db0", "rp0")
+	}
+
+	// a protected measurement without LIMIT
+	for _, s := range []string{
+		"SELECT * FROM cpu",
+		"SELECT * FROM db0.rp0.cpu",
+		"SELECT * FROM /^c/",
+		"SELECT * FROM mem, cpu",
+		"SELECT count(value) FROM (SELECT value FROM cpu) LIMIT 1",
+	} {
+		err := normalize(s)
+		assert.True(t, errno.Equal(err, errno.SelectLimitRequired), s)
+		assert.EqualError(t, err, "a LIMIT is required to query measurement db0.cpu", s)
+	}
+
+	// a protected measurement with LIMIT, or an unprotected measurement
+	for _, s := range []string{
+		"SELECT * FROM cpu LIMIT 10",
+		"SELECT * FROM mem",
+		"SELECT * FROM /^m/",
+		"SELECT * FROM db1.rp0.cpu",
+	} {
+		assert.NoError(t, normalize(s), s)
+	}
+
+	// nothing is protected by default
+	e.LimitRequiredMeasurements = nil
+	assert.NoError(t, normalize("SELECT * FROM cpu"))
+}
+
+func TestStatementExecutor_executeShowSlowQueries(t *testing.T) {
+	e := newMockStatementExecutor()
+	e.SlowQueryThreshold = time.Second
+	e.SlowQueries = NewSlowQueryLog(2)
+
+	// only the statements slower than the threshold are recorded
+	e.recordSlowQuery(newMockSelectStatement("rp0", "fast"), "db0", 0, 100*time.Millisecond, 200*time.Millisecond)
+	e.recordSlowQuery(newMockSelectStatement("rp0", "slow1"), "db0", 200*time.Millisecond, 500*time.Millisecond, time.Second)
+	rows, err := e.executeShowSlowQueries()
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, []string{"time", "database", "statement", "duration", "plan_duration", "iterator_duration", "emit_duration"}, rows[0].Columns)
+	require.Len(t, rows[0].Values, 1)
+	assert.Equal(t, []interface{}{"db0", `SELECT "field"::integer FROM db.rp0.slow1`, "1.5s", "200ms", "500ms", "1s"}, rows[0].Values[0][1:])
+
+	// the oldest slow query is evicted
+	e.recordSlowQuery(newMockSelectStatement("rp0", "slow2"), "db0", 0, 2*time.Second, 0)
+	e.recordSlowQuery(newMockSelectStatement("rp0", "slow3"), "db1", 0, 3*time.Second, 0)
+	rows, err = e.executeShowSlowQueries()
+	require.NoError(t, err)
+	require.Len(t, rows[0].Values, 2)
+	assert.Equal(t, `SELECT "field"::integer FROM db.rp0.slow3`, rows[0].Values[0][2])
+	assert.Equal(t, `SELECT "field"::integer FROM db.rp0.slow2`, rows[0].Values[1][2])
+
+	// no slow query is recorded without a threshold
+	e.SlowQueryThreshold = 0
+	e.recordSlowQuery(newMockSelectStatement("rp0", "slow4"), "db0", 0, time.Hour, 0)
+	assert.Equal(t, `SELECT "field"::integer FROM db.rp0.slow3`, e.SlowQueries.Recent()[0].Statement)
+}
+
+func TestStatementExecutor_executeClearSlowQueries(t *testing.T) {
+	e := newMockStatementExecutor()
+	e.SlowQueryThreshold = time.Second
+	e.SlowQueries = NewSlowQueryLog(2)
+	e.recordSlowQuery(newMockSelectStatement("rp0", "slow1"), "db0", 0, 2*time.Second, 0)
+	require.Len(t, e.SlowQueries.Recent(), 1)
+
+	privileges, err := (&influxql.ClearSlowQueriesStatement{}).RequiredPrivileges()
+	require.NoError(t, err)
+	assert.True(t, privileges[0].Admin)
+
+	e.executeClearSlowQueries()
+	rows, err := e.executeShowSlowQueries()
+	require.NoError(t, err)
+	assert.Empty(t, rows[0].Values)
+}
+
+func TestStatementExecutor_SlowQueryPhases(t *testing.T) {
+	e := newMockStatementExecutor()
+	e.SlowQueryThreshold = time.Nanosecond
+	e.SlowQueries = NewSlowQueryLog(10)
+
+	// the planning of the statement is measured apart from the statistics of the query
+	qStat := statistics.NewSqlSlowQueryStatistics("db0")
+	qStat.AddDuration("PrepareDuration", int64(time.Hour))
+	ctx := &query.ExecutionContext{Context: context.WithValue(context.Background(), query.QueryDurationKey, qStat)}
+	start := time.Now()
+	err := e.executeSelectStatement(newMockSelectStatement("wrongRp", "mst"), ctx, 0)
+	require.EqualError(t, err, "retention policy not found")
+	assert.True(t, time.Duration(qStat.PrepareDuration) >= time.Hour)
+
+	stmtStat := statistics.NewSqlSlowQueryStatistics("db0")
+	assert.Equal(t, time.Duration(0), planDuration(nil))
+	assert.Equal(t, time.Duration(0), planDuration(stmtStat))
+	stmtStat.AddDuration("PrepareDuration", int64(20*time.Millisecond))
+	assert.Equal(t, 20*time.Millisecond, planDuration(stmtStat))
+
+	e.recordSlowQuery(newMockSelectStatement("rp0", "mst"), "db0", planDuration(stmtStat), time.Since(start), time.Since(start))
+	queries := e.SlowQueries.Recent()
+	require.Len(t, queries, 1)
+	q := queries[0]
+	assert.Equal(t, 20*time.Millisecond, q.PlanDuration)
+	assert.True(t, q.IteratorDuration >= 0)
+	assert.True(t, q.EmitDuration >= 0)
+	assert.Equal(t, q.IteratorDuration+q.EmitDuration, q.Duration)
+}
+
+func TestStatementExecutor_NameValidation(t *testing.T) {
+	e := newMockStatementExecutor()
+	names := []string{"cpu", "cpu_load-1", "cpu.load", "cpu load", "温度"}
+
+	// the default validation accepts the printable names
+	for _, name := range names {
+		assert.True(t, meta2.ValidMeasurementName(name) && meta2.ValidNameStrictness(name, e.NameValidation), name)
+	}
+
+	e.NameValidation = config.NameValidationASCII
+	for _, name := range names[:4] {
+		assert.True(t, meta2.ValidNameStrictness(name, e.NameValidation), name)
+	}
+	err := e.executeCreateMeasurementStatement(&influxql.CreateMeasurementStatement{Database: "db0", RetentionPolicy: "rp0", Name: "温度"})
+	assert.Equal(t, meta2.ErrInvalidName, err)
+	err = e.executeCreateDatabaseStatement(&influxql.CreateDatabaseStatement{Name: "数据库"})
+	assert.Equal(t, meta2.ErrInvalidName, err)
+
+	e.NameValidation = config.NameValidationStrict
+	for _, name := range names[:2] {
+		assert.True(t, meta2.ValidNameStrictness(name, e.NameValidation), name)
+	}
+	for _, name := range names[2:] {
+		err = e.executeCreateMeasurementStatement(&influxql.CreateMeasurementStatement{Database: "db0", RetentionPolicy: "rp0", Name: name})
+		assert.Equal(t, meta2.ErrInvalidName, err, name)
+	}
+	err = e.executeCreateDatabaseStatement(&influxql.CreateDatabaseStatement{Name: "db 0"})
+	assert.Equal(t, meta2.ErrInvalidName, err)
+}
+
+type mockDatabasesMetaClient struct {
+	MockMetaClient
+	databases map[string]*meta2.DatabaseInfo
+}
+
+func (m *mockDatabasesMetaClient) Databases() map[string]*meta2.DatabaseInfo {
+	return m.databases
+}
+
+func TestStatementExecutor_executeShowDatabasesStatement_Deleting(t *testing.T) {
+	e := newMockStatementExecutor()
+	e.MetaClient = &mockDatabasesMetaClient{databases: map[string]*meta2.DatabaseInfo{
+		"db0": {Name: "db0", ReplicaN: 1},
+		"db1": {Name: "db1", ReplicaN: 1, MarkDeleted: true},
+	}}
+	ctx := &query.ExecutionContext{ExecutionOptions: query.ExecutionOptions{Authorizer: query.OpenAuthorizer}}
+	show := func(sql string) (models.Rows, error) {
+		YyParser := &influxql.YyParser{Query: influxql.Query{}}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(sql))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		require.NoError(t, err)
+		return e.executeShowDatabasesStatement(q.Statements[0].(*influxql.ShowDatabasesStatement), ctx)
+	}
+
+	// the database pending deletion is flagged
+	rows, err := show("SHOW DATABASES DETAIL")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"name", "ReplicaN", "Tag Attribute", "deleting"}, rows[0].Columns)
+	assert.Equal(t, [][]interface{}{{"db0", "1", "default", false}, {"db1", "1", "default", true}}, rows[0].Values)
+
+	// and can be hidden
+	rows, err = show("SHOW DATABASES WHERE deleting = false")
+	require.NoError(t, err)
+	assert.Equal(t, [][]interface{}{{"db0"}}, rows[0].Values)
+	rows, err = show("SHOW DATABASES DETAIL WHERE deleting = true")
+	require.NoError(t, err)
+	assert.Equal(t, [][]interface{}{{"db1", "1", "default", true}}, rows[0].Values)
+	rows, err = show(`SHOW DATABASES WHERE deleting = false AND "name" = 'db1'`)
+	require.NoError(t, err)
+	assert.Empty(t, rows[0].Values)
+
+	_, err = show("SHOW DATABASES WHERE host = 'a'")
+	assert.EqualError(t, err, "SHOW DATABASES can only filter on name and deleting, not host")
+}
+
+type mockEmptyShardMapper struct {
+	MockShardMapper
+}
+
+func (m *mockEmptyShardMapper) MapShards(influxql.Sources, influxql.TimeRange, query.SelectOptions, influxql.Expr) (query.ShardGroup, error) {
+	return nil, influxql.ErrDeclareEmptyCollection
+}
+
+func TestStatementExecutor_executeExplainStatement(t *testing.T) {
+	e := newMockStatementExecutor()
+	ctx := &query.ExecutionContext{Context: context.Background()}
+
+	// the errors of the planning are returned
+	_, err := e.executeExplainStatement(&influxql.ExplainStatement{Statement: newMockSelectStatement("wrongRp", "mst")}, ctx)
+	require.EqualError(t, err, "retention policy not found")
+
+	// nothing is explained without an executor
+	e.ShardMapper = &mockEmptyShardMapper{}
+	rows, err := e.executeExplainStatement(&influxql.ExplainStatement{Statement: newMockSelectStatement("rp0", "mst")}, ctx)
+	require.NoError(t, err)
+	assert.Equal(t, models.Rows{}, rows)
+}
+
+func TestExplainRows(t *testing.T) {
+	rowDataType := hybridqp.NewRowDataTypeImpl(influxql.VarRef{Val: "value", Type: influxql.Float})
+	source := executor.NewSourceFromSingleChunk(rowDataType, executor.NewChunkBuilder(rowDataType).NewChunk("mst"))
+	sink := executor.NewNilSink(rowDataType)
+	sourceVertex := executor.NewTransformVertex(nil, source)
+	sinkVertex := executor.NewTransformVertex(nil, sink)
+	dag := executor.NewTransformDag()
+	dag.AddVertex(sourceVertex)
+	dag.AddVertex(sinkVertex)
+	dag.AddEdge(sourceVertex, sinkVertex)
+
+	// the fields and dimensions of the statement are explained if the root has no schema
+	stmt := newMockSelectStatement("rp0", "mst")
+	stmt.Dimensions = influxql.Dimensions{{Expr: &influxql.VarRef{Val: "host"}}}
+	rows := explainRows(stmt, executor.NewPipelineExecutorFromDag(dag, sinkVertex), 3)
+	require.Len(t, rows, 1)
+	assert.Equal(t, []string{"EXPLAIN"}, rows[0].Columns)
+	assert.Equal(t, [][]interface{}{
+		{"shards: 3"},
+		{"fields: 1, dimensions: 1"},
+		{"NilSink"},
+		{"  SourceFromSingleChunk"},
+	}, rows[0].Values)
+}
+
+func TestStatementExecutor_executeSelectStatement_QueryIDMessage(t *testing.T) {
+	e := newMockStatementExecutor()
+	e.ShardMapper = &mockEmptyShardMapper{}
+	ctx := &query.ExecutionContext{Context: context.Background(), Results: make(chan *query.Result, 1)}
+	ctx.QueryID = []uint64{10}
+
+	// the query id is only sent if it is reported
+	require.NoError(t, e.executeSelectStatement(newMockSelectStatement("rp0", "mst"), ctx, 0))
+	result := <-ctx.Results
+	assert.Empty(t, result.Messages)
+
+	// the query id registered for the statement is sent with its result
+	ctx.ReportQueryID = true
+	require.NoError(t, e.executeSelectStatement(newMockSelectStatement("rp0", "mst"), ctx, 0))
+	result = <-ctx.Results
+	assert.Equal(t, []*query.Message{{Level: query.InfoLevel, Text: "qid: 10"}}, result.Messages)
+
+	// followed by the shards if they are reported
+	ctx.ReportShards = true
+	require.NoError(t, e.executeSelectStatement(newMockSelectStatement("rp0", "mst"), ctx, 0))
+	result = <-ctx.Results
+	assert.Equal(t, []*query.Message{query.QueryIDMessage(10), {Level: query.InfoLevel, Text: "shards: "}}, result.Messages)
+
+	// nothing is sent for a query which is not registered
+	ctx.QueryID = nil
+	ctx.ReportShards = false
+	require.NoError(t, e.executeSelectStatement(newMockSelectStatement("rp0", "mst"), ctx, 0))
+	result = <-ctx.Results
+	assert.Empty(t, result.Messages)
+}
+
+func TestStatementExecutor_StatementStatistics(t *testing.T) {
+	assert.Equal(t, "select", statementType(&influxql.SelectStatement{}))
+	assert.Equal(t, "show_measurements", statementType(&influxql.ShowMeasurementsStatement{}))
+	assert.Equal(t, "drop_continuous_query", statementType(&influxql.DropContinuousQueryStatement{}))
+
+	e := newMockStatementExecutor()
+	e.ShardMapper = &mockEmptyShardMapper{}
+	stat := statistics.StatementStat
+	selects, shows := stat.Count("select"), stat.Count("show_query_limits")
+	execute := func(stmt influxql.Statement) {
+		ctx := &query.ExecutionContext{Context: context.Background(), Results: make(chan *query.Result, 1)}
+		require.NoError(t, e.ExecuteStatement(stmt, ctx, 0))
+		<-ctx.Results
+	}
+
+	execute(newMockSelectStatement("rp0", "mst"))
+	execute(newMockSelectStatement("rp0", "mst"))
+	execute(parseScript(t, "SHOW QUERY LIMITS ON db0")[0])
+	assert.Equal(t, selects+2, stat.Count("select"))
+	assert.Equal(t, shows+1, stat.Count("show_query_limits"))
+}
+
+type mockDiagnosticsMetaClient struct {
+	MockMetaClient
+	dataNodes []meta2.DataNode
+	err       error
+}
+
+func (m *mockDiagnosticsMetaClient) MetaLeader() (string, error) {
+	return "127.0.0.1:8092", m.err
+}
+
+func (m *mockDiagnosticsMetaClient) MetaNodes() ([]meta2.NodeInfo, error) {
+	return []meta2.NodeInfo{{ID: 1}}, nil
+}
+
+func (m *mockDiagnosticsMetaClient) DataNodes() ([]meta2.DataNode, error) {
+	return m.dataNodes, m.err
+}
+
+func TestStatementExecutor_executeShowDiagnosticsStatement(t *testing.T) {
+	mc := &mockDiagnosticsMetaClient{}
+	e := newMockStatementExecutor()
+	e.MetaClient = mc
+	e.ServerInfo = app.ServerInfo{Version: "v1.3.0", Commit: "abc", Branch: "main"}
+	e.Hostname = "127.0.0.1:8086"
+	e.StartTime = time.Now().Add(-time.Hour)
+
+	// a single node without data nodes
+	rows, err := e.executeShowDiagnosticsStatement(&influxql.ShowDiagnosticsStatement{})
+	require.NoError(t, err)
+	names := make([]string, len(rows))
+	for i, row := range rows {
+		names[i] = row.Name
+	}
+	assert.Equal(t, []string{"build", "runtime", "system", "network", "meta", "storage"}, names)
+	assert.Equal(t, "v1.3.0", rows[0].Values[0][0])
+	assert.True(t, rows[1].Values[0][3].(int) > 0)
+	assert.Equal(t, "1h0m0s", rows[2].Values[0][3])
+	assert.Equal(t, [][]interface{}{{"127.0.0.1:8086"}}, rows[3].Values)
+	assert.Equal(t, [][]interface{}{{"127.0.0.1:8092", 1, "ok"}}, rows[4].Values)
+	assert.Empty(t, rows[5].Values)
+
+	// the data nodes and their status
+	mc.dataNodes = []meta2.DataNode{{NodeInfo: meta2.NodeInfo{ID: 2, Host: "127.0.0.1:8400", TCPHost: "127.0.0.1:8401", Status: serf.StatusAlive}}}
+	rows, err = e.executeShowDiagnosticsStatement(&influxql.ShowDiagnosticsStatement{Module: "STORAGE"})
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, [][]interface{}{{uint64(2), "127.0.0.1:8400", "127.0.0.1:8401", "alive"}}, rows[0].Values)
+
+	// the failed connections are reported in the status
+	mc.err = errors.New("meta unavailable")
+	rows, err = e.executeShowDiagnosticsStatement(&influxql.ShowDiagnosticsStatement{Module: "meta"})
+	require.NoError(t, err)
+	assert.Equal(t, [][]interface{}{{"", 0, "meta unavailable"}}, rows[0].Values)
+	rows, err = e.executeShowDiagnosticsStatement(&influxql.ShowDiagnosticsStatement{Module: "storage"})
+	require.NoError(t, err)
+	assert.Equal(t, [][]interface{}{{0, "", "", "meta unavailable"}}, rows[0].Values)
+
+	rows, err = e.executeShowDiagnosticsStatement(&influxql.ShowDiagnosticsStatement{Module: "unknown"})
+	require.NoError(t, err)
+	assert.Empty(t, rows)
+}
+
+// mockDeleteSeriesNS records the measurements whose series are deleted on each node as rp.mst,
+// the next failures calls fail with a retried error.
+type mockDeleteSeriesNS struct {
+	netstorage.NetStorage
+	mu        sync.Mutex
+	deleted   map[uint64][]string
+	condition influxql.Expr
+	failures  int
+}
+
+func (s *mockDeleteSeriesNS) DeleteSeries(nodeID uint64, _, rp string, _ []uint32, measurements []string, condition influxql.Expr) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.failures > 0 {
+		s.failures--
+		return errors.New("read message type: EOF")
+	}
+	// a retried statement deletes the series of the node again
+	deleted := make(map[string]bool)
+	for _, name := range s.deleted[nodeID] {
+		deleted[name] = true
+	}
+	for _, mst := range measurements {
+		if !deleted[rp+"."+mst] {
+			s.deleted[nodeID] = append(s.deleted[nodeID], rp+"."+mst)
+		}
+	}
+	sort.Strings(s.deleted[nodeID])
+	s.condition = condition
+	return nil
+}
+
+func TestStatementExecutor_executeDeleteSeriesStatement(t *testing.T) {
+	ns := &mockDeleteSeriesNS{}
+	e := newMockSeriesStatementExecutor(ns)
+	deleteSeries := func(sql string) error {
+		ns.deleted = make(map[uint64][]string)
+		ns.condition = nil
+		YyParser := &influxql.YyParser{Query: influxql.Query{}}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(sql))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		require.NoError(t, err)
+		stmt := q.Statements[0]
+		require.NoError(t, e.NormalizeStatement(stmt, "db0", "rp0"))
+		ctx := &query.ExecutionContext{Context: context.Background(), Results: make(chan *query.Result, 1)}
+		return e.ExecuteStatement(stmt, ctx, 0)
+	}
+
+	// the series are deleted on every node of the database
+	require.NoError(t, deleteSeries("DELETE FROM cpu, mem WHERE host = 'a' AND time < 1000"))
+	assert.Equal(t, map[uint64][]string{1: {"rp0.cpu_0000", "rp0.mem_0000"}, 2: {"rp0.cpu_0000", "rp0.mem_0000"}}, ns.deleted)
+	assert.Equal(t, "host = 'a' AND time < 1000", ns.condition.String())
+
+	// the series are deleted from the retention policy of each measurement only
+	require.NoError(t, deleteSeries("DELETE FROM db0.rp1.cpu, mem"))
+	assert.Equal(t, map[uint64][]string{1: {"rp0.mem_0000", "rp1.cpu_0000"}, 2: {"rp0.mem_0000", "rp1.cpu_0000"}}, ns.deleted)
+
+	// a retried error of a node, which is not retried for the pt view, retries the statement
+	ns.failures = 1
+	require.NoError(t, deleteSeries("DELETE FROM cpu"))
+	assert.Equal(t, map[uint64][]string{1: {"rp0.cpu_0000"}, 2: {"rp0.cpu_0000"}}, ns.deleted)
+	assert.Nil(t, ns.condition)
+
+	// the condition can not filter on fields
+	err := deleteSeries("DELETE FROM cpu WHERE usage > 1")
+	require.EqualError(t, err, "fields not supported in WHERE clause during deletion: usage")
+	assert.Empty(t, ns.deleted)
+
+	// the measurements get the default database and retention policy
+	stmt := &influxql.DeleteSeriesStatement{Sources: influxql.Sources{&influxql.Measurement{Name: "cpu"}}}
+	require.NoError(t, e.NormalizeStatement(stmt, "db0", "rp0"))
+	assert.Equal(t, "DELETE FROM db0.rp0.cpu", stmt.String())
+
+	// the database is required without source
+	err = e.executeDeleteSeriesStatement(&influxql.DeleteSeriesStatement{}, "")
+	assert.Equal(t, coordinator.ErrDatabaseNameRequired, err)
+}
+
+// mockDropSeriesNS records the measurements whose series are dropped on each node, the nodes in failed fail.
+type mockDropSeriesNS struct {
+	netstorage.NetStorage
+	mu        sync.Mutex
+	dropped   map[uint64][]string
+	condition influxql.Expr
+	failed    map[uint64]bool
+}
+
+func (s *mockDropSeriesNS) DropSeries(nodeID uint64, _ string, _ []uint32, measurements []string, condition influxql.Expr) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.failed[nodeID] {
+		return errors.New("disk is full")
+	}
+	sort.Strings(measurements)
+	s.dropped[nodeID] = measurements
+	s.condition = condition
+	return nil
+}
+
+func TestStatementExecutor_executeDropSeriesStatement(t *testing.T) {
+	ns := &mockDropSeriesNS{}
+	e := newMockSeriesStatementExecutor(ns)
+	dropSeries := func(sql string) (*query.Result, error) {
+		ns.dropped = make(map[uint64][]string)
+		ns.condition = nil
+		YyParser := &influxql.YyParser{Query: influxql.Query{}}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(sql))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		require.NoError(t, err)
+		stmt := q.Statements[0]
+		require.NoError(t, e.NormalizeStatement(stmt, "db0", "rp0"))
+		ctx := &query.ExecutionContext{Context: context.Background(), Results: make(chan *query.Result, 1), ExecutionOptions: query.ExecutionOptions{Database: "db0"}}
+		if err := e.ExecuteStatement(stmt, ctx, 0); err != nil {
+			return nil, err
+		}
+		return <-ctx.Results, nil
+	}
+
+	// the series are dropped on every node of the database
+	result, err := dropSeries("DROP SERIES FROM cpu, mem WHERE host = 'a'")
+	require.NoError(t, err)
+	assert.Equal(t, map[uint64][]string{1: {"cpu_0000", "mem_0000"}, 2: {"cpu_0000", "mem_0000"}}, ns.dropped)
+	assert.Equal(t, "host = 'a'", ns.condition.String())
+	require.Len(t, result.Series, 1)
+	assert.Equal(t, []string{"database", "nodes", "succeeded"}, result.Series[0].Columns)
+	assert.Equal(t, [][]interface{}{{"db0", 2, 2}}, result.Series[0].Values)
+
+	// no node is asked if no measurement matches
+	result, err = dropSeries("DROP SERIES WHERE host = 'a'")
+	require.NoError(t, err)
+	assert.Empty(t, ns.dropped)
+	assert.Equal(t, [][]interface{}{{"db0", 0, 0}}, result.Series[0].Values)
+
+	// a partial drop tells on how many nodes the series are dropped
+	ns.failed = map[uint64]bool{2: true}
+	_, err = dropSeries("DROP SERIES FROM cpu")
+	require.EqualError(t, err, "drop series on database db0 succeeded on 1 of 2 nodes: disk is full")
+	assert.Equal(t, map[uint64][]string{1: {"cpu_0000"}}, ns.dropped)
+	ns.failed = nil
+
+	// the condition can not filter on fields or time
+	_, err = dropSeries("DROP SERIES FROM cpu WHERE usage > 1")
+	require.EqualError(t, err, "fields not supported in WHERE clause during deletion: usage")
+	_, err = dropSeries("DROP SERIES FROM cpu WHERE time < 1000")
+	require.EqualError(t, err, "DROP SERIES doesn't support time in WHERE clause")
+	assert.Empty(t, ns.dropped)
+
+	// the database is required without source
+	_, err = e.executeDropSeriesStatement(&influxql.DropSeriesStatement{}, "")
+	assert.Equal(t, coordinator.ErrDatabaseNameRequired, err)
+}
+
+type mockStreamMetaClient struct {
+	MockMetaClient
+	exists              bool
+	onCreateMeasurement func()
+	policyErr           error
+	calls               []string
+}
+
+func (m *mockStreamMetaClient) Measurement(_, _, _ string) (*meta2.MeasurementInfo, error) {
+	if m.exists {
+		return &meta2.MeasurementInfo{Name: "dst_0000"}, nil
+	}
+	return nil, meta2.ErrMeasurementNotFound
+}
+
+func (m *mockStreamMetaClient) CreateStreamMeasurement(_ *meta2.StreamInfo, _, _ *influxql.Measurement, _ *influxql.SelectStatement) error {
+	m.calls = append(m.calls, "measurement")
+	if m.onCreateMeasurement != nil {
+		m.onCreateMeasurement()
+	}
+	return nil
+}
+
+func (m *mockStreamMetaClient) CreateStreamPolicy(_ *meta2.StreamInfo) error {
+	m.calls = append(m.calls, "policy")
+	return m.policyErr
+}
+
+func (m *mockStreamMetaClient) MarkMeasurementDelete(database, mst string) error {
+	m.calls = append(m.calls, "rollback "+database+"."+mst)
+	return nil
+}
+
+type mockQueryIDRegister struct{}
+
+func (mockQueryIDRegister) RetryRegisterQueryIDOffset(string) (uint64, error) {
+	return 0, nil
+}
+
+type blockingShardMapper struct {
+	query.ShardMapper
+	entered chan struct{}
+	release chan struct{}
+}
+
+func (m *blockingShardMapper) MapShards(_ influxql.Sources, _ influxql.TimeRange, _ query.SelectOptions, _ influxql.Expr) (query.ShardGroup, error) {
+	close(m.entered)
+	<-m.release
+	return nil, errno.NewError(errno.NoConnectionAvailable)
+}
+
+func newCreateStreamStatement() *influxql.CreateStreamStatement {
+	return &influxql.CreateStreamStatement{
+		Name:   "stream0",
+		Query:  newMockSelectStatement("rp", "mst"),
+		Target: &influxql.Target{Measurement: &influxql.Measurement{Database: "db0", RetentionPolicy: "rp0", Name: "dst"}},
+	}
+}
+
+func TestStatementExecutor_CreateStreamInterrupted(t *testing.T) {
+	tm := query.NewTaskManager()
+	tm.Register = mockQueryIDRegister{}
+	mc := &mockStreamMetaClient{}
+	mapper := &blockingShardMapper{entered: make(chan struct{}), release: make(chan struct{})}
+	e := &StatementExecutor{MetaClient: mc, ShardMapper: mapper, StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown)}
+
+	// the client disconnects while the shards of the source are mapped
+	stmt := newCreateStreamStatement()
+	interrupt := make(chan struct{})
+	ctx, detach, err := tm.AttachQuery(&influxql.Query{Statements: influxql.Statements{stmt}}, query.ExecutionOptions{}, interrupt, nil)
+	require.NoError(t, err)
+	defer detach()
+	go func() {
+		<-mapper.entered
+		close(interrupt)
+	}()
+	err = e.executeCreateStreamStatement(stmt, ctx)
+	close(mapper.release)
+	assert.True(t, errno.Equal(err, errno.ErrQueryKilled))
+	assert.Empty(t, mc.calls)
+
+	// the client has already disconnected
+	err = e.executeCreateStreamStatement(newCreateStreamStatement(), ctx)
+	assert.True(t, errno.Equal(err, errno.ErrQueryKilled))
+	assert.Empty(t, mc.calls)
+}
+
+func TestStatementExecutor_createStreamRollback(t *testing.T) {
+	e := &StatementExecutor{StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown)}
+	stmt := newCreateStreamStatement()
+	src := stmt.Query.(*influxql.SelectStatement).Sources[0].(*influxql.Measurement)
+	info := &meta2.StreamInfo{Name: stmt.Name}
+
+	// the client disconnects after the measurement is created, before the policy
+	ctx, cancel := context.WithCancel(context.Background())
+	mc := &mockStreamMetaClient{onCreateMeasurement: cancel}
+	e.MetaClient = mc
+	err := e.createStream(ctx, info, src, stmt.Target.Measurement, stmt.Query.(*influxql.SelectStatement))
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, []string{"measurement", "rollback db0.dst"}, mc.calls)
+
+	// the policy fails
+	mc = &mockStreamMetaClient{policyErr: errors.New("stream already exists")}
+	e.MetaClient = mc
+	err = e.createStream(context.Background(), info, src, stmt.Target.Measurement, stmt.Query.(*influxql.SelectStatement))
+	assert.EqualError(t, err, "stream already exists")
+	assert.Equal(t, []string{"measurement", "policy", "rollback db0.dst"}, mc.calls)
+
+	// the measurement is not created by the stream, it is never dropped
+	mc = &mockStreamMetaClient{exists: true, policyErr: errors.New("stream already exists")}
+	e.MetaClient = mc
+	err = e.createStream(context.Background(), info, src, stmt.Target.Measurement, stmt.Query.(*influxql.SelectStatement))
+	assert.Error(t, err)
+	assert.Equal(t, []string{"measurement", "policy"}, mc.calls)
+
+	mc = &mockStreamMetaClient{}
+	e.MetaClient = mc
+	err = e.createStream(context.Background(), info, src, stmt.Target.Measurement, stmt.Query.(*influxql.SelectStatement))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"measurement", "policy"}, mc.calls)
+}
+
+func TestStatementExecutor_CreateStreamUnsupportedCall(t *testing.T) {
+	e := &StatementExecutor{MetaClient: &mockStreamMetaClient{}, StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown)}
+	stmt := newCreateStreamStatement()
+	stmt.Query = &influxql.SelectStatement{
+		Fields: influxql.Fields{
+			{Expr: &influxql.Call{Name: "sum", Args: []influxql.Expr{&influxql.VarRef{Val: "f1"}}}},
+			{Expr: &influxql.Call{Name: "mean", Args: []influxql.Expr{&influxql.VarRef{Val: "f2"}}}},
+		},
+		Sources: influxql.Sources{&influxql.Measurement{Database: "db0", RetentionPolicy: "rp0", Name: "mst"}},
+	}
+	ctx := &query.ExecutionContext{Context: context.Background()}
+	err := e.executeCreateStreamStatement(stmt, ctx)
+	assert.EqualError(t, err, "unsupported call function mean in stream, the supported functions are count, max, min, sum")
+
+	assert.NoError(t, checkStreamCalls(newMockSelectStatement("rp", "mst")))
+}
+
+type mockStreamRPMetaClient struct {
+	MockMetaClient
+	shardGroupDuration time.Duration
+}
+
+func (m *mockStreamRPMetaClient) RetentionPolicy(_, name string) (*meta2.RetentionPolicyInfo, error) {
+	return &meta2.RetentionPolicyInfo{Name: name, ShardGroupDuration: m.shardGroupDuration}, nil
+}
+
+func TestStatementExecutor_checkStreamInterval(t *testing.T) {
+	mc := &mockStreamRPMetaClient{shardGroupDuration: time.Hour}
+	e := &StatementExecutor{MetaClient: mc}
+	dst := &influxql.Measurement{Database: "db0", RetentionPolicy: "rp0", Name: "dst"}
+	groupBy := func(interval time.Duration) *influxql.SelectStatement {
+		return &influxql.SelectStatement{Dimensions: influxql.Dimensions{{Expr: &influxql.Call{
+			Name: "time", Args: []influxql.Expr{&influxql.DurationLiteral{Val: interval}}}}}}
+	}
+
+	// aligned
+	assert.NoError(t, e.checkStreamInterval(groupBy(10*time.Minute), dst))
+	assert.NoError(t, e.checkStreamInterval(groupBy(time.Hour), dst))
+
+	// misaligned
+	err := e.checkStreamInterval(groupBy(7*time.Minute), dst)
+	assert.EqualError(t, err, "stream group by time interval 7m0s does not divide evenly the shard group duration 1h0m0s of retention policy db0.rp0")
+	assert.Error(t, e.checkStreamInterval(groupBy(2*time.Hour), dst))
+
+	// the shard group duration is unknown
+	mc.shardGroupDuration = 0
+	assert.NoError(t, e.checkStreamInterval(groupBy(7*time.Minute), dst))
+}
+
+type mockStreamTargetsMetaClient struct {
+	MockMetaClient
+	streams map[string]meta2.StreamMeasurementInfo
+	dropped []meta2.StreamMeasurementInfo
+}
+
+func (m *mockStreamTargetsMetaClient) ShowStreams(_ string, _ bool) (models.Rows, error) {
+	row := &models.Row{Columns: []string{"database", "retention", "measurement", "Name"}}
+	for name, t := range m.streams {
+		row.Values = append(row.Values, []interface{}{t.Database, t.RetentionPolicy, t.Name, name})
+	}
+	return models.Rows{row}, nil
+}
+
+func (m *mockStreamTargetsMetaClient) ShowDroppedStreamTargets(database string) []meta2.StreamMeasurementInfo {
+	var targets []meta2.StreamMeasurementInfo
+	for _, t := range m.dropped {
+		if database == "" || t.Database == database {
+			targets = append(targets, t)
+		}
+	}
+	return targets
+}
+
+func (m *mockStreamTargetsMetaClient) MarkMeasurementDelete(database, mst string) error {
+	targets := m.dropped[:0]
+	for _, t := range m.dropped {
+		if t.Database != database || t.Name != mst {
+			targets = append(targets, t)
+		}
+	}
+	m.dropped = targets
+	return nil
+}
+
+func TestStatementExecutor_executeShowStreamsStatement_RetentionPolicy(t *testing.T) {
+	mc := &mockStreamTargetsMetaClient{streams: map[string]meta2.StreamMeasurementInfo{
+		"s1": {Database: "db0", RetentionPolicy: "rp0", Name: "t1"},
+		"s2": {Database: "db0", RetentionPolicy: "rp1", Name: "t2"},
+	}}
+	e := &StatementExecutor{MetaClient: mc}
+	names := func(rows models.Rows) []interface{} {
+		require.Len(t, rows, 1)
+		var res []interface{}
+		for _, value := range rows[0].Values {
+			res = append(res, value[3])
+		}
+		sort.Slice(res, func(i, j int) bool { return res[i].(string) < res[j].(string) })
+		return res
+	}
+
+	rows, err := e.executeShowStreamsStatement(&influxql.ShowStreamsStatement{Database: "db0", RetentionPolicy: "rp1"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"database", "retention", "measurement", "Name"}, rows[0].Columns)
+	assert.Equal(t, []interface{}{"s2"}, names(rows))
+
+	rows, err = e.executeShowStreamsStatement(&influxql.ShowStreamsStatement{Database: "db0", RetentionPolicy: "rp2"})
+	require.NoError(t, err)
+	assert.Empty(t, names(rows))
+
+	// all the streams are shown without retention policy
+	rows, err = e.executeShowStreamsStatement(&influxql.ShowStreamsStatement{Database: "db0"})
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"s1", "s2"}, names(rows))
+}
+
+type mockCQMetaClient struct {
+	MockMetaClient
+	lastRunTimes map[string]time.Time // the last successful runs reported to meta
+}
+
+func (m *mockCQMetaClient) Database(name string) (*meta2.DatabaseInfo, error) {
+	dbi := &meta2.DatabaseInfo{Name: name, ContinuousQueries: map[string]*meta2.ContinuousQueryInfo{}}
+	for cq, lastRun := range m.lastRunTimes {
+		dbi.ContinuousQueries[cq] = &meta2.ContinuousQueryInfo{Name: cq, LastRunTime: lastRun}
+	}
+	return dbi, nil
+}
+
+func (m *mockCQMetaClient) ShowContinuousQueries() (models.Rows, error) {
+	return models.Rows{
+		{Name: "db0", Columns: []string{"name", "query"}, Values: [][]interface{}{{"cq0", "CREATE CONTINUOUS QUERY cq0 ON db0"}}},
+		{Name: "db1", Columns: []string{"name", "query"}, Values: [][]interface{}{{"cq1", "CREATE CONTINUOUS QUERY cq1 ON db1"}}},
+	}, nil
+}
+
+func TestStatementExecutor_executeShowContinuousQueriesStatement(t *testing.T) {
+	e := &StatementExecutor{MetaClient: &mockCQMetaClient{}}
+	show := func(sql, defaultDatabase string) []string {
+		stmt := parseScript(t, sql)[0]
+		require.NoError(t, e.NormalizeStatement(stmt, defaultDatabase, ""))
+		rows, err := e.executeShowContinuousQueriesStatement(stmt.(*influxql.ShowContinuousQueriesStatement))
+		require.NoError(t, err)
+		var dbs []string
+		for _, row := range rows {
+			dbs = append(dbs, row.Name)
+		}
+		return dbs
+	}
+
+	// the continuous queries of the database in use
+	assert.Equal(t, []string{"db1"}, show("SHOW CONTINUOUS QUERIES", "db1"))
+	assert.Equal(t, []string{"db0"}, show("SHOW CONTINUOUS QUERIES ON db0", "db1"))
+	assert.Empty(t, show("SHOW CONTINUOUS QUERIES ON db2", ""))
+	// all the continuous queries without database
+	assert.Equal(t, []string{"db0", "db1"}, show("SHOW CONTINUOUS QUERIES", ""))
+}
+
+func TestStatementExecutor_ShowContinuousQueriesLastRun(t *testing.T) {
+	cqService := continuousquery.NewService("127.0.0.1:8086", time.Second, 1)
+	cqService.WithLogger(Logger.NewLogger(errno.ModuleUnknown))
+	cqService.QueryExecutor = &mockCQQueryExecutor{err: errors.New("write failed")}
+	cq := continuousquery.NewContinuousQuery("rp0", `CREATE CONTINUOUS QUERY cq1 ON db1 BEGIN SELECT count(value) INTO mst1 FROM mst0 GROUP BY time(1h) END`)
+	_, err := cqService.ExecuteContinuousQuery(cq, time.Now())
+	require.EqualError(t, err, "write failed")
+	run, ok := cqService.LastRun("cq1")
+	require.True(t, ok)
+
+	// cq0 has run on another sql node, cq1 has run on this node after its last success
+	reported := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	mc := &mockCQMetaClient{lastRunTimes: map[string]time.Time{"cq0": reported, "cq1": reported}}
+	e := &StatementExecutor{MetaClient: mc, ContinuousQueryService: cqService}
+	rows, err := e.executeShowContinuousQueriesStatement(&influxql.ShowContinuousQueriesStatement{})
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	for _, row := range rows {
+		assert.Equal(t, []string{"name", "query", "last_run", "last_run_status"}, row.Columns)
+	}
+	assert.Equal(t, []interface{}{"cq0", "CREATE CONTINUOUS QUERY cq0 ON db0", "2024-01-01T00:00:00Z", "success"}, rows[0].Values[0])
+	assert.Equal(t, []interface{}{"cq1", "CREATE CONTINUOUS QUERY cq1 ON db1", run.Time.UTC().Format(time.RFC3339Nano), "failed: write failed"}, rows[1].Values[0])
+
+	// the continuous query never run has no last run, a later success reported to meta replaces the local run
+	mc.lastRunTimes = map[string]time.Time{"cq0": time.Unix(0, 0), "cq1": run.Time.Add(time.Hour)}
+	rows, err = e.executeShowContinuousQueriesStatement(&influxql.ShowContinuousQueriesStatement{})
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, []interface{}{"cq0", "CREATE CONTINUOUS QUERY cq0 ON db0", "", ""}, rows[0].Values[0])
+	assert.Equal(t, []interface{}{"cq1", "CREATE CONTINUOUS QUERY cq1 ON db1", run.Time.Add(time.Hour).UTC().Format(time.RFC3339Nano), "success"}, rows[1].Values[0])
+
+	// the last runs reported to meta are shown without the continuous query service
+	e.ContinuousQueryService = nil
+	mc.lastRunTimes = map[string]time.Time{"cq1": reported}
+	rows, err = e.executeShowContinuousQueriesStatement(&influxql.ShowContinuousQueriesStatement{Database: "db1"})
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, []interface{}{"cq1", "CREATE CONTINUOUS QUERY cq1 ON db1", "2024-01-01T00:00:00Z", "success"}, rows[0].Values[0])
+}
+
+type mockCQQueryExecutor struct {
+	err error
+}
+
+func (e *mockCQQueryExecutor) ExecuteQuery(_ *influxql.Query, _ query.ExecutionOptions, _ chan struct{}, _ *statistics.SQLSlowQueryStatistics) <-chan *query.Result {
+	results := make(chan *query.Result, 1)
+	results <- &query.Result{Err: e.err}
+	close(results)
+	return results
+}
+
+func TestStatementExecutor_StreamTargets(t *testing.T) {
+	mc := &mockStreamTargetsMetaClient{}
+	e := &StatementExecutor{MetaClient: mc, StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown)}
+
+	rows, err := e.executeShowStreamTargets(&influxql.ShowStreamTargetsStatement{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"database", "retention", "measurement"}, rows[0].Columns)
+	assert.Empty(t, rows[0].Values)
+
+	mc.dropped = []meta2.StreamMeasurementInfo{
+		{Database: "db0", RetentionPolicy: "rp0", Name: "t1"},
+		{Database: "db1", RetentionPolicy: "rp0", Name: "t3"},
+	}
+	rows, err = e.executeShowStreamTargets(&influxql.ShowStreamTargetsStatement{})
+	require.NoError(t, err)
+	assert.Equal(t, [][]interface{}{{"db0", "rp0", "t1"}, {"db1", "rp0", "t3"}}, rows[0].Values)
+
+	rows, err = e.executeShowStreamTargets(&influxql.ShowStreamTargetsStatement{Database: "db0"})
+	require.NoError(t, err)
+	assert.Equal(t, [][]interface{}{{"db0", "rp0", "t1"}}, rows[0].Values)
+
+	// the target of db1 is kept
+	require.NoError(t, e.executeDropStreamTargets(&influxql.DropStreamTargetsStatement{Database: "db0"}))
+	rows, err = e.executeShowStreamTargets(&influxql.ShowStreamTargetsStatement{})
+	require.NoError(t, err)
+	assert.Equal(t, [][]interface{}{{"db1", "rp0", "t3"}}, rows[0].Values)
+}
+
+func TestAppendQueryLabels(t *testing.T) {
+	labels := func(trace *tracing.Trace) map[string]interface{} {
+		m := make(map[string]interface{})
+		for _, f := range trace.Tree().Raw.Fields {
+			m[f.Key()] = f.Value()
+		}
+		return m
+	}
+	ectx := &query.ExecutionContext{
+		Context:          context.Background(),
+		QueryID:          []uint64{7},
+		ExecutionOptions: query.ExecutionOptions{Database: "db0"},
+	}
+
+	trace, span := tracing.NewTrace("SELECT")
+	appendQueryLabels(span, &influxql.SelectStatement{Sources: influxql.Sources{&influxql.Measurement{Name: "mst"}}}, ectx)
+	span.Finish()
+	fields := labels(trace)
+	assert.Equal(t, "db=db0", fields["__name__db"])
+	assert.Equal(t, "measurement=mst", fields["__name__measurement"])
+	assert.Equal(t, "qid=7", fields["__name__qid"])
+
+	// the database of the sources overrides the default database, the regex sources are kept as is
+	stmt := &influxql.SelectStatement{Sources: influxql.Sources{
+		&influxql.Measurement{Database: "db1", Name: "cpu"},
+		&influxql.Measurement{Database: "db1", Regex: &influxql.RegexLiteral{Val: regexp.MustCompile("^mem")}},
+	}}
+	trace, span = tracing.NewTrace("SELECT")
+	appendQueryLabels(span, stmt, ectx)
+	span.Finish()
+	fields = labels(trace)
+	assert.Equal(t, "db=db1", fields["__name__db"])
+	assert.Equal(t, "measurement=cpu,/^mem/", fields["__name__measurement"])
+}
+
+type mockSlowDDLMetaClient struct {
+	MockMetaClient
+	delay time.Duration
+}
+
+func (m *mockSlowDDLMetaClient) MarkMeasurementDelete(_, _ string) error {
+	time.Sleep(m.delay)
+	return nil
+}
+
+func TestStatementExecutor_LogSlowStatement(t *testing.T) {
+	core, logs := observer.New(zap.WarnLevel)
+	lg := Logger.NewLogger(errno.ModuleUnknown)
+	orig := lg.GetZapLogger()
+	lg.SetZapLogger(zap.New(core))
+	defer lg.SetZapLogger(orig)
+
+	mc := &mockSlowDDLMetaClient{}
+	e := &StatementExecutor{MetaClient: mc, StmtExecLogger: lg, LogStatementsAfter: 50 * time.Millisecond}
+	ctx := &query.ExecutionContext{ExecutionOptions: query.ExecutionOptions{Database: "db0"}}
+	stmt := &influxql.DropMeasurementStatement{Name: "mst0"}
+
+	_, err := e.retryExecuteStatement(stmt, ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 0, logs.FilterMessage("slow statement").Len())
+
+	mc.delay = 100 * time.Millisecond
+	_, err = e.retryExecuteStatement(stmt, ctx, 0)
+	require.NoError(t, err)
+	entries := logs.FilterMessage("slow statement").All()
+	require.Len(t, entries, 1)
+	fields := entries[0].ContextMap()
+	assert.Equal(t, "DropMeasurementStatement", fields["type"])
+	assert.Equal(t, uint32(1), fields["retry"])
+	assert.Equal(t, 50*time.Millisecond, fields["threshold"])
+
+	// disabled
+	e.LogStatementsAfter = 0
+	_, err = e.retryExecuteStatement(stmt, ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, logs.FilterMessage("slow statement").Len())
+}
+
+type mockRepeatMarkDeleteMetaClient struct {
+	MockMetaClient
+	calls int64
+}
+
+func (m *mockRepeatMarkDeleteMetaClient) MarkMeasurementDelete(_, _ string) error {
+	atomic.AddInt64(&m.calls, 1)
+	return errors.New("repeat mark delete")
+}
+
+func TestStatementExecutor_retryExecuteStatement_Canceled(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	lg := Logger.NewLogger(errno.ModuleUnknown)
+	orig := lg.GetZapLogger()
+	lg.SetZapLogger(zap.New(core))
+	defer lg.SetZapLogger(orig)
+
+	mc := &mockRepeatMarkDeleteMetaClient{}
+	e := &StatementExecutor{MetaClient: mc, StmtExecLogger: lg}
+	c, cancel := context.WithCancel(context.Background())
+	ctx := &query.ExecutionContext{Context: c, ExecutionOptions: query.ExecutionOptions{Database: "db0"}}
+	stmt := &influxql.DropMeasurementStatement{Name: "mst0"}
+
+	time.AfterFunc(3*coordinator.DMLRetryInternalMillisecond*time.Millisecond/2, cancel)
+	start := time.Now()
+	_, err := e.retryExecuteStatement(stmt, ctx, 0)
+	assert.Equal(t, context.Canceled, err)
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Equal(t, int64(2), atomic.LoadInt64(&mc.calls))
+	assert.Equal(t, 0, logs.FilterMessage("ExecuteStatement error ").Len())
+}
+
+type mockFieldKeysMetaClient struct {
+	MockMetaClient
+	fields map[string]map[string]int32
+}
+
+func (m *mockFieldKeysMetaClient) FieldKeys(_ string, _ influxql.Measurements) (map[string]map[string]int32, error) {
+	ret := make(map[string]map[string]int32, len(m.fields))
+	for mst, fields := range m.fields {
+		ret[mst] = fields
+	}
+	return ret, nil
+}
+
+// mockSeriesNS returns the number of series matching the condition on each node
+type mockSeriesNS struct {
+	netstorage.NetStorage
+	cardinality map[uint64]map[string]uint64
+}
+
+func (s *mockSeriesNS) SeriesExactCardinality(nodeID uint64, _ string, _ []uint32, measurements []string, _ influxql.Expr) (map[string]uint64, error) {
+	ret := make(map[string]uint64, len(measurements))
+	for _, name := range measurements {
+		name = influx.GetOriginMstName(name)
+		ret[name] = s.cardinality[nodeID][name]
+	}
+	return ret, nil
+}
+
+func (s *mockSeriesNS) SeriesCardinality(nodeID uint64, _ string, _ []uint32, measurements []string, _ influxql.Expr) ([]meta2.MeasurementCardinalityInfo, error) {
+	day := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ret := make([]meta2.MeasurementCardinalityInfo, 0, len(measurements))
+	for _, name := range measurements {
+		name = influx.GetOriginMstName(name)
+		ret = append(ret, meta2.MeasurementCardinalityInfo{
+			Name: name,
+			CardinalityInfos: []meta2.CardinalityInfo{
+				{TimeRange: meta2.TimeRangeInfo{StartTime: day, EndTime: day.Add(24 * time.Hour)}, Cardinality: s.cardinality[nodeID][name]},
+			},
+		})
+	}
+	return ret, nil
+}
+
+func newMockSeriesStatementExecutor(ns netstorage.Storage) *StatementExecutor {
+	mc := &mockFieldKeysMetaClient{fields: map[string]map[string]int32{
+		"cpu": {"usage": influx.Field_Type_Float, "idle": influx.Field_Type_Float},
+		"mem": {"used": influx.Field_Type_Int},
+	}}
+	return &StatementExecutor{
+		MetaClient:     mc,
+		NetStorage:     ns,
+		MetaExecutor:   &coordinator.MetaExecutor{MetaClient: mc, Logger: Logger.NewLogger(errno.ModuleUnknown)},
+		StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown),
+	}
+}
+
+// mockShowMeasurementsMetaClient has the measurements of mockFieldKeysMetaClient
+type mockShowMeasurementsMetaClient struct {
+	mockFieldKeysMetaClient
+}
+
+func (m *mockShowMeasurementsMetaClient) Measurements(_ string, _ influxql.Measurements) ([]string, error) {
+	return []string{"cpu", "mem"}, nil
+}
+
+func (m *mockShowMeasurementsMetaClient) MatchMeasurements(_ string, _ influxql.Measurements) (map[string]*meta2.MeasurementInfo, error) {
+	return map[string]*meta2.MeasurementInfo{"cpu": {Name: "cpu_0000"}, "mem": {Name: "mem_0000"}}, nil
+}
+
+func TestStatementExecutor_executeShowMeasurementsStatement_Condition(t *testing.T) {
+	mc := &mockShowMeasurementsMetaClient{mockFieldKeysMetaClient{fields: map[string]map[string]int32{
+		"cpu": {"usage": influx.Field_Type_Float},
+		"mem": {"used": influx.Field_Type_Int},
+	}}}
+	ns := &mockSeriesNS{cardinality: map[uint64]map[string]uint64{1: {"cpu": 2}, 2: {"cpu": 1}}}
+	e := &StatementExecutor{
+		MetaClient:     mc,
+		NetStorage:     ns,
+		MetaExecutor:   &coordinator.MetaExecutor{MetaClient: mc, Logger: Logger.NewLogger(errno.ModuleUnknown)},
+		StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown),
+	}
+	show := func(cond string) (models.Rows, error) {
+		ctx := &query.ExecutionContext{Context: context.Background(), Results: make(chan *query.Result, 1)}
+		stmt := &influxql.ShowMeasurementsStatement{Database: "db0", Condition: influxql.MustParseExpr(cond)}
+		if err := e.executeShowMeasurementsStatement(stmt, ctx, 0); err != nil {
+			return nil, err
+		}
+		return collectResults(ctx), nil
+	}
+
+	// only the measurements with series matching the condition are returned
+	rows, err := show("host = 'a'")
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, [][]interface{}{{"cpu"}}, rows[0].Values)
+
+	ns.cardinality = nil
+	rows, err = show("host = 'b'")
+	require.NoError(t, err)
+	assert.Empty(t, rows)
+
+	// the condition can not filter on fields
+	_, err = show("host = 'a' AND usage > 1")
+	require.EqualError(t, err, "only tag predicates are supported in the WHERE clause of SHOW MEASUREMENTS, usage is a field")
+}
+
+// mockFlakyMeasurementsMetaClient fails to list the measurements with err the first failures times.
+type mockFlakyMeasurementsMetaClient struct {
+	mockShowMeasurementsMetaClient
+	failures int
+	err      error
+	calls    int
+}
+
+func (m *mockFlakyMeasurementsMetaClient) Measurements(database string, ms influxql.Measurements) ([]string, error) {
+	m.calls++
+	if m.calls <= m.failures {
+		return nil, m.err
+	}
+	return m.mockShowMeasurementsMetaClient.Measurements(database, ms)
+}
+
+func TestStatementExecutor_executeShowMeasurementsOnce(t *testing.T) {
+	mc := &mockFlakyMeasurementsMetaClient{failures: 2, err: errno.NewError(errno.PtNotFound)}
+	e := &StatementExecutor{MetaClient: mc, StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown)}
+	stmt := &influxql.ShowMeasurementsStatement{Database: "db0"}
+
+	// a retryable error is retried until the measurements are listed
+	ctx := &query.ExecutionContext{Context: context.Background(), Results: make(chan *query.Result, 1)}
+	require.NoError(t, e.ExecuteStatement(stmt, ctx, 0))
+	assert.Equal(t, 3, mc.calls)
+	rows := collectResults(ctx)
+	require.Len(t, rows, 1)
+	assert.Equal(t, [][]interface{}{{"cpu"}, {"mem"}}, rows[0].Values)
+
+	// any other error is returned at once
+	mc.calls = 0
+	mc.err = errors.New("measurements unavailable")
+	ctx = &query.ExecutionContext{Context: context.Background(), Results: make(chan *query.Result, 1)}
+	assert.EqualError(t, e.ExecuteStatement(stmt, ctx, 0), "measurements unavailable")
+	assert.Equal(t, 1, mc.calls)
+}
+
+type mockManyMeasurementsMetaClient struct {
+	MockMetaClient
+	names []string
+}
+
+func (m *mockManyMeasurementsMetaClient) Measurements(_ string, _ influxql.Measurements) ([]string, error) {
+	return m.names, nil
+}
+
+func TestStatementExecutor_executeShowMeasurementsStatement_Chunked(t *testing.T) {
+	mc := &mockManyMeasurementsMetaClient{}
+	for i := 0; i < 5; i++ {
+		mc.names = append(mc.names, fmt.Sprintf("mst%d", i))
+	}
+	e := &StatementExecutor{MetaClient: mc, StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown)}
+	stmt := &influxql.ShowMeasurementsStatement{Database: "db0"}
+	tm := query.NewTaskManager()
+	tm.Register = mockQueryIDRegister{}
+	show := func(opt query.ExecutionOptions) []*query.Result {
+		ctx, detach, err := tm.AttachQuery(&influxql.Query{Statements: influxql.Statements{stmt}}, opt, nil, nil)
+		require.NoError(t, err)
+		defer detach()
+		ctx.Results = make(chan *query.Result, len(mc.names))
+		require.NoError(t, e.executeShowMeasurementsStatement(stmt, ctx, 0))
+		close(ctx.Results)
+		var results []*query.Result
+		for r := range ctx.Results {
+			results = append(results, r)
+		}
+		return results
+	}
+
+	// the measurements are sent in chunks of the chunk size
+	results := show(query.ExecutionOptions{Chunked: true, ChunkSize: 2})
+	require.Len(t, results, 3)
+	var names []interface{}
+	for i, r := range results {
+		require.Len(t, r.Series, 1)
+		assert.Equal(t, i < 2, r.Partial)
+		assert.LessOrEqual(t, len(r.Series[0].Values), 2)
+		for _, v := range r.Series[0].Values {
+			names = append(names, v[0])
+		}
+	}
+	assert.Equal(t, []interface{}{"mst0", "mst1", "mst2", "mst3", "mst4"}, names)
+
+	// all the measurements are sent at once without chunking
+	results = show(query.ExecutionOptions{ChunkSize: 2})
+	require.Len(t, results, 1)
+	assert.False(t, results[0].Partial)
+	assert.Len(t, results[0].Series[0].Values, 5)
+}
+
+func BenchmarkStatementExecutor_ShowMeasurements(b *testing.B) {
+	e := &StatementExecutor{MetaClient: &mockShowMeasurementsMetaClient{}, StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown)}
+	stmt := &influxql.ShowMeasurementsStatement{Database: "db0"}
+	ctx := &query.ExecutionContext{Context: context.Background(), Results: make(chan *query.Result, 1)}
+
+	b.Run("retry", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := e.retryExecuteStatement(stmt, ctx, 0); err != nil {
+				b.Fatal(err)
+			}
+			// the context has no task, the result may not be sent
+			select {
+			case <-ctx.Results:
+			default:
+			}
+		}
+	})
+	b.Run("once", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if err := e.executeShowMeasurementsOnce(stmt, ctx, 0); err != nil {
+				b.Fatal(err)
+			}
+			// the context has no task, the result may not be sent
+			select {
+			case <-ctx.Results:
+			default:
+			}
+		}
+	})
+}
+
+func TestStatementExecutor_executeShowMeasurementCardinality_Condition(t *testing.T) {
+	mc := &mockShowMeasurementsMetaClient{mockFieldKeysMetaClient{fields: map[string]map[string]int32{
+		"cpu": {"usage": influx.Field_Type_Float},
+		"mem": {"used": influx.Field_Type_Int},
+	}}}
+	ns := &mockSeriesNS{cardinality: map[uint64]map[string]uint64{1: {"cpu": 2}, 2: {"cpu": 1, "mem": 3}}}
+	e := &StatementExecutor{
+		MetaClient:     mc,
+		NetStorage:     ns,
+		MetaExecutor:   &coordinator.MetaExecutor{MetaClient: mc, Logger: Logger.NewLogger(errno.ModuleUnknown)},
+		StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown),
+	}
+	count := func(exact bool, cond string) (interface{}, error) {
+		stmt := &influxql.ShowMeasurementCardinalityStatement{Database: "db0", Exact: exact, Condition: influxql.MustParseExpr(cond)}
+		rows, err := e.executeShowMeasurementCardinalityStatement(stmt)
+		if err != nil {
+			return nil, err
+		}
+		require.Len(t, rows, 1)
+		assert.Equal(t, []string{"count"}, rows[0].Columns)
+		return rows[0].Values[0][0], nil
+	}
+
+	for _, exact := range []bool{true, false} {
+		ns.cardinality = map[uint64]map[string]uint64{1: {"cpu": 2}, 2: {"cpu": 1, "mem": 3}}
+		n, err := count(exact, "host = 'a'")
+		require.NoError(t, err)
+		assert.Equal(t, 2, n)
+
+		ns.cardinality = map[uint64]map[string]uint64{2: {"cpu": 1}}
+		n, err = count(exact, "host = 'a'")
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+
+		ns.cardinality = nil
+		n, err = count(exact, "host = 'b'")
+		require.NoError(t, err)
+		assert.Equal(t, 0, n)
+
+		// the condition can not filter on fields
+		_, err = count(exact, "used > 1")
+		require.EqualError(t, err, "only tag predicates are supported in the WHERE clause of SHOW MEASUREMENT CARDINALITY, used is a field")
+	}
+}
+
+func collectResults(ctx *query.ExecutionContext) models.Rows {
+	close(ctx.Results)
+	var rows models.Rows
+	for r := range ctx.Results {
+		rows = append(rows, r.Series...)
+	}
+	return rows
+}
+
+func TestStatementExecutor_executeShowFieldKeyCardinality_Condition(t *testing.T) {
+	sources := influxql.Sources{&influxql.Measurement{Name: "cpu"}, &influxql.Measurement{Name: "mem"}}
+	cond := &influxql.BinaryExpr{Op: influxql.EQ, LHS: &influxql.VarRef{Val: "host"}, RHS: &influxql.StringLiteral{Val: "a"}}
+
+	// without condition all the measurements are counted
+	ns := &mockSeriesNS{}
+	e := newMockSeriesStatementExecutor(ns)
+	ctx := &query.ExecutionContext{Context: context.Background(), Results: make(chan *query.Result, 4)}
+	err := e.executeShowFieldKeyCardinality(&influxql.ShowFieldKeyCardinalityStatement{Database: "db0", Sources: sources}, ctx, 0)
+	require.NoError(t, err)
+	assert.Len(t, collectResults(ctx), 2)
+
+	// no series matches the condition, the fields are not counted
+	ctx = &query.ExecutionContext{Context: context.Background(), Results: make(chan *query.Result, 4)}
+	err = e.executeShowFieldKeyCardinality(&influxql.ShowFieldKeyCardinalityStatement{Database: "db0", Sources: sources, Condition: cond}, ctx, 0)
+	require.NoError(t, err)
+	assert.Empty(t, collectResults(ctx))
+}
+
+func TestStatementExecutor_executeShowFieldKeys_Condition(t *testing.T) {
+	sources := influxql.Sources{&influxql.Measurement{Name: "cpu"}, &influxql.Measurement{Name: "mem"}}
+	cond := &influxql.BinaryExpr{Op: influxql.EQ, LHS: &influxql.VarRef{Val: "host"}, RHS: &influxql.StringLiteral{Val: "a"}}
+
+	// without condition all the measurements are listed
+	ns := &mockSeriesNS{}
+	e := newMockSeriesStatementExecutor(ns)
+	ctx := &query.ExecutionContext{Context: context.Background(), Results: make(chan *query.Result, 4)}
+	err := e.executeShowFieldKeys(&influxql.ShowFieldKeysStatement{Database: "db0", Sources: sources}, ctx, 0)
+	require.NoError(t, err)
+	rows := collectResults(ctx)
+	require.Len(t, rows, 2)
+	assert.Equal(t, [][]interface{}{{"idle", "float"}, {"usage", "float"}}, rows[0].Values)
+
+	// no series matches the condition
+	ctx = &query.ExecutionContext{Context: context.Background(), Results: make(chan *query.Result, 4)}
+	err = e.executeShowFieldKeys(&influxql.ShowFieldKeysStatement{Database: "db0", Sources: sources, Condition: cond}, ctx, 0)
+	require.NoError(t, err)
+	assert.Empty(t, collectResults(ctx))
+}
+
+func TestFieldProbeStatement(t *testing.T) {
+	sources := influxql.Measurements{
+		{Database: "db0", RetentionPolicy: "rp0", Name: "cpu"},
+		{Database: "db0", RetentionPolicy: "rp1", Regex: &influxql.RegexLiteral{Val: regexp.MustCompile("^m")}},
+	}
+	cond := influxql.MustParseExpr("host = 'a' AND time > 0")
+
+	stmt := fieldProbeStatement(sourceOfMeasurement(sources, "cpu"), meta.FieldKey{Field: "idle"}, cond)
+	assert.Equal(t, `SELECT idle FROM db0.rp0.cpu WHERE host = 'a' AND time > 0 LIMIT 1`, stmt.String())
+	assert.Equal(t, "db0.rp1.mem", sourceOfMeasurement(sources, "mem").String())
+}
+
+func TestFieldProbeCondition(t *testing.T) {
+	cond := influxql.MustParseExpr("host = 'a' AND time > 0")
+	assert.Equal(t, cond, fieldProbeCondition(cond, time.Now()))
+
+	// the probe without time range looks at the last week
+	now := time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)
+	cond = influxql.MustParseExpr("host = 'a' OR host = 'b'")
+	assert.Equal(t, `(host = 'a' OR host = 'b') AND time >= '2024-01-01T00:00:00Z'`, fieldProbeCondition(cond, now).String())
+}
+
+func TestHasValues(t *testing.T) {
+	assert.True(t, hasValues(models.Rows{{Columns: []string{"idle"}, Values: [][]interface{}{{float64(1)}}}}))
+	// the series matching the condition write nothing
+	assert.False(t, hasValues(models.Rows{{Columns: []string{"idle"}, Values: [][]interface{}{{nil}}}}))
+	assert.False(t, hasValues(nil))
+}
+
+// mockTagKeysNS returns the tag keys of the series matching the condition on each node
+type mockTagKeysNS struct {
+	netstorage.NetStorage
+	tagKeys map[uint64][]string
+}
+
+func (s *mockTagKeysNS) ShowTagKeys(nodeID uint64, _ string, _ []uint32, _ []string, _ influxql.Expr) ([]string, error) {
+	return s.tagKeys[nodeID], nil
+}
+
+func TestStatementExecutor_executeShowTagKeyCardinality_Condition(t *testing.T) {
+	sources := influxql.Sources{&influxql.Measurement{Name: "cpu"}, &influxql.Measurement{Name: "mem"}}
+	cond := &influxql.BinaryExpr{Op: influxql.EQ, LHS: &influxql.VarRef{Val: "region"}, RHS: &influxql.StringLiteral{Val: "us"}}
+
+	ns := &mockTagKeysNS{tagKeys: map[uint64][]string{
+		1: {"cpu,host,region", "mem,region"},
+		2: {"cpu,host,region,zone"},
+	}}
+	e := newMockSeriesStatementExecutor(ns)
+	ctx := &query.ExecutionContext{Context: context.Background(), Results: make(chan *query.Result, 4)}
+	err := e.executeShowTagKeyCardinality(&influxql.ShowTagKeyCardinalityStatement{Database: "db0", Sources: sources, Condition: cond}, ctx, 0)
+	require.NoError(t, err)
+	rows := collectResults(ctx)
+	require.Len(t, rows, 2)
+	assert.Equal(t, "cpu", rows[0].Name)
+	assert.Equal(t, [][]interface{}{{3}}, rows[0].Values)
+	assert.Equal(t, "mem", rows[1].Name)
+	assert.Equal(t, [][]interface{}{{1}}, rows[1].Values)
+
+	// no series matches the condition
+	ns.tagKeys = nil
+	ctx = &query.ExecutionContext{Context: context.Background(), Results: make(chan *query.Result, 4)}
+	err = e.executeShowTagKeyCardinality(&influxql.ShowTagKeyCardinalityStatement{Database: "db0", Sources: sources, Condition: cond}, ctx, 0)
+	require.NoError(t, err)
+	assert.Empty(t, collectResults(ctx))
+}
+
+func TestStatementExecutor_executeShowTagKeys_Condition(t *testing.T) {
+	sources := influxql.Sources{&influxql.Measurement{Name: "cpu"}, &influxql.Measurement{Name: "mem"}}
+	cond := &influxql.BinaryExpr{Op: influxql.EQ, LHS: &influxql.VarRef{Val: "host"}, RHS: &influxql.StringLiteral{Val: "a"}}
+
+	// the tag keys of the series matching the condition, the measurements are listed in order
+	ns := &mockTagKeysNS{tagKeys: map[uint64][]string{
+		1: {"mem,host", "cpu,host"},
+		2: {"cpu,host,region"},
+	}}
+	e := newMockSeriesStatementExecutor(ns)
+	ctx := &query.ExecutionContext{Context: context.Background(), Results: make(chan *query.Result, 4)}
+	err := e.executeShowTagKeys(&influxql.ShowTagKeysStatement{Database: "db0", Sources: sources, Condition: cond}, ctx, 0)
+	require.NoError(t, err)
+	rows := collectResults(ctx)
+	require.Len(t, rows, 2)
+	assert.Equal(t, "cpu", rows[0].Name)
+	assert.Equal(t, [][]interface{}{{"host"}, {"region"}}, rows[0].Values)
+	assert.Equal(t, "mem", rows[1].Name)
+	assert.Equal(t, [][]interface{}{{"host"}}, rows[1].Values)
+
+	ns.tagKeys = nil
+	ctx = &query.ExecutionContext{Context: context.Background(), Results: make(chan *query.Result, 4)}
+	err = e.executeShowTagKeys(&influxql.ShowTagKeysStatement{Database: "db0", Sources: sources, Condition: cond}, ctx, 0)
+	require.NoError(t, err)
+	assert.Empty(t, collectResults(ctx))
+}
+
+type mockUsersMetaClient struct {
+	MockMetaClient
+	users   []meta2.UserInfo
+	dropped []string
+	updated []string
+}
+
+func (m *mockUsersMetaClient) Users() []meta2.UserInfo {
+	return m.users
+}
+
+// DropUser refuses to drop the admin user like the meta client.
+func (m *mockUsersMetaClient) DropUser(name string) error {
+	for i := range m.users {
+		if m.users[i].Name == name && m.users[i].AuthorizeUnrestricted() {
+			return meta2.ErrUserDropSelf
+		}
+	}
+	m.dropped = append(m.dropped, name)
+	return nil
+}
+
+func (m *mockUsersMetaClient) UpdateUser(name, _ string) error {
+	m.updated = append(m.updated, name)
+	return nil
+}
+
+func TestStatementExecutor_executeDropUserStatement(t *testing.T) {
+	newClient := func() *mockUsersMetaClient {
+		return &mockUsersMetaClient{users: []meta2.UserInfo{{Name: "admin", Admin: true}, {Name: "a"}, {Name: "b"}, {Name: "c"}}}
+	}
+
+	mc := newClient()
+	e := &StatementExecutor{MetaClient: mc}
+	require.NoError(t, e.executeDropUserStatement(&influxql.DropUserStatement{Names: []string{"a", "c", "a"}}, "admin"))
+	assert.Equal(t, []string{"a", "c"}, mc.dropped)
+
+	// the statement is rejected before any user is dropped
+	mc = newClient()
+	e = &StatementExecutor{MetaClient: mc}
+	err := e.executeDropUserStatement(&influxql.DropUserStatement{Names: []string{"a", "x", "b"}}, "admin")
+	require.ErrorIs(t, err, meta2.ErrUserNotFound)
+	assert.Contains(t, err.Error(), "x")
+	assert.Empty(t, mc.dropped)
+
+	// a list with the admin user is rejected with the error of DROP USER admin
+	err = e.executeDropUserStatement(&influxql.DropUserStatement{Names: []string{"a", "admin"}}, "admin")
+	assert.Equal(t, meta2.ErrUserDropSelf, err)
+	assert.Empty(t, mc.dropped)
+	assert.Equal(t, err, e.executeDropUserStatement(&influxql.DropUserStatement{Name: "admin"}, "admin"))
+	assert.Empty(t, mc.dropped)
+
+	// the user running the statement can not drop itself
+	err = e.executeDropUserStatement(&influxql.DropUserStatement{Names: []string{"a", "b"}}, "b")
+	assert.Equal(t, meta2.ErrUserDropSelf, err)
+	assert.Empty(t, mc.dropped)
+
+	require.NoError(t, e.executeDropUserStatement(&influxql.DropUserStatement{Name: "b"}, "admin"))
+	assert.Equal(t, []string{"b"}, mc.dropped)
+}
+
+func TestStatementExecutor_executeSetPasswordUserStatement(t *testing.T) {
+	mc := &mockUsersMetaClient{users: []meta2.UserInfo{{Name: "admin", Admin: true}, {Name: "a"}}}
+	e := &StatementExecutor{MetaClient: mc}
+	require.NoError(t, e.executeSetPasswordUserStatement(&influxql.SetPasswordUserStatement{Name: "a", Password: "Aa@123456789"}))
+	assert.Equal(t, []string{"a"}, mc.updated)
+
+	err := e.executeSetPasswordUserStatement(&influxql.SetPasswordUserStatement{Name: "x", Password: "Aa@123456789"})
+	require.True(t, errno.Equal(err, errno.UserNotFound))
+	assert.Contains(t, err.Error(), "user not found: x")
+	assert.Equal(t, []string{"a"}, mc.updated)
+}
+
+type mockPrivilegesMetaClient struct {
+	mockUsersMetaClient
+	privileges map[string]originql.Privilege
+	calls      int
+	loads      int
+}
+
+func (m *mockPrivilegesMetaClient) UserPrivileges(_ string) (map[string]originql.Privilege, error) {
+	m.loads++
+	return m.privileges, nil
+}
+
+func (m *mockPrivilegesMetaClient) SetPrivilege(_, database string, p originql.Privilege) error {
+	m.calls++
+	m.privileges[database] = p
+	return nil
+}
+
+func (m *mockPrivilegesMetaClient) SetAdminPrivilege(username string, admin bool) error {
+	for i := range m.users {
+		if m.users[i].Name == username {
+			m.users[i].Admin = admin
+		}
+	}
+	return nil
+}
+
+// mockCreateUserMetaClient creates the users, granting a privilege on a database not in databases fails.
+type mockCreateUserMetaClient struct {
+	mockPrivilegesMetaClient
+	databases map[string]bool
+	created   []string
+}
+
+func (m *mockCreateUserMetaClient) CreateUser(name, _ string, _, _ bool) (meta2.User, error) {
+	m.created = append(m.created, name)
+	return nil, nil
+}
+
+func (m *mockCreateUserMetaClient) SetPrivilege(username, database string, p originql.Privilege) error {
+	if !m.databases[database] {
+		return query.ErrDatabaseNotFound(database)
+	}
+	return m.mockPrivilegesMetaClient.SetPrivilege(username, database, p)
+}
+
+func TestStatementExecutor_executeCreateUserStatement_Grants(t *testing.T) {
+	newClient := func() *mockCreateUserMetaClient {
+		return &mockCreateUserMetaClient{
+			mockPrivilegesMetaClient: mockPrivilegesMetaClient{privileges: map[string]originql.Privilege{}},
+			databases:                map[string]bool{"db1": true, "db2": true},
+		}
+	}
+	parse := func(sql string) *influxql.CreateUserStatement {
+		YyParser := &influxql.YyParser{Query: influxql.Query{}}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(sql))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		require.NoError(t, err)
+		return q.Statements[0].(*influxql.CreateUserStatement)
+	}
+
+	// the user is created with its privileges
+	mc := newClient()
+	e := &StatementExecutor{MetaClient: mc, StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown)}
+	stmt := parse("CREATE USER u WITH PASSWORD 'Aa@123456789' GRANT READ ON db1, WRITE ON db2")
+	assert.Equal(t, "CREATE USER u WITH PASSWORD [REDACTED] GRANT READ ON db1, WRITE ON db2", stmt.String())
+	require.NoError(t, e.executeCreateUserStatement(stmt))
+	assert.Equal(t, []string{"u"}, mc.created)
+	assert.Equal(t, map[string]originql.Privilege{"db1": originql.ReadPrivilege, "db2": originql.WritePrivilege}, mc.privileges)
+	assert.Empty(t, mc.dropped)
+
+	// the user is dropped if a privilege can not be granted
+	mc = newClient()
+	e = &StatementExecutor{MetaClient: mc, StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown)}
+	stmt = parse("CREATE USER u WITH PASSWORD 'Aa@123456789' GRANT ALL ON db1, READ ON db3")
+	err := e.executeCreateUserStatement(stmt)
+	require.EqualError(t, err, "grant READ on db3: database not found: db3")
+	assert.Equal(t, []string{"u"}, mc.created)
+	assert.Equal(t, []string{"u"}, mc.dropped)
+}
+
+func TestStatementExecutor_executeRevokeAllStatement(t *testing.T) {
+	mc := &mockPrivilegesMetaClient{
+		mockUsersMetaClient: mockUsersMetaClient{users: []meta2.UserInfo{{Name: "a", Admin: true}}},
+		privileges: map[string]originql.Privilege{
+			"db0": originql.AllPrivileges,
+			"db1": originql.ReadPrivilege,
+			"db2": originql.WritePrivilege,
+		},
+	}
+	e := &StatementExecutor{MetaClient: mc}
+	expected := map[string]originql.Privilege{
+		"db0": originql.NoPrivileges,
+		"db1": originql.NoPrivileges,
+		"db2": originql.NoPrivileges,
+	}
+
+	require.NoError(t, e.executeRevokeAllStatement(&influxql.RevokeAllStatement{User: "a"}))
+	assert.Equal(t, expected, mc.privileges)
+	assert.Equal(t, 3, mc.calls)
+	assert.False(t, mc.users[0].Admin)
+
+	// revoking again changes nothing
+	require.NoError(t, e.executeRevokeAllStatement(&influxql.RevokeAllStatement{User: "a"}))
+	assert.Equal(t, expected, mc.privileges)
+	assert.Equal(t, 3, mc.calls)
+	assert.False(t, mc.users[0].Admin)
+}
+
+func TestStatementExecutor_PrivilegeCache(t *testing.T) {
+	mc := &mockPrivilegesMetaClient{
+		mockUsersMetaClient: mockUsersMetaClient{users: []meta2.UserInfo{{Name: "a"}}},
+		privileges:          map[string]originql.Privilege{"db0": originql.ReadPrivilege},
+	}
+	e := &StatementExecutor{MetaClient: mc, PrivilegeCacheTTL: time.Hour}
+	// the privileges of the authenticated user are outdated, the cached ones are used
+	ctx := &query.ExecutionContext{ExecutionOptions: query.ExecutionOptions{Authorizer: &meta2.UserInfo{Name: "a"}}}
+
+	require.NoError(t, e.authorize(ctx, "db0", originql.ReadPrivilege))
+	require.NoError(t, e.authorize(ctx, "db0", originql.ReadPrivilege))
+	require.True(t, errno.Equal(e.authorize(ctx, "db1", originql.ReadPrivilege), errno.NoDatabasePrivilege))
+	assert.Equal(t, 1, mc.loads)
+
+	// a grant drops the cached privileges of the user
+	require.NoError(t, e.executeGrantStatement(&influxql.GrantStatement{Privilege: influxql.ReadPrivilege, On: "db1", User: "a"}))
+	require.NoError(t, e.authorize(ctx, "db1", originql.ReadPrivilege))
+	assert.Equal(t, 2, mc.loads)
+
+	// the privileges are loaded again once expired
+	e.PrivilegeCacheTTL = time.Millisecond
+	e.privileges.invalidate("a")
+	require.NoError(t, e.authorize(ctx, "db0", originql.ReadPrivilege))
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, e.authorize(ctx, "db0", originql.ReadPrivilege))
+	assert.Equal(t, 4, mc.loads)
+
+	// the cache is not used if disabled
+	e.PrivilegeCacheTTL = 0
+	require.True(t, errno.Equal(e.authorize(ctx, "db0", originql.ReadPrivilege), errno.NoDatabasePrivilege))
+	assert.Equal(t, 4, mc.loads)
+}
+
+func TestStatementExecutor_executeShowConfigs_Component(t *testing.T) {
+	e := &StatementExecutor{Hostname: "127.0.0.1:8086", SqlConfigs: map[string]interface{}{"http.bind-address": "127.0.0.1:8086"}}
+
+	for _, component := range []string{"", "sql"} {
+		rows, err := e.executeShowConfigs(&influxql.ShowConfigsStatement{Component: component}, nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		require.Len(t, rows[0].Values, 7)
+		assert.Equal(t, []interface{}{"sql", "127.0.0.1:8086", "http.bind-address", "127.0.0.1:8086"}, rows[0].Values[0])
+		assert.Equal(t, "logging.level", rows[0].Values[1][2])
+	}
+
+	// the configs of the store and meta nodes are not known by the sql node
+	_, err := e.executeShowConfigs(&influxql.ShowConfigsStatement{Component: "store"}, nil)
+	require.EqualError(t, err, "the configs of the store nodes are not available from a sql node")
+	_, err = e.executeShowConfigs(&influxql.ShowConfigsStatement{Component: "meta"}, nil)
+	require.EqualError(t, err, "the configs of the meta nodes are not available from a sql node")
+
+	_, err = e.executeShowConfigs(&influxql.ShowConfigsStatement{Component: "unknown"}, nil)
+	require.EqualError(t, err, "unknown config component: unknown")
+}
+
+func TestStatementExecutor_executeShowConfigs_Condition(t *testing.T) {
+	e := &StatementExecutor{Hostname: "127.0.0.1:8086", SqlConfigs: map[string]interface{}{
+		"http.bind-address":      "127.0.0.1:8086",
+		"http.auth-enabled":      false,
+		"coordinator.shard-tier": "warm",
+	}}
+	showConfigs := func(cond string) ([]string, error) {
+		YyParser := &influxql.YyParser{Query: influxql.Query{}}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader("SHOW CONFIGS WHERE " + cond))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		require.NoError(t, err)
+		rows, err := e.executeShowConfigs(q.Statements[0].(*influxql.ShowConfigsStatement), nil)
+		if err != nil {
+			return nil, err
+		}
+		names := make([]string, 0, len(rows[0].Values))
+		for _, v := range rows[0].Values {
+			names = append(names, v[2].(string))
+		}
+		return names, nil
+	}
+
+	names, err := showConfigs(`component = 'sql' AND name =~ /http\..*/`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"http.auth-enabled", "http.bind-address"}, names)
+
+	names, err = showConfigs(`name = 'logging.level' OR name = 'coordinator.shard-tier'`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"coordinator.shard-tier", "logging.level"}, names)
+
+	names, err = showConfigs(`component =~ /store|meta/`)
+	require.NoError(t, err)
+	assert.Empty(t, names)
+
+	names, err = showConfigs(`name = 'unknown'`)
+	require.NoError(t, err)
+	assert.Empty(t, names)
+
+	_, err = showConfigs(`value = 'warm'`)
+	require.EqualError(t, err, "SHOW CONFIGS can only filter on component and name, not value")
+}
+
+func TestStatementExecutor_executeShowConfigs_SelectSpec(t *testing.T) {
+	schemaLimit := sysconfig.GetQuerySchemaLimit()
+	defer sysconfig.SetQuerySchemaLimit(schemaLimit)
+	sysconfig.SetQuerySchemaLimit(50)
+
+	e := &StatementExecutor{Hostname: "127.0.0.1:8086", SqlConfigs: map[string]interface{}{"http.bind-address": "127.0.0.1:8086"},
+		MaxSelectPointN: 10, MaxSelectSeriesN: 20, MaxSelectFieldsN: 30, MaxSelectBucketsN: 40}
+	rows, err := e.executeShowConfigs(&influxql.ShowConfigsStatement{Component: "sql"}, nil)
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	require.Len(t, rows[0].Values, 7)
+	assert.Equal(t, [][]interface{}{
+		{"sql", "127.0.0.1:8086", "spec-limit.max-select-buckets-n", int64(40)},
+		{"sql", "127.0.0.1:8086", "spec-limit.max-select-fields-n", int64(30)},
+		{"sql", "127.0.0.1:8086", "spec-limit.max-select-point-n", int64(10)},
+		{"sql", "127.0.0.1:8086", "spec-limit.max-select-series-n", int64(20)},
+		{"sql", "127.0.0.1:8086", "spec-limit.query-schema-limit", int64(50)},
+	}, rows[0].Values[2:])
+
+	// neither the spec limits nor the logging level are added to the sql configs
+	assert.Len(t, e.SqlConfigs, 1)
+}
+
+func TestStatementExecutor_executeShowConfigs_ValueTypes(t *testing.T) {
+	e := &StatementExecutor{Hostname: "127.0.0.1:8086", SqlConfigs: config.NewTSSql(false).ShowConfigs()}
+	rows, err := e.executeShowConfigs(&influxql.ShowConfigsStatement{}, nil)
+	require.NoError(t, err)
+
+	buf, err := json.Marshal(rows[0].Values)
+	require.NoError(t, err)
+	var values [][]interface{}
+	require.NoError(t, json.Unmarshal(buf, &values))
+	configs := make(map[string]interface{}, len(values))
+	for _, v := range values {
+		configs[v[2].(string)] = v[3]
+	}
+
+	assert.IsType(t, float64(0), configs["coordinator.max-query-mem"])
+	assert.IsType(t, float64(0), configs["coordinator.max-concurrent-queries"])
+	assert.IsType(t, true, configs["spdy.tls-enable"])
+	assert.Equal(t, "0s", configs["coordinator.query-timeout"])
+	assert.IsType(t, "", configs["logging.level"])
+	assert.IsType(t, []interface{}{}, configs["coordinator.time-range-limit"])
+}
+
+func TestStatementExecutor_executeSetConfig_LoggingFormat(t *testing.T) {
+	c := config.NewTSSql(false)
+	e := newMockStatementExecutor()
+	e.SqlConfig = c
+	e.SqlConfigs = c.ShowConfigs()
+	defer func() {
+		_ = Logger.SetFormat(Logger.FormatAuto)
+	}()
+	showFormat := func() interface{} {
+		rows, err := e.executeShowConfigs(&influxql.ShowConfigsStatement{Component: sqlConfig}, nil)
+		require.NoError(t, err)
+		for _, v := range rows[0].Values {
+			if v[2] == loggingFormat {
+				return v[3]
+			}
+		}
+		return nil
+	}
+
+	stmt := &influxql.SetConfigStatement{Component: sqlConfig, Key: "logging.format", Value: "console"}
+	require.NoError(t, e.executeSetConfig(stmt))
+	assert.Equal(t, "console", Logger.Format())
+	assert.Equal(t, "console", showFormat())
+	// the format is read from the logger, the shared configs are not written
+	assert.Equal(t, "auto", e.SqlConfigs["logging.format"])
+
+	// the unknown formats are rejected and the format is kept
+	stmt.Value = "text"
+	require.EqualError(t, e.executeSetConfig(stmt), `unknown logging format "text", expect auto, json or console`)
+	assert.Equal(t, "console", showFormat())
+	stmt.Value = int64(1)
+	require.EqualError(t, e.executeSetConfig(stmt), "illegal type of logging format input")
+
+	stmt.Value = "json"
+	require.NoError(t, e.executeSetConfig(stmt))
+	assert.Equal(t, "json", showFormat())
+}
+
+func TestStatementExecutor_executeShowConfigs_Mask(t *testing.T) {
+	e := &StatementExecutor{Hostname: "127.0.0.1:8086", SqlConfigs: map[string]interface{}{
+		"http.bind-address":      "127.0.0.1:8086",
+		"http.shared-secret":     "secret",
+		"http.https-private-key": "",
+		"spdy.tls-private-key":   "/etc/ssl/key.pem",
+		"spdy.tls-ca-root":       "/etc/ssl/ca.pem",
+	}}
+	show := func(u *meta2.UserInfo) map[string]interface{} {
+		ctx := &query.ExecutionContext{ExecutionOptions: query.ExecutionOptions{Authorizer: u}}
+		rows, err := e.executeShowConfigs(&influxql.ShowConfigsStatement{}, ctx)
+		require.NoError(t, err)
+		configs := make(map[string]interface{}, len(rows[0].Values))
+		for _, v := range rows[0].Values {
+			configs[v[2].(string)] = v[3]
+		}
+		return configs
+	}
+
+	configs := show(&meta2.UserInfo{Name: "user", Rwuser: true})
+	assert.Equal(t, "127.0.0.1:8086", configs["http.bind-address"])
+	assert.Equal(t, "***", configs["http.shared-secret"])
+	assert.Equal(t, "", configs["http.https-private-key"])
+	assert.Equal(t, "***", configs["spdy.tls-private-key"])
+	assert.Equal(t, "***", configs["spdy.tls-ca-root"])
+
+	configs = show(&meta2.UserInfo{Name: "admin", Admin: true})
+	assert.Equal(t, "secret", configs["http.shared-secret"])
+	assert.Equal(t, "/etc/ssl/key.pem", configs["spdy.tls-private-key"])
+	assert.Equal(t, "/etc/ssl/ca.pem", configs["spdy.tls-ca-root"])
+}
+
+func TestStatementExecutor_executeCheckConfig(t *testing.T) {
+	e := &StatementExecutor{}
+	_, err := e.executeCheckConfig()
+	require.Error(t, err)
+
+	failures := func(rows models.Rows) map[string]string {
+		require.Len(t, rows, 1)
+		assert.Equal(t, []string{"check", "status", "detail"}, rows[0].Columns)
+		ret := make(map[string]string)
+		for _, v := range rows[0].Values {
+			if v[1] == "fail" {
+				ret[v[0].(string)] = v[2].(string)
+			}
+		}
+		return ret
+	}
+
+	e.SqlConfig = config.NewTSSql(false)
+	rows, err := e.executeCheckConfig()
+	require.NoError(t, err)
+	assert.Empty(t, failures(rows))
+
+	e.SqlConfig.HTTP.FlightAuthEnabled = true
+	e.SqlConfig.HTTP.MaxEnqueuedQueryLimit = 10
+	e.SqlConfig.Coordinator.WriteTimeout = -1
+	rows, err = e.executeCheckConfig()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"coordinator":                   "coordinator write-timeout can not be negative",
+		"http.flight-auth-enabled":      "http flight-auth-enabled requires flight-enabled",
+		"http.max-enqueued-query-limit": "http max-enqueued-query-limit requires max-concurrent-query-limit",
+	}, failures(rows))
 }
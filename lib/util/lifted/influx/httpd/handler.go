@@ -233,6 +233,10 @@ func NewHandler(c config.Config) *Handler {
 			"query", // Query serving route.
 			"POST", "/query", true, true, h.serveQuery,
 		},
+		Route{
+			"query-events", // Query start and completion events as server-sent events.
+			"GET", "/query/events", true, true, h.serveQueryEvents,
+		},
 		Route{
 			"write-options", // Satisfy CORS checks.
 			"OPTIONS", "/write", false, true, h.serveOptions,
@@ -643,6 +647,52 @@ func (h *Handler) serveSysCtrl(w http.ResponseWriter, r *http.Request, user meta
 	h.serveDebug(w, r)
 }
 
+// serveQueryEvents streams the query start and completion events as server-sent events until the client disconnects.
+func (h *Handler) serveQueryEvents(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	if h.Config.AuthEnabled && (user == nil || !user.AuthorizeUnrestricted()) {
+		h.httpError(w, "error authorizing, requires admin privilege only", http.StatusForbidden)
+		return
+	}
+	if h.QueryExecutor == nil || h.QueryExecutor.EventBus == nil {
+		h.httpError(w, "query events are not available", http.StatusServiceUnavailable)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		h.httpError(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	bus := h.QueryExecutor.EventBus
+	sub := bus.Subscribe()
+	defer bus.Unsubscribe(sub)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	h.writeHeader(w, http.StatusOK)
+	flusher.Flush()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case ev, ok := <-sub.C:
+			if !ok {
+				return
+			}
+			b, err := json.Marshal(ev)
+			if err != nil {
+				h.Logger.Error("marshal query event failed", zap.Error(err))
+				continue
+			}
+			if _, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", ev.Type, b); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
 func (h *Handler) getQueryFromRequest(r *http.Request, param *QueryParam, user meta2.User) string {
 	var qp string
 	if param == nil {
@@ -874,6 +924,9 @@ func (h *Handler) updateStmtId2Result(r *query.Result, stmtID2Result map[int]*qu
 		// Append remaining rows as new rows.
 		r.Series = r.Series[rowsMerged:]
 		cr.Series = append(cr.Series, r.Series...)
+		if len(cr.ColumnTypes) == 0 {
+			cr.ColumnTypes = r.ColumnTypes
+		}
 		cr.Messages = append(cr.Messages, r.Messages...)
 		cr.Partial = r.Partial
 	} else {
@@ -1093,6 +1146,14 @@ func (h *Handler) serveQuery(w http.ResponseWriter, r *http.Request, user meta2.
 		ParallelQuery:   atomic.LoadInt32(&syscontrol.ParallelQueryInBatch) == 1,
 		Quiet:           true,
 		Authorizer:      h.getAuthorizer(user),
+		RemoteAddr:      r.RemoteAddr,
+		ReportShards:    r.FormValue("report_shards") == "true",
+		ReportQueryID:   r.FormValue("report_qid") == "true",
+
+		ReportColumnTypes: r.FormValue("column_types") == "true",
+	}
+	if user != nil {
+		opts.UserID = user.ID()
 	}
 
 	// Make sure if the client disconnects we signal the query to abort
@@ -1114,6 +1175,15 @@ func (h *Handler) serveQuery(w http.ResponseWriter, r *http.Request, user meta2.
 		}()
 	}
 
+	// The rate and concurrency limits are checked before the query is executed, so that a rejected query
+	// is answered with 429 and Retry-After before the status header is written.
+	if err := h.QueryExecutor.CheckQueryLimits(q, opts); err != nil {
+		setRetryAfter(rw, err)
+		h.httpError(rw, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+	opts.LimitsChecked = true
+
 	// Execute query
 	results := h.QueryExecutor.ExecuteQuery(q, opts, closing, qDuration)
 
@@ -1146,7 +1216,14 @@ func (h *Handler) serveQuery(w http.ResponseWriter, r *http.Request, user meta2.
 			continue
 		}
 
+		if r.Err != nil {
+			h.logQueryRetries(db, r.Err)
+		}
 		if isPipe && r.Err != nil {
+			if setRetryAfter(rw, r.Err) {
+				h.httpError(rw, r.Err.Error(), http.StatusTooManyRequests)
+				return
+			}
 			h.httpError(rw, r.Err.Error(), http.StatusBadRequest)
 			return
 		}
@@ -1824,6 +1901,26 @@ func (h *Handler) httpError(w http.ResponseWriter, errmsg string, code int) {
 	w.Write(b)
 }
 
+// setRetryAfter sets the Retry-After header in whole seconds, rounded up, if err suggests a retry delay.
+func setRetryAfter(w http.ResponseWriter, err error) bool {
+	d := errno.RetryAfter(err)
+	if d <= 0 {
+		return false
+	}
+	w.Header().Set("Retry-After", strconv.FormatInt(int64((d+time.Second-1)/time.Second), 10))
+	return true
+}
+
+// logQueryRetries logs how many times and how long a failed statement was retried, if it was.
+func (h *Handler) logQueryRetries(db string, err error) {
+	retries, wait := errno.Retries(err)
+	if retries == 0 {
+		return
+	}
+	h.Logger.Warn("query failed after retries", zap.String("db", db), zap.Uint32("retries", retries),
+		zap.Duration("wait", wait), zap.Error(err))
+}
+
 // Filters and filter helpers
 
 type credentials struct {
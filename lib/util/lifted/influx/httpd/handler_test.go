@@ -2,6 +2,8 @@ package httpd
 
 import (
 	"bufio"
+	"context"
+	"errors"
 	"fmt"
 	"math"
 	"net/http"
@@ -22,7 +24,9 @@ import (
 	"github.com/openGemini/openGemini/lib/record"
 	"github.com/openGemini/openGemini/lib/syscontrol"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/config"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
 	"github.com/openGemini/openGemini/lib/util/lifted/promql2influxql"
 	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
 	"github.com/stretchr/testify/assert"
@@ -739,3 +743,116 @@ func TestMergeFragments(t *testing.T) {
 	fragments = mergeFragments(fragments)
 	assert.Equal(t, 2, len(fragments))
 }
+
+func TestSetRetryAfter(t *testing.T) {
+	w := httptest.NewRecorder()
+	assert.False(t, setRetryAfter(w, errno.NewError(errno.RateLimited, "db0")))
+	assert.Equal(t, "", w.Header().Get("Retry-After"))
+
+	// rounded up to whole seconds
+	assert.True(t, setRetryAfter(w, errno.NewError(errno.RateLimited, "db0").SetRetryAfter(1500*time.Millisecond)))
+	assert.Equal(t, "2", w.Header().Get("Retry-After"))
+}
+
+// limitedStatementExecutor rejects queries with limitErr before they are executed, and fails every
+// statement with err.
+type limitedStatementExecutor struct {
+	limitErr error
+	err      error
+
+	// whether the executed statements were told that the limits were checked
+	limitsChecked []bool
+}
+
+func (e *limitedStatementExecutor) CheckQueryLimits(_ *influxql.Query, _ query.ExecutionOptions) error {
+	return e.limitErr
+}
+
+func (e *limitedStatementExecutor) ExecuteStatement(_ influxql.Statement, ctx *query.ExecutionContext, _ int) error {
+	e.limitsChecked = append(e.limitsChecked, ctx.LimitsChecked)
+	return e.err
+}
+
+func (e *limitedStatementExecutor) Statistics(buffer []byte) ([]byte, error) {
+	return buffer, nil
+}
+
+type mockQueryIDRegister struct{}
+
+func (r *mockQueryIDRegister) RetryRegisterQueryIDOffset(_ string) (uint64, error) {
+	return 0, nil
+}
+
+func TestHandler_ServeQueryRetryAfter(t *testing.T) {
+	h := Handler{
+		Config:         &config.Config{},
+		QueryExecutor:  query.NewExecutor(1),
+		requestTracker: httpd.NewRequestTracker(),
+		Logger:         logger.NewLogger(errno.ModuleHTTP),
+	}
+	h.QueryExecutor.TaskManager.Register = &mockQueryIDRegister{}
+	se := &limitedStatementExecutor{limitErr: errno.NewError(errno.RateLimited, "db0").SetRetryAfter(1500 * time.Millisecond)}
+	h.QueryExecutor.StatementExecutor = se
+
+	// a query rejected by the limits is answered with 429 and Retry-After without being executed
+	w := httptest.NewRecorder()
+	h.serveQuery(w, httptest.NewRequest(http.MethodGet, "/query?db=db0&q=SHOW+MEASUREMENTS", nil), nil)
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "2", w.Header().Get("Retry-After"))
+	assert.Empty(t, se.limitsChecked)
+
+	// failures of the execution keep the OK status with the error in the results
+	se.limitErr, se.err = nil, errors.New("failed")
+	w = httptest.NewRecorder()
+	h.serveQuery(w, httptest.NewRequest(http.MethodGet, "/query?db=db0&q=SHOW+MEASUREMENTS", nil), nil)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "", w.Header().Get("Retry-After"))
+	assert.Contains(t, w.Body.String(), "failed")
+	assert.Equal(t, []bool{true}, se.limitsChecked)
+}
+
+// notifyRecorder signals every flush so that the test can wait for a streamed event.
+type notifyRecorder struct {
+	*httptest.ResponseRecorder
+	flushed chan struct{}
+}
+
+func (r *notifyRecorder) Flush() {
+	r.ResponseRecorder.Flush()
+	r.flushed <- struct{}{}
+}
+
+func TestHandler_ServeQueryEvents(t *testing.T) {
+	h := Handler{Config: &config.Config{}, QueryExecutor: query.NewExecutor(1), Logger: logger.NewLogger(errno.ModuleHTTP)}
+	bus := h.QueryExecutor.EventBus
+
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &notifyRecorder{ResponseRecorder: httptest.NewRecorder(), flushed: make(chan struct{}, 2)}
+	req := httptest.NewRequest(http.MethodGet, "/query/events", nil).WithContext(ctx)
+	done := make(chan struct{})
+	go func() {
+		h.serveQueryEvents(w, req, nil)
+		close(done)
+	}()
+
+	// the header is flushed once subscribed
+	<-w.flushed
+	assert.True(t, bus.HasSubscribers())
+	bus.Publish(&query.QueryEvent{Type: query.QueryEventComplete, QueryID: 1, Database: "db0", Status: query.QueryStatusSuccess})
+	<-w.flushed
+	cancel()
+	<-done
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+	body := w.Body.String()
+	assert.True(t, strings.HasPrefix(body, "event: complete\ndata: {"), body)
+	assert.Contains(t, body, `"qid":1,"database":"db0"`)
+	assert.False(t, bus.HasSubscribers())
+
+	// only admin users can watch the events when authentication is enabled
+	h.Config.AuthEnabled = true
+	rec := httptest.NewRecorder()
+	h.serveQueryEvents(rec, httptest.NewRequest(http.MethodGet, "/query/events", nil), nil)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
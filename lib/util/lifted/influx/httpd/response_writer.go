@@ -379,6 +379,9 @@ func (f *msgpackFormatter) WriteResponse(w io.Writer, resp Response) (err error)
 			if len(result.Messages) > 0 {
 				sz++
 			}
+			if len(result.ColumnTypes) > 0 {
+				sz++
+			}
 			if result.Partial {
 				sz++
 			}
@@ -396,6 +399,13 @@ func (f *msgpackFormatter) WriteResponse(w io.Writer, resp Response) (err error)
 					enc.WriteString(msg.Text)
 				}
 			}
+			if len(result.ColumnTypes) > 0 {
+				enc.WriteString("column_types")
+				enc.WriteArrayHeader(uint32(len(result.ColumnTypes)))
+				for _, typ := range result.ColumnTypes {
+					enc.WriteString(typ)
+				}
+			}
 			enc.WriteString("series")
 			enc.WriteArrayHeader(uint32(len(result.Series)))
 			for _, series := range result.Series {
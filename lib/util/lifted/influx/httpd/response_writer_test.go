@@ -17,10 +17,13 @@ limitations under the License.
 package httpd
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/influxdata/influxdb/models"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type mockWriter struct {
@@ -91,3 +94,27 @@ func BenchmarkSonicJsonFormatter(b *testing.B) {
 		f.WriteResponse(writer, resp)
 	}
 }
+
+func TestJsonFormatter_ColumnTypes(t *testing.T) {
+	columns := []string{"time", "value", "host"}
+	types := []string{"time", "float", "tag"}
+	h := &Handler{}
+	stmtID2Result := map[int]*query.Result{}
+	require.True(t, h.updateStmtId2Result(&query.Result{Series: models.Rows{
+		{Name: "cpu", Columns: columns, Values: [][]interface{}{{int64(1), nil, "h1"}}, Partial: true},
+	}, Partial: true}, stmtID2Result))
+	// the types of the columns come with the next chunk of the statement
+	require.True(t, h.updateStmtId2Result(&query.Result{Series: models.Rows{
+		{Name: "cpu", Columns: columns, Values: [][]interface{}{{int64(2), nil, "h1"}}},
+	}, ColumnTypes: types}, stmtID2Result))
+	assert.Equal(t, types, stmtID2Result[0].ColumnTypes)
+
+	var buf bytes.Buffer
+	require.NoError(t, (&jsonFormatter{}).WriteResponse(&buf, Response{Results: []*query.Result{stmtID2Result[0]}}))
+	assert.Contains(t, buf.String(), `"column_types":["time","float","tag"]`)
+
+	// nothing is written if the types are not reported
+	buf.Reset()
+	require.NoError(t, (&jsonFormatter{}).WriteResponse(&buf, Response{Results: []*query.Result{{Series: models.Rows{{Name: "cpu", Columns: columns}}}}}))
+	assert.NotContains(t, buf.String(), "column_types")
+}
@@ -295,6 +295,7 @@ func (*GrantAdminStatement) node()                 {}
 func (*KillQueryStatement) node()                  {}
 func (*RevokeStatement) node()                     {}
 func (*RevokeAdminStatement) node()                {}
+func (*RevokeAllStatement) node()                  {}
 func (*SelectStatement) node()                     {}
 func (*SetPasswordUserStatement) node()            {}
 func (*ShowContinuousQueriesStatement) node()      {}
@@ -484,6 +485,7 @@ func (*ShowTagValuesStatement) stmt()              {}
 func (*ShowUsersStatement) stmt()                  {}
 func (*RevokeStatement) stmt()                     {}
 func (*RevokeAdminStatement) stmt()                {}
+func (*RevokeAllStatement) stmt()                  {}
 func (*SelectStatement) stmt()                     {}
 func (*SetPasswordUserStatement) stmt()            {}
 func (*PrepareSnapshotStatement) stmt()            {}
@@ -1022,6 +1024,9 @@ type CreateUserStatement struct {
 
 	//rwuser
 	Rwuser bool
+
+	// Privileges granted to the user on databases once it is created.
+	Grants []*GrantStatement
 }
 
 // String returns a string representation of the create user statement.
@@ -1034,6 +1039,16 @@ func (s *CreateUserStatement) String() string {
 	if s.Admin {
 		_, _ = buf.WriteString(" WITH ALL PRIVILEGES")
 	}
+	for i, grant := range s.Grants {
+		if i == 0 {
+			_, _ = buf.WriteString(" GRANT ")
+		} else {
+			_, _ = buf.WriteString(", ")
+		}
+		_, _ = buf.WriteString(grant.Privilege.String())
+		_, _ = buf.WriteString(" ON ")
+		_, _ = buf.WriteString(QuoteIdent(grant.On))
+	}
 	return buf.String()
 }
 
@@ -1046,16 +1061,38 @@ func (s *CreateUserStatement) RequiredPrivileges() (ExecutionPrivileges, error)
 type DropUserStatement struct {
 	// Name of the user to drop.
 	Name string
+
+	// Names of the users to drop by DROP USER IN (...).
+	Names []string
 }
 
 // String returns a string representation of the drop user statement.
 func (s *DropUserStatement) String() string {
 	var buf bytes.Buffer
 	_, _ = buf.WriteString("DROP USER ")
-	_, _ = buf.WriteString(QuoteIdent(s.Name))
+	if len(s.Names) == 0 {
+		_, _ = buf.WriteString(QuoteIdent(s.Name))
+		return buf.String()
+	}
+	_, _ = buf.WriteString("IN (")
+	for i, name := range s.Names {
+		if i > 0 {
+			_, _ = buf.WriteString(", ")
+		}
+		_, _ = buf.WriteString(QuoteIdent(name))
+	}
+	_, _ = buf.WriteString(")")
 	return buf.String()
 }
 
+// Users returns the names of the users dropped by the statement.
+func (s *DropUserStatement) Users() []string {
+	if len(s.Names) == 0 {
+		return []string{s.Name}
+	}
+	return s.Names
+}
+
 // RequiredPrivileges returns the privilege(s) required to execute a DropUserStatement.
 func (s *DropUserStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
 	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: false, Privilege: AllPrivileges}}, nil
@@ -1249,6 +1286,26 @@ func (s *RevokeAdminStatement) RequiredPrivileges() (ExecutionPrivileges, error)
 	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: false, Privilege: AllPrivileges}}, nil
 }
 
+// RevokeAllStatement represents a command to revoke the privileges of a user on all databases
+// together with its admin privilege.
+type RevokeAllStatement struct {
+	// Who to revoke privileges from.
+	User string
+}
+
+// String returns a string representation of the revoke all statement.
+func (s *RevokeAllStatement) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("REVOKE ALL PRIVILEGES ON ALL FROM ")
+	_, _ = buf.WriteString(QuoteIdent(s.User))
+	return buf.String()
+}
+
+// RequiredPrivileges returns the privilege required to execute a RevokeAllStatement.
+func (s *RevokeAllStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: false, Privilege: AllPrivileges}}, nil
+}
+
 // CreateRetentionPolicyStatement represents a command to create a retention policy.
 type CreateRetentionPolicyStatement struct {
 	// Name of policy to create.
@@ -1426,6 +1483,43 @@ const (
 	LinearFill
 )
 
+// ReadAvailability represents the number of replicas of each replica group that must be readable for a query to run.
+// It is an availability precondition: whatever the level, each replica group is read from a single replica, the
+// replicas are neither read together nor merged.
+type ReadAvailability int
+
+const (
+	// DefaultAvailability reads from the master of each replica group.
+	DefaultAvailability ReadAvailability = iota
+	// AnyAvailability reads from one readable replica of each replica group, the master if it is readable.
+	AnyAvailability
+	// QuorumAvailability reads as AnyAvailability once a majority of the replicas of each replica group is readable.
+	QuorumAvailability
+	// AllAvailability reads as AnyAvailability once all the replicas of each replica group are readable.
+	AllAvailability
+)
+
+var readAvailabilityNames = map[ReadAvailability]string{
+	AnyAvailability:    "any",
+	QuorumAvailability: "quorum",
+	AllAvailability:    "all",
+}
+
+// ParseReadAvailability returns the read availability level of its name, any, quorum or all.
+func ParseReadAvailability(name string) (ReadAvailability, error) {
+	for level, n := range readAvailabilityNames {
+		if strings.EqualFold(name, n) {
+			return level, nil
+		}
+	}
+	return DefaultAvailability, fmt.Errorf("invalid availability level %s, expect any, quorum or all", name)
+}
+
+// String returns the name of the read availability level.
+func (c ReadAvailability) String() string {
+	return readAvailabilityNames[c]
+}
+
 // SelectStatement represents a command for extracting data from the database.
 type SelectStatement struct {
 	// Expressions returned from the selection.
@@ -1535,6 +1629,9 @@ type SelectStatement struct {
 	BinOpSource []*BinOp
 
 	IsPromQuery bool
+
+	// Availability is the read availability level set by WITH AVAILABILITY.
+	Availability ReadAvailability
 }
 
 func (s *SelectStatement) SetStmtId(id int) {
@@ -2920,6 +3017,9 @@ func (s *SelectStatement) String() string {
 	if s.Location != nil {
 		_, _ = fmt.Fprintf(&buf, ` TZ('%s')`, s.Location)
 	}
+	if s.Availability != DefaultAvailability {
+		_, _ = fmt.Fprintf(&buf, " WITH AVAILABILITY %s", s.Availability)
+	}
 	return buf.String()
 }
 
@@ -3203,11 +3303,26 @@ func (t *Target) String() string {
 	return buf.String()
 }
 
+// ExplainVerbosity controls how much of the trace is rendered by EXPLAIN ANALYZE.
+type ExplainVerbosity uint8
+
+const (
+	ExplainDefault ExplainVerbosity = iota
+	ExplainVerbose
+	ExplainSummary
+	ExplainBrief
+)
+
 // ExplainStatement represents a command for explaining a select statement.
 type ExplainStatement struct {
 	Statement *SelectStatement
 
 	Analyze bool
+
+	// Cost only estimates the scan cost of the statement without executing it.
+	Cost bool
+
+	Verbosity ExplainVerbosity
 }
 
 // String returns a string representation of the explain statement.
@@ -3216,6 +3331,16 @@ func (e *ExplainStatement) String() string {
 	buf.WriteString("EXPLAIN ")
 	if e.Analyze {
 		buf.WriteString("ANALYZE ")
+		switch e.Verbosity {
+		case ExplainVerbose:
+			buf.WriteString("VERBOSE ")
+		case ExplainSummary:
+			buf.WriteString("SUMMARY ")
+		case ExplainBrief:
+			buf.WriteString("BRIEF ")
+		}
+	} else if e.Cost {
+		buf.WriteString("COST ")
 	}
 	buf.WriteString(e.Statement.String())
 	return buf.String()
@@ -3481,10 +3606,18 @@ func (s *ShowSeriesCardinalityStatement) DefaultDatabase() string {
 }
 
 // ShowContinuousQueriesStatement represents a command for listing continuous queries.
-type ShowContinuousQueriesStatement struct{}
+type ShowContinuousQueriesStatement struct {
+	// Database to list the continuous queries of, all the databases if empty.
+	Database string
+}
 
 // String returns a string representation of the show continuous queries statement.
-func (s *ShowContinuousQueriesStatement) String() string { return "SHOW CONTINUOUS QUERIES" }
+func (s *ShowContinuousQueriesStatement) String() string {
+	if s.Database == "" {
+		return "SHOW CONTINUOUS QUERIES"
+	}
+	return "SHOW CONTINUOUS QUERIES ON " + QuoteIdent(s.Database)
+}
 
 // RequiredPrivileges returns the privilege required to execute a ShowContinuousQueriesStatement.
 func (s *ShowContinuousQueriesStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
@@ -3515,10 +3648,24 @@ func (s *ShowGrantsForUserStatement) RequiredPrivileges() (ExecutionPrivileges,
 type ShowDatabasesStatement struct {
 	// ShowDetail indicates the detail attribute of the database, e.g., TAG ATTRIBUTE, REPLICAS
 	ShowDetail bool
+
+	// Condition filters the databases by their name and deletion state, e.g., WHERE deleting = false
+	Condition Expr
 }
 
 // String returns a string representation of the show databases command.
-func (s *ShowDatabasesStatement) String() string { return "SHOW DATABASES" }
+func (s *ShowDatabasesStatement) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("SHOW DATABASES")
+	if s.ShowDetail {
+		_, _ = buf.WriteString(" DETAIL")
+	}
+	if s.Condition != nil {
+		_, _ = buf.WriteString(" WHERE ")
+		_, _ = buf.WriteString(s.Condition.String())
+	}
+	return buf.String()
+}
 
 // RequiredPrivileges returns the privilege required to execute a ShowDatabasesStatement.
 func (s *ShowDatabasesStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
@@ -3697,6 +3844,12 @@ type ShowMeasurementsStatement struct {
 	// Measurement name or regex.
 	Source Source
 
+	// SQL LIKE pattern of the measurement name, % matches any sequence and _ matches a single character.
+	LikePattern string
+
+	// Whether the LIKE pattern is matched case-insensitively (ILIKE).
+	LikeCaseInsensitive bool
+
 	// An expression evaluated on data point.
 	Condition Expr
 
@@ -3729,6 +3882,14 @@ func (s *ShowMeasurementsStatement) String() string {
 		}
 		_, _ = buf.WriteString(s.Source.String())
 	}
+	if s.LikePattern != "" {
+		if s.LikeCaseInsensitive {
+			_, _ = buf.WriteString(" ILIKE ")
+		} else {
+			_, _ = buf.WriteString(" LIKE ")
+		}
+		_, _ = buf.WriteString(QuoteString(s.LikePattern))
+	}
 	if s.Condition != nil {
 		_, _ = buf.WriteString(" WHERE ")
 		_, _ = buf.WriteString(s.Condition.String())
@@ -3748,6 +3909,37 @@ func (s *ShowMeasurementsStatement) String() string {
 	return buf.String()
 }
 
+// CompileLikePattern translates a SQL LIKE pattern to an anchored regular expression.
+// % matches any sequence of characters, _ matches a single character, and a backslash escapes the next character.
+func CompileLikePattern(pattern string, caseInsensitive bool) (*regexp.Regexp, error) {
+	var buf strings.Builder
+	if caseInsensitive {
+		buf.WriteString("(?i)")
+	}
+	buf.WriteString("^")
+	escaped := false
+	for _, c := range pattern {
+		switch {
+		case escaped:
+			buf.WriteString(regexp.QuoteMeta(string(c)))
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case c == '%':
+			buf.WriteString("(?s:.*)")
+		case c == '_':
+			buf.WriteString("(?s:.)")
+		default:
+			buf.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	if escaped {
+		return nil, fmt.Errorf("invalid LIKE pattern %s: trailing escape character", QuoteString(pattern))
+	}
+	buf.WriteString("$")
+	return regexp.Compile(buf.String())
+}
+
 // RequiredPrivileges returns the privilege(s) required to execute a ShowMeasurementsStatement.
 func (s *ShowMeasurementsStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
 	return ExecutionPrivileges{{Admin: false, Name: s.Database, Rwuser: true, Privilege: ReadPrivilege}}, nil
@@ -3778,11 +3970,32 @@ func (s *DropMeasurementStatement) RequiredPrivileges() (ExecutionPrivileges, er
 }
 
 // ShowQueriesStatement represents a command for listing all running queries.
-type ShowQueriesStatement struct{}
+type ShowQueriesStatement struct {
+	// Format of the output, the tabular form if empty or a JSON document per query if it is "json"
+	Format string
+
+	// Precise shows the full duration of the queries instead of truncating it to its largest unit
+	Precise bool
+
+	// CountByNode shows the number of queries running on each store node instead of the queries
+	CountByNode bool
+}
 
 // String returns a string representation of the show queries statement.
 func (s *ShowQueriesStatement) String() string {
-	return "SHOW QUERIES"
+	var buf strings.Builder
+	buf.WriteString("SHOW QUERIES")
+	if s.CountByNode {
+		buf.WriteString(" COUNT BY NODE")
+	}
+	if s.Precise {
+		buf.WriteString(" PRECISE")
+	}
+	if s.Format != "" {
+		buf.WriteString(" FORMAT ")
+		buf.WriteString(strings.ToUpper(s.Format))
+	}
+	return buf.String()
 }
 
 // RequiredPrivileges returns the privilege required to execute a ShowQueriesStatement.
@@ -3794,6 +4007,9 @@ func (s *ShowQueriesStatement) RequiredPrivileges() (ExecutionPrivileges, error)
 type ShowRetentionPoliciesStatement struct {
 	// Name of the database to list policies for.
 	Database string
+
+	// ShowDetail adds the number of shard groups of each policy.
+	ShowDetail bool
 }
 
 // String returns a string representation of a ShowRetentionPoliciesStatement.
@@ -3804,6 +4020,9 @@ func (s *ShowRetentionPoliciesStatement) String() string {
 		_, _ = buf.WriteString(" ON ")
 		_, _ = buf.WriteString(QuoteIdent(s.Database))
 	}
+	if s.ShowDetail {
+		_, _ = buf.WriteString(" DETAIL")
+	}
 	return buf.String()
 }
 
@@ -3981,10 +4200,15 @@ func (s *DropSubscriptionStatement) DefaultDatabase() string {
 
 // ShowSubscriptionsStatement represents a command to show a list of subscriptions.
 type ShowSubscriptionsStatement struct {
+	// ShowDetail indicates the delivery lag of each destination
+	ShowDetail bool
 }
 
 // String returns a string representation of the ShowSubscriptionsStatement.
 func (s *ShowSubscriptionsStatement) String() string {
+	if s.ShowDetail {
+		return "SHOW SUBSCRIPTIONS DETAIL"
+	}
 	return "SHOW SUBSCRIPTIONS"
 }
 
@@ -4353,6 +4577,9 @@ type ShowFieldKeysStatement struct {
 	// Data sources that fields are extracted from.
 	Sources Sources
 
+	// An expression evaluated on a series name or tag.
+	Condition Expr
+
 	// Fields to sort results by
 	SortFields SortFields
 
@@ -4377,6 +4604,10 @@ func (s *ShowFieldKeysStatement) String() string {
 		_, _ = buf.WriteString(" FROM ")
 		_, _ = buf.WriteString(s.Sources.String())
 	}
+	if s.Condition != nil {
+		_, _ = buf.WriteString(" WHERE ")
+		_, _ = buf.WriteString(s.Condition.String())
+	}
 	if len(s.SortFields) > 0 {
 		_, _ = buf.WriteString(" ORDER BY ")
 		_, _ = buf.WriteString(s.SortFields.String())
@@ -5488,6 +5719,7 @@ func Walk(v Visitor, node Node) {
 
 	case *ShowFieldKeysStatement:
 		Walk(v, n.Sources)
+		Walk(v, n.Condition)
 		Walk(v, n.SortFields)
 
 	case SortFields:
@@ -7823,6 +8055,8 @@ func (c *CreateStreamStatement) Check(stmt *SelectStatement, supportTable map[st
 
 type ShowStreamsStatement struct {
 	Database string
+	// RetentionPolicy shows only the streams whose target is in this retention policy of Database, all if empty
+	RetentionPolicy string
 }
 
 func (s *ShowStreamsStatement) stmt() {}
@@ -7837,7 +8071,12 @@ func (s *ShowStreamsStatement) String() string {
 	var buf bytes.Buffer
 	_, _ = buf.WriteString("SHOW STREAMS")
 	if len(s.Database) > 0 {
+		_, _ = buf.WriteString(" ON ")
 		_, _ = buf.WriteString(QuoteIdent(s.Database))
+		if len(s.RetentionPolicy) > 0 {
+			_, _ = buf.WriteString(".")
+			_, _ = buf.WriteString(QuoteIdent(s.RetentionPolicy))
+		}
 	}
 
 	return buf.String()
@@ -7887,8 +8126,12 @@ func (s *ShowMeasurementKeysStatement) String() string {
 }
 
 type ShowConfigsStatement struct {
-	Scope string
-	Key   *ConfigKey
+	// Component whose configs are listed, all components are listed if empty.
+	Component string
+	Scope     string
+	Key       *ConfigKey
+	// Condition filters the configs on their component and name.
+	Condition Expr
 }
 
 type ConfigKey struct {
@@ -7916,15 +8159,21 @@ func (s *ShowConfigsStatement) RequiredPrivileges() (ExecutionPrivileges, error)
 
 func (s *ShowConfigsStatement) String() string {
 	var buf bytes.Buffer
+	_, _ = buf.WriteString(`SHOW CONFIGS`)
+	if s.Component != "" {
+		_, _ = buf.WriteString(` FOR `)
+		_, _ = buf.WriteString(QuoteString(s.Component))
+	}
 
-	if s.Scope == "" && s.Key == nil {
-		return `SHOW CONFIGS`
-	} else if s.Scope == "" {
-		_, _ = buf.WriteString(fmt.Sprintf(`SHOW CONFIGS WHERE scope = %s`, s.Scope))
-	} else if s.Key == nil {
-		_, _ = buf.WriteString(fmt.Sprintf(`SHOW CONFIGS WHERE name = %s`, s.Key.String()))
-	} else {
-		_, _ = buf.WriteString(fmt.Sprintf(`SHOW CONFIGS WHERE scope = %s AND name = %s`, s.Scope, s.Key.String()))
+	if s.Condition != nil {
+		_, _ = buf.WriteString(` WHERE `)
+		_, _ = buf.WriteString(s.Condition.String())
+	} else if s.Scope != "" && s.Key != nil {
+		_, _ = buf.WriteString(fmt.Sprintf(` WHERE scope = %s AND name = %s`, s.Scope, s.Key.String()))
+	} else if s.Scope != "" {
+		_, _ = buf.WriteString(fmt.Sprintf(` WHERE scope = %s`, s.Scope))
+	} else if s.Key != nil {
+		_, _ = buf.WriteString(fmt.Sprintf(` WHERE name = %s`, s.Key.String()))
 	}
 	return buf.String()
 }
@@ -7955,6 +8204,221 @@ func (s *SetConfigStatement) String() string {
 	return buf.String()
 }
 
+// RebalanceDatabaseStatement represents a command for spreading the pts of a database evenly over the data nodes.
+type RebalanceDatabaseStatement struct {
+	Database string
+
+	// DryRun only reports the planned pt moves without executing them
+	DryRun bool
+}
+
+func (s *RebalanceDatabaseStatement) stmt() {}
+
+func (s *RebalanceDatabaseStatement) node() {}
+
+func (s *RebalanceDatabaseStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: true, Privilege: AllPrivileges}}, nil
+}
+
+func (s *RebalanceDatabaseStatement) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("REBALANCE DATABASE ")
+	_, _ = buf.WriteString(QuoteIdent(s.Database))
+	if s.DryRun {
+		_, _ = buf.WriteString(" DRYRUN")
+	}
+	return buf.String()
+}
+
+// DrainNodeStatement represents a command for routing the new queries away from a data node before its maintenance,
+// it completes once the queries running on the node are finished.
+type DrainNodeStatement struct {
+	NodeID uint64
+
+	// Off routes the new queries to the node again
+	Off bool
+}
+
+func (s *DrainNodeStatement) stmt() {}
+
+func (s *DrainNodeStatement) node() {}
+
+func (s *DrainNodeStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: true, Privilege: AllPrivileges}}, nil
+}
+
+func (s *DrainNodeStatement) String() string {
+	if s.Off {
+		return fmt.Sprintf("DRAIN NODE %d OFF", s.NodeID)
+	}
+	return fmt.Sprintf("DRAIN NODE %d", s.NodeID)
+}
+
+// CheckConfigStatement represents a command for checking the consistency of the running config.
+type CheckConfigStatement struct{}
+
+func (s *CheckConfigStatement) stmt() {}
+
+func (s *CheckConfigStatement) node() {}
+
+func (s *CheckConfigStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: true, Privilege: AllPrivileges}}, nil
+}
+
+func (s *CheckConfigStatement) String() string {
+	return "CHECK CONFIG"
+}
+
+// ShowExecutorLimitsStatement represents a command for listing the limits the statement executor enforces on the queries.
+type ShowExecutorLimitsStatement struct{}
+
+func (s *ShowExecutorLimitsStatement) stmt() {}
+
+func (s *ShowExecutorLimitsStatement) node() {}
+
+// String returns a string representation of a ShowExecutorLimitsStatement.
+func (s *ShowExecutorLimitsStatement) String() string {
+	return "SHOW EXECUTOR LIMITS"
+}
+
+// RequiredPrivileges returns the privilege(s) required to execute a ShowExecutorLimitsStatement.
+func (s *ShowExecutorLimitsStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: false, Name: "", Rwuser: true, Privilege: ReadPrivilege}}, nil
+}
+
+// ShowQueryLimitsStatement represents a command for listing the query limits effective on a database.
+type ShowQueryLimitsStatement struct {
+	Database string
+}
+
+func (s *ShowQueryLimitsStatement) stmt() {}
+
+func (s *ShowQueryLimitsStatement) node() {}
+
+// String returns a string representation of a ShowQueryLimitsStatement.
+func (s *ShowQueryLimitsStatement) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("SHOW QUERY LIMITS")
+	if s.Database != "" {
+		_, _ = buf.WriteString(" ON ")
+		_, _ = buf.WriteString(QuoteIdent(s.Database))
+	}
+	return buf.String()
+}
+
+// RequiredPrivileges returns the privilege(s) required to execute a ShowQueryLimitsStatement.
+func (s *ShowQueryLimitsStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: false, Name: s.Database, Rwuser: true, Privilege: ReadPrivilege}}, nil
+}
+
+// DefaultDatabase returns the default database from the statement.
+func (s *ShowQueryLimitsStatement) DefaultDatabase() string {
+	return s.Database
+}
+
+// ShowShardMappingStatement represents a command for listing the nodes the shards of a database are mapped to.
+type ShowShardMappingStatement struct {
+	Database string
+}
+
+func (s *ShowShardMappingStatement) stmt() {}
+
+func (s *ShowShardMappingStatement) node() {}
+
+// String returns a string representation of a ShowShardMappingStatement.
+func (s *ShowShardMappingStatement) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("SHOW SHARD MAPPING")
+	if s.Database != "" {
+		_, _ = buf.WriteString(" ON ")
+		_, _ = buf.WriteString(QuoteIdent(s.Database))
+	}
+	return buf.String()
+}
+
+// RequiredPrivileges returns the privilege(s) required to execute a ShowShardMappingStatement.
+func (s *ShowShardMappingStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: true, Privilege: AllPrivileges}}, nil
+}
+
+// DefaultDatabase returns the default database from the statement.
+func (s *ShowShardMappingStatement) DefaultDatabase() string {
+	return s.Database
+}
+
+// ShowVersionStatement represents a command for showing the version of the server.
+type ShowVersionStatement struct{}
+
+func (s *ShowVersionStatement) stmt() {}
+
+func (s *ShowVersionStatement) node() {}
+
+// String returns a string representation of a ShowVersionStatement.
+func (s *ShowVersionStatement) String() string {
+	return "SHOW VERSION"
+}
+
+// RequiredPrivileges returns the privilege(s) required to execute a ShowVersionStatement.
+func (s *ShowVersionStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: false, Name: "", Rwuser: true, Privilege: NoPrivileges}}, nil
+}
+
+// ShowSlowQueriesStatement represents a command for listing the most recent slow queries.
+type ShowSlowQueriesStatement struct{}
+
+func (s *ShowSlowQueriesStatement) stmt() {}
+
+func (s *ShowSlowQueriesStatement) node() {}
+
+// String returns a string representation of a ShowSlowQueriesStatement.
+func (s *ShowSlowQueriesStatement) String() string {
+	return "SHOW SLOW QUERIES"
+}
+
+// RequiredPrivileges returns the privilege(s) required to execute a ShowSlowQueriesStatement.
+func (s *ShowSlowQueriesStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: false, Name: "", Rwuser: true, Privilege: ReadPrivilege}}, nil
+}
+
+// ClearSlowQueriesStatement represents a command for forgetting the slow queries listed by SHOW SLOW QUERIES.
+type ClearSlowQueriesStatement struct{}
+
+func (s *ClearSlowQueriesStatement) stmt() {}
+
+func (s *ClearSlowQueriesStatement) node() {}
+
+// String returns a string representation of a ClearSlowQueriesStatement.
+func (s *ClearSlowQueriesStatement) String() string {
+	return "CLEAR SLOW QUERIES"
+}
+
+// RequiredPrivileges returns the privilege(s) required to execute a ClearSlowQueriesStatement.
+func (s *ClearSlowQueriesStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: false, Privilege: AllPrivileges}}, nil
+}
+
+// SetQueryTracingStatement represents a command for turning the tracing of every select statement on or off.
+type SetQueryTracingStatement struct {
+	Enabled bool
+}
+
+func (s *SetQueryTracingStatement) stmt() {}
+
+func (s *SetQueryTracingStatement) node() {}
+
+// String returns a string representation of a SetQueryTracingStatement.
+func (s *SetQueryTracingStatement) String() string {
+	if s.Enabled {
+		return "SET QUERY TRACING ON"
+	}
+	return "SET QUERY TRACING OFF"
+}
+
+// RequiredPrivileges returns the privilege(s) required to execute a SetQueryTracingStatement.
+func (s *SetQueryTracingStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: true, Privilege: AllPrivileges}}, nil
+}
+
 type ShowClusterStatement struct {
 	NodeType string
 	NodeID   int64
@@ -7984,6 +8448,84 @@ func (s *ShowClusterStatement) String() string {
 	return buf.String()
 }
 
+// ShowDataNodesStatement represents a command for listing the data nodes and their pts.
+type ShowDataNodesStatement struct {
+	// Detail lists the pt ids assigned to each node
+	Detail bool
+}
+
+func (s *ShowDataNodesStatement) stmt() {}
+
+func (s *ShowDataNodesStatement) node() {}
+
+func (s *ShowDataNodesStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: true, Privilege: AllPrivileges}}, nil
+}
+
+func (s *ShowDataNodesStatement) String() string {
+	if s.Detail {
+		return "SHOW DATA NODES DETAIL"
+	}
+	return "SHOW DATA NODES"
+}
+
+// ShowMetaNodesStatement represents a command for listing the meta nodes and their raft roles.
+type ShowMetaNodesStatement struct{}
+
+func (s *ShowMetaNodesStatement) stmt() {}
+
+func (s *ShowMetaNodesStatement) node() {}
+
+func (s *ShowMetaNodesStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: true, Privilege: AllPrivileges}}, nil
+}
+
+func (s *ShowMetaNodesStatement) String() string {
+	return "SHOW META NODES"
+}
+
+// ShowStreamTargetsStatement represents a command for listing the stream target measurements
+// which are no longer referenced by any stream.
+type ShowStreamTargetsStatement struct {
+	Database string
+}
+
+func (s *ShowStreamTargetsStatement) stmt() {}
+
+func (s *ShowStreamTargetsStatement) node() {}
+
+func (s *ShowStreamTargetsStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: true, Privilege: AllPrivileges}}, nil
+}
+
+func (s *ShowStreamTargetsStatement) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("SHOW STREAM TARGETS")
+	if s.Database != "" {
+		_, _ = buf.WriteString(" ON ")
+		_, _ = buf.WriteString(QuoteIdent(s.Database))
+	}
+	return buf.String()
+}
+
+// DropStreamTargetsStatement represents a command for dropping the stream target measurements
+// of a database which are no longer referenced by any stream.
+type DropStreamTargetsStatement struct {
+	Database string
+}
+
+func (s *DropStreamTargetsStatement) stmt() {}
+
+func (s *DropStreamTargetsStatement) node() {}
+
+func (s *DropStreamTargetsStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: true, Privilege: AllPrivileges}}, nil
+}
+
+func (s *DropStreamTargetsStatement) String() string {
+	return "DROP STREAM TARGETS ON " + QuoteIdent(s.Database)
+}
+
 type Unnests []*Unnest
 
 func (us Unnests) String() string {
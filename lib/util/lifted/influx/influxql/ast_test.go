@@ -109,3 +109,35 @@ func Test_NumberLiteralString(t *testing.T) {
 		}
 	}
 }
+
+func TestCompileLikePattern(t *testing.T) {
+	match := func(pattern string, caseInsensitive bool, name string) bool {
+		re, err := CompileLikePattern(pattern, caseInsensitive)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return re.MatchString(name)
+	}
+
+	// prefix
+	assert.True(t, match("cpu%", false, "cpu_load"))
+	assert.False(t, match("cpu%", false, "mem_cpu"))
+	// contains
+	assert.True(t, match("%load%", false, "cpu_load_avg"))
+	assert.False(t, match("%load%", false, "cpu_idle"))
+	// single character
+	assert.True(t, match("cpu_", false, "cpu0"))
+	assert.False(t, match("cpu_", false, "cpu10"))
+	// regex meta characters are matched literally
+	assert.True(t, match("a.b%", false, "a.bc"))
+	assert.False(t, match("a.b%", false, "axbc"))
+	// escaped wildcards
+	assert.True(t, match(`cpu\_%`, false, "cpu_0"))
+	assert.False(t, match(`cpu\_%`, false, "cpu00"))
+	// case-insensitive
+	assert.False(t, match("CPU%", false, "cpu0"))
+	assert.True(t, match("CPU%", true, "cpu0"))
+
+	_, err := CompileLikePattern(`cpu\`, false)
+	assert.Error(t, err)
+}
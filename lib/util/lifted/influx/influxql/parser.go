@@ -39,6 +39,13 @@ const (
 	FilterNullColumn = "filter_null_column"
 
 	ExactStatisticQuery = "exact_statistic_query"
+
+	// ForceBroadcastQuery sends the query to all the shards whatever its condition on the shard key,
+	// as the force-broadcast-query config does for all the queries.
+	ForceBroadcastQuery = "force_broadcast"
+
+	// MaxSeriesNHint requests a higher series cap for the query, e.g. /*+ max_series_n(500000) */
+	MaxSeriesNHint = "max_series_n"
 )
 
 var SupportHit = map[string]bool{
@@ -46,6 +53,28 @@ var SupportHit = map[string]bool{
 	FullSeriesQuery:     true,
 	FilterNullColumn:    true,
 	ExactStatisticQuery: true,
+	ForceBroadcastQuery: true,
+}
+
+// IsSupportHint returns whether the hint is supported, including the parameterized hints.
+func IsSupportHint(hint string) bool {
+	if support, ok := SupportHit[hint]; ok {
+		return support
+	}
+	_, ok := ParseMaxSeriesNHint(hint)
+	return ok
+}
+
+// ParseMaxSeriesNHint returns the series cap requested by the max_series_n(N) hint.
+func ParseMaxSeriesNHint(hint string) (int, bool) {
+	if !strings.HasPrefix(hint, MaxSeriesNHint+"(") || !strings.HasSuffix(hint, ")") {
+		return 0, false
+	}
+	n, err := strconv.Atoi(hint[len(MaxSeriesNHint)+1 : len(hint)-1])
+	if err != nil || n <= 0 {
+		return 0, false
+	}
+	return n, true
 }
 
 // Parser represents an InfluxQL parser.
@@ -645,6 +674,13 @@ func (p *Parser) parseRevokeStatement() (Statement, error) {
 	// Check for ON or FROM clauses.
 	tok, pos, lit := p.ScanIgnoreWhitespace()
 	if tok == ON {
+		// All privileges on all databases are revoked by ALL [PRIVILEGES] ON ALL.
+		if priv == AllPrivileges {
+			if tok, _, _ := p.ScanIgnoreWhitespace(); tok == ALL {
+				return p.parseRevokeAllStatement()
+			}
+			p.Unscan()
+		}
 		stmt, err := p.parseRevokeOnStatement()
 		if err != nil {
 			return nil, err
@@ -712,6 +748,22 @@ func (p *Parser) parseRevokeAdminStatement() (*RevokeAdminStatement, error) {
 	return stmt, nil
 }
 
+// parseRevokeAllStatement parses a string and returns a revoke all statement.
+// This function assumes the ALL [PRIVILEGES] ON ALL tokens have already been consumed.
+func (p *Parser) parseRevokeAllStatement() (*RevokeAllStatement, error) {
+	// Check for required FROM token.
+	if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != FROM {
+		return nil, newParseError(tokstr(tok, lit), []string{"FROM"}, pos)
+	}
+
+	// Parse the name of the user.
+	lit, err := p.ParseIdent()
+	if err != nil {
+		return nil, err
+	}
+	return &RevokeAllStatement{User: lit}, nil
+}
+
 // parseGrantStatement parses a string and returns a grant statement.
 // This function assumes the GRANT token has already been consumed.
 func (p *Parser) parseGrantStatement() (Statement, error) {
@@ -1217,6 +1269,16 @@ func (p *Parser) parseShowMeasurementsStatement() (*ShowMeasurementsStatement, e
 		p.Unscan()
 	}
 
+	// Parse optional LIKE clause: "LIKE 'pattern'" or "ILIKE 'pattern'".
+	if tok, _, lit := p.ScanIgnoreWhitespace(); tok == LIKE || (tok == IDENT && strings.ToUpper(lit) == "ILIKE") {
+		stmt.LikeCaseInsensitive = tok == IDENT
+		if stmt.LikePattern, err = p.parseString(); err != nil {
+			return nil, err
+		}
+	} else {
+		p.Unscan()
+	}
+
 	// Parse condition: "WHERE EXPR".
 	if stmt.Condition, err = p.parseCondition(); err != nil {
 		return nil, err
@@ -1564,6 +1626,11 @@ func (p *Parser) parseShowUsersStatement() (*ShowUsersStatement, error) {
 // This function assumes the "SHOW SUBSCRIPTIONS" tokens have been consumed.
 func (p *Parser) parseShowSubscriptionsStatement() (*ShowSubscriptionsStatement, error) {
 	stmt := &ShowSubscriptionsStatement{}
+	if tok, _, _ := p.ScanIgnoreWhitespace(); tok == DETAIL {
+		stmt.ShowDetail = true
+	} else {
+		p.Unscan()
+	}
 	return stmt, nil
 }
 
@@ -1650,6 +1717,11 @@ func (p *Parser) parseShowFieldKeysStatement() (*ShowFieldKeysStatement, error)
 		p.Unscan()
 	}
 
+	// Parse condition: "WHERE EXPR".
+	if stmt.Condition, err = p.parseCondition(); err != nil {
+		return nil, err
+	}
+
 	// Parse sort: "ORDER BY FIELD+".
 	if stmt.SortFields, err = p.parseOrderBy(); err != nil {
 		return nil, err
@@ -2164,6 +2236,23 @@ func (p *Parser) parseCreateUserStatement() (*CreateUserStatement, error) {
 func (p *Parser) parseDropUserStatement() (*DropUserStatement, error) {
 	stmt := &DropUserStatement{}
 
+	// Parse the names of the users to be dropped: "IN (IDENT+)".
+	if tok, _, _ := p.ScanIgnoreWhitespace(); tok == IN {
+		if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != LPAREN {
+			return nil, newParseError(tokstr(tok, lit), []string{"("}, pos)
+		}
+		names, err := p.ParseIdentList()
+		if err != nil {
+			return nil, err
+		}
+		if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != RPAREN {
+			return nil, newParseError(tokstr(tok, lit), []string{")"}, pos)
+		}
+		stmt.Names = names
+		return stmt, nil
+	}
+	p.Unscan()
+
 	// Parse the name of the user to be dropped.
 	lit, err := p.ParseIdent()
 	if err != nil {
@@ -2179,8 +2268,19 @@ func (p *Parser) parseDropUserStatement() (*DropUserStatement, error) {
 func (p *Parser) parseExplainStatement() (*ExplainStatement, error) {
 	stmt := &ExplainStatement{}
 
-	if tok, _, _ := p.ScanIgnoreWhitespace(); tok == ANALYZE {
+	if tok, _, lit := p.ScanIgnoreWhitespace(); tok == ANALYZE {
 		stmt.Analyze = true
+		if tok, _, lit := p.ScanIgnoreWhitespace(); tok == IDENT && strings.ToLower(lit) == "verbose" {
+			stmt.Verbosity = ExplainVerbose
+		} else if tok == IDENT && strings.ToLower(lit) == "summary" {
+			stmt.Verbosity = ExplainSummary
+		} else if tok == IDENT && strings.ToLower(lit) == "brief" {
+			stmt.Verbosity = ExplainBrief
+		} else {
+			p.Unscan()
+		}
+	} else if tok == IDENT && strings.ToLower(lit) == "cost" {
+		stmt.Cost = true
 	} else {
 		p.Unscan()
 	}
@@ -3417,8 +3517,7 @@ func (p *Parser) parseHints() (Hints, error) {
 
 	var hints Hints
 	for _, l := range hitLits {
-		support, ok := SupportHit[l]
-		if support && ok {
+		if IsSupportHint(l) {
 			val := &StringLiteral{Val: l}
 			hints = append(hints, &Hint{Expr: val})
 		}
@@ -45,6 +45,22 @@ func TestParallelParseExpr(t *testing.T) {
 	wg.Wait()
 }
 
+func TestParseMaxSeriesNHint(t *testing.T) {
+	q, err := influxql.ParseQuery("select /*+ max_series_n(500000) Filter_Null_Column */ f1 from mst")
+	assert.NoError(t, err)
+	stmt := q.Statements[0].(*influxql.SelectStatement)
+	assert.Equal(t, 2, len(stmt.Hints))
+	n, ok := influxql.ParseMaxSeriesNHint(stmt.Hints[0].String())
+	assert.True(t, ok)
+	assert.Equal(t, 500000, n)
+
+	for _, hint := range []string{"max_series_n", "max_series_n()", "max_series_n(-1)", "max_series_n(a)"} {
+		_, ok = influxql.ParseMaxSeriesNHint(hint)
+		assert.False(t, ok)
+		assert.False(t, influxql.IsSupportHint(hint))
+	}
+}
+
 func BenchmarkParseExpr(b *testing.B) {
 	cond := "a = 1 and b = 2 and c= 3"
 	for i := 0; i < b.N; i++ {
@@ -101,6 +101,8 @@ func deal_Fill (fill interface{})  (FillOption , interface{},bool) {
     indexOption         *IndexOption
     databasePolicy      DatabasePolicy
     cmOption            *CreateMeasurementStatementOption
+    grant               *GrantStatement
+    grants              []*GrantStatement
 }
 
 %token <str>    FROM MEASUREMENT INTO ON SELECT WHERE AS GROUP BY ORDER LIMIT OFFSET SLIMIT SOFFSET SHOW CREATE FULL PRIVILEGES OUTER JOIN
@@ -145,8 +147,11 @@ func deal_Fill (fill interface{})  (FillOption , interface{},bool) {
                                     CREATE_CONTINUOUS_QUERY_STATEMENT SHOW_CONTINUOUS_QUERIES_STATEMENT DROP_CONTINUOUS_QUERY_STATEMENT
                                     CREATE_DOWNSAMPLE_STATEMENT DOWNSAMPLE_INTERVALS DROP_DOWNSAMPLE_STATEMENT SHOW_DOWNSAMPLE_STATEMENT
                                     CREATE_STREAM_STATEMENT SHOW_STREAM_STATEMENT DROP_STREAM_STATEMENT COLUMN_LISTS SHOW_MEASUREMENT_KEYS_STATEMENT
-                                    SHOW_QUERIES_STATEMENT KILL_QUERY_STATEMENT SHOW_CONFIGS_STATEMENT SET_CONFIG_STATEMENT SHOW_CLUSTER_STATEMENT
+                                    SHOW_QUERIES_STATEMENT KILL_QUERY_STATEMENT SHOW_CONFIGS_STATEMENT SET_CONFIG_STATEMENT SHOW_CLUSTER_STATEMENT SHOW_NODES_STATEMENT
                                     CREATE_SUBSCRIPTION_STATEMENT SHOW_SUBSCRIPTION_STATEMENT DROP_SUBSCRIPTION_STATEMENT
+                                    REBALANCE_DATABASE_STATEMENT CHECK_CONFIG_STATEMENT SHOW_QUERY_LIMITS_STATEMENT SHOW_VERSION_STATEMENT
+                                    SET_QUERY_TRACING_STATEMENT SHOW_SLOW_QUERIES_STATEMENT CLEAR_SLOW_QUERIES_STATEMENT SHOW_DIAGNOSTICS_STATEMENT
+                                    DRAIN_NODE_STATEMENT SHOW_SHARD_MAPPING_STATEMENT
 %type <fields>                      COLUMN_CLAUSES IDENTS
 %type <field>                       COLUMN_CLAUSE
 %type <stmts>                       ALL_QUERIES ALL_QUERY
@@ -164,7 +169,7 @@ func deal_Fill (fill interface{})  (FillOption , interface{},bool) {
 %type <intSlice>                    OPTION_CLAUSES LIMIT_OFFSET_OPTION SLIMIT_SOFFSET_OPTION
 %type <inter>                       FILL_CLAUSE FILLCONTENT
 %type <durations>                   SHARD_HOT_WARM_INDEX_DURATIONS SHARD_HOT_WARM_INDEX_DURATION CREAT_DATABASE_POLICY  CREAT_DATABASE_POLICYS
-%type <str>                         REGULAR_EXPRESSION TAG_KEY ON_DATABASE TYPE_CLAUSE SHARD_KEY STRING_TYPE MEASUREMENT_INFO SUBSCRIPTION_TYPE COMPACTION_TYPE_CLAUSE
+%type <str>                         AVAILABILITY_LEVEL REGULAR_EXPRESSION TAG_KEY ON_DATABASE TYPE_CLAUSE SHARD_KEY STRING_TYPE MEASUREMENT_INFO SUBSCRIPTION_TYPE COMPACTION_TYPE_CLAUSE
 %type <strSlice>                    SHARDKEYLIST CMOPTION_SHARDKEY INDEX_LIST PRIMARYKEY_LIST SORTKEY_LIST ALL_DESTINATION CMOPTION_PRIMARYKEY CMOPTION_SORTKEY
 %type <strSlices>                   MEASUREMENT_PROPERTYS MEASUREMENT_PROPERTY MEASUREMENT_PROPERTYS_LIST CMOPTION_PROPERTIES
 %type <location>                    TIME_ZONE
@@ -180,6 +185,8 @@ func deal_Fill (fill interface{})  (FillOption , interface{},bool) {
 %type <databasePolicy>              DATABASE_POLICY
 %type <cmOption>                    CMOPTIONS_TS CMOPTIONS_CS
 %type <str>                         CMOPTION_ENGINETYPE_TS CMOPTION_ENGINETYPE_CS
+%type <grant>                       USER_GRANT
+%type <grants>                      USER_GRANTS
 
 %%
 
@@ -214,6 +221,21 @@ STATEMENT:
     {
         $$ = $1
     }
+    |SELECT_STATEMENT WITH IDENT AVAILABILITY_LEVEL
+    {
+        stmt := $1.(*SelectStatement)
+        if strings.ToUpper($3) != "AVAILABILITY" {
+            yylex.Error("expect WITH AVAILABILITY for SELECT")
+            goto ret1
+        }
+        level, err := ParseReadAvailability($4)
+        if err != nil {
+            yylex.Error(err.Error())
+            goto ret1
+        }
+        stmt.Availability = level
+        $$ = stmt
+    }
     |SHOW_DATABASES_STATEMENT
     {
         $$ = $1
@@ -350,6 +372,10 @@ STATEMENT:
     {
         $$ = $1
     }
+    |SHOW_SHARD_MAPPING_STATEMENT
+    {
+        $$ = $1
+    }
     |DROP_MEASUREMENT_STATEMENT
     {
         $$ = $1
@@ -426,6 +452,60 @@ STATEMENT:
     {
     	$$ = $1
     }
+    |SHOW_NODES_STATEMENT
+    {
+    	$$ = $1
+    }
+    |REBALANCE_DATABASE_STATEMENT
+    {
+    	$$ = $1
+    }
+    |CHECK_CONFIG_STATEMENT
+    {
+    	$$ = $1
+    }
+    |SHOW_QUERY_LIMITS_STATEMENT
+    {
+    	$$ = $1
+    }
+    |SHOW_VERSION_STATEMENT
+    {
+    	$$ = $1
+    }
+    |SET_QUERY_TRACING_STATEMENT
+    {
+    	$$ = $1
+    }
+    |SHOW_SLOW_QUERIES_STATEMENT
+    {
+    	$$ = $1
+    }
+    |CLEAR_SLOW_QUERIES_STATEMENT
+    {
+    	$$ = $1
+    }
+    |SHOW_DIAGNOSTICS_STATEMENT
+    {
+    	$$ = $1
+    }
+    |DRAIN_NODE_STATEMENT
+    {
+    	$$ = $1
+    }
+
+AVAILABILITY_LEVEL:
+    ANY
+    {
+        $$ = "any"
+    }
+    |ALL
+    {
+        $$ = "all"
+    }
+    |IDENT
+    {
+        $$ = $1
+    }
 
 SELECT_STATEMENT:
     SELECT COLUMN_CLAUSES INTO_CLAUSE FROM_CLAUSE WHERE_CLAUSE GROUP_BY_CLAUSE EXCEPT_CLAUSE FILL_CLAUSE ORDER_CLAUSES OPTION_CLAUSES TIME_ZONE
@@ -1163,6 +1243,10 @@ COLUMN_VAREF:
     {
         $$ = &VarRef{Val:$1}
     }
+    |NAME
+    {
+        $$ = &VarRef{Val:"name"}
+    }
     |IDENT DOUBLECOLON COLUMN_VAREF_TYPE
     {
     	$$ = &VarRef{Val:$1, Type:$3}
@@ -1327,13 +1411,13 @@ SLIMIT_SOFFSET_OPTION:
     }
 
 SHOW_DATABASES_STATEMENT:
-    SHOW DATABASES
+    SHOW DATABASES WHERE_CLAUSE
     {
-        $$ = &ShowDatabasesStatement{ShowDetail:false}
+        $$ = &ShowDatabasesStatement{ShowDetail:false, Condition: $3}
     }
-    |SHOW DATABASES DETAIL
+    |SHOW DATABASES DETAIL WHERE_CLAUSE
     {
-        $$ = &ShowDatabasesStatement{ShowDetail:true}
+        $$ = &ShowDatabasesStatement{ShowDetail:true, Condition: $4}
     }
 
 CREATE_DATABASE_STATEMENT:
@@ -1577,6 +1661,33 @@ SHOW_MEASUREMENTS_STATEMENT:
          sms.Offset = $6[1]
          $$ = sms
      }
+    |SHOW MEASUREMENTS ON_DATABASE LIKE STRING WHERE_CLAUSE ORDER_CLAUSES OPTION_CLAUSES
+    {
+        sms := &ShowMeasurementsStatement{}
+        sms.Database = $3
+        sms.LikePattern = $5
+        sms.Condition = $6
+        sms.SortFields = $7
+        sms.Limit = $8[0]
+        sms.Offset = $8[1]
+        $$ = sms
+    }
+    |SHOW MEASUREMENTS ON_DATABASE IDENT STRING WHERE_CLAUSE ORDER_CLAUSES OPTION_CLAUSES
+    {
+        if strings.ToUpper($4) != "ILIKE" {
+            yylex.Error("expect LIKE or ILIKE for SHOW MEASUREMENTS")
+            goto ret1
+        }
+        sms := &ShowMeasurementsStatement{}
+        sms.Database = $3
+        sms.LikePattern = $5
+        sms.LikeCaseInsensitive = true
+        sms.Condition = $6
+        sms.SortFields = $7
+        sms.Limit = $8[0]
+        sms.Offset = $8[1]
+        $$ = sms
+    }
 
 MEASUREMENT_WITH:
 
@@ -1618,6 +1729,17 @@ SHOW_RETENTION_POLICIES_STATEMENT:
     {
          $$ = &ShowRetentionPoliciesStatement{ }
     }
+    |SHOW RETENTION POLICIES ON IDENT DETAIL
+    {
+        $$ = &ShowRetentionPoliciesStatement{
+            Database: $5,
+            ShowDetail: true,
+        }
+    }
+    |SHOW RETENTION POLICIES DETAIL
+    {
+        $$ = &ShowRetentionPoliciesStatement{ShowDetail: true}
+    }
 
 
 CREATE_RENTRENTION_POLICY_STATEMENT:
@@ -1661,6 +1783,51 @@ CREATE_USER_STATEMENT:
         stmt.Rwuser = true
         $$ = stmt
     }
+    |CREATE USER IDENT WITH PASSWORD STRING GRANT USER_GRANTS
+    {
+        stmt := &CreateUserStatement{}
+        stmt.Name = $3
+        stmt.Password = $6
+        for _, grant := range $8 {
+            grant.User = $3
+        }
+        stmt.Grants = $8
+        $$ = stmt
+    }
+
+USER_GRANTS:
+    USER_GRANT
+    {
+        $$ = []*GrantStatement{$1}
+    }
+    |USER_GRANTS COMMA USER_GRANT
+    {
+        $$ = append($1, $3)
+    }
+
+USER_GRANT:
+    ALL ON IDENT
+    {
+        $$ = &GrantStatement{Privilege: AllPrivileges, On: $3}
+    }
+    |ALL PRIVILEGES ON IDENT
+    {
+        $$ = &GrantStatement{Privilege: AllPrivileges, On: $4}
+    }
+    |IDENT ON IDENT
+    {
+        stmt := &GrantStatement{On: $3}
+        switch strings.ToLower($1){
+        case "read":
+            stmt.Privilege = ReadPrivilege
+        case "write":
+            stmt.Privilege = WritePrivilege
+        default:
+            yylex.Error("wrong Privilege")
+            goto ret1
+        }
+        $$ = stmt
+    }
 
 
 RP_DURATION_OPTIONS:
@@ -1932,7 +2099,15 @@ GRANT_ADMIN_STATEMENT:
     }
 
 REVOKE_STATEMENT:
-    REVOKE ALL ON IDENT FROM IDENT
+    REVOKE ALL ON ALL FROM IDENT
+    {
+    	$$ = &RevokeAllStatement{User: $6}
+    }
+    |REVOKE ALL PRIVILEGES ON ALL FROM IDENT
+    {
+    	$$ = &RevokeAllStatement{User: $7}
+    }
+    |REVOKE ALL ON IDENT FROM IDENT
     {
     	stmt := &RevokeStatement{}
     	stmt.Privilege = AllPrivileges
@@ -1979,6 +2154,14 @@ DROP_USER_STATEMENT:
     {
     	$$ = &DropUserStatement{Name:$3}
     }
+    |DROP USER IN LPAREN IDENTS RPAREN
+    {
+        names := make([]string, 0, len($5))
+        for _, f := range $5 {
+            names = append(names, f.Expr.(*VarRef).Val)
+        }
+        $$ = &DropUserStatement{Names:names}
+    }
 
 SHOW_TAG_KEYS_STATEMENT:
   SHOW TAG KEYS ON_DATABASE FROM_CLAUSE WHERE_CLAUSE ORDER_CLAUSES OPTION_CLAUSES
@@ -2037,13 +2220,22 @@ MEASUREMENT_INFO:
     {
         $$ = "INDEXES"
     }
+    |INDEX
+    {
+        $$ = "INDEX"
+    }
     |COMPACT
     {
         $$ = "COMPACT"
     }
     |IDENT
     {
-        yylex.Error("SHOW command error, only support PRIMARYKEY, SORTKEY, SHARDKEY, ENGINETYPE, INDEXES, SCHEMA, COMPACT")
+        if strings.ToUpper($1) == "VERSION" {
+            $$ = "VERSION"
+        } else {
+            yylex.Error("SHOW command error, only support PRIMARYKEY, SORTKEY, SHARDKEY, ENGINETYPE, INDEXES, INDEX, SCHEMA, COMPACT, VERSION")
+            goto ret1
+        }
     }
 
 SHOW_MEASUREMENT_KEYS_STATEMENT:
@@ -2098,23 +2290,25 @@ ON_DATABASE:
   }
 
 SHOW_FIELD_KEYS_STATEMENT:
-  SHOW FIELD KEYS ON_DATABASE FROM_CLAUSE ORDER_CLAUSES LIMIT_OFFSET_OPTION
+  SHOW FIELD KEYS ON_DATABASE FROM_CLAUSE WHERE_CLAUSE ORDER_CLAUSES LIMIT_OFFSET_OPTION
   {
       stmt := &ShowFieldKeysStatement{}
       stmt.Database = $4
       stmt.Sources = $5
-      stmt.SortFields = $6
-      stmt.Limit = $7[0]
-      stmt.Offset = $7[1]
+      stmt.Condition = $6
+      stmt.SortFields = $7
+      stmt.Limit = $8[0]
+      stmt.Offset = $8[1]
       $$ = stmt
   }
-  |SHOW FIELD KEYS ON_DATABASE ORDER_CLAUSES LIMIT_OFFSET_OPTION
+  |SHOW FIELD KEYS ON_DATABASE WHERE_CLAUSE ORDER_CLAUSES LIMIT_OFFSET_OPTION
    {
        stmt := &ShowFieldKeysStatement{}
        stmt.Database = $4
-       stmt.SortFields = $5
-       stmt.Limit = $6[0]
-       stmt.Offset = $6[1]
+       stmt.Condition = $5
+       stmt.SortFields = $6
+       stmt.Limit = $7[0]
+       stmt.Offset = $7[1]
        $$ = stmt
    }
 
@@ -2219,6 +2413,24 @@ EXPLAIN_STATEMENT:
         stmt.Analyze = true
         $$ = stmt
     }
+    |EXPLAIN ANALYZE IDENT SELECT_STATEMENT
+    {
+        stmt := &ExplainStatement{}
+        stmt.Statement = $4.(*SelectStatement)
+        stmt.Analyze = true
+        switch strings.ToLower($3) {
+        case "verbose":
+            stmt.Verbosity = ExplainVerbose
+        case "summary":
+            stmt.Verbosity = ExplainSummary
+        case "brief":
+            stmt.Verbosity = ExplainBrief
+        default:
+            yylex.Error("EXPLAIN ANALYZE VERBOSE, SUMMARY or BRIEF is expected")
+            goto ret1
+        }
+        $$ = stmt
+    }
     |EXPLAIN SELECT_STATEMENT
     {
         stmt := &ExplainStatement{}
@@ -2226,6 +2438,18 @@ EXPLAIN_STATEMENT:
         stmt.Analyze = false
         $$ = stmt
     }
+    |EXPLAIN IDENT SELECT_STATEMENT
+    {
+        stmt := &ExplainStatement{}
+        stmt.Statement = $3.(*SelectStatement)
+        if strings.ToLower($2) == "cost" {
+            stmt.Cost = true
+        } else {
+            yylex.Error("EXPLAIN ANALYZE or EXPLAIN COST is expected")
+            goto ret1
+        }
+        $$ = stmt
+    }
 
 
 SHOW_TAG_KEY_CARDINALITY_STATEMENT:
@@ -3093,6 +3317,26 @@ SHOW_SHARD_GROUPS_STATEMENT:
         $$ = stmt
     }
 
+SHOW_SHARD_MAPPING_STATEMENT:
+    SHOW SHARD IDENT ON IDENT
+    {
+        if strings.ToUpper($3) != "MAPPING" {
+            yylex.Error("SHOW SHARD command error, only support GROUPS, MAPPING")
+            goto ret1
+        }
+        $$ = &ShowShardMappingStatement{
+            Database: $5,
+        }
+    }
+    |SHOW SHARD IDENT
+    {
+        if strings.ToUpper($3) != "MAPPING" {
+            yylex.Error("SHOW SHARD command error, only support GROUPS, MAPPING")
+            goto ret1
+        }
+        $$ = &ShowShardMappingStatement{}
+    }
+
 DROP_MEASUREMENT_STATEMENT:
     DROP MEASUREMENT IDENT
     {
@@ -3143,9 +3387,9 @@ SAMPLE_POLICY:
     }
 
 SHOW_CONTINUOUS_QUERIES_STATEMENT:
-    SHOW CONTINUOUS QUERIES
+    SHOW CONTINUOUS QUERIES ON_DATABASE
     {
-        $$ = &ShowContinuousQueriesStatement{}
+        $$ = &ShowContinuousQueriesStatement{Database: $4}
     }
 
 DROP_CONTINUOUS_QUERY_STATEMENT:
@@ -3294,22 +3538,90 @@ SHOW_STREAM_STATEMENT:
     {
         $$ = &ShowStreamsStatement{Database:$4}
     }
+    |SHOW STREAMS ON STRING_TYPE DOT STRING_TYPE
+    {
+        $$ = &ShowStreamsStatement{Database:$4, RetentionPolicy:$6}
+    }
+    |SHOW STREAM IDENT
+    {
+        if strings.ToUpper($3) != "TARGETS" {
+            yylex.Error("SHOW STREAM command error, only support TARGETS")
+            goto ret1
+        }
+        $$ = &ShowStreamTargetsStatement{}
+    }
+    |SHOW STREAM IDENT ON STRING_TYPE
+    {
+        if strings.ToUpper($3) != "TARGETS" {
+            yylex.Error("SHOW STREAM command error, only support TARGETS")
+            goto ret1
+        }
+        $$ = &ShowStreamTargetsStatement{Database: $5}
+    }
 
 DROP_STREAM_STATEMENT:
     DROP STREAM STRING_TYPE
     {
     	$$ = &DropStreamsStatement{Name: $3}
     }
+    |DROP STREAM IDENT ON STRING_TYPE
+    {
+        if strings.ToUpper($3) != "TARGETS" {
+            yylex.Error("DROP STREAM command error, only support TARGETS ON")
+            goto ret1
+        }
+        $$ = &DropStreamTargetsStatement{Database: $5}
+    }
 SHOW_QUERIES_STATEMENT:
     SHOW QUERIES
     {
         $$ = &ShowQueriesStatement{}
     }
+    |SHOW QUERIES IDENT IDENT
+    {
+        if strings.ToUpper($3) != "FORMAT" || strings.ToUpper($4) != "JSON" {
+            yylex.Error("expect FORMAT JSON for SHOW QUERIES")
+            goto ret1
+        }
+        $$ = &ShowQueriesStatement{Format: "json"}
+    }
+    |SHOW QUERIES IDENT
+    {
+        if strings.ToUpper($3) != "PRECISE" {
+            yylex.Error("expect PRECISE or FORMAT JSON for SHOW QUERIES")
+            goto ret1
+        }
+        $$ = &ShowQueriesStatement{Precise: true}
+    }
+    |SHOW QUERIES IDENT IDENT IDENT
+    {
+        if strings.ToUpper($3) != "PRECISE" || strings.ToUpper($4) != "FORMAT" || strings.ToUpper($5) != "JSON" {
+            yylex.Error("expect PRECISE FORMAT JSON for SHOW QUERIES")
+            goto ret1
+        }
+        $$ = &ShowQueriesStatement{Format: "json", Precise: true}
+    }
+    |SHOW QUERIES IDENT BY IDENT
+    {
+        if strings.ToUpper($3) != "COUNT" || strings.ToUpper($5) != "NODE" {
+            yylex.Error("expect COUNT BY NODE for SHOW QUERIES")
+            goto ret1
+        }
+        $$ = &ShowQueriesStatement{CountByNode: true}
+    }
 KILL_QUERY_STATEMENT:
     KILL QUERY INTEGER
     {
         $$ = &KillQueryStatement{QueryID: uint64($3)}
     }
+    |KILL QUERY INTEGER ON IDENT
+    {
+        $$ = &KillQueryStatement{QueryID: uint64($3), Host: $5}
+    }
+    |KILL QUERY INTEGER ON STRING
+    {
+        $$ = &KillQueryStatement{QueryID: uint64($3), Host: $5}
+    }
 
 ALL_DESTINATION:
     STRING_TYPE
@@ -3346,6 +3658,10 @@ SHOW_SUBSCRIPTION_STATEMENT:
     {
         $$ = &ShowSubscriptionsStatement{}
     }
+    |SHOW SUBSCRIPTIONS DETAIL
+    {
+        $$ = &ShowSubscriptionsStatement{ShowDetail:true}
+    }
 
 DROP_SUBSCRIPTION_STATEMENT:
     DROP ALL SUBSCRIPTIONS
@@ -3366,9 +3682,17 @@ DROP_SUBSCRIPTION_STATEMENT:
     }
 
 SHOW_CONFIGS_STATEMENT:
-    SHOW CONFIGS
+    SHOW CONFIGS WHERE_CLAUSE
     {
         stmt := &ShowConfigsStatement{}
+        stmt.Condition = $3
+        $$ = stmt
+    }
+    |SHOW CONFIGS FOR STRING_TYPE WHERE_CLAUSE
+    {
+        stmt := &ShowConfigsStatement{}
+        stmt.Component = $4
+        stmt.Condition = $5
         $$ = stmt
     }
 
@@ -3414,6 +3738,183 @@ SET_CONFIG_STATEMENT:
         $$ = stmt
     }
 
+CHECK_CONFIG_STATEMENT:
+    IDENT CONFIG
+    {
+        if strings.ToUpper($1) != "CHECK" {
+            yylex.Error("expect CHECK CONFIG")
+            goto ret1
+        }
+        $$ = &CheckConfigStatement{}
+    }
+
+SHOW_QUERY_LIMITS_STATEMENT:
+    SHOW QUERY IDENT ON IDENT
+    {
+        if strings.ToUpper($3) != "LIMITS" {
+            yylex.Error("expect LIMITS for SHOW QUERY")
+            goto ret1
+        }
+        $$ = &ShowQueryLimitsStatement{
+            Database: $5,
+        }
+    }
+    |SHOW QUERY IDENT
+    {
+        if strings.ToUpper($3) != "LIMITS" {
+            yylex.Error("expect LIMITS for SHOW QUERY")
+            goto ret1
+        }
+        $$ = &ShowQueryLimitsStatement{}
+    }
+
+SHOW_VERSION_STATEMENT:
+    SHOW IDENT
+    {
+        if strings.ToUpper($2) != "VERSION" {
+            yylex.Error("SHOW command error, only support VERSION")
+            goto ret1
+        }
+        $$ = &ShowVersionStatement{}
+    }
+
+SET_QUERY_TRACING_STATEMENT:
+    SET QUERY IDENT ON
+    {
+        if strings.ToUpper($3) != "TRACING" {
+            yylex.Error("SET QUERY command error, only support TRACING")
+            goto ret1
+        }
+        $$ = &SetQueryTracingStatement{Enabled: true}
+    }
+    |SET QUERY IDENT IDENT
+    {
+        if strings.ToUpper($3) != "TRACING" {
+            yylex.Error("SET QUERY command error, only support TRACING")
+            goto ret1
+        }
+        if strings.ToUpper($4) != "OFF" {
+            yylex.Error("expect ON or OFF for SET QUERY TRACING")
+            goto ret1
+        }
+        $$ = &SetQueryTracingStatement{Enabled: false}
+    }
+
+SHOW_SLOW_QUERIES_STATEMENT:
+    SHOW IDENT QUERIES
+    {
+        if strings.ToUpper($2) != "SLOW" {
+            yylex.Error("SHOW QUERIES command error, only support SLOW")
+            goto ret1
+        }
+        $$ = &ShowSlowQueriesStatement{}
+    }
+
+CLEAR_SLOW_QUERIES_STATEMENT:
+    IDENT IDENT QUERIES
+    {
+        if strings.ToUpper($1) != "CLEAR" {
+            yylex.Error("expect CLEAR SLOW QUERIES")
+            goto ret1
+        }
+        if strings.ToUpper($2) != "SLOW" {
+            yylex.Error("CLEAR command error, only support SLOW QUERIES")
+            goto ret1
+        }
+        $$ = &ClearSlowQueriesStatement{}
+    }
+
+SHOW_DIAGNOSTICS_STATEMENT:
+    SHOW DIAGNOSTICS
+    {
+        $$ = &ShowDiagnosticsStatement{}
+    }
+    |SHOW DIAGNOSTICS FOR STRING
+    {
+        $$ = &ShowDiagnosticsStatement{Module: $4}
+    }
+
+DRAIN_NODE_STATEMENT:
+    IDENT IDENT INTEGER
+    {
+        if strings.ToUpper($1) != "DRAIN" {
+            yylex.Error("expect DRAIN NODE")
+            goto ret1
+        }
+        if strings.ToUpper($2) != "NODE" {
+            yylex.Error("DRAIN command error, only support NODE")
+            goto ret1
+        }
+        $$ = &DrainNodeStatement{NodeID: uint64($3)}
+    }
+    |IDENT IDENT INTEGER IDENT
+    {
+        if strings.ToUpper($1) != "DRAIN" {
+            yylex.Error("expect DRAIN NODE")
+            goto ret1
+        }
+        if strings.ToUpper($2) != "NODE" {
+            yylex.Error("DRAIN command error, only support NODE")
+            goto ret1
+        }
+        if strings.ToUpper($4) != "OFF" {
+            yylex.Error("expect OFF for DRAIN NODE")
+            goto ret1
+        }
+        $$ = &DrainNodeStatement{NodeID: uint64($3), Off: true}
+    }
+
+REBALANCE_DATABASE_STATEMENT:
+    IDENT DATABASE IDENT
+    {
+        if strings.ToUpper($1) != "REBALANCE" {
+            yylex.Error("expect REBALANCE DATABASE")
+            goto ret1
+        }
+        stmt := &RebalanceDatabaseStatement{}
+        stmt.Database = $3
+        $$ = stmt
+    }
+    |IDENT DATABASE IDENT IDENT
+    {
+        if strings.ToUpper($1) != "REBALANCE" {
+            yylex.Error("expect REBALANCE DATABASE")
+            goto ret1
+        }
+        if strings.ToUpper($4) != "DRYRUN" {
+            yylex.Error("expect DRYRUN for REBALANCE DATABASE")
+            goto ret1
+        }
+        stmt := &RebalanceDatabaseStatement{}
+        stmt.Database = $3
+        stmt.DryRun = true
+        $$ = stmt
+    }
+
+SHOW_NODES_STATEMENT:
+    SHOW IDENT IDENT
+    {
+        switch strings.ToUpper($2 + " " + $3) {
+        case "DATA NODES":
+            $$ = &ShowDataNodesStatement{}
+        case "META NODES":
+            $$ = &ShowMetaNodesStatement{}
+        case "EXECUTOR LIMITS":
+            $$ = &ShowExecutorLimitsStatement{}
+        default:
+            yylex.Error("SHOW command error, only support DATA NODES, META NODES, EXECUTOR LIMITS")
+            goto ret1
+        }
+    }
+    |SHOW IDENT IDENT DETAIL
+    {
+        if strings.ToUpper($2) != "DATA" || strings.ToUpper($3) != "NODES" {
+            yylex.Error("SHOW command error, only support DATA NODES DETAIL")
+            goto ret1
+        }
+        $$ = &ShowDataNodesStatement{Detail: true}
+    }
+
 SHOW_CLUSTER_STATEMENT:
     SHOW CLUSTER
      {
@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/stretchr/testify/assert"
 )
 
 var cases []string
@@ -101,6 +102,10 @@ func init() {
 		"SHOW TAG VALUES WITH KEY = region WHERE host !~ /server0[12]/",                             // add int
 		"explain analyze select * from a where b>0",                                                 // add explain analyze
 		"explain select * from a where b>0",                                                         // add explain
+		"explain cost select * from a where b>0",                                                    // add explain cost
+		"explain analyze verbose select * from a where b>0",                                         // add explain analyze verbose
+		"explain analyze summary select * from a where b>0",                                         // add explain analyze summary
+		"explain analyze brief select * from a where b>0",                                           // add explain analyze brief
 		"SHOW FIELD KEY CARDINALITY",                                                                // add show field key cardinality
 		"SHOW TAG VALUES EXACT CARDINALITY WITH KEY = host WHERE region =~ /ca.*/",                  // add show tag values cardinality
 		"SHOW TAG KEY EXACT CARDINALITY",                                                            // add show tag key cardinality
@@ -160,6 +165,7 @@ func init() {
 		"create subscription subs0 on db0.autogen destinations all \"127.0.0.1:1000\", \"127.0.0.1:1001\"",
 		"create subscription subs0 on db0 destinations any \"127.0.0.1:1000\", \"127.0.0.1:1001\"",
 		"SHOW SUBSCRIPTIONS",
+		"SHOW SUBSCRIPTIONS DETAIL",
 		"DROP ALL SUBSCRIPTIONS",
 		"DROP ALL SUBSCRIPTIONS on db0",
 		"DROP SUBSCRIPTION subs0 on db0.autogen",
@@ -225,6 +231,9 @@ func init() {
 		"CREATE USER jdoe WITH PASSWORD 'Jdoe@1337'",                     //add create user with.
 		"grant all privileges to jdoe",                                   //grant privileges to admin.
 		"DROP USER jdoe",                                                 //drop user
+		"DROP USER IN (jdoe, \"todd\")",                                  //drop users
+		"REVOKE ALL ON ALL FROM jdoe",                                    //revoke on all databases
+		"REVOKE ALL PRIVILEGES ON ALL FROM jdoe",                         //revoke on all databases
 		"REVOKE all privileges FROM admin",                               //revoke from admin
 		"Drop Shard 123",                                                 //Drop Shard
 		"SET PASSWORD FOR \"todd\" = 'password4todd'",                    //add SET PASSWORD
@@ -235,6 +244,9 @@ func init() {
 		"drop measurement m1",                                            //drop measurement
 		"alter measurement tb1",                                          //alter measurement
 		"alter measurement tb1 with shardkey tag2,tag1",                  //alter measurement with unsorted key
+		"explain cost select * from a where b>0",                         //add explain cost
+		"explain analyze summary select * from a where b>0",              //add explain analyze summary
+		"select /*+ max_series_n(500000) */ f1 from mst",                 //add max_series_n hint
 	}
 }
 
@@ -345,6 +357,7 @@ func TestSingleParser(t *testing.T) {
 		"show schema from mst",
 		"show indexes from mst",
 		"show INDExeS from mst",
+		"show version from mst",
 		"create measurement db0.rp0.mst0 (tag1 tag, field1 int64 field) with ENGINETYPE = columnstore indextype bloomfilter indexlist tag1",
 		"create measurement db0.rp0.mst0 (tag1 tag, field1 int64 field) with ENGINETYPE = tsstore indextype text indexlist tag1",
 		"create measurement db0.rp0.mst0 (tag1 tag, field1 int64 field) with ENGINETYPE = tsstore indextype field indexlist tag1",
@@ -358,6 +371,63 @@ func TestSingleParser(t *testing.T) {
 		"create measurement mst0 (tag1 tag, field1 int64 field) with ENGINETYPE = columnstore SHARDKEY tag1 SHARDS 10 type hash",
 		"create measurement mst0 (tag1 tag, field1 int64 field) with ENGINETYPE = tsstore SHARDKEY tag1 SHARDS 10 type hash",
 		"show shards from db.autogen.mst",
+		"show measurements like 'cpu%'",
+		"show measurements on db0 ilike 'CPU_' limit 10",
+		"rebalance database db0",
+		"rebalance database db0 dryrun",
+		"select rebalance from mst",
+		"show data nodes",
+		"SHOW DATA NODES DETAIL",
+		"show meta nodes",
+		"show queries format json",
+		"show queries precise",
+		"show queries precise format json",
+		"show queries count by node",
+		"create user u with password 'Aa@123456789' grant read on db1, write on db2, all on db3",
+		"show index from mst",
+		"show index from db0.rp0.mst",
+		"kill query 1 on '127.0.0.1:8400'",
+		"kill query 1 on \"127.0.0.1:8400\"",
+		"show stream targets",
+		"show stream targets on db0",
+		"drop stream targets on db0",
+		"show field keys on db0 from t1 where host = 'a'",
+		"show field keys where host = 'a' order by fieldKey limit 2",
+		"drop user in (a, \"b\", c)",
+		"show configs for 'store'",
+		"show configs for sql",
+		"check config",
+		"select check from mst",
+		"select limits from mst",
+		"select clear from mst",
+		"show query limits on db0",
+		"show query limits",
+		"show executor limits",
+		"show streams on db1.rp1",
+		"show continuous queries on db0",
+		"select * from mst with availability any",
+		"select count(f1) from mst where time > now() - 1h group by time(1m) with availability QUORUM",
+		"select * from mst with availability all",
+		"drain node 2",
+		"drain node 2 off",
+		"select drain from mst",
+		"show shard mapping on db0",
+		"show shard mapping",
+		"show version",
+		"show version from mst",
+		"set query tracing on",
+		"set query tracing off",
+		"show retention policies detail",
+		"show retention policies on db0 detail",
+		"show slow queries",
+		"SHOW SLOW QUERIES",
+		"clear slow queries",
+		"show databases where deleting = false",
+		"show databases detail where deleting = true",
+		"show diagnostics",
+		"show diagnostics for 'build'",
+		"show configs where component = 'sql' and name =~ /http\\..*/",
+		"show configs for sql where name = 'logging.level'",
 	}
 	for _, c := range c {
 		YyParser.Scanner = influxql.NewScanner(strings.NewReader(c))
@@ -388,7 +458,6 @@ func TestSingleParserError(t *testing.T) {
 		"create measurement mst0 (column4 float,column1 string,column0 string,column3 float,column2 int) with enginetype = columnstore  SHARDKEY column2,column3 TYPE hash  PRIMARYKEY column3,column4,column0,column1 SORTKEY column2,column3,column4,column0,column1",
 		"create measurement mst0 (column4 float64,column1 string,column0 string,column3 float64,column2 int64) with enginetype = columnstore  SHARDKEY column2,column3 TYPE hash  PRIMARYKEY column3,column4,column0,column1 SORTKEY column2,column3,column4,column0,column1",
 		"show sortkey1 from mst",
-		"show index from mst",
 		"create measurement db0.rp0.mst0 (tag1 tag, field1 int64 field) with ENGINETYPE = tsstore indextype bloomfilter indexlist tag1",
 		"create measurement db0.rp0.mst0 (tag1 tag, field1 int64 field) with ENGINETYPE = tsstore indextype field1 indexlist tag1",
 		"create measurement db0.rp0.mst0 (tag1 tag, field1 int64 field) with ENGINETYPE = columnstore indextype field indexlist tag1",
@@ -408,6 +477,34 @@ func TestSingleParserError(t *testing.T) {
 		"create measurement mst0 (tag1 tag, field1 int64 field) with ENGINETYPE = tsstore SHARDKEY tag1 SHARDS 10 type range",
 		"create measurement mst0 (tag1 tag, field1 int64 field) with ENGINETYPE = columnstore SHARDKEY tag1 SHARDS auto type range",
 		"create measurement mst0 (tag1 tag, field1 int64 field) with ENGINETYPE = tsstore SHARDKEY tag1 SHARDS auto type range",
+		"explain costs select * from a",
+		"explain analyze short select * from a",
+		"rebalance database db0 now",
+		"rebalanced database db0",
+		"checks config",
+		"show meta nodes detail",
+		"show data node",
+		"show queries format csv",
+		"show queries fast",
+		"show queries precise format csv",
+		"show queries count by host",
+		"create user u with password 'Aa@123456789' grant admin on db1",
+		"show stream sources",
+		"drop stream sources on db0",
+		"set query trace on",
+		"set query tracing enable",
+		"show fast queries",
+		"clear fast queries",
+		"show planner limits",
+		"show query limitss on db0",
+		"select * from mst with availability one",
+		"select * from mst with level all",
+		"drain nodes 2",
+		"drain node 2 on1",
+		"drian node 2",
+		"show shard map on db0",
+		"show versions",
+		"clean slow queries",
 	}
 
 	cr := []string{
@@ -423,8 +520,7 @@ func TestSingleParserError(t *testing.T) {
 		"syntax error: unexpected TAG, expecting COMMA or RPAREN",
 		"expect FLOAT64, INT64, BOOL, STRING for column data type",
 		"PrimaryKey should be left prefix of SortKey",
-		"SHOW command error, only support PRIMARYKEY, SORTKEY, SHARDKEY, ENGINETYPE, INDEXES, SCHEMA, COMPACT",
-		"syntax error: unexpected INDEX",
+		"SHOW command error, only support PRIMARYKEY, SORTKEY, SHARDKEY, ENGINETYPE, INDEXES, INDEX, SCHEMA, COMPACT, VERSION",
 		"Invalid index type for TSSTORE",
 		"Invalid index type for TSSTORE",
 		"Invalid index type for COLUMNSTORE",
@@ -444,6 +540,34 @@ func TestSingleParserError(t *testing.T) {
 		"Not support to set num-of-shards for range sharding",
 		"Not support to set num-of-shards for range sharding",
 		"Not support to set num-of-shards for range sharding",
+		"EXPLAIN ANALYZE or EXPLAIN COST is expected",
+		"EXPLAIN ANALYZE VERBOSE, SUMMARY or BRIEF is expected",
+		"expect DRYRUN for REBALANCE DATABASE",
+		"expect REBALANCE DATABASE",
+		"expect CHECK CONFIG",
+		"SHOW command error, only support DATA NODES DETAIL",
+		"SHOW command error, only support DATA NODES, META NODES, EXECUTOR LIMITS",
+		"expect FORMAT JSON for SHOW QUERIES",
+		"expect PRECISE or FORMAT JSON for SHOW QUERIES",
+		"expect PRECISE FORMAT JSON for SHOW QUERIES",
+		"expect COUNT BY NODE for SHOW QUERIES",
+		"wrong Privilege",
+		"SHOW STREAM command error, only support TARGETS",
+		"DROP STREAM command error, only support TARGETS ON",
+		"SET QUERY command error, only support TRACING",
+		"expect ON or OFF for SET QUERY TRACING",
+		"SHOW QUERIES command error, only support SLOW",
+		"CLEAR command error, only support SLOW QUERIES",
+		"SHOW command error, only support DATA NODES, META NODES, EXECUTOR LIMITS",
+		"expect LIMITS for SHOW QUERY",
+		"invalid availability level one, expect any, quorum or all",
+		"expect WITH AVAILABILITY for SELECT",
+		"DRAIN command error, only support NODE",
+		"expect OFF for DRAIN NODE",
+		"expect DRAIN NODE",
+		"SHOW SHARD command error, only support GROUPS, MAPPING",
+		"SHOW command error, only support VERSION",
+		"expect CLEAR SLOW QUERIES",
 	}
 	for i, c := range c {
 		YyParser.Scanner = influxql.NewScanner(strings.NewReader(c))
@@ -479,3 +603,23 @@ func BenchmarkPreviousParser(b *testing.B) {
 		}
 	}
 }
+
+func TestShowMeasurementsLike(t *testing.T) {
+	for sql, exp := range map[string]string{
+		"SHOW MEASUREMENTS LIKE 'cpu%'":                  "SHOW MEASUREMENTS LIKE 'cpu%'",
+		"SHOW MEASUREMENTS ON db0 ILIKE 'cpu_' LIMIT 10": "SHOW MEASUREMENTS ON db0 ILIKE 'cpu_' LIMIT 10",
+		"SHOW MEASUREMENTS LIKE 'cpu%' WHERE a = 'b'":    "SHOW MEASUREMENTS LIKE 'cpu%' WHERE a = 'b'",
+		"SHOW MEASUREMENTS ILIKE 'cpu%' WHERE a = 'b'":   "SHOW MEASUREMENTS ILIKE 'cpu%' WHERE a = 'b'",
+	} {
+		YyParser := &influxql.YyParser{Query: influxql.Query{}}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(sql))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		assert.NoError(t, err)
+		assert.Equal(t, exp, q.Statements[0].String())
+
+		stmt, err := influxql.NewParser(strings.NewReader(sql)).ParseStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, exp, stmt.String())
+	}
+}
@@ -106,6 +106,8 @@ type yySymType struct {
 	indexOption      *IndexOption
 	databasePolicy   DatabasePolicy
 	cmOption         *CreateMeasurementStatementOption
+	grant            *GrantStatement
+	grants           []*GrantStatement
 }
 
 const FROM = 57346
@@ -433,552 +435,611 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line sql.y:3476
+//line sql.y:3977
 
 //line yacctab:1
 var yyExca = [...]int16{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 70,
-	4, 92,
-	-2, 138,
-	-1, 467,
-	113, 155,
-	132, 155,
-	133, 155,
-	134, 155,
-	135, 155,
-	136, 155,
-	137, 155,
-	140, 155,
-	141, 155,
-	-2, 144,
+	-1, 83,
+	4, 107,
+	-2, 153,
+	-1, 123,
+	4, 300,
+	-2, 467,
+	-1, 548,
+	113, 170,
+	132, 170,
+	133, 170,
+	134, 170,
+	135, 170,
+	136, 170,
+	137, 170,
+	140, 170,
+	141, 170,
+	-2, 159,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 1161
+const yyLast = 1269
 
 var yyAct = [...]int16{
-	493, 896, 922, 508, 866, 769, 688, 421, 796, 887,
-	709, 262, 391, 507, 786, 737, 641, 692, 702, 827,
-	4, 548, 489, 626, 74, 70, 630, 235, 767, 549,
-	382, 491, 138, 419, 205, 440, 245, 321, 231, 178,
-	318, 229, 2, 153, 173, 160, 161, 165, 166, 389,
-	233, 279, 162, 163, 167, 164, 160, 161, 165, 166,
-	846, 707, 668, 80, 467, 567, 347, 348, 847, 84,
-	85, 88, 162, 163, 167, 164, 160, 161, 165, 166,
-	347, 348, 494, 347, 348, 213, 627, 148, 667, 499,
-	366, 628, 234, 88, 88, 495, 212, 156, 878, 213,
-	168, 204, 172, 154, 571, 203, 603, 206, 206, 358,
-	359, 360, 361, 362, 363, 716, 717, 365, 364, 718,
-	212, 932, 862, 213, 211, 214, 560, 86, 860, 75,
-	281, 88, 181, 347, 348, 225, 212, 227, 269, 213,
-	849, 270, 76, 82, 79, 83, 81, 897, 87, 88,
-	88, 58, 77, 607, 608, 73, 445, 204, 894, 217,
-	444, 203, 864, 206, 206, 246, 880, 870, 257, 837,
-	228, 202, 162, 163, 167, 164, 160, 161, 165, 166,
-	836, 784, 865, 266, 783, 271, 272, 273, 274, 275,
-	276, 277, 278, 248, 764, 280, 721, 264, 315, 673,
-	265, 246, 672, 671, 290, 670, 80, 544, 541, 542,
-	772, 292, 84, 85, 296, 772, 726, 725, 58, 288,
-	289, 556, 159, 298, 299, 300, 212, 605, 307, 213,
-	606, 644, 312, 284, 80, 285, 331, 503, 504, 547,
-	84, 85, 545, 558, 313, 506, 505, 529, 207, 334,
-	432, 528, 409, 306, 260, 332, 408, 305, 176, 220,
-	380, 926, 349, 867, 145, 350, 797, 207, 861, 739,
-	207, 143, 75, 346, 88, 351, 352, 345, 703, 771,
-	550, 632, 794, 207, 775, 76, 82, 79, 83, 81,
-	761, 87, 760, 752, 381, 77, 712, 711, 73, 698,
-	75, 657, 88, 656, 283, 620, 619, 602, 600, 395,
-	599, 597, 387, 76, 82, 79, 83, 81, 71, 87,
-	411, 207, 595, 77, 582, 581, 73, 443, 394, 580,
-	575, 398, 400, 573, 453, 557, 174, 396, 642, 643,
-	457, 458, 404, 559, 406, 416, 646, 645, 615, 413,
-	546, 414, 703, 531, 613, 500, 472, 473, 418, 484,
-	483, 480, 446, 162, 163, 167, 164, 160, 161, 165,
-	166, 146, 246, 246, 470, 465, 466, 479, 144, 169,
-	460, 393, 246, 459, 379, 461, 378, 377, 171, 170,
-	374, 373, 209, 488, 474, 372, 369, 367, 338, 513,
-	337, 336, 335, 330, 329, 328, 323, 512, 316, 314,
-	517, 310, 293, 519, 501, 533, 497, 286, 498, 259,
-	221, 219, 215, 532, 201, 199, 169, 158, 540, 515,
-	516, 579, 518, 655, 583, 171, 170, 569, 530, 527,
-	456, 449, 443, 522, 568, 525, 536, 538, 539, 578,
-	450, 447, 534, 407, 543, 207, 327, 928, 823, 822,
-	681, 487, 486, 417, 800, 88, 69, 799, 463, 207,
-	555, 207, 933, 577, 911, 574, 565, 564, 899, 566,
-	570, 898, 572, 893, 879, 853, 839, 831, 604, 588,
-	798, 793, 591, 792, 587, 790, 789, 704, 700, 596,
-	349, 699, 610, 594, 585, 686, 590, 464, 451, 386,
-	616, 496, 496, 925, 210, 874, 633, 80, 845, 609,
-	834, 637, 741, 84, 85, 687, 614, 635, 636, 611,
-	589, 638, 58, 639, 629, 618, 658, 471, 468, 654,
-	356, 355, 59, 60, 666, 353, 326, 634, 662, 710,
-	664, 665, 65, 344, 62, 69, 927, 912, 652, 653,
-	342, 889, 669, 842, 63, 809, 791, 660, 661, 728,
-	663, 729, 730, 207, 612, 207, 593, 64, 592, 691,
-	584, 67, 157, 75, 695, 88, 61, 383, 785, 322,
-	207, 177, 433, 705, 706, 319, 76, 82, 79, 83,
-	81, 66, 87, 149, 683, 765, 77, 222, 208, 73,
-	701, 151, 690, 918, 840, 780, 685, 832, 831, 194,
-	669, 80, 68, 680, 714, 137, 696, 84, 85, 708,
-	678, 724, 713, 621, 622, 322, 320, 226, 828, 732,
-	733, 195, 768, 916, 719, 921, 731, 723, 80, 477,
-	908, 892, 734, 234, 84, 85, 779, 735, 751, 740,
-	343, 308, 309, 412, 749, 750, 756, 747, 758, 759,
-	179, 405, 754, 755, 736, 757, 403, 341, 311, 766,
-	297, 150, 320, 188, 748, 189, 774, 75, 811, 88,
-	303, 304, 753, 787, 191, 192, 746, 207, 179, 762,
-	76, 82, 79, 83, 81, 745, 87, 650, 773, 640,
-	77, 58, 207, 782, 475, 521, 88, 184, 185, 186,
-	682, 267, 246, 268, 434, 788, 795, 76, 82, 79,
-	83, 81, 722, 87, 3, 806, 130, 77, 802, 720,
-	496, 322, 301, 302, 871, 617, 801, 778, 388, 808,
-	287, 804, 216, 816, 817, 805, 176, 810, 819, 820,
-	815, 821, 812, 813, 824, 818, 135, 872, 807, 258,
-	182, 183, 128, 742, 743, 125, 830, 127, 190, 261,
-	814, 147, 129, 710, 424, 425, 763, 689, 838, 675,
-	829, 554, 126, 833, 553, 422, 426, 428, 431, 835,
-	429, 430, 552, 841, 152, 551, 423, 295, 247, 844,
-	843, 218, 851, 200, 848, 180, 436, 131, 142, 858,
-	850, 563, 859, 873, 136, 852, 857, 427, 854, 693,
-	694, 139, 132, 133, 781, 120, 134, 868, 863, 777,
-	776, 140, 787, 787, 869, 855, 856, 428, 431, 744,
-	429, 430, 139, 877, 882, 139, 875, 876, 648, 141,
-	676, 886, 881, 291, 649, 576, 98, 884, 885, 520,
-	888, 119, 439, 368, 117, 324, 118, 354, 490, 524,
-	895, 469, 402, 370, 598, 826, 481, 883, 902, 903,
-	900, 385, 478, 112, 905, 901, 888, 909, 904, 910,
-	371, 249, 462, 93, 89, 913, 90, 91, 825, 624,
-	625, 727, 100, 917, 919, 250, 121, 924, 251, 803,
-	97, 392, 92, 124, 397, 399, 401, 929, 924, 931,
-	930, 122, 94, 410, 96, 123, 511, 255, 415, 139,
-	253, 263, 111, 108, 109, 110, 115, 101, 392, 104,
-	384, 99, 586, 105, 254, 240, 239, 509, 510, 58,
-	139, 155, 140, 102, 140, 140, 198, 58, 103, 59,
-	60, 376, 697, 179, 375, 476, 455, 106, 107, 65,
-	454, 62, 113, 114, 452, 448, 435, 340, 339, 333,
-	294, 63, 80, 256, 252, 224, 223, 197, 84, 85,
-	196, 155, 390, 116, 64, 601, 485, 482, 67, 139,
-	193, 187, 562, 61, 561, 438, 437, 442, 441, 684,
-	679, 677, 514, 770, 914, 915, 923, 906, 66, 890,
-	523, 907, 526, 891, 920, 95, 738, 420, 715, 535,
-	537, 241, 623, 242, 492, 631, 282, 357, 175, 68,
-	78, 244, 243, 236, 502, 230, 232, 1, 237, 72,
-	88, 54, 53, 52, 57, 56, 55, 51, 50, 49,
-	325, 238, 82, 79, 83, 81, 48, 87, 47, 46,
-	45, 77, 44, 43, 42, 41, 40, 39, 38, 37,
-	36, 35, 34, 33, 32, 31, 30, 29, 28, 27,
-	26, 25, 24, 23, 20, 19, 21, 18, 22, 17,
-	16, 15, 13, 14, 12, 11, 674, 7, 10, 9,
-	8, 317, 6, 5, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 647, 0,
-	0, 651, 0, 0, 0, 0, 0, 0, 0, 0,
-	659,
+	578, 1008, 1034, 593, 977, 874, 785, 806, 499, 869,
+	998, 900, 309, 592, 891, 460, 837, 4, 789, 935,
+	637, 723, 736, 719, 872, 574, 272, 638, 83, 87,
+	576, 497, 533, 451, 240, 518, 381, 282, 268, 378,
+	2, 156, 270, 197, 177, 584, 953, 326, 266, 184,
+	185, 189, 190, 458, 954, 69, 186, 187, 191, 188,
+	184, 185, 189, 190, 804, 94, 989, 203, 186, 187,
+	191, 188, 184, 185, 189, 190, 409, 410, 102, 548,
+	764, 720, 763, 409, 410, 94, 721, 696, 656, 579,
+	93, 183, 248, 649, 1009, 166, 98, 99, 409, 410,
+	814, 815, 580, 1006, 816, 247, 1044, 463, 248, 462,
+	93, 247, 102, 180, 248, 375, 98, 99, 192, 523,
+	196, 571, 991, 522, 572, 178, 241, 981, 186, 187,
+	191, 188, 184, 185, 189, 190, 102, 409, 410, 739,
+	945, 316, 246, 249, 317, 201, 262, 660, 1038, 248,
+	241, 944, 271, 261, 102, 264, 88, 328, 102, 247,
+	975, 239, 248, 227, 889, 238, 888, 969, 241, 89,
+	96, 92, 97, 95, 206, 101, 88, 865, 102, 90,
+	976, 237, 86, 294, 819, 296, 252, 102, 283, 89,
+	96, 92, 97, 95, 239, 101, 769, 265, 238, 90,
+	174, 241, 86, 285, 768, 767, 700, 701, 766, 633,
+	313, 630, 631, 870, 318, 319, 320, 321, 322, 323,
+	324, 325, 327, 877, 368, 312, 94, 337, 311, 372,
+	283, 301, 186, 187, 191, 188, 184, 185, 189, 190,
+	158, 69, 335, 336, 338, 340, 737, 738, 347, 967,
+	331, 93, 332, 956, 741, 740, 877, 98, 99, 588,
+	589, 364, 339, 277, 276, 306, 305, 591, 590, 824,
+	69, 172, 823, 647, 391, 94, 349, 350, 351, 247,
+	698, 358, 248, 699, 645, 363, 302, 636, 634, 366,
+	617, 392, 876, 394, 616, 100, 510, 445, 173, 444,
+	93, 412, 565, 436, 411, 480, 98, 99, 371, 479,
+	408, 165, 407, 442, 357, 300, 256, 88, 356, 102,
+	871, 330, 230, 219, 69, 880, 255, 978, 200, 901,
+	89, 96, 92, 97, 95, 84, 101, 413, 414, 163,
+	90, 161, 1000, 86, 341, 973, 971, 968, 839, 278,
+	450, 279, 639, 725, 898, 862, 155, 861, 852, 810,
+	809, 808, 796, 466, 456, 646, 274, 534, 102, 752,
+	490, 220, 307, 342, 751, 167, 231, 713, 712, 275,
+	96, 92, 97, 95, 695, 101, 692, 521, 465, 90,
+	691, 469, 471, 690, 531, 688, 686, 673, 672, 669,
+	482, 537, 538, 539, 534, 487, 198, 664, 662, 566,
+	437, 648, 635, 619, 493, 585, 467, 496, 553, 554,
+	567, 475, 524, 477, 561, 560, 541, 494, 484, 492,
+	485, 491, 446, 551, 242, 488, 546, 547, 370, 283,
+	283, 193, 464, 254, 449, 428, 164, 448, 162, 283,
+	195, 194, 540, 242, 542, 443, 242, 555, 295, 441,
+	440, 435, 434, 573, 420, 421, 422, 423, 424, 425,
+	601, 431, 427, 426, 1040, 242, 429, 399, 398, 397,
+	395, 600, 605, 390, 582, 389, 388, 607, 586, 383,
+	629, 376, 367, 361, 343, 333, 583, 303, 621, 299,
+	628, 251, 298, 257, 597, 598, 250, 236, 234, 603,
+	604, 229, 606, 225, 242, 224, 176, 708, 706, 615,
+	521, 182, 657, 620, 527, 668, 624, 626, 627, 193,
+	632, 750, 674, 528, 308, 658, 618, 536, 195, 194,
+	525, 489, 610, 667, 613, 478, 387, 644, 666, 927,
+	926, 622, 778, 570, 569, 653, 663, 495, 659, 904,
+	661, 102, 903, 346, 1045, 654, 94, 679, 655, 1023,
+	682, 697, 81, 1011, 544, 1010, 1005, 990, 678, 687,
+	960, 947, 939, 685, 902, 897, 411, 896, 895, 894,
+	703, 93, 676, 801, 798, 797, 709, 98, 99, 783,
+	681, 670, 545, 529, 728, 455, 1037, 702, 244, 732,
+	985, 952, 841, 726, 727, 942, 730, 731, 784, 722,
+	707, 734, 733, 704, 680, 754, 552, 549, 749, 711,
+	418, 417, 762, 415, 396, 386, 753, 758, 807, 760,
+	761, 81, 404, 406, 729, 1039, 1024, 1001, 765, 950,
+	931, 913, 827, 828, 452, 747, 748, 88, 826, 102,
+	705, 684, 683, 675, 756, 757, 671, 759, 788, 454,
+	89, 96, 92, 97, 95, 793, 101, 181, 890, 379,
+	90, 158, 344, 242, 802, 803, 599, 374, 226, 511,
+	382, 258, 243, 780, 171, 787, 168, 866, 799, 242,
+	1030, 242, 948, 885, 792, 782, 468, 470, 472, 765,
+	940, 939, 777, 800, 263, 481, 775, 812, 805, 382,
+	486, 936, 221, 1033, 794, 1028, 1020, 822, 811, 1004,
+	245, 204, 3, 204, 832, 833, 817, 380, 873, 558,
+	829, 830, 831, 821, 884, 359, 360, 834, 581, 581,
+	405, 483, 779, 851, 476, 840, 474, 853, 835, 403,
+	849, 850, 857, 362, 859, 860, 380, 170, 847, 855,
+	856, 867, 858, 348, 169, 213, 138, 214, 836, 354,
+	355, 216, 217, 879, 209, 210, 211, 915, 848, 846,
+	892, 202, 845, 863, 345, 745, 735, 854, 609, 512,
+	314, 878, 315, 352, 353, 207, 208, 820, 818, 382,
+	982, 710, 137, 887, 175, 135, 883, 136, 457, 242,
+	334, 242, 893, 200, 602, 283, 928, 899, 983, 158,
+	297, 232, 611, 215, 614, 910, 906, 807, 864, 242,
+	786, 623, 625, 772, 771, 643, 642, 905, 909, 908,
+	641, 640, 912, 920, 921, 284, 253, 139, 914, 923,
+	924, 919, 925, 69, 142, 773, 235, 922, 916, 917,
+	205, 514, 140, 70, 71, 652, 141, 911, 228, 160,
+	157, 938, 984, 76, 157, 73, 714, 715, 157, 918,
+	886, 790, 791, 946, 937, 74, 506, 509, 941, 507,
+	508, 943, 882, 881, 844, 416, 744, 743, 75, 949,
+	665, 612, 78, 951, 608, 473, 958, 72, 517, 430,
+	159, 955, 384, 965, 82, 575, 966, 957, 550, 432,
+	959, 964, 77, 689, 562, 559, 543, 930, 961, 292,
+	929, 970, 290, 974, 907, 979, 433, 825, 932, 980,
+	892, 892, 461, 79, 242, 596, 291, 453, 962, 963,
+	286, 993, 933, 988, 986, 987, 310, 742, 997, 992,
+	746, 242, 717, 718, 287, 995, 996, 288, 999, 755,
+	594, 595, 157, 677, 271, 179, 158, 158, 158, 233,
+	69, 1007, 439, 972, 934, 438, 795, 80, 204, 1014,
+	1015, 581, 179, 994, 1012, 557, 1017, 1013, 999, 1021,
+	1016, 1022, 535, 532, 530, 526, 112, 1025, 868, 513,
+	447, 402, 401, 400, 459, 1029, 1031, 393, 373, 1036,
+	369, 365, 293, 94, 289, 260, 842, 843, 259, 1041,
+	1036, 1043, 1042, 130, 223, 222, 694, 693, 568, 564,
+	563, 157, 218, 107, 103, 94, 104, 105, 93, 212,
+	651, 650, 114, 133, 98, 99, 516, 515, 520, 519,
+	111, 781, 106, 776, 774, 875, 1026, 1027, 1035, 1018,
+	93, 1002, 108, 1019, 110, 1003, 98, 99, 1032, 109,
+	838, 304, 129, 126, 127, 128, 134, 115, 124, 119,
+	498, 113, 813, 120, 716, 577, 724, 329, 419, 199,
+	91, 281, 280, 116, 273, 587, 118, 267, 117, 122,
+	269, 1, 85, 39, 88, 68, 102, 121, 125, 67,
+	66, 65, 131, 132, 69, 64, 63, 89, 96, 92,
+	97, 95, 62, 101, 70, 71, 556, 90, 102, 61,
+	86, 148, 60, 123, 76, 55, 73, 54, 53, 89,
+	96, 92, 97, 95, 59, 101, 74, 58, 57, 90,
+	56, 52, 51, 50, 385, 49, 48, 47, 46, 75,
+	45, 153, 44, 78, 43, 42, 41, 146, 72, 40,
+	143, 38, 145, 37, 36, 35, 34, 147, 502, 503,
+	33, 32, 31, 77, 30, 29, 28, 144, 27, 500,
+	504, 506, 509, 26, 507, 508, 25, 24, 23, 20,
+	501, 19, 21, 18, 79, 22, 17, 16, 15, 13,
+	14, 12, 149, 11, 770, 7, 10, 9, 8, 154,
+	377, 505, 6, 5, 0, 0, 0, 150, 151, 0,
+	0, 152, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 80,
 }
 
 var yyPact = [...]int16{
-	951, -1000, 427, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1126, -1000, 513, -1000, 893, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 171, 861,
-	830, 731, 956, 813, 236, 229, 703, 566, 503, 951,
-	955, 454, 455, 288, 212, 558, 297, 558, -1000, -1000,
-	194, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 472,
-	966, 768, 691, -1000, 643, 1007, 609, 720, 615, 1006,
-	525, 553, 993, 990, -1000, -1000, -1000, 957, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 283, 765, 282,
-	19, 500, 385, -46, -46, 280, 956, 763, 279, 116,
-	278, 499, 989, 988, -46, 545, -46, 953, -1000, -37,
-	929, 760, 19, 894, 987, 933, 986, 959, -1000, 711,
-	277, 111, -1000, 1005, 930, -37, 995, 454, 650, -4,
-	558, 558, 558, 558, 558, 558, 558, 558, -79, 0,
-	162, 275, -1000, 684, 692, 692, 929, -1000, 832, 270,
-	983, 956, 600, 966, 966, 663, 611, 115, 966, 582,
-	269, 598, 966, 19, -1000, -1000, 267, -46, 266, 564,
-	264, 844, 417, 318, 263, -1000, -1000, -1000, 262, 261,
-	454, 995, -1000, -1000, 982, -1000, 953, -1000, 260, -1000,
-	-1000, -1000, 259, 258, 256, -1000, 981, 980, -1000, -1000,
-	550, 533, -1000, -1000, 524, -83, -1000, 929, 250, 416,
-	850, 412, 411, -1000, -1000, -23, -99, 255, 842, 254,
-	876, 253, 249, 248, 967, 245, 244, -1000, 242, -46,
-	-1000, 953, 463, 938, -1000, 1005, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -110, -110, -110, -1000, -1000, -110, -1000,
-	379, -1000, -1000, -1000, -1000, -1000, -1000, 558, 682, -1000,
-	-16, 997, 908, -1000, 239, 953, 908, 966, 956, 956,
-	851, 596, 966, 591, 966, 315, 114, 935, 583, 966,
-	-1000, 966, 956, -1000, -1000, -1000, 331, 518, -1000, 746,
-	107, 474, 652, 979, 779, 841, -46, 18, 313, 978,
-	312, 378, 977, -46, -1000, 973, 969, 302, -1000, -46,
-	-46, -37, 238, -37, 879, 338, 377, 929, 929, -79,
-	-66, 409, 856, 959, 408, -46, -46, 585, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 968, 568, 868,
-	235, 219, -1000, 862, 1003, 218, 217, -1000, 1002, 330,
-	329, 930, 849, -60, -60, 953, -1000, 21, 213, 558,
-	105, 943, 924, -1000, 908, 943, 956, 953, 930, 953,
-	908, 838, 639, 966, 848, 966, 956, 109, 300, 211,
-	908, 943, 966, 956, 956, 953, 930, 66, -1000, -1000,
-	746, -1000, 63, 99, 208, 96, -1000, 138, 756, 753,
-	745, 742, 670, 78, 193, 201, -19, -1000, -1000, 789,
-	-1000, -46, 349, -6, 299, -38, -1000, -38, 191, 454,
-	188, 834, 959, 311, 187, 183, 182, -1000, 296, -1000,
-	453, -1000, -37, 942, -1000, -1000, -1000, -1000, 143, 401,
-	376, 959, 451, 449, -1000, 929, 180, 138, 169, 860,
-	-1000, 168, 166, 1001, -1000, 165, -39, 84, 463, 908,
-	400, -1000, 447, 215, 397, 209, -1000, -1000, 930, -1000,
-	677, -99, 953, 164, 163, 334, 334, -1000, 893, -57,
-	-57, 139, 943, -1000, 953, 930, 930, 943, 908, 943,
-	633, 206, 827, 833, 631, 956, 953, 930, 295, 161,
-	159, -1000, 943, -1000, 956, 953, 930, 953, 930, 930,
-	943, -61, -87, -1000, -1000, -1000, -1000, -1000, 435, -1000,
-	-1000, 61, 59, 58, 55, -1000, -1000, -1000, -1000, 740,
-	829, 535, 528, 328, -1000, -1000, -1000, -1000, 647, -38,
-	-1000, -1000, -1000, 516, 375, 396, 738, 506, -46, 794,
-	-1000, -1000, -1000, -46, -37, 965, 157, 371, 368, 210,
-	-1000, 367, -46, -46, -69, 746, 493, -1000, 155, -1000,
-	-1000, 154, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 849,
-	943, -27, -60, 668, 52, 661, 463, -1000, 908, -1000,
-	-1000, -1000, -1000, -1000, 74, 73, 896, -1000, -1000, -1000,
-	-1000, 442, 446, -1000, 930, 943, 943, -1000, 943, -1000,
-	206, 953, 127, 127, 393, 334, 334, 818, 629, 620,
-	206, 953, 930, 930, 943, 151, -1000, -1000, -1000, 953,
-	930, 930, 943, 930, 943, 943, -1000, 150, 148, 138,
-	-1000, -1000, -1000, -1000, 736, 50, 570, 561, 137, 561,
-	142, 806, -1000, -1000, 680, 557, 803, 454, -1000, 40,
-	37, 468, -46, -1000, -1000, -1000, -1000, 929, -1000, -1000,
-	-1000, 366, 365, 439, -1000, 363, 361, -1000, -1000, -1000,
-	140, -1000, -1000, 908, 124, 360, -1000, -1000, -1000, -1000,
-	-1000, 337, -1000, 849, 943, 902, -1000, -57, 139, -1000,
-	-1000, 943, -1000, -1000, -1000, 953, 908, -1000, 438, -1000,
-	-1000, 127, -1000, -1000, 612, 206, 206, 953, 930, 943,
-	943, -1000, -1000, 930, 943, 943, -1000, 943, -1000, -1000,
-	327, 326, -1000, -1000, 704, 887, 864, 548, 138, -1000,
-	137, 522, 521, 548, -1000, 391, -1000, -1000, 959, 36,
-	25, 738, 356, 511, -1000, 794, -1000, 436, -83, -1000,
-	-1000, 136, -1000, -1000, -1000, 943, -1000, 389, -1000, -1000,
-	-84, 908, -1000, -3, -1000, -1000, -1000, 908, 943, 127,
-	355, 206, 953, 953, 930, 943, -1000, -1000, 943, -1000,
-	-1000, -1000, -15, 126, -21, -1000, -1000, 727, 39, 435,
-	-1000, 121, 121, 727, 23, 676, 709, -1000, -1000, 792,
-	386, -46, -46, -1000, 124, -47, 354, 22, 943, -1000,
-	943, -1000, -1000, -1000, 953, 930, 930, 943, -1000, -1000,
-	-1000, -1000, 796, -1000, -1000, -1000, -1000, 434, -1000, 569,
-	353, -1000, 14, 738, 3, -1000, -1000, -1000, 351, -1000,
-	348, 124, -1000, 930, 943, 943, -1000, -1000, 796, 121,
-	567, -1000, 121, 137, -1000, -1000, 344, 430, -1000, -1000,
-	-1000, 943, -1000, -1000, -1000, -1000, 559, -1000, 121, -1000,
-	-1000, 509, 3, -1000, 560, -1000, -46, -1000, 384, -1000,
-	-1000, 119, -1000, 429, 325, 3, -1000, -46, -22, 342,
-	-1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 188,
+	1011, 771, 1146, 978, 874, 306, 304, 233, 659, 586,
+	156, 1126, 374, 979, 995, 550, 382, 81, 528, 400,
+	528, -1000, -1000, 264, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 672, 991, 823, 726, -1000, 710, 1055,
+	701, 775, 702, 1048, 229, 634, 1038, 1037, 373, 371,
+	569, 820, 369, 234, 773, 980, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 366, 818, 365, 56, 584,
+	601, -31, -31, 364, 978, 808, 301, 173, 361, 583,
+	1031, 1028, 4, 622, -31, 977, -1000, 23, 237, 807,
+	56, 953, 1027, 935, 1025, 316, -1000, 982, 772, 360,
+	357, 172, -1000, 143, 355, -1000, 230, 1047, 955, 23,
+	996, 995, 729, -1, 528, 528, 528, 528, 528, 528,
+	528, 528, -83, 27, 179, 353, -1000, 754, 759, 759,
+	237, -1000, 977, 231, 352, 675, 978, 693, 991, 991,
+	724, 700, 176, 991, 666, 351, 683, 991, 56, -1000,
+	1024, 991, 350, -31, 1023, 296, -1000, -1000, -31, 1021,
+	-1000, 568, -30, 349, 648, 347, 891, 506, 408, 344,
+	-1000, -1000, -1000, 343, 341, 995, 996, -1000, -1000, 1020,
+	-1000, 977, -1000, 338, -1000, 505, -1000, -1000, 337, 336,
+	335, -1000, 1016, 1015, 1014, -1000, -1000, 632, 623, -1000,
+	-1000, 855, -73, -1000, 237, 312, 504, 878, 502, 501,
+	-1000, -1000, 332, -95, 334, 888, 329, 922, 320, 319,
+	268, 988, 318, 317, -1000, 982, -1000, 313, -31, 290,
+	1013, -1000, 305, 302, -1000, -1000, -1000, -1000, 977, 530,
+	945, -1000, 1047, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-106, -106, -106, -1000, -1000, -106, -1000, 475, -1000, -1000,
+	-1000, -1000, -1000, -1000, 528, 752, -1000, -12, -1000, 1019,
+	939, -36, -38, -1000, 300, -1000, 977, 939, 991, 978,
+	978, 884, 676, 991, 674, 991, 407, 167, 978, 671,
+	991, -1000, 991, 978, -1000, 293, -1000, -1000, 403, -31,
+	289, 287, 977, 285, -1000, -1000, 425, 619, -1000, 1160,
+	153, 571, 727, 1012, 834, 887, -31, -19, 402, 1008,
+	395, 473, 1007, -31, -1000, 1006, 225, 1005, 399, -1000,
+	-31, -31, -31, 23, 284, 23, 913, 444, 472, 237,
+	237, -83, -51, 498, 903, 982, 497, -31, -31, 1017,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 998,
+	658, 911, 283, 282, -1000, 910, 1046, 1045, 267, 278,
+	-1000, 1044, -1000, 422, 421, -1000, -1000, -21, -1000, -1000,
+	955, 896, -53, -53, 977, -1000, -23, 273, 528, 127,
+	966, 943, 977, 977, 567, 939, 966, 978, 977, 955,
+	977, 939, 883, 722, 991, 880, 991, 978, 152, 398,
+	271, 977, 939, 991, 978, 978, 977, 955, -1000, -31,
+	-1000, -1000, -1000, -1000, -1000, 69, -1000, -1000, 1160, -1000,
+	65, 145, 270, 144, -1000, 210, 802, 801, 797, 796,
+	738, 141, 223, 269, -52, -1000, -1000, 843, -1000, -31,
+	438, 17, 397, 5, -1000, 5, 266, 995, 265, 879,
+	982, 405, 257, 471, 539, 256, 255, -1000, -1000, 394,
+	-1000, 536, -1000, 23, 973, -1000, -1000, -1000, -1000, 47,
+	495, 470, 982, 535, 534, -1000, 237, 254, 210, 253,
+	909, -1000, 251, 248, 244, 1043, 1042, -1000, 242, -58,
+	137, -1000, -1000, 530, 939, 494, -1000, 533, 379, 491,
+	378, -1000, -1000, 955, -1000, 743, -95, 977, 236, 235,
+	430, 430, -1000, 956, -62, -62, 211, 939, 939, -1000,
+	966, -1000, 977, 955, 955, 966, 939, 966, 720, 114,
+	876, 875, 719, 978, 977, 955, 393, 232, 227, -1000,
+	939, 966, 978, 977, 955, 977, 955, 955, 966, -1000,
+	-67, -69, -1000, -1000, -1000, -1000, -1000, 521, -1000, -1000,
+	64, 61, 60, 52, -1000, -1000, -1000, -1000, 795, 812,
+	621, 617, 420, -1000, -1000, -1000, -1000, 679, 5, -1000,
+	-1000, -1000, 605, 469, 489, 791, 589, -31, 856, -1000,
+	-1000, 225, -1000, -1000, -31, 23, 989, 220, 465, 464,
+	262, -1000, 463, -31, -31, -66, 1160, 582, -1000, 219,
+	-1000, -1000, -1000, 218, 217, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 896, 966, -42, -53, 737, 40, 736, 530,
+	-1000, 939, -1000, -1000, -1000, -1000, -1000, 129, 126, 932,
+	-1000, -1000, -1000, -1000, 531, 527, 966, 966, -1000, 955,
+	966, 966, -1000, 966, -1000, 114, 977, 206, 206, 483,
+	430, 430, 873, 716, 713, 114, 977, 955, 955, 966,
+	216, -1000, -1000, 966, -1000, 977, 955, 955, 966, 955,
+	966, 966, -1000, 215, 213, 210, -1000, -1000, -1000, -1000,
+	788, 33, 662, 178, 657, 150, 657, 183, 869, -1000,
+	-1000, 749, 645, 859, 995, -1000, 22, 20, 558, -31,
+	-1000, -1000, -1000, -1000, -1000, 237, -1000, -1000, -1000, 459,
+	458, -1000, 457, 455, -1000, -1000, -1000, 212, -1000, -1000,
+	-1000, 939, 187, 454, -1000, -1000, -1000, -1000, -1000, 432,
+	-1000, 896, 966, 927, -1000, -62, 211, -1000, -1000, -1000,
+	-1000, 966, -1000, -1000, -1000, 977, 939, -1000, 524, -1000,
+	-1000, 206, -1000, -1000, 711, 114, 114, 977, 955, 966,
+	966, -1000, -1000, -1000, 955, 966, 966, -1000, 966, -1000,
+	-1000, 418, 417, -1000, -1000, 766, 919, 916, 523, -1000,
+	941, 987, 631, 210, -1000, 150, 615, 614, 631, -1000,
+	486, -1000, -1000, 982, 7, -4, 791, 451, 599, -1000,
+	856, -1000, 522, -73, -1000, -1000, -1000, -1000, -1000, 966,
+	-1000, 482, -1000, -1000, -98, 939, -1000, 110, -1000, -1000,
+	-1000, 939, 966, 206, 450, 114, 977, 977, 955, 966,
+	-1000, -1000, 966, -1000, -1000, -1000, 106, 205, 24, -1000,
+	-1000, 178, 204, 986, 203, 781, 37, 521, -1000, 185,
+	185, 781, -17, 742, 770, -1000, -1000, 851, 481, -31,
+	-31, 187, -79, 447, -22, 966, -1000, 966, -1000, -1000,
+	-1000, 977, 955, 955, 966, -1000, -1000, -1000, -1000, 845,
+	-1000, -1000, 200, -1000, -1000, -1000, -1000, -1000, 520, -1000,
+	647, 446, -1000, -41, 791, -50, -1000, -1000, -1000, 445,
+	-1000, 443, 187, -1000, 955, 966, 966, -1000, -1000, 845,
+	-1000, 185, 643, -1000, 185, 150, -1000, -1000, 439, 519,
+	-1000, -1000, -1000, 966, -1000, -1000, -1000, -1000, 641, -1000,
+	185, -1000, -1000, 596, -50, -1000, 638, -1000, -31, -1000,
+	477, -1000, -1000, 6, -1000, 518, 342, -50, -1000, -31,
+	-37, 434, -1000, -1000, -1000, -1000,
 }
 
 var yyPgo = [...]int16{
-	0, 734, 1123, 1122, 1121, 1120, 20, 1119, 1118, 1117,
-	1116, 1115, 1114, 1113, 1112, 1111, 1110, 1109, 1108, 1107,
-	1106, 1105, 1104, 1103, 1102, 1101, 16, 1100, 1099, 1098,
-	1097, 1096, 1095, 1094, 1093, 1092, 1091, 1090, 1089, 1088,
-	1087, 1086, 1085, 1084, 1083, 6, 1082, 1080, 1079, 1078,
-	1076, 1070, 1069, 1068, 1067, 1066, 1065, 1064, 1063, 1062,
-	1061, 25, 18, 1059, 1057, 42, 625, 41, 38, 43,
-	1056, 34, 1055, 50, 1054, 32, 1053, 1052, 27, 1051,
-	1050, 24, 36, 15, 1048, 44, 1047, 1046, 26, 12,
-	1045, 11, 30, 31, 1044, 13, 3, 1042, 22, 1038,
-	9, 7, 1037, 33, 127, 1036, 39, 10, 29, 0,
-	1035, 17, 1034, 21, 28, 4, 1033, 1031, 14, 1029,
-	1027, 2, 1026, 1025, 1024, 8, 1023, 5, 1021, 1020,
-	1019, 1, 23, 19, 37, 1018, 1017, 35, 40, 1016,
-	1015, 1014, 1012,
+	0, 732, 1243, 1242, 1240, 1238, 17, 1237, 1236, 1235,
+	1234, 1233, 1231, 1230, 1229, 1228, 1227, 1226, 1225, 1223,
+	1222, 1221, 1219, 1218, 1217, 1216, 22, 1213, 1208, 1206,
+	1205, 1204, 1202, 1201, 1200, 1196, 1195, 1194, 1193, 1191,
+	1189, 1186, 1185, 1184, 1182, 6, 1180, 1178, 1177, 1176,
+	1175, 1174, 1173, 1172, 1171, 1170, 1168, 1167, 1164, 1158,
+	1157, 1155, 1152, 1149, 1142, 1136, 1135, 1131, 1130, 1129,
+	1125, 1123, 28, 32, 1122, 1121, 40, 356, 48, 38,
+	44, 1120, 34, 1117, 42, 1115, 41, 1114, 1112, 26,
+	1111, 1110, 29, 37, 16, 1109, 43, 1108, 1107, 21,
+	15, 1106, 12, 33, 30, 1105, 13, 3, 1104, 25,
+	1102, 10, 8, 1100, 31, 1091, 295, 1090, 67, 7,
+	27, 0, 1089, 18, 1088, 20, 24, 4, 1085, 1083,
+	14, 1081, 1079, 2, 1078, 1077, 1076, 11, 1075, 5,
+	1074, 1073, 1071, 1, 23, 19, 36, 1069, 1068, 35,
+	39, 1067, 1066, 1061, 1060, 9, 1018,
 }
 
 var yyR1 = [...]uint8{
-	0, 64, 65, 65, 65, 1, 1, 1, 1, 1,
+	0, 75, 76, 76, 76, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 6,
-	6, 6, 61, 61, 63, 63, 63, 63, 63, 63,
-	85, 85, 84, 62, 62, 81, 81, 81, 81, 81,
-	81, 81, 81, 81, 81, 81, 81, 81, 81, 81,
-	81, 69, 69, 66, 67, 67, 67, 67, 67, 67,
-	67, 70, 68, 68, 68, 72, 73, 73, 73, 73,
-	73, 71, 71, 71, 91, 91, 92, 92, 93, 93,
-	109, 109, 94, 94, 94, 94, 94, 94, 94, 94,
-	125, 125, 98, 98, 99, 99, 99, 75, 75, 77,
-	77, 76, 76, 78, 78, 78, 78, 78, 78, 78,
-	78, 78, 78, 79, 82, 82, 86, 86, 86, 86,
-	86, 86, 86, 86, 86, 104, 80, 80, 80, 80,
-	80, 80, 80, 80, 80, 80, 87, 87, 87, 89,
-	89, 88, 88, 90, 90, 90, 95, 132, 132, 96,
-	96, 96, 96, 97, 97, 97, 97, 2, 2, 3,
-	3, 138, 138, 138, 138, 138, 134, 134, 4, 103,
-	103, 102, 102, 102, 102, 102, 102, 102, 7, 7,
-	74, 74, 74, 74, 8, 8, 9, 9, 5, 5,
-	5, 10, 10, 100, 100, 101, 101, 101, 101, 11,
-	11, 12, 14, 13, 13, 15, 15, 16, 17, 19,
-	19, 19, 21, 21, 20, 20, 20, 22, 22, 18,
-	23, 23, 110, 110, 110, 110, 110, 110, 110, 110,
-	110, 52, 52, 52, 52, 52, 106, 106, 24, 24,
-	25, 25, 26, 26, 26, 26, 26, 83, 83, 105,
-	27, 27, 28, 28, 28, 28, 29, 29, 29, 29,
-	30, 30, 30, 30, 31, 31, 139, 139, 140, 128,
-	128, 129, 129, 129, 114, 114, 133, 133, 133, 141,
-	141, 142, 119, 119, 120, 120, 124, 124, 112, 112,
-	51, 51, 137, 137, 135, 135, 136, 136, 136, 126,
-	126, 127, 127, 115, 115, 107, 107, 116, 117, 121,
-	121, 123, 122, 122, 122, 113, 113, 108, 32, 33,
-	34, 35, 35, 35, 35, 36, 36, 36, 36, 37,
-	37, 38, 38, 39, 40, 41, 130, 130, 130, 130,
-	42, 43, 44, 44, 44, 46, 46, 46, 46, 47,
-	47, 45, 131, 131, 48, 48, 49, 49, 50, 53,
-	54, 118, 118, 111, 111, 58, 58, 59, 60, 60,
-	60, 60, 55, 56, 56, 56, 56, 56, 57, 57,
-	57, 57, 57,
+	1, 115, 115, 115, 6, 6, 6, 72, 72, 74,
+	74, 74, 74, 74, 74, 96, 96, 95, 73, 73,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92,
+	92, 92, 92, 92, 92, 92, 80, 80, 77, 78,
+	78, 78, 78, 78, 78, 78, 81, 79, 79, 79,
+	83, 84, 84, 84, 84, 84, 82, 82, 82, 102,
+	102, 103, 103, 104, 104, 121, 121, 105, 105, 105,
+	105, 105, 105, 105, 105, 137, 137, 109, 109, 110,
+	110, 110, 86, 86, 88, 88, 87, 87, 89, 89,
+	89, 89, 89, 89, 89, 89, 89, 89, 90, 93,
+	93, 97, 97, 97, 97, 97, 97, 97, 97, 97,
+	116, 91, 91, 91, 91, 91, 91, 91, 91, 91,
+	91, 91, 98, 98, 98, 100, 100, 99, 99, 101,
+	101, 101, 106, 144, 144, 107, 107, 107, 107, 108,
+	108, 108, 108, 2, 2, 3, 3, 150, 150, 150,
+	150, 150, 146, 146, 4, 114, 114, 113, 113, 113,
+	113, 113, 113, 113, 7, 7, 7, 7, 85, 85,
+	85, 85, 8, 8, 8, 8, 9, 9, 5, 5,
+	5, 5, 156, 156, 155, 155, 155, 10, 10, 111,
+	111, 112, 112, 112, 112, 11, 11, 12, 14, 13,
+	13, 15, 15, 16, 17, 19, 19, 19, 21, 21,
+	20, 20, 20, 20, 20, 22, 22, 18, 18, 23,
+	23, 122, 122, 122, 122, 122, 122, 122, 122, 122,
+	122, 52, 52, 52, 52, 52, 118, 118, 24, 24,
+	25, 25, 26, 26, 26, 26, 26, 94, 94, 117,
+	27, 27, 27, 27, 28, 28, 28, 28, 29, 29,
+	29, 29, 30, 30, 30, 30, 31, 31, 151, 151,
+	152, 140, 140, 141, 141, 141, 126, 126, 145, 145,
+	145, 153, 153, 154, 131, 131, 132, 132, 136, 136,
+	124, 124, 51, 51, 149, 149, 147, 147, 148, 148,
+	148, 138, 138, 139, 139, 127, 127, 119, 119, 128,
+	129, 133, 133, 135, 134, 134, 134, 125, 125, 120,
+	32, 33, 34, 35, 35, 35, 35, 36, 36, 36,
+	36, 37, 37, 38, 38, 39, 71, 71, 40, 41,
+	142, 142, 142, 142, 42, 43, 44, 44, 44, 46,
+	46, 46, 46, 47, 47, 45, 143, 143, 48, 48,
+	49, 49, 49, 49, 49, 50, 50, 53, 53, 53,
+	53, 53, 54, 54, 54, 130, 130, 123, 123, 59,
+	59, 60, 60, 61, 61, 61, 61, 55, 55, 56,
+	56, 56, 56, 56, 63, 64, 64, 65, 66, 66,
+	67, 68, 69, 69, 70, 70, 62, 62, 58, 58,
+	57, 57, 57, 57, 57,
 }
 
 var yyR2 = [...]int8{
-	0, 1, 1, 2, 3, 1, 1, 1, 1, 1,
+	0, 1, 1, 2, 3, 1, 4, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 11,
-	12, 9, 1, 3, 1, 3, 3, 1, 3, 3,
-	1, 2, 4, 1, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 4, 3, 2, 1, 1, 5,
-	6, 2, 0, 2, 1, 3, 1, 3, 3, 5,
-	1, 6, 3, 5, 3, 1, 5, 4, 4, 3,
-	1, 1, 1, 1, 3, 0, 2, 0, 1, 3,
-	1, 1, 1, 3, 4, 6, 7, 1, 3, 1,
-	4, 0, 4, 0, 1, 1, 1, 2, 0, 1,
-	3, 1, 3, 1, 3, 5, 5, 4, 6, 6,
-	5, 6, 6, 3, 1, 3, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 3, 1, 1,
-	1, 1, 1, 1, 3, 1, 1, 1, 1, 3,
-	0, 1, 3, 1, 2, 2, 2, 1, 1, 4,
-	2, 2, 0, 4, 2, 2, 0, 2, 3, 5,
-	4, 2, 1, 3, 3, 0, 3, 3, 2, 1,
-	2, 1, 2, 2, 2, 2, 1, 2, 9, 6,
-	2, 2, 2, 2, 5, 3, 7, 8, 6, 9,
-	9, 5, 4, 1, 2, 3, 3, 3, 3, 7,
-	6, 2, 3, 4, 3, 3, 2, 7, 6, 6,
-	7, 6, 5, 4, 6, 7, 6, 5, 4, 3,
-	8, 7, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 4, 8, 7, 7, 6, 2, 0, 7, 6,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 11, 12, 9, 1, 3, 1,
+	3, 3, 1, 3, 3, 1, 2, 4, 1, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 4,
+	3, 2, 1, 1, 5, 6, 2, 0, 2, 1,
+	3, 1, 3, 3, 5, 1, 6, 3, 5, 3,
+	1, 5, 4, 4, 3, 1, 1, 1, 1, 3,
+	0, 2, 0, 1, 3, 1, 1, 1, 3, 4,
+	6, 7, 1, 3, 1, 4, 0, 4, 0, 1,
+	1, 1, 2, 0, 1, 3, 1, 3, 1, 3,
+	5, 5, 4, 6, 6, 5, 6, 6, 3, 1,
+	3, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 3, 1, 1, 1, 1, 1, 1,
+	3, 1, 1, 1, 1, 3, 0, 1, 3, 1,
+	2, 2, 2, 1, 1, 4, 2, 2, 0, 4,
+	2, 2, 0, 3, 4, 5, 4, 2, 1, 3,
+	3, 0, 3, 3, 2, 1, 2, 1, 2, 2,
+	2, 2, 1, 2, 9, 6, 8, 8, 2, 2,
+	2, 2, 5, 3, 6, 4, 7, 8, 6, 9,
+	9, 8, 1, 3, 3, 4, 3, 5, 4, 1,
+	2, 3, 3, 3, 3, 7, 6, 2, 3, 4,
+	3, 3, 2, 7, 6, 6, 7, 6, 5, 4,
+	6, 7, 6, 7, 6, 5, 4, 3, 6, 8,
+	7, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 4, 8, 7, 7, 6, 2, 0, 8, 7,
 	11, 10, 2, 2, 4, 2, 2, 1, 3, 1,
-	3, 2, 10, 9, 9, 8, 13, 12, 12, 11,
-	10, 9, 9, 8, 5, 5, 0, 6, 10, 0,
-	2, 0, 2, 6, 0, 2, 0, 2, 2, 0,
-	3, 3, 0, 1, 0, 1, 0, 1, 0, 2,
-	2, 0, 2, 1, 2, 2, 2, 3, 2, 3,
-	3, 2, 0, 1, 3, 2, 0, 2, 2, 3,
-	1, 2, 3, 3, 0, 1, 3, 1, 3, 6,
-	4, 9, 8, 8, 7, 9, 8, 8, 7, 2,
-	4, 7, 3, 3, 3, 10, 3, 3, 5, 0,
-	3, 6, 9, 11, 7, 4, 6, 2, 4, 2,
-	4, 10, 1, 3, 8, 6, 2, 4, 3, 2,
-	3, 1, 3, 1, 1, 10, 8, 2, 3, 5,
-	7, 5, 2, 6, 6, 6, 6, 6, 2, 6,
-	6, 10, 10,
+	3, 4, 2, 3, 10, 9, 9, 8, 13, 12,
+	12, 11, 10, 9, 9, 8, 5, 5, 0, 6,
+	10, 0, 2, 0, 2, 6, 0, 2, 0, 2,
+	2, 0, 3, 3, 0, 1, 0, 1, 0, 1,
+	0, 2, 2, 0, 2, 1, 2, 2, 2, 3,
+	2, 3, 3, 2, 0, 1, 3, 2, 0, 2,
+	2, 3, 1, 2, 3, 3, 0, 1, 3, 1,
+	3, 6, 4, 9, 8, 8, 7, 9, 8, 8,
+	7, 2, 4, 7, 3, 3, 5, 3, 3, 10,
+	3, 3, 5, 0, 4, 6, 9, 11, 7, 4,
+	6, 2, 4, 2, 4, 10, 1, 3, 8, 6,
+	2, 4, 6, 3, 5, 3, 5, 2, 4, 3,
+	5, 5, 3, 5, 5, 1, 3, 1, 1, 10,
+	8, 2, 3, 3, 5, 7, 5, 3, 5, 6,
+	6, 6, 6, 6, 2, 5, 3, 2, 4, 4,
+	3, 3, 2, 4, 3, 4, 3, 4, 3, 4,
+	2, 6, 6, 10, 10,
 }
 
 var yyChk = [...]int16{
-	-1000, -64, -65, -1, -6, -2, -3, -9, -5, -7,
+	-1000, -75, -76, -1, -6, -2, -3, -9, -5, -7,
 	-8, -11, -12, -14, -13, -15, -16, -17, -19, -21,
 	-22, -20, -18, -23, -24, -25, -27, -28, -29, -30,
-	-31, -32, -33, -34, -35, -36, -37, -38, -39, -40,
-	-41, -42, -43, -44, -46, -47, -48, -49, -50, -52,
-	-53, -54, -58, -59, -60, -55, -56, -57, 8, 18,
-	19, 62, 30, 40, 53, 28, 77, 57, 98, 128,
-	-61, 147, -63, 155, -81, 129, 142, 152, -80, 144,
-	63, 146, 143, 145, 69, 70, -104, 148, 131, 43,
-	45, 46, 61, 42, 71, -110, 73, 59, 5, 90,
-	51, 86, 102, 107, 88, 92, 116, 117, 82, 83,
-	84, 81, 32, 121, 122, 85, 142, 44, 46, 41,
-	5, 86, 101, 105, 93, 44, 61, 46, 41, 51,
-	5, 86, 101, 102, 105, 35, 93, -66, -75, 4,
-	9, 46, 5, 35, 142, 35, 142, 78, -6, 37,
-	115, 108, -1, -69, -75, 6, -61, 127, 139, 10,
-	155, 156, 151, 152, 154, 157, 158, 153, -81, 129,
-	139, 138, -81, -85, 142, -84, 64, 119, -106, 7,
-	47, -106, 79, 80, 74, 75, 76, 4, 74, 76,
-	58, 79, 80, 4, 94, 88, 7, 7, 9, 142,
-	48, 142, -73, 142, 138, -71, 145, -104, 108, 7,
-	129, -109, 142, 145, -109, 142, -66, -75, 48, 142,
-	143, 142, 108, 7, 7, -109, 92, -109, -75, -67,
-	-72, -68, -70, -73, 129, -78, -76, 129, 142, 27,
-	26, 112, 114, -77, -79, -82, -81, 48, -73, 7,
-	21, 24, 7, 7, 21, 4, 7, -6, 58, 142,
-	143, -66, -91, 11, -67, -69, -61, 71, 73, 142,
-	145, -81, -81, -81, -81, -81, -81, -81, -81, 130,
-	-61, 130, -87, 142, 71, 73, 142, 66, -85, -85,
-	-78, 31, -75, 142, 7, -66, -75, 80, -106, -106,
-	-106, 79, 80, 79, 80, 142, 138, -106, 79, 80,
-	142, 80, -106, -73, 142, -109, 142, -4, -138, 31,
-	118, -134, 71, 142, 31, -51, 129, 138, 142, 142,
-	142, -61, -69, 7, -75, 142, 142, 142, 142, 7,
-	7, 127, 10, 127, 20, -65, -68, 149, 150, -81,
-	-78, 25, 26, 129, 27, 129, 129, -86, 132, 133,
-	134, 135, 136, 137, 141, 140, 113, 142, 31, 142,
-	7, 24, 142, 142, 142, 7, 4, 142, 142, 142,
-	-109, -75, -92, 124, 12, -66, 130, -81, 66, 65,
-	5, -89, 13, 142, -75, -89, -106, -66, -75, -66,
-	-75, -66, 31, 80, -106, 80, -106, 138, 142, 138,
-	-66, -89, 80, -106, -106, -66, -75, 132, -138, -103,
-	-102, -101, 49, 60, 38, 39, 50, 81, 51, 54,
-	55, 52, 143, 118, 72, 7, 37, -139, -140, 31,
-	-137, -135, -136, -109, 142, 138, -71, 138, 7, 129,
-	138, 130, 7, -109, 7, 7, 138, -109, -109, -67,
-	142, -67, 23, 130, 130, -78, -78, 130, 129, 25,
-	-6, 129, -109, -109, -82, 129, 7, 81, 24, 142,
-	142, 24, 4, 142, 142, 4, 132, 132, -91, -98,
-	29, -93, -94, -109, 142, 155, -104, -93, -75, 68,
-	142, -81, -74, 132, 133, 141, 140, -95, -96, 14,
-	15, 12, -89, -96, -66, -75, -75, -91, -75, -89,
-	31, 76, -106, -66, 31, -106, -66, -75, 142, 138,
-	138, 142, -89, -96, -106, -66, -75, -66, -75, -75,
-	-91, 142, 143, -103, 144, 143, 142, 143, -113, -108,
-	142, 49, 49, 49, 49, -134, 143, 142, 50, 142,
-	145, -141, -142, 32, -137, 127, 130, 71, -109, 138,
-	-71, 142, -71, 142, -61, 142, 31, -6, 138, 120,
-	142, 142, 142, 138, 127, -67, 10, -61, -6, 129,
-	130, -6, 127, 127, -78, 142, -113, 142, 24, 142,
-	142, 4, 142, 145, -109, 143, 146, 69, 70, -92,
-	-89, 129, 127, 139, 129, 139, -91, 68, -75, 142,
-	142, -104, -104, -97, 16, 17, -132, 143, 148, -132,
-	-88, -90, 142, -96, -75, -91, -91, -96, -89, -95,
-	76, -26, 132, 133, 25, 141, 140, -66, 31, 31,
-	76, -66, -75, -75, -91, 138, 142, 142, -96, -66,
-	-75, -75, -91, -75, -91, -91, -96, 149, 149, 127,
-	144, 144, 144, 144, -10, 49, 31, -128, 95, -129,
-	95, 132, 73, -71, -130, 100, 130, 129, -45, 49,
-	106, -109, -111, 35, 36, -109, -67, 7, 142, 130,
-	130, -6, -62, 142, 130, -109, -109, 130, -103, -107,
-	56, 142, 142, -98, -95, -99, 142, 143, 146, -93,
-	71, 144, 71, -92, -89, 143, 143, 15, 127, 125,
-	126, -91, -96, -96, -95, -26, -75, -83, -105, 142,
-	-83, 129, -104, -104, 31, 76, 76, -26, -75, -91,
-	-91, -96, 142, -75, -91, -91, -96, -91, -96, -96,
-	142, 142, -108, 50, 144, 35, 109, -114, 81, -127,
-	-126, 142, 73, -114, -127, 142, 34, 33, 67, 99,
-	58, 31, -61, 144, 144, 120, -118, -109, -78, 130,
-	130, 127, 130, 130, 142, -89, -125, 142, 130, 130,
-	127, -98, -95, 17, -132, -88, -96, -75, -89, 127,
-	-83, 76, -26, -26, -75, -91, -96, -96, -91, -96,
-	-96, -96, 132, 132, 60, 21, 21, -133, 90, -113,
-	-127, 96, 96, -133, 129, -6, 144, 144, -45, 130,
-	103, -111, 127, -62, -95, 129, 144, 152, -89, 143,
-	-89, -96, -83, 130, -26, -75, -75, -91, -96, -96,
-	143, 142, 143, -107, 123, 143, -115, 142, -115, -107,
-	144, 68, 58, 31, 129, -118, -118, -125, 145, 130,
-	144, -95, -96, -75, -91, -91, -96, -100, -101, 127,
-	-119, -116, 82, 130, 144, -45, -131, 144, 130, 130,
-	-125, -91, -96, -96, -100, -115, -120, -117, 83, -115,
-	-127, 130, 127, -96, -124, -123, 84, -115, 104, -131,
-	-112, 85, -121, -122, -109, 129, 142, 127, 132, -131,
-	-121, -109, 143, 130,
+	-31, -32, -33, -34, -35, -36, -37, -38, -39, -71,
+	-40, -41, -42, -43, -44, -46, -47, -48, -49, -50,
+	-52, -53, -54, -59, -60, -61, -55, -56, -57, -58,
+	-62, -63, -64, -65, -66, -67, -68, -69, -70, 8,
+	18, 19, 62, 30, 40, 53, 28, 77, 57, 98,
+	142, 128, 31, -72, 147, -74, 155, -92, 129, 142,
+	152, -91, 144, 63, 38, 146, 143, 145, 69, 70,
+	-116, 148, 131, 43, 45, 46, 61, 42, 71, -122,
+	73, 59, 5, 90, 51, 86, 102, 107, 105, 88,
+	92, 116, 108, 142, 87, 117, 82, 83, 84, 81,
+	32, 121, 122, 52, 85, 44, 46, 41, 5, 86,
+	101, 105, 93, 44, 61, 46, 41, 51, 5, 86,
+	101, 102, 105, 35, 93, -77, -86, 4, 9, 46,
+	5, 35, 142, 35, 142, 78, -6, 142, 37, 115,
+	108, 108, 115, 142, 44, -1, 142, -80, -86, 6,
+	-72, 127, 139, 10, 155, 156, 151, 152, 154, 157,
+	158, 153, -92, 129, 139, 138, -92, -96, 142, -95,
+	64, -86, 119, -118, 7, 47, -118, 79, 80, 74,
+	75, 76, 4, 74, 76, 58, 79, 80, 4, 94,
+	142, 88, 7, 7, 142, 142, 119, -86, 58, 142,
+	88, 142, 58, 9, 142, 48, 142, -84, 142, 138,
+	-82, 145, -116, 108, 7, 129, -121, 142, 145, -121,
+	142, -77, -86, 48, 142, 25, 143, 142, 108, 7,
+	7, -121, 142, 92, -121, -86, -78, -83, -79, -81,
+	-84, 129, -89, -87, 129, 142, 27, 26, 112, 114,
+	-88, -90, -93, -92, 48, -84, 7, 21, 24, 7,
+	7, 21, 4, 7, -6, 142, -6, 58, 142, 142,
+	143, 88, 143, 142, -115, 36, 35, 142, -77, -102,
+	11, -78, -80, -72, 71, 73, 142, 145, -92, -92,
+	-92, -92, -92, -92, -92, -92, 130, -72, 130, -98,
+	142, 71, 73, 142, 66, -96, -96, -89, -86, 31,
+	-86, 113, 142, 142, 7, 119, -77, -86, 80, -118,
+	-118, -118, 79, 80, 79, 80, 142, 138, -118, 79,
+	80, 142, 80, -118, -84, 7, -118, 142, -121, 7,
+	142, 12, -121, 7, 119, 145, 142, -4, -150, 31,
+	118, -146, 71, 142, 31, -51, 129, 138, 142, 142,
+	142, -72, -80, 7, -86, 142, 129, 142, 142, 142,
+	7, 7, 7, 127, 10, 127, 20, -76, -79, 149,
+	150, -92, -89, 25, 26, 129, 27, 129, 129, -97,
+	132, 133, 134, 135, 136, 137, 141, 140, 113, 142,
+	31, 142, 7, 24, 142, 142, 35, 142, 7, 4,
+	142, 142, -6, 142, -121, 7, 142, 7, 142, 142,
+	-86, -103, 124, 12, -77, 130, -92, 66, 65, 5,
+	-100, 13, 145, 145, 142, -86, -100, -118, -77, -86,
+	-77, -86, -77, 31, 80, -118, 80, -118, 138, 142,
+	138, -77, -86, 80, -118, -118, -77, -86, 142, 138,
+	-121, 142, 142, -86, 142, 132, -150, -114, -113, -112,
+	49, 60, 38, 39, 50, 81, 51, 54, 55, 52,
+	143, 118, 72, 7, 37, -151, -152, 31, -149, -147,
+	-148, -121, 142, 138, -82, 138, 7, 129, 138, 130,
+	7, -121, 7, -73, 142, 7, 138, -121, -121, -121,
+	-78, 142, -78, 23, 130, 130, -89, -89, 130, 129,
+	25, -6, 129, -121, -121, -93, 129, 7, 81, 24,
+	142, 142, 24, 4, 4, 35, 142, 142, 4, 132,
+	132, 142, 145, -102, -109, 29, -104, -105, -121, 142,
+	155, -116, -104, -86, 68, 142, -92, -85, 132, 133,
+	141, 140, -106, -107, 14, 15, 12, -86, -86, 119,
+	-100, -107, -77, -86, -86, -102, -86, -100, 31, 76,
+	-118, -77, 31, -118, -77, -86, 142, 138, 138, 142,
+	-86, -100, -118, -77, -86, -77, -86, -86, -102, -121,
+	142, 143, -114, 144, 143, 142, 143, -125, -120, 142,
+	49, 49, 49, 49, -146, 143, 142, 50, 142, 145,
+	-153, -154, 32, -149, 127, 130, 71, -121, 138, -82,
+	142, -82, 142, -72, 142, 31, -6, 138, 120, 142,
+	130, 127, 142, 142, 138, 127, -78, 10, -72, -6,
+	129, 130, -6, 127, 127, -89, 142, -125, 142, 24,
+	142, 142, 142, 4, 4, 142, 145, -121, 143, 146,
+	69, 70, -103, -100, 129, 127, 139, 129, 139, -102,
+	68, -86, 142, 142, -116, -116, -108, 16, 17, -144,
+	143, 148, -144, -99, -101, 142, -100, -100, -107, -86,
+	-102, -102, -107, -100, -106, 76, -26, 132, 133, 25,
+	141, 140, -77, 31, 31, 76, -77, -86, -86, -102,
+	138, 142, 142, -100, -107, -77, -86, -86, -102, -86,
+	-102, -102, -107, 149, 149, 127, 144, 144, 144, 144,
+	-10, 49, 31, 53, -140, 95, -141, 95, 132, 73,
+	-82, -142, 100, 130, 129, -45, 49, 106, -121, -123,
+	35, 36, -73, -121, -78, 7, 142, 130, 130, -6,
+	-73, 130, -121, -121, 130, -114, -119, 56, 142, 142,
+	142, -109, -106, -110, 142, 143, 146, -104, 71, 144,
+	71, -103, -100, 143, 143, 15, 127, 125, 126, -106,
+	-106, -102, -107, -107, -106, -26, -86, -94, -117, 142,
+	-94, 129, -116, -116, 31, 76, 76, -26, -86, -102,
+	-102, -107, 142, -107, -86, -102, -102, -107, -102, -107,
+	-107, 142, 142, -120, 50, 144, 35, 109, -156, -155,
+	35, 142, -126, 81, -139, -138, 142, 73, -126, -139,
+	142, 34, 33, 67, 99, 58, 31, -72, 144, 144,
+	120, -130, -121, -89, 130, 130, 130, 130, 142, -100,
+	-137, 142, 130, 130, 127, -109, -106, 17, -144, -99,
+	-107, -86, -100, 127, -94, 76, -26, -26, -86, -102,
+	-107, -107, -102, -107, -107, -107, 132, 132, 60, 21,
+	21, 127, 7, 21, 7, -145, 90, -125, -139, 96,
+	96, -145, 129, -6, 144, 144, -45, 130, 103, -123,
+	127, -106, 129, 144, 152, -100, 143, -100, -107, -94,
+	130, -26, -86, -86, -102, -107, -107, 143, 142, 143,
+	-155, 142, 7, 142, -119, 123, 143, -127, 142, -127,
+	-119, 144, 68, 58, 31, 129, -130, -130, -137, 145,
+	130, 144, -106, -107, -86, -102, -102, -107, -111, -112,
+	142, 127, -131, -128, 82, 130, 144, -45, -143, 144,
+	130, 130, -137, -102, -107, -107, -111, -127, -132, -129,
+	83, -127, -139, 130, 127, -107, -136, -135, 84, -127,
+	104, -143, -124, 85, -133, -134, -121, 129, 142, 127,
+	132, -143, -133, -121, 143, 130,
 }
 
 var yyDef = [...]int16{
-	0, -2, 1, 2, 5, 6, 7, 8, 9, 10,
-	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
-	21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
-	31, 32, 33, 34, 35, 36, 37, 38, 39, 40,
-	41, 42, 43, 44, 45, 46, 47, 48, 49, 50,
-	51, 52, 53, 54, 55, 56, 57, 58, 0, 0,
-	0, 0, 138, 0, 0, 0, 0, 0, 0, 3,
-	-2, 0, 62, 64, 67, 0, 166, 0, 87, 88,
-	0, 168, 169, 170, 171, 172, 173, 175, 165, 197,
-	277, 0, 277, 241, 0, 0, 0, 0, 0, 369,
-	0, 0, 389, 396, 399, 407, 412, 418, 262, 263,
-	264, 265, 266, 267, 268, 269, 270, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 138, 0, 0, 0,
-	0, 0, 0, 387, 0, 0, 0, 138, 246, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 291, 0,
-	0, 0, 4, 0, 115, 0, 92, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 86, 0, 0, 70, 0, 198, 138, 0,
-	225, 138, 0, 277, 277, 277, 0, 0, 277, 0,
-	0, 0, 277, 0, 373, 380, 0, 0, 0, 205,
-	0, 0, 331, 111, 0, 110, 112, 113, 0, 0,
-	0, 92, 120, 121, 0, 242, 138, 244, 0, 259,
-	358, 374, 0, 0, 0, 398, 408, 0, 245, 93,
-	94, 96, 100, 105, 0, 137, 143, 0, 166, 0,
-	0, 0, 0, 141, 139, 0, 154, 0, 372, 0,
-	0, 0, 0, 0, 0, 0, 0, 290, 0, 0,
-	400, 138, 117, 0, 91, 0, 63, 65, 66, 68,
-	69, 75, 76, 77, 78, 79, 80, 81, 82, 83,
-	0, 85, 167, 176, 177, 178, 174, 0, 0, 71,
-	0, 0, 180, 276, 0, 138, 180, 277, 138, 138,
-	0, 0, 277, 0, 277, 271, 0, 180, 0, 277,
-	360, 277, 138, 370, 390, 397, 0, 205, 200, 0,
-	0, 202, 0, 0, 0, 306, 0, 0, 0, 0,
-	0, 0, 0, 0, 243, 0, 0, 385, 388, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 154,
-	0, 0, 0, 0, 0, 0, 0, 0, 156, 157,
-	158, 159, 160, 161, 162, 163, 164, 0, 0, 0,
-	0, 0, 253, 0, 0, 0, 0, 258, 0, 0,
-	0, 115, 133, 0, 0, 138, 84, 0, 0, 0,
-	0, 192, 0, 224, 180, 192, 138, 138, 115, 138,
-	180, 0, 0, 277, 0, 277, 138, 0, 0, 0,
-	180, 192, 277, 138, 138, 138, 115, 0, 199, 208,
-	209, 211, 0, 0, 0, 0, 216, 0, 0, 0,
-	0, 0, 201, 0, 0, 0, 0, 304, 305, 319,
-	330, 333, 0, 0, 111, 0, 109, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 409, 411, 95,
-	98, 97, 0, 102, 104, 140, 142, -2, 0, 0,
+	0, -2, 1, 2, 5, 7, 8, 9, 10, 11,
+	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
+	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
+	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
+	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
+	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
+	62, 63, 64, 65, 66, 67, 68, 69, 70, 0,
+	0, 0, 0, 153, 0, 0, 0, 0, 0, 0,
+	0, 3, 0, -2, 0, 77, 79, 82, 0, 181,
+	0, 102, 103, 0, 182, 184, 185, 186, 187, 188,
+	189, 191, 180, 153, 307, 0, 307, 267, 0, 0,
+	0, 0, 0, 401, 0, 0, 423, 430, 0, 437,
+	451, 153, 0, -2, 472, 480, 291, 292, 293, 294,
+	295, 296, 297, 298, 299, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 153, 0, 0, 0, 0, 0,
-	252, 0, 0, 0, 257, 0, 0, 0, 117, 180,
-	0, 116, 118, 122, 120, 127, 129, 114, 115, 89,
-	0, 72, 138, 0, 0, 0, 0, 219, 196, 0,
-	0, 0, 192, 240, 138, 115, 115, 192, 180, 192,
-	0, 0, 0, 0, 0, 138, 138, 115, 0, 0,
-	0, 275, 192, 279, 138, 138, 115, 138, 115, 115,
-	192, 419, 420, 210, 212, 213, 214, 215, 217, 355,
-	357, 0, 0, 0, 0, 203, 204, 206, 207, 0,
-	228, 309, 311, 0, 332, 334, 335, 336, 338, 0,
-	108, 111, 107, 379, 0, 0, 0, 395, 0, 0,
-	248, 381, 386, 0, 0, 0, 0, 0, 0, 0,
-	147, 0, 0, 0, 0, 0, 346, 249, 0, 251,
-	254, 0, 256, 359, 413, 414, 415, 416, 417, 133,
-	192, 0, 0, 0, 0, 0, 117, 90, 180, 220,
-	221, 222, 223, 186, 0, 0, 190, 187, 188, 191,
-	179, 181, 183, 239, 115, 192, 192, 368, 192, 261,
-	0, 138, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 138, 115, 115, 192, 0, 273, 274, 278, 138,
-	115, 115, 192, 115, 192, 192, 364, 0, 0, 0,
-	235, 236, 237, 238, 226, 0, 0, 314, 342, 314,
-	342, 0, 337, 106, 0, 0, 0, 0, 384, 0,
-	0, 0, 0, 403, 404, 410, 99, 0, 103, 145,
-	146, 0, 0, 73, 150, 0, 0, 155, 247, 371,
-	0, 250, 255, 180, 131, 0, 134, 135, 136, 119,
-	123, 0, 128, 133, 192, 194, 195, 0, 0, 184,
-	185, 192, 366, 367, 260, 138, 180, 282, 287, 289,
-	283, 0, 285, 286, 0, 0, 0, 138, 115, 192,
-	192, 295, 272, 115, 192, 192, 303, 192, 362, 363,
-	0, 0, 356, 227, 0, 0, 0, 316, 0, 310,
-	342, 0, 0, 316, 312, 0, 320, 321, 0, 0,
-	0, 0, 0, 0, 394, 0, 406, 401, 101, 148,
-	149, 0, 151, 152, 345, 192, 61, 0, 132, 124,
-	0, 180, 218, 0, 189, 182, 365, 180, 192, 0,
-	0, 0, 138, 138, 115, 192, 293, 294, 192, 301,
-	302, 361, 0, 0, 0, 229, 230, 346, 0, 315,
-	341, 0, 0, 346, 0, 0, 376, 377, 382, 0,
-	0, 0, 0, 74, 131, 0, 0, 0, 192, 193,
-	192, 281, 288, 284, 138, 115, 115, 192, 292, 300,
-	422, 421, 232, 307, 317, 318, 339, 343, 340, 322,
-	0, 375, 0, 0, 0, 405, 402, 59, 0, 125,
-	0, 131, 280, 115, 192, 192, 299, 231, 233, 0,
-	324, 323, 0, 342, 378, 383, 0, 392, 130, 126,
-	60, 192, 297, 298, 234, 344, 326, 325, 0, 347,
-	313, 0, 0, 296, 328, 327, 354, 348, 0, 393,
-	308, 0, 351, 350, 0, 0, 329, 354, 0, 0,
-	349, 352, 353, 391,
+	0, 421, 0, 0, 0, 153, 272, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 322, 0, 0, 0,
+	0, 0, 464, 0, 0, 4, 0, 0, 130, 0,
+	107, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 101, 0, 0, 85,
+	0, 213, 153, 153, 0, 243, 153, 0, 307, 307,
+	307, 0, 0, 307, 0, 0, 0, 307, 0, 405,
+	407, 307, 0, 0, 433, 439, 452, 457, 0, 466,
+	470, 478, 0, 0, 221, 0, 0, 363, 126, 0,
+	125, 127, 128, 0, 0, 0, 107, 135, 136, 0,
+	268, 153, 270, 0, 287, 0, 390, 408, 0, 0,
+	0, 435, 135, 453, 0, 271, 108, 109, 111, 115,
+	120, 0, 152, 158, 0, 181, 0, 0, 0, 0,
+	156, 154, 0, 169, 0, 404, 0, 0, 0, 0,
+	0, 0, 0, 0, 320, 0, 323, 0, 0, 0,
+	442, 471, 474, 476, 6, 71, 72, 73, 153, 132,
+	0, 106, 0, 78, 80, 81, 83, 84, 90, 91,
+	92, 93, 94, 95, 96, 97, 98, 0, 100, 183,
+	192, 193, 194, 190, 0, 0, 86, 0, 214, 0,
+	196, 0, 0, 306, 0, 245, 153, 196, 307, 153,
+	153, 0, 0, 307, 0, 307, 301, 0, 153, 0,
+	307, 392, 307, 153, 402, 0, 414, 424, 431, 0,
+	438, 0, 153, 0, 479, 473, 0, 221, 216, 0,
+	0, 218, 0, 0, 0, 338, 0, 0, 0, 0,
+	0, 0, 0, 0, 269, 0, 0, 0, 419, 422,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 169, 0, 0, 0, 0, 0, 0, 0, 0,
+	171, 172, 173, 174, 175, 176, 177, 178, 179, 0,
+	0, 0, 0, 0, 279, 0, 0, 0, 0, 0,
+	286, 0, 321, 0, 0, 468, 469, 0, 475, 477,
+	130, 148, 0, 0, 153, 99, 0, 0, 0, 0,
+	208, 0, 153, 153, 242, 196, 208, 153, 153, 130,
+	153, 196, 0, 0, 307, 0, 307, 153, 0, 0,
+	0, 153, 196, 307, 153, 153, 153, 130, 406, 0,
+	434, 440, 441, 458, 465, 0, 215, 224, 225, 227,
+	0, 0, 0, 0, 232, 0, 0, 0, 0, 0,
+	217, 0, 0, 0, 0, 336, 337, 351, 362, 365,
+	0, 0, 126, 0, 124, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 88, 0, 0, 436, 454, 456,
+	110, 113, 112, 0, 117, 119, 155, 157, -2, 0,
+	0, 0, 0, 0, 0, 168, 0, 0, 0, 0,
+	0, 278, 0, 0, 0, 0, 0, 285, 0, 0,
+	0, 443, 444, 132, 196, 0, 131, 133, 137, 135,
+	142, 144, 129, 130, 104, 0, 87, 153, 0, 0,
+	0, 0, 235, 212, 0, 0, 0, 196, 196, 244,
+	208, 266, 153, 130, 130, 208, 196, 208, 0, 0,
+	0, 0, 0, 153, 153, 130, 0, 0, 0, 305,
+	196, 208, 153, 153, 130, 153, 130, 130, 208, 432,
+	481, 482, 226, 228, 229, 230, 231, 233, 387, 389,
+	0, 0, 0, 0, 219, 220, 222, 223, 0, 248,
+	341, 343, 0, 364, 366, 367, 368, 370, 0, 123,
+	126, 122, 413, 0, 0, 0, 429, 0, 0, 274,
+	288, 0, 415, 420, 0, 0, 0, 0, 0, 0,
+	0, 162, 0, 0, 0, 0, 0, 378, 275, 0,
+	277, 280, 282, 0, 0, 284, 391, 459, 460, 461,
+	462, 463, 148, 208, 0, 0, 0, 0, 0, 132,
+	105, 196, 238, 239, 240, 241, 202, 0, 0, 206,
+	203, 204, 207, 195, 197, 199, 208, 208, 265, 130,
+	208, 208, 400, 208, 290, 0, 153, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 153, 130, 130, 208,
+	0, 303, 304, 208, 309, 153, 130, 130, 208, 130,
+	208, 208, 396, 0, 0, 0, 261, 262, 263, 264,
+	246, 0, 0, 0, 346, 374, 346, 374, 0, 369,
+	121, 0, 0, 0, 0, 418, 0, 0, 0, 0,
+	447, 448, 89, 455, 114, 0, 118, 160, 161, 0,
+	0, 165, 0, 0, 170, 273, 403, 0, 276, 281,
+	283, 196, 146, 0, 149, 150, 151, 134, 138, 0,
+	143, 148, 208, 210, 211, 0, 0, 200, 201, 236,
+	237, 208, 398, 399, 289, 153, 196, 312, 317, 319,
+	313, 0, 315, 316, 0, 0, 0, 153, 130, 208,
+	208, 327, 302, 308, 130, 208, 208, 335, 208, 394,
+	395, 0, 0, 388, 247, 0, 0, 0, 251, 252,
+	0, 0, 348, 0, 342, 374, 0, 0, 348, 344,
+	0, 352, 353, 0, 0, 0, 0, 0, 0, 428,
+	0, 450, 445, 116, 163, 164, 166, 167, 377, 208,
+	76, 0, 147, 139, 0, 196, 234, 0, 205, 198,
+	397, 196, 208, 0, 0, 0, 153, 153, 130, 208,
+	325, 326, 208, 333, 334, 393, 0, 0, 0, 249,
+	250, 0, 0, 0, 0, 378, 0, 347, 373, 0,
+	0, 378, 0, 0, 410, 411, 416, 0, 0, 0,
+	0, 146, 0, 0, 0, 208, 209, 208, 311, 318,
+	314, 153, 130, 130, 208, 324, 332, 484, 483, 258,
+	253, 254, 0, 256, 339, 349, 350, 371, 375, 372,
+	354, 0, 409, 0, 0, 0, 449, 446, 74, 0,
+	140, 0, 146, 310, 130, 208, 208, 331, 257, 259,
+	255, 0, 356, 355, 0, 374, 412, 417, 0, 426,
+	145, 141, 75, 208, 329, 330, 260, 376, 358, 357,
+	0, 379, 345, 0, 0, 328, 360, 359, 386, 380,
+	0, 427, 340, 0, 383, 382, 0, 0, 361, 386,
+	0, 0, 381, 384, 385, 425,
 }
 
 var yyTok1 = [...]int8{
@@ -1347,19 +1408,19 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:188
+//line sql.y:195
 		{
 			setParseTree(yylex, yyDollar[1].stmts)
 		}
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:194
+//line sql.y:201
 		{
 			yyVAL.stmts = []Statement{yyDollar[1].stmt}
 		}
 	case 3:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:198
+//line sql.y:205
 		{
 			if len(yyDollar[1].stmts) >= 1 {
 				yyVAL.stmts = yyDollar[1].stmts
@@ -1369,337 +1430,438 @@ yydefault:
 		}
 	case 4:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:206
+//line sql.y:213
 		{
 			yyVAL.stmts = append(yyDollar[1].stmts, yyDollar[3].stmt)
 		}
 	case 5:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:214
+//line sql.y:221
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 6:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:218
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:225
 		{
-			yyVAL.stmt = yyDollar[1].stmt
+			stmt := yyDollar[1].stmt.(*SelectStatement)
+			if strings.ToUpper(yyDollar[3].str) != "AVAILABILITY" {
+				yylex.Error("expect WITH AVAILABILITY for SELECT")
+				goto ret1
+			}
+			level, err := ParseReadAvailability(yyDollar[4].str)
+			if err != nil {
+				yylex.Error(err.Error())
+				goto ret1
+			}
+			stmt.Availability = level
+			yyVAL.stmt = stmt
 		}
 	case 7:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:222
+//line sql.y:240
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 8:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:226
+//line sql.y:244
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 9:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:230
+//line sql.y:248
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 10:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:234
+//line sql.y:252
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 11:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:238
+//line sql.y:256
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 12:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:242
+//line sql.y:260
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 13:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:246
+//line sql.y:264
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 14:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:250
+//line sql.y:268
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 15:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:254
+//line sql.y:272
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 16:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:258
+//line sql.y:276
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 17:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:262
+//line sql.y:280
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 18:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:266
+//line sql.y:284
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 19:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:270
+//line sql.y:288
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 20:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:274
+//line sql.y:292
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 21:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:278
+//line sql.y:296
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 22:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:282
+//line sql.y:300
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 23:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:286
+//line sql.y:304
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 24:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:290
+//line sql.y:308
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 25:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:294
+//line sql.y:312
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 26:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:298
+//line sql.y:316
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 27:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:302
+//line sql.y:320
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 28:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:306
+//line sql.y:324
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 29:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:310
+//line sql.y:328
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 30:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:314
+//line sql.y:332
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 31:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:318
+//line sql.y:336
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 32:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:322
+//line sql.y:340
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 33:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:326
+//line sql.y:344
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 34:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:330
+//line sql.y:348
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 35:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:334
+//line sql.y:352
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 36:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:338
+//line sql.y:356
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:342
+//line sql.y:360
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 38:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:346
+//line sql.y:364
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:350
+//line sql.y:368
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:354
+//line sql.y:372
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 41:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:358
+//line sql.y:376
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 42:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:362
+//line sql.y:380
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 43:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:366
+//line sql.y:384
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 44:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:370
+//line sql.y:388
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 45:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:374
+//line sql.y:392
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 46:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:378
+//line sql.y:396
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 47:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:382
+//line sql.y:400
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 48:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:386
+//line sql.y:404
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 49:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:390
+//line sql.y:408
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 50:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:394
+//line sql.y:412
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 51:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:398
+//line sql.y:416
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 52:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:402
+//line sql.y:420
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 53:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:406
+//line sql.y:424
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 54:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:410
+//line sql.y:428
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 55:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:414
+//line sql.y:432
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 56:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:418
+//line sql.y:436
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 57:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:422
+//line sql.y:440
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 58:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:426
+//line sql.y:444
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 59:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:448
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 60:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:452
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 61:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:456
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 62:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:460
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 63:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:464
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 64:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:468
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 65:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:472
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 66:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:476
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 67:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:480
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 68:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:484
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 69:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:488
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 70:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:492
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 71:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:498
+		{
+			yyVAL.str = "any"
+		}
+	case 72:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:502
+		{
+			yyVAL.str = "all"
+		}
+	case 73:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:506
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 74:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:432
+//line sql.y:512
 		{
 			stmt := &SelectStatement{}
 			stmt.Fields = yyDollar[2].fields
@@ -1740,9 +1902,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 60:
+	case 75:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:473
+//line sql.y:553
 		{
 			stmt := &SelectStatement{}
 			stmt.Hints = yyDollar[2].hints
@@ -1784,9 +1946,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 61:
+	case 76:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:515
+//line sql.y:595
 		{
 			stmt := &SelectStatement{}
 			stmt.Fields = yyDollar[2].fields
@@ -1814,147 +1976,147 @@ yydefault:
 			stmt.Location = yyDollar[9].location
 			yyVAL.stmt = stmt
 		}
-	case 62:
+	case 77:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:546
+//line sql.y:626
 		{
 			yyVAL.fields = []*Field{yyDollar[1].field}
 		}
-	case 63:
+	case 78:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:550
+//line sql.y:630
 		{
 			yyVAL.fields = append([]*Field{yyDollar[1].field}, yyDollar[3].fields...)
 		}
-	case 64:
+	case 79:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:556
+//line sql.y:636
 		{
 			yyVAL.field = &Field{Expr: &Wildcard{Type: Token(yyDollar[1].int)}}
 		}
-	case 65:
+	case 80:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:560
+//line sql.y:640
 		{
 			yyVAL.field = &Field{Expr: &Wildcard{Type: TAG}}
 		}
-	case 66:
+	case 81:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:564
+//line sql.y:644
 		{
 			yyVAL.field = &Field{Expr: &Wildcard{Type: FIELD}}
 		}
-	case 67:
+	case 82:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:568
+//line sql.y:648
 		{
 			yyVAL.field = &Field{Expr: yyDollar[1].expr}
 		}
-	case 68:
+	case 83:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:572
+//line sql.y:652
 		{
 			yyVAL.field = &Field{Expr: yyDollar[1].expr, Alias: yyDollar[3].str}
 		}
-	case 69:
+	case 84:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:576
+//line sql.y:656
 		{
 			yyVAL.field = &Field{Expr: yyDollar[1].expr, Alias: yyDollar[3].str}
 		}
-	case 70:
+	case 85:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:582
+//line sql.y:662
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 71:
+	case 86:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:586
+//line sql.y:666
 		{
 			c := yyDollar[1].expr.(*CaseWhenExpr)
 			c.Conditions = append(c.Conditions, yyDollar[2].expr.(*CaseWhenExpr).Conditions...)
 			c.Assigners = append(c.Assigners, yyDollar[2].expr.(*CaseWhenExpr).Assigners...)
 			yyVAL.expr = c
 		}
-	case 72:
+	case 87:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:595
+//line sql.y:675
 		{
 			c := &CaseWhenExpr{}
 			c.Conditions = []Expr{yyDollar[2].expr}
 			c.Assigners = []Expr{yyDollar[4].expr}
 			yyVAL.expr = c
 		}
-	case 73:
+	case 88:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:604
+//line sql.y:684
 		{
 			yyVAL.fields = []*Field{&Field{Expr: &VarRef{Val: yyDollar[1].str}}}
 		}
-	case 74:
+	case 89:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:608
+//line sql.y:688
 		{
 			yyVAL.fields = append([]*Field{&Field{Expr: &VarRef{Val: yyDollar[1].str}}}, yyDollar[3].fields...)
 		}
-	case 75:
+	case 90:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:614
+//line sql.y:694
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(MUL), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 76:
+	case 91:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:618
+//line sql.y:698
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(DIV), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 77:
+	case 92:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:622
+//line sql.y:702
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(ADD), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 78:
+	case 93:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:626
+//line sql.y:706
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(SUB), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 79:
+	case 94:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:630
+//line sql.y:710
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(BITWISE_XOR), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 80:
+	case 95:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:634
+//line sql.y:714
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(MOD), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 81:
+	case 96:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:638
+//line sql.y:718
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(BITWISE_AND), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 82:
+	case 97:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:642
+//line sql.y:722
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(BITWISE_OR), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 83:
+	case 98:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:646
+//line sql.y:726
 		{
 			yyVAL.expr = &ParenExpr{Expr: yyDollar[2].expr}
 		}
-	case 84:
+	case 99:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:650
+//line sql.y:730
 		{
 			if strings.ToLower(yyDollar[1].str) == "cast" {
 				if len(yyDollar[3].fields) != 1 {
@@ -1985,16 +2147,16 @@ yydefault:
 				yyVAL.expr = cols
 			}
 		}
-	case 85:
+	case 100:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:681
+//line sql.y:761
 		{
 			cols := &Call{Name: strings.ToLower(yyDollar[1].str)}
 			yyVAL.expr = cols
 		}
-	case 86:
+	case 101:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:686
+//line sql.y:766
 		{
 			switch s := yyDollar[2].expr.(type) {
 			case *NumberLiteral:
@@ -2008,98 +2170,98 @@ yydefault:
 			}
 
 		}
-	case 87:
+	case 102:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:700
+//line sql.y:780
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 88:
+	case 103:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:704
+//line sql.y:784
 		{
 			yyVAL.expr = &DurationLiteral{Val: yyDollar[1].tdur}
 		}
-	case 89:
+	case 104:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:708
+//line sql.y:788
 		{
 			c := yyDollar[2].expr.(*CaseWhenExpr)
 			c.Assigners = append(c.Assigners, yyDollar[4].expr)
 			yyVAL.expr = c
 		}
-	case 90:
+	case 105:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:714
+//line sql.y:794
 		{
 			yyVAL.expr = &VarRef{}
 		}
-	case 91:
+	case 106:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:720
+//line sql.y:800
 		{
 			yyVAL.sources = yyDollar[2].sources
 		}
-	case 92:
+	case 107:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:724
+//line sql.y:804
 		{
 			yyVAL.sources = nil
 		}
-	case 93:
+	case 108:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:730
+//line sql.y:810
 		{
 			yyVAL.sources = yyDollar[2].sources
 		}
-	case 94:
+	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:736
+//line sql.y:816
 		{
 			yyVAL.sources = []Source{yyDollar[1].ment}
 		}
-	case 95:
+	case 110:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:740
+//line sql.y:820
 		{
 			yyVAL.sources = append([]Source{yyDollar[1].ment}, yyDollar[3].sources...)
 		}
-	case 96:
+	case 111:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:744
+//line sql.y:824
 		{
 			yyVAL.sources = yyDollar[1].sources
 
 		}
-	case 97:
+	case 112:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:749
+//line sql.y:829
 		{
 			yyVAL.sources = append(yyDollar[1].sources, yyDollar[3].sources...)
 		}
-	case 98:
+	case 113:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:753
+//line sql.y:833
 		{
 			yyDollar[1].ment.Alias = yyDollar[3].str
 			yyVAL.sources = []Source{yyDollar[1].ment}
 		}
-	case 99:
+	case 114:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:758
+//line sql.y:838
 		{
 			yyDollar[1].ment.Alias = yyDollar[3].str
 			yyVAL.sources = append([]Source{yyDollar[1].ment}, yyDollar[5].sources...)
 		}
-	case 100:
+	case 115:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:763
+//line sql.y:843
 		{
 			yyVAL.sources = []Source{yyDollar[1].source}
 		}
-	case 101:
+	case 116:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:769
+//line sql.y:849
 		{
 			join := &Join{}
 			if len(yyDollar[1].sources) != 1 || len(yyDollar[4].sources) != 1 {
@@ -2110,9 +2272,9 @@ yydefault:
 			join.Condition = yyDollar[6].expr
 			yyVAL.source = join
 		}
-	case 102:
+	case 117:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:782
+//line sql.y:862
 		{
 			all_subquerys := []Source{}
 			for _, temp_stmt := range yyDollar[2].stmts {
@@ -2125,9 +2287,9 @@ yydefault:
 			}
 			yyVAL.sources = all_subquerys
 		}
-	case 103:
+	case 118:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:795
+//line sql.y:875
 		{
 			if len(yyDollar[2].stmts) != 1 {
 				yylex.Error("expexted SelectStatement length")
@@ -2144,72 +2306,72 @@ yydefault:
 			all_subquerys = append(all_subquerys, build_SubQuery)
 			yyVAL.sources = all_subquerys
 		}
-	case 104:
+	case 119:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:812
+//line sql.y:892
 		{
 			yyVAL.sources = yyDollar[2].sources
 		}
-	case 105:
+	case 120:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:818
+//line sql.y:898
 		{
 			yyVAL.ment = yyDollar[1].ment
 		}
-	case 106:
+	case 121:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:824
+//line sql.y:904
 		{
 			mst := yyDollar[5].ment
 			mst.Database = yyDollar[1].str
 			mst.RetentionPolicy = yyDollar[3].str
 			yyVAL.ment = mst
 		}
-	case 107:
+	case 122:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:831
+//line sql.y:911
 		{
 			mst := yyDollar[4].ment
 			mst.RetentionPolicy = yyDollar[2].str
 			yyVAL.ment = mst
 		}
-	case 108:
+	case 123:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:837
+//line sql.y:917
 		{
 			mst := yyDollar[4].ment
 			mst.Database = yyDollar[1].str
 			yyVAL.ment = mst
 		}
-	case 109:
+	case 124:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:843
+//line sql.y:923
 		{
 			mst := yyDollar[3].ment
 			mst.RetentionPolicy = yyDollar[1].str
 			yyVAL.ment = mst
 		}
-	case 110:
+	case 125:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:849
+//line sql.y:929
 		{
 			yyVAL.ment = yyDollar[1].ment
 		}
-	case 111:
+	case 126:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:855
+//line sql.y:935
 		{
 			yyVAL.ment = &Measurement{Name: yyDollar[1].str}
 		}
-	case 112:
+	case 127:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:859
+//line sql.y:939
 		{
 			yyVAL.ment = &Measurement{Name: yyDollar[1].str}
 		}
-	case 113:
+	case 128:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:863
+//line sql.y:943
 		{
 			re, err := regexp.Compile(yyDollar[1].str)
 			if err != nil {
@@ -2218,69 +2380,69 @@ yydefault:
 
 			yyVAL.ment = &Measurement{Regex: &RegexLiteral{Val: re}}
 		}
-	case 114:
+	case 129:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:874
+//line sql.y:954
 		{
 			yyVAL.dimens = yyDollar[3].dimens
 		}
-	case 115:
+	case 130:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:878
+//line sql.y:958
 		{
 			yyVAL.dimens = nil
 		}
-	case 116:
+	case 131:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:884
+//line sql.y:964
 		{
 			yyVAL.dimens = yyDollar[2].dimens
 		}
-	case 117:
+	case 132:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:888
+//line sql.y:968
 		{
 			yyVAL.dimens = nil
 		}
-	case 118:
+	case 133:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:894
+//line sql.y:974
 		{
 			yyVAL.dimens = []*Dimension{yyDollar[1].dimen}
 		}
-	case 119:
+	case 134:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:898
+//line sql.y:978
 		{
 			yyVAL.dimens = append([]*Dimension{yyDollar[1].dimen}, yyDollar[3].dimens...)
 		}
-	case 120:
+	case 135:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:904
+//line sql.y:984
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 121:
+	case 136:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:908
+//line sql.y:988
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 122:
+	case 137:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:914
+//line sql.y:994
 		{
 			yyVAL.dimen = &Dimension{Expr: &VarRef{Val: yyDollar[1].str}}
 		}
-	case 123:
+	case 138:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:918
+//line sql.y:998
 		{
 			yyVAL.dimen = &Dimension{Expr: &VarRef{Val: yyDollar[1].str}}
 		}
-	case 124:
+	case 139:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:922
+//line sql.y:1002
 		{
 			if strings.ToLower(yyDollar[1].str) != "time" {
 				yylex.Error("Invalid group by combination for no-time tag and time duration")
@@ -2288,9 +2450,9 @@ yydefault:
 
 			yyVAL.dimen = &Dimension{Expr: &Call{Name: "time", Args: []Expr{&DurationLiteral{Val: yyDollar[3].tdur}}}}
 		}
-	case 125:
+	case 140:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:930
+//line sql.y:1010
 		{
 			if strings.ToLower(yyDollar[1].str) != "time" {
 				yylex.Error("Invalid group by combination for no-time tag and time duration")
@@ -2298,9 +2460,9 @@ yydefault:
 
 			yyVAL.dimen = &Dimension{Expr: &Call{Name: "time", Args: []Expr{&DurationLiteral{Val: yyDollar[3].tdur}, &DurationLiteral{Val: yyDollar[5].tdur}}}}
 		}
-	case 126:
+	case 141:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:938
+//line sql.y:1018
 		{
 			if strings.ToLower(yyDollar[1].str) != "time" {
 				yylex.Error("Invalid group by combination for no-time tag and time duration")
@@ -2308,21 +2470,21 @@ yydefault:
 
 			yyVAL.dimen = &Dimension{Expr: &Call{Name: "time", Args: []Expr{&DurationLiteral{Val: yyDollar[3].tdur}, &DurationLiteral{Val: time.Duration(-yyDollar[6].tdur)}}}}
 		}
-	case 127:
+	case 142:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:946
+//line sql.y:1026
 		{
 			yyVAL.dimen = &Dimension{Expr: &Wildcard{Type: Token(yyDollar[1].int)}}
 		}
-	case 128:
+	case 143:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:950
+//line sql.y:1030
 		{
 			yyVAL.dimen = &Dimension{Expr: &Wildcard{Type: Token(yyDollar[1].int)}}
 		}
-	case 129:
+	case 144:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:954
+//line sql.y:1034
 		{
 			re, err := regexp.Compile(yyDollar[1].str)
 			if err != nil {
@@ -2330,9 +2492,9 @@ yydefault:
 			}
 			yyVAL.dimen = &Dimension{Expr: &RegexLiteral{Val: re}}
 		}
-	case 130:
+	case 145:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:965
+//line sql.y:1045
 		{
 			if strings.ToLower(yyDollar[1].str) != "tz" {
 				yylex.Error("Expect tz")
@@ -2343,93 +2505,93 @@ yydefault:
 			}
 			yyVAL.location = loc
 		}
-	case 131:
+	case 146:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:976
+//line sql.y:1056
 		{
 			yyVAL.location = nil
 		}
-	case 132:
+	case 147:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:982
+//line sql.y:1062
 		{
 			yyVAL.inter = yyDollar[3].inter
 		}
-	case 133:
+	case 148:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:986
+//line sql.y:1066
 		{
 			yyVAL.inter = "null"
 		}
-	case 134:
+	case 149:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:992
+//line sql.y:1072
 		{
 			yyVAL.inter = yyDollar[1].str
 		}
-	case 135:
+	case 150:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:996
+//line sql.y:1076
 		{
 			yyVAL.inter = yyDollar[1].int64
 		}
-	case 136:
+	case 151:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1000
+//line sql.y:1080
 		{
 			yyVAL.inter = yyDollar[1].float64
 		}
-	case 137:
+	case 152:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1006
+//line sql.y:1086
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 138:
+	case 153:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1010
+//line sql.y:1090
 		{
 			yyVAL.expr = nil
 		}
-	case 139:
+	case 154:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1016
+//line sql.y:1096
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 140:
+	case 155:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1020
+//line sql.y:1100
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(yyDollar[2].int), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 141:
+	case 156:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1026
+//line sql.y:1106
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 142:
+	case 157:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1030
+//line sql.y:1110
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(yyDollar[2].int), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 143:
+	case 158:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1036
+//line sql.y:1116
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 144:
+	case 159:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1040
+//line sql.y:1120
 		{
 			yyVAL.expr = &ParenExpr{Expr: yyDollar[2].expr}
 		}
-	case 145:
+	case 160:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1044
+//line sql.y:1124
 		{
 			ident := &VarRef{Val: yyDollar[1].str}
 			var expr, e Expr
@@ -2443,39 +2605,39 @@ yydefault:
 			}
 			yyVAL.expr = e
 		}
-	case 146:
+	case 161:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1058
+//line sql.y:1138
 		{
 			yyVAL.expr = &InCondition{Stmt: yyDollar[4].stmt.(*SelectStatement), Column: &VarRef{Val: yyDollar[1].str}}
 		}
-	case 147:
+	case 162:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1062
+//line sql.y:1142
 		{
 			yyVAL.expr = &BinaryExpr{}
 		}
-	case 148:
+	case 163:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1066
+//line sql.y:1146
 		{
 			yyVAL.expr = &BinaryExpr{}
 		}
-	case 149:
+	case 164:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1070
+//line sql.y:1150
 		{
 			yyVAL.expr = &BinaryExpr{}
 		}
-	case 150:
+	case 165:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1074
+//line sql.y:1154
 		{
 			yyVAL.expr = &BinaryExpr{}
 		}
-	case 151:
+	case 166:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1078
+//line sql.y:1158
 		{
 			yyVAL.expr = &BinaryExpr{
 				LHS: &VarRef{Val: yyDollar[3].str},
@@ -2483,9 +2645,9 @@ yydefault:
 				Op:  MATCH,
 			}
 		}
-	case 152:
+	case 167:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1086
+//line sql.y:1166
 		{
 			yyVAL.expr = &BinaryExpr{
 				LHS: &VarRef{Val: yyDollar[3].str},
@@ -2493,9 +2655,9 @@ yydefault:
 				Op:  MATCHPHRASE,
 			}
 		}
-	case 153:
+	case 168:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1096
+//line sql.y:1176
 		{
 			if yyDollar[2].int == NEQREGEX {
 				switch yyDollar[3].expr.(type) {
@@ -2506,123 +2668,129 @@ yydefault:
 			}
 			yyVAL.expr = &BinaryExpr{Op: Token(yyDollar[2].int), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 154:
+	case 169:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1109
+//line sql.y:1189
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 155:
+	case 170:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1113
+//line sql.y:1193
 		{
 			yyVAL.expr = &ParenExpr{Expr: yyDollar[2].expr}
 		}
-	case 156:
+	case 171:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1119
+//line sql.y:1199
 		{
 			yyVAL.int = EQ
 		}
-	case 157:
+	case 172:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1123
+//line sql.y:1203
 		{
 			yyVAL.int = NEQ
 		}
-	case 158:
+	case 173:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1127
+//line sql.y:1207
 		{
 			yyVAL.int = LT
 		}
-	case 159:
+	case 174:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1131
+//line sql.y:1211
 		{
 			yyVAL.int = LTE
 		}
-	case 160:
+	case 175:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1135
+//line sql.y:1215
 		{
 			yyVAL.int = GT
 		}
-	case 161:
+	case 176:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1139
+//line sql.y:1219
 		{
 			yyVAL.int = GTE
 		}
-	case 162:
+	case 177:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1143
+//line sql.y:1223
 		{
 			yyVAL.int = EQREGEX
 		}
-	case 163:
+	case 178:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1147
+//line sql.y:1227
 		{
 			yyVAL.int = NEQREGEX
 		}
-	case 164:
+	case 179:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1151
+//line sql.y:1231
 		{
 			yyVAL.int = LIKE
 		}
-	case 165:
+	case 180:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1157
+//line sql.y:1237
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 166:
+	case 181:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1163
+//line sql.y:1243
 		{
 			yyVAL.expr = &VarRef{Val: yyDollar[1].str}
 		}
-	case 167:
+	case 182:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1247
+		{
+			yyVAL.expr = &VarRef{Val: "name"}
+		}
+	case 183:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1167
+//line sql.y:1251
 		{
 			yyVAL.expr = &VarRef{Val: yyDollar[1].str, Type: yyDollar[3].dataType}
 		}
-	case 168:
+	case 184:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1171
+//line sql.y:1255
 		{
 			yyVAL.expr = &NumberLiteral{Val: yyDollar[1].float64}
 		}
-	case 169:
+	case 185:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1175
+//line sql.y:1259
 		{
 			yyVAL.expr = &IntegerLiteral{Val: yyDollar[1].int64}
 		}
-	case 170:
+	case 186:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1179
+//line sql.y:1263
 		{
 			yyVAL.expr = &StringLiteral{Val: yyDollar[1].str}
 		}
-	case 171:
+	case 187:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1183
+//line sql.y:1267
 		{
 			yyVAL.expr = &BooleanLiteral{Val: true}
 		}
-	case 172:
+	case 188:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1187
+//line sql.y:1271
 		{
 			yyVAL.expr = &BooleanLiteral{Val: false}
 		}
-	case 173:
+	case 189:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1191
+//line sql.y:1275
 		{
 			re, err := regexp.Compile(yyDollar[1].str)
 			if err != nil {
@@ -2630,21 +2798,21 @@ yydefault:
 			}
 			yyVAL.expr = &RegexLiteral{Val: re}
 		}
-	case 174:
+	case 190:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1199
+//line sql.y:1283
 		{
 			yyVAL.expr = &VarRef{Val: yyDollar[1].str + "." + yyDollar[3].str, Type: Tag}
 		}
-	case 175:
+	case 191:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1203
+//line sql.y:1287
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 176:
+	case 192:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1209
+//line sql.y:1293
 		{
 			switch strings.ToLower(yyDollar[1].str) {
 			case "float":
@@ -2665,75 +2833,75 @@ yydefault:
 				yylex.Error("wrong field dataType")
 			}
 		}
-	case 177:
+	case 193:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1230
+//line sql.y:1314
 		{
 			yyVAL.dataType = Tag
 		}
-	case 178:
+	case 194:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1234
+//line sql.y:1318
 		{
 			yyVAL.dataType = AnyField
 		}
-	case 179:
+	case 195:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1240
+//line sql.y:1324
 		{
 			yyVAL.sortfs = yyDollar[3].sortfs
 		}
-	case 180:
+	case 196:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1244
+//line sql.y:1328
 		{
 			yyVAL.sortfs = nil
 		}
-	case 181:
+	case 197:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1250
+//line sql.y:1334
 		{
 			yyVAL.sortfs = []*SortField{yyDollar[1].sortf}
 		}
-	case 182:
+	case 198:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1254
+//line sql.y:1338
 		{
 			yyVAL.sortfs = append([]*SortField{yyDollar[1].sortf}, yyDollar[3].sortfs...)
 		}
-	case 183:
+	case 199:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1260
+//line sql.y:1344
 		{
 			yyVAL.sortf = &SortField{Name: yyDollar[1].str, Ascending: true}
 		}
-	case 184:
+	case 200:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1264
+//line sql.y:1348
 		{
 			yyVAL.sortf = &SortField{Name: yyDollar[1].str, Ascending: false}
 		}
-	case 185:
+	case 201:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1268
+//line sql.y:1352
 		{
 			yyVAL.sortf = &SortField{Name: yyDollar[1].str, Ascending: true}
 		}
-	case 186:
+	case 202:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1274
+//line sql.y:1358
 		{
 			yyVAL.intSlice = append(yyDollar[1].intSlice, yyDollar[2].intSlice...)
 		}
-	case 187:
+	case 203:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1280
+//line sql.y:1364
 		{
 			yyVAL.int64 = yyDollar[1].int64
 		}
-	case 188:
+	case 204:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1285
+//line sql.y:1369
 		{
 			if n, ok := yyDollar[1].expr.(*IntegerLiteral); ok {
 				yyVAL.int64 = n.Val
@@ -2741,69 +2909,69 @@ yydefault:
 				yylex.Error("unsupported type, expect integer type")
 			}
 		}
-	case 189:
+	case 205:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1295
+//line sql.y:1379
 		{
 			yyVAL.intSlice = []int{int(yyDollar[2].int64), int(yyDollar[4].int64)}
 		}
-	case 190:
+	case 206:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1299
+//line sql.y:1383
 		{
 			yyVAL.intSlice = []int{int(yyDollar[2].int64), 0}
 		}
-	case 191:
+	case 207:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1303
+//line sql.y:1387
 		{
 			yyVAL.intSlice = []int{0, int(yyDollar[2].int64)}
 		}
-	case 192:
+	case 208:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1307
+//line sql.y:1391
 		{
 			yyVAL.intSlice = []int{0, 0}
 		}
-	case 193:
+	case 209:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1313
+//line sql.y:1397
 		{
 			yyVAL.intSlice = []int{int(yyDollar[2].int64), int(yyDollar[4].int64)}
 		}
-	case 194:
+	case 210:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1317
+//line sql.y:1401
 		{
 			yyVAL.intSlice = []int{int(yyDollar[2].int64), 0}
 		}
-	case 195:
+	case 211:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1321
+//line sql.y:1405
 		{
 			yyVAL.intSlice = []int{0, int(yyDollar[2].int64)}
 		}
-	case 196:
+	case 212:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1325
+//line sql.y:1409
 		{
 			yyVAL.intSlice = []int{0, 0}
 		}
-	case 197:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1331
+	case 213:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1415
 		{
-			yyVAL.stmt = &ShowDatabasesStatement{ShowDetail: false}
+			yyVAL.stmt = &ShowDatabasesStatement{ShowDetail: false, Condition: yyDollar[3].expr}
 		}
-	case 198:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1335
+	case 214:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1419
 		{
-			yyVAL.stmt = &ShowDatabasesStatement{ShowDetail: true}
+			yyVAL.stmt = &ShowDatabasesStatement{ShowDetail: true, Condition: yyDollar[4].expr}
 		}
-	case 199:
+	case 215:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1341
+//line sql.y:1425
 		{
 			sms := yyDollar[4].stmt
 
@@ -2811,9 +2979,9 @@ yydefault:
 			sms.(*CreateDatabaseStatement).DatabaseAttr = yyDollar[5].databasePolicy
 			yyVAL.stmt = sms
 		}
-	case 200:
+	case 216:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1349
+//line sql.y:1433
 		{
 			stmt := &CreateDatabaseStatement{}
 			stmt.RetentionPolicyCreate = false
@@ -2821,54 +2989,54 @@ yydefault:
 			stmt.DatabaseAttr = yyDollar[4].databasePolicy
 			yyVAL.stmt = stmt
 		}
-	case 201:
+	case 217:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1359
+//line sql.y:1443
 		{
 			yyVAL.databasePolicy = DatabasePolicy{Replicas: uint32(yyDollar[2].int64), EnableTagArray: false}
 		}
-	case 202:
+	case 218:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1364
+//line sql.y:1448
 		{
 			yyVAL.databasePolicy = DatabasePolicy{EnableTagArray: yyDollar[1].bool}
 		}
-	case 203:
+	case 219:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1369
+//line sql.y:1453
 		{
 			yyVAL.databasePolicy = DatabasePolicy{Replicas: uint32(yyDollar[2].int64), EnableTagArray: yyDollar[3].bool}
 		}
-	case 204:
+	case 220:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1374
+//line sql.y:1458
 		{
 			yyVAL.databasePolicy = DatabasePolicy{Replicas: uint32(yyDollar[3].int64), EnableTagArray: yyDollar[1].bool}
 		}
-	case 205:
+	case 221:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1378
+//line sql.y:1462
 		{
 			yyVAL.databasePolicy = DatabasePolicy{EnableTagArray: false}
 		}
-	case 206:
+	case 222:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1384
+//line sql.y:1468
 		{
 			if strings.ToLower(yyDollar[3].str) != "array" {
 				yylex.Error("unsupport type")
 			}
 			yyVAL.bool = true
 		}
-	case 207:
+	case 223:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1391
+//line sql.y:1475
 		{
 			yyVAL.bool = false
 		}
-	case 208:
+	case 224:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1398
+//line sql.y:1482
 		{
 			stmt := &CreateDatabaseStatement{}
 			stmt.RetentionPolicyCreate = true
@@ -2907,15 +3075,15 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 209:
+	case 225:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1441
+//line sql.y:1525
 		{
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 210:
+	case 226:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1445
+//line sql.y:1529
 		{
 			if yyDollar[1].durations.ShardGroupDuration < 0 || yyDollar[2].durations.ShardGroupDuration < 0 {
 				if yyDollar[2].durations.ShardGroupDuration >= 0 {
@@ -2988,22 +3156,22 @@ yydefault:
 			}
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 211:
+	case 227:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1520
+//line sql.y:1604
 		{
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 212:
+	case 228:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1524
+//line sql.y:1608
 		{
 			duration := yyDollar[2].tdur
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, PolicyDuration: &duration}
 		}
-	case 213:
+	case 229:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1529
+//line sql.y:1613
 		{
 			if yyDollar[2].int64 < 1 || yyDollar[2].int64%2 == 0 {
 				yylex.Error("REPLICATION must be an odd number")
@@ -3011,36 +3179,36 @@ yydefault:
 			replicaN := int(yyDollar[2].int64)
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, Replication: &replicaN}
 		}
-	case 214:
+	case 230:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1537
+//line sql.y:1621
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, PolicyName: yyDollar[2].str}
 		}
-	case 215:
+	case 231:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1541
+//line sql.y:1625
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, ReplicaNum: uint32(yyDollar[2].int64)}
 		}
-	case 216:
+	case 232:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1545
+//line sql.y:1629
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, rpdefault: true}
 		}
-	case 217:
+	case 233:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1549
+//line sql.y:1633
 		{
 			if len(yyDollar[2].strSlice) == 0 {
 				yylex.Error("ShardKey should not be nil")
 			}
 			yyVAL.durations = &Durations{ShardKey: yyDollar[2].strSlice, ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, rpdefault: false}
 		}
-	case 218:
+	case 234:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1560
+//line sql.y:1644
 		{
 			sms := &ShowMeasurementsStatement{}
 			sms.Database = yyDollar[3].str
@@ -3051,9 +3219,9 @@ yydefault:
 			sms.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = sms
 		}
-	case 219:
+	case 235:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1571
+//line sql.y:1655
 		{
 			sms := &ShowMeasurementsStatement{}
 			sms.Database = yyDollar[3].str
@@ -3063,21 +3231,52 @@ yydefault:
 			sms.Offset = yyDollar[6].intSlice[1]
 			yyVAL.stmt = sms
 		}
-	case 220:
+	case 236:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:1665
+		{
+			sms := &ShowMeasurementsStatement{}
+			sms.Database = yyDollar[3].str
+			sms.LikePattern = yyDollar[5].str
+			sms.Condition = yyDollar[6].expr
+			sms.SortFields = yyDollar[7].sortfs
+			sms.Limit = yyDollar[8].intSlice[0]
+			sms.Offset = yyDollar[8].intSlice[1]
+			yyVAL.stmt = sms
+		}
+	case 237:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:1676
+		{
+			if strings.ToUpper(yyDollar[4].str) != "ILIKE" {
+				yylex.Error("expect LIKE or ILIKE for SHOW MEASUREMENTS")
+				goto ret1
+			}
+			sms := &ShowMeasurementsStatement{}
+			sms.Database = yyDollar[3].str
+			sms.LikePattern = yyDollar[5].str
+			sms.LikeCaseInsensitive = true
+			sms.Condition = yyDollar[6].expr
+			sms.SortFields = yyDollar[7].sortfs
+			sms.Limit = yyDollar[8].intSlice[0]
+			sms.Offset = yyDollar[8].intSlice[1]
+			yyVAL.stmt = sms
+		}
+	case 238:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1584
+//line sql.y:1695
 		{
 			yyVAL.ment = &Measurement{Name: yyDollar[2].str}
 		}
-	case 221:
+	case 239:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1588
+//line sql.y:1699
 		{
 			yyVAL.ment = &Measurement{Name: yyDollar[2].str}
 		}
-	case 222:
+	case 240:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1592
+//line sql.y:1703
 		{
 			re, err := regexp.Compile(yyDollar[2].str)
 			if err != nil {
@@ -3085,9 +3284,9 @@ yydefault:
 			}
 			yyVAL.ment = &Measurement{Regex: &RegexLiteral{Val: re}}
 		}
-	case 223:
+	case 241:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1600
+//line sql.y:1711
 		{
 			re, err := regexp.Compile(yyDollar[2].str)
 			if err != nil {
@@ -3095,32 +3294,47 @@ yydefault:
 			}
 			yyVAL.ment = &Measurement{Regex: &RegexLiteral{Val: re}}
 		}
-	case 224:
+	case 242:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1612
+//line sql.y:1723
 		{
 			yyVAL.stmt = &ShowRetentionPoliciesStatement{
 				Database: yyDollar[5].str,
 			}
 		}
-	case 225:
+	case 243:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1618
+//line sql.y:1729
 		{
 			yyVAL.stmt = &ShowRetentionPoliciesStatement{}
 		}
-	case 226:
-		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1625
+	case 244:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1733
 		{
-			stmt := yyDollar[7].stmt.(*CreateRetentionPolicyStatement)
-			stmt.Name = yyDollar[4].str
-			stmt.Database = yyDollar[6].str
-			yyVAL.stmt = stmt
+			yyVAL.stmt = &ShowRetentionPoliciesStatement{
+				Database:   yyDollar[5].str,
+				ShowDetail: true,
+			}
 		}
-	case 227:
+	case 245:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1740
+		{
+			yyVAL.stmt = &ShowRetentionPoliciesStatement{ShowDetail: true}
+		}
+	case 246:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1747
+		{
+			stmt := yyDollar[7].stmt.(*CreateRetentionPolicyStatement)
+			stmt.Name = yyDollar[4].str
+			stmt.Database = yyDollar[6].str
+			yyVAL.stmt = stmt
+		}
+	case 247:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1632
+//line sql.y:1754
 		{
 			stmt := yyDollar[7].stmt.(*CreateRetentionPolicyStatement)
 			stmt.Name = yyDollar[4].str
@@ -3128,18 +3342,18 @@ yydefault:
 			stmt.Default = true
 			yyVAL.stmt = stmt
 		}
-	case 228:
+	case 248:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1642
+//line sql.y:1764
 		{
 			stmt := &CreateUserStatement{}
 			stmt.Name = yyDollar[3].str
 			stmt.Password = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 229:
+	case 249:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1649
+//line sql.y:1771
 		{
 			stmt := &CreateUserStatement{}
 			stmt.Name = yyDollar[3].str
@@ -3147,9 +3361,9 @@ yydefault:
 			stmt.Admin = true
 			yyVAL.stmt = stmt
 		}
-	case 230:
+	case 250:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1657
+//line sql.y:1779
 		{
 			stmt := &CreateUserStatement{}
 			stmt.Name = yyDollar[3].str
@@ -3157,9 +3371,62 @@ yydefault:
 			stmt.Rwuser = true
 			yyVAL.stmt = stmt
 		}
-	case 231:
+	case 251:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:1787
+		{
+			stmt := &CreateUserStatement{}
+			stmt.Name = yyDollar[3].str
+			stmt.Password = yyDollar[6].str
+			for _, grant := range yyDollar[8].grants {
+				grant.User = yyDollar[3].str
+			}
+			stmt.Grants = yyDollar[8].grants
+			yyVAL.stmt = stmt
+		}
+	case 252:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1800
+		{
+			yyVAL.grants = []*GrantStatement{yyDollar[1].grant}
+		}
+	case 253:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1804
+		{
+			yyVAL.grants = append(yyDollar[1].grants, yyDollar[3].grant)
+		}
+	case 254:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1810
+		{
+			yyVAL.grant = &GrantStatement{Privilege: AllPrivileges, On: yyDollar[3].str}
+		}
+	case 255:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1814
+		{
+			yyVAL.grant = &GrantStatement{Privilege: AllPrivileges, On: yyDollar[4].str}
+		}
+	case 256:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1818
+		{
+			stmt := &GrantStatement{On: yyDollar[3].str}
+			switch strings.ToLower(yyDollar[1].str) {
+			case "read":
+				stmt.Privilege = ReadPrivilege
+			case "write":
+				stmt.Privilege = WritePrivilege
+			default:
+				yylex.Error("wrong Privilege")
+				goto ret1
+			}
+			yyVAL.grant = stmt
+		}
+	case 257:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1668
+//line sql.y:1835
 		{
 			stmt := &CreateRetentionPolicyStatement{}
 			stmt.Duration = yyDollar[2].tdur
@@ -3194,9 +3461,9 @@ yydefault:
 
 			yyVAL.stmt = stmt
 		}
-	case 232:
+	case 258:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1703
+//line sql.y:1870
 		{
 			stmt := &CreateRetentionPolicyStatement{}
 			stmt.Duration = yyDollar[2].tdur
@@ -3206,15 +3473,15 @@ yydefault:
 			stmt.Replication = int(yyDollar[4].int64)
 			yyVAL.stmt = stmt
 		}
-	case 233:
+	case 259:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1716
+//line sql.y:1883
 		{
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 234:
+	case 260:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1720
+//line sql.y:1887
 		{
 			if yyDollar[1].durations.ShardGroupDuration < 0 || yyDollar[2].durations.ShardGroupDuration < 0 {
 				if yyDollar[2].durations.ShardGroupDuration >= 0 {
@@ -3249,33 +3516,33 @@ yydefault:
 			}
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 235:
+	case 261:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1758
+//line sql.y:1925
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: yyDollar[3].tdur, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1}
 		}
-	case 236:
+	case 262:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1762
+//line sql.y:1929
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: yyDollar[3].tdur, WarmDuration: -1, IndexGroupDuration: -1}
 		}
-	case 237:
+	case 263:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1766
+//line sql.y:1933
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: yyDollar[3].tdur, IndexGroupDuration: -1}
 		}
-	case 238:
+	case 264:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1770
+//line sql.y:1937
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: yyDollar[3].tdur}
 		}
-	case 239:
+	case 265:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1778
+//line sql.y:1945
 		{
 			stmt := &ShowSeriesStatement{}
 			stmt.Database = yyDollar[3].str
@@ -3286,9 +3553,9 @@ yydefault:
 			stmt.Offset = yyDollar[7].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 240:
+	case 266:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1789
+//line sql.y:1956
 		{
 			stmt := &ShowSeriesStatement{}
 			stmt.Database = yyDollar[3].str
@@ -3298,57 +3565,57 @@ yydefault:
 			stmt.Offset = yyDollar[6].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 241:
+	case 267:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1801
+//line sql.y:1968
 		{
 			yyVAL.stmt = &ShowUsersStatement{}
 		}
-	case 242:
+	case 268:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1807
+//line sql.y:1974
 		{
 			stmt := &DropDatabaseStatement{}
 			stmt.Name = yyDollar[3].str
 			yyVAL.stmt = stmt
 		}
-	case 243:
+	case 269:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1815
+//line sql.y:1982
 		{
 			stmt := &DropSeriesStatement{}
 			stmt.Sources = yyDollar[3].sources
 			stmt.Condition = yyDollar[4].expr
 			yyVAL.stmt = stmt
 		}
-	case 244:
+	case 270:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1822
+//line sql.y:1989
 		{
 			stmt := &DropSeriesStatement{}
 			stmt.Condition = yyDollar[3].expr
 			yyVAL.stmt = stmt
 		}
-	case 245:
+	case 271:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1830
+//line sql.y:1997
 		{
 			stmt := &DeleteSeriesStatement{}
 			stmt.Sources = yyDollar[2].sources
 			stmt.Condition = yyDollar[3].expr
 			yyVAL.stmt = stmt
 		}
-	case 246:
+	case 272:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1837
+//line sql.y:2004
 		{
 			stmt := &DeleteSeriesStatement{}
 			stmt.Condition = yyDollar[2].expr
 			yyVAL.stmt = stmt
 		}
-	case 247:
+	case 273:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1846
+//line sql.y:2013
 		{
 			stmt := &AlterRetentionPolicyStatement{}
 			stmt.Name = yyDollar[4].str
@@ -3382,18 +3649,18 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 248:
+	case 274:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1884
+//line sql.y:2051
 		{
 			stmt := &DropRetentionPolicyStatement{}
 			stmt.Name = yyDollar[4].str
 			stmt.Database = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 249:
+	case 275:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1893
+//line sql.y:2060
 		{
 			stmt := &GrantStatement{}
 			stmt.Privilege = AllPrivileges
@@ -3401,9 +3668,9 @@ yydefault:
 			stmt.User = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 250:
+	case 276:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1901
+//line sql.y:2068
 		{
 			stmt := &GrantStatement{}
 			stmt.Privilege = AllPrivileges
@@ -3411,9 +3678,9 @@ yydefault:
 			stmt.User = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 251:
+	case 277:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1909
+//line sql.y:2076
 		{
 			stmt := &GrantStatement{}
 			switch strings.ToLower(yyDollar[2].str) {
@@ -3428,21 +3695,33 @@ yydefault:
 			stmt.User = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 252:
+	case 278:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1926
+//line sql.y:2093
 		{
 			yyVAL.stmt = &GrantAdminStatement{User: yyDollar[5].str}
 		}
-	case 253:
+	case 279:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1930
+//line sql.y:2097
 		{
 			yyVAL.stmt = &GrantAdminStatement{User: yyDollar[4].str}
 		}
-	case 254:
+	case 280:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2103
+		{
+			yyVAL.stmt = &RevokeAllStatement{User: yyDollar[6].str}
+		}
+	case 281:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2107
+		{
+			yyVAL.stmt = &RevokeAllStatement{User: yyDollar[7].str}
+		}
+	case 282:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1936
+//line sql.y:2111
 		{
 			stmt := &RevokeStatement{}
 			stmt.Privilege = AllPrivileges
@@ -3450,9 +3729,9 @@ yydefault:
 			stmt.User = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 255:
+	case 283:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1944
+//line sql.y:2119
 		{
 			stmt := &RevokeStatement{}
 			stmt.Privilege = AllPrivileges
@@ -3460,9 +3739,9 @@ yydefault:
 			stmt.User = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 256:
+	case 284:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1952
+//line sql.y:2127
 		{
 			stmt := &RevokeStatement{}
 			switch strings.ToLower(yyDollar[2].str) {
@@ -3477,27 +3756,37 @@ yydefault:
 			stmt.User = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 257:
+	case 285:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1969
+//line sql.y:2144
 		{
 			yyVAL.stmt = &RevokeAdminStatement{User: yyDollar[5].str}
 		}
-	case 258:
+	case 286:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1973
+//line sql.y:2148
 		{
 			yyVAL.stmt = &RevokeAdminStatement{User: yyDollar[4].str}
 		}
-	case 259:
+	case 287:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1979
+//line sql.y:2154
 		{
 			yyVAL.stmt = &DropUserStatement{Name: yyDollar[3].str}
 		}
-	case 260:
+	case 288:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2158
+		{
+			names := make([]string, 0, len(yyDollar[5].fields))
+			for _, f := range yyDollar[5].fields {
+				names = append(names, f.Expr.(*VarRef).Val)
+			}
+			yyVAL.stmt = &DropUserStatement{Names: names}
+		}
+	case 289:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1985
+//line sql.y:2168
 		{
 			stmt := &ShowTagKeysStatement{}
 			stmt.Database = yyDollar[4].str
@@ -3511,9 +3800,9 @@ yydefault:
 			yyVAL.stmt = stmt
 
 		}
-	case 261:
+	case 290:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1999
+//line sql.y:2182
 		{
 			stmt := &ShowTagKeysStatement{}
 			stmt.Database = yyDollar[4].str
@@ -3525,72 +3814,83 @@ yydefault:
 			stmt.SOffset = yyDollar[7].intSlice[3]
 			yyVAL.stmt = stmt
 		}
-	case 262:
+	case 291:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2013
+//line sql.y:2196
 		{
 			yyVAL.str = "PRIMARYKEY"
 		}
-	case 263:
+	case 292:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2017
+//line sql.y:2200
 		{
 			yyVAL.str = "SORTKEY"
 		}
-	case 264:
+	case 293:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2021
+//line sql.y:2204
 		{
 			yyVAL.str = "PROPERTY"
 		}
-	case 265:
+	case 294:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2025
+//line sql.y:2208
 		{
 			yyVAL.str = "SHARDKEY"
 		}
-	case 266:
+	case 295:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2029
+//line sql.y:2212
 		{
 			yyVAL.str = "ENGINETYPE"
 		}
-	case 267:
+	case 296:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2033
+//line sql.y:2216
 		{
 			yyVAL.str = "SCHEMA"
 		}
-	case 268:
+	case 297:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2037
+//line sql.y:2220
 		{
 			yyVAL.str = "INDEXES"
 		}
-	case 269:
+	case 298:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2224
+		{
+			yyVAL.str = "INDEX"
+		}
+	case 299:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2041
+//line sql.y:2228
 		{
 			yyVAL.str = "COMPACT"
 		}
-	case 270:
+	case 300:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2045
+//line sql.y:2232
 		{
-			yylex.Error("SHOW command error, only support PRIMARYKEY, SORTKEY, SHARDKEY, ENGINETYPE, INDEXES, SCHEMA, COMPACT")
+			if strings.ToUpper(yyDollar[1].str) == "VERSION" {
+				yyVAL.str = "VERSION"
+			} else {
+				yylex.Error("SHOW command error, only support PRIMARYKEY, SORTKEY, SHARDKEY, ENGINETYPE, INDEXES, INDEX, SCHEMA, COMPACT, VERSION")
+				goto ret1
+			}
 		}
-	case 271:
+	case 301:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2051
+//line sql.y:2243
 		{
 			stmt := &ShowMeasurementKeysStatement{}
 			stmt.Name = yyDollar[2].str
 			stmt.Measurement = yyDollar[4].str
 			yyVAL.stmt = stmt
 		}
-	case 272:
+	case 302:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2058
+//line sql.y:2250
 		{
 			stmt := &ShowMeasurementKeysStatement{}
 			stmt.Name = yyDollar[2].str
@@ -3599,9 +3899,9 @@ yydefault:
 			stmt.Measurement = yyDollar[8].str
 			yyVAL.stmt = stmt
 		}
-	case 273:
+	case 303:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2067
+//line sql.y:2259
 		{
 			stmt := &ShowMeasurementKeysStatement{}
 			stmt.Name = yyDollar[2].str
@@ -3609,9 +3909,9 @@ yydefault:
 			stmt.Measurement = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 274:
+	case 304:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2075
+//line sql.y:2267
 		{
 			stmt := &ShowMeasurementKeysStatement{}
 			stmt.Name = yyDollar[2].str
@@ -3619,53 +3919,55 @@ yydefault:
 			stmt.Measurement = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 275:
+	case 305:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2083
+//line sql.y:2275
 		{
 			stmt := &ShowMeasurementKeysStatement{}
 			stmt.Name = yyDollar[2].str
 			stmt.Measurement = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 276:
+	case 306:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2092
+//line sql.y:2284
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 277:
+	case 307:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2096
+//line sql.y:2288
 		{
 			yyVAL.str = ""
 		}
-	case 278:
-		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2102
+	case 308:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:2294
 		{
 			stmt := &ShowFieldKeysStatement{}
 			stmt.Database = yyDollar[4].str
 			stmt.Sources = yyDollar[5].sources
-			stmt.SortFields = yyDollar[6].sortfs
-			stmt.Limit = yyDollar[7].intSlice[0]
-			stmt.Offset = yyDollar[7].intSlice[1]
+			stmt.Condition = yyDollar[6].expr
+			stmt.SortFields = yyDollar[7].sortfs
+			stmt.Limit = yyDollar[8].intSlice[0]
+			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 279:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2112
+	case 309:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2305
 		{
 			stmt := &ShowFieldKeysStatement{}
 			stmt.Database = yyDollar[4].str
-			stmt.SortFields = yyDollar[5].sortfs
-			stmt.Limit = yyDollar[6].intSlice[0]
-			stmt.Offset = yyDollar[6].intSlice[1]
+			stmt.Condition = yyDollar[5].expr
+			stmt.SortFields = yyDollar[6].sortfs
+			stmt.Limit = yyDollar[7].intSlice[0]
+			stmt.Offset = yyDollar[7].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 280:
+	case 310:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:2124
+//line sql.y:2318
 		{
 			stmt := yyDollar[8].stmt.(*ShowTagValuesStatement)
 			stmt.TagKeyCondition = nil
@@ -3678,9 +3980,9 @@ yydefault:
 			yyVAL.stmt = stmt
 
 		}
-	case 281:
+	case 311:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:2137
+//line sql.y:2331
 		{
 			stmt := yyDollar[7].stmt.(*ShowTagValuesStatement)
 			stmt.TagKeyCondition = nil
@@ -3691,36 +3993,36 @@ yydefault:
 			stmt.Offset = yyDollar[10].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 282:
+	case 312:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2150
+//line sql.y:2344
 		{
 			stmt := &ShowTagValuesStatement{}
 			stmt.Op = EQ
 			stmt.TagKeyExpr = yyDollar[2].expr.(*ListLiteral)
 			yyVAL.stmt = stmt
 		}
-	case 283:
+	case 313:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2157
+//line sql.y:2351
 		{
 			stmt := &ShowTagValuesStatement{}
 			stmt.Op = NEQ
 			stmt.TagKeyExpr = yyDollar[2].expr.(*ListLiteral)
 			yyVAL.stmt = stmt
 		}
-	case 284:
+	case 314:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2164
+//line sql.y:2358
 		{
 			stmt := &ShowTagValuesStatement{}
 			stmt.Op = IN
 			stmt.TagKeyExpr = yyDollar[3].expr.(*ListLiteral)
 			yyVAL.stmt = stmt
 		}
-	case 285:
+	case 315:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2171
+//line sql.y:2365
 		{
 			stmt := &ShowTagValuesStatement{}
 			stmt.Op = EQREGEX
@@ -3731,9 +4033,9 @@ yydefault:
 			stmt.TagKeyExpr = &RegexLiteral{Val: re}
 			yyVAL.stmt = stmt
 		}
-	case 286:
+	case 316:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2182
+//line sql.y:2376
 		{
 			stmt := &ShowTagValuesStatement{}
 			stmt.Op = NEQREGEX
@@ -3744,61 +4046,95 @@ yydefault:
 			stmt.TagKeyExpr = &RegexLiteral{Val: re}
 			yyVAL.stmt = stmt
 		}
-	case 287:
+	case 317:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2196
+//line sql.y:2390
 		{
 			temp := []string{yyDollar[1].str}
 			yyVAL.expr = &ListLiteral{Vals: temp}
 		}
-	case 288:
+	case 318:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2201
+//line sql.y:2395
 		{
 			yyDollar[3].expr.(*ListLiteral).Vals = append(yyDollar[3].expr.(*ListLiteral).Vals, yyDollar[1].str)
 			yyVAL.expr = yyDollar[3].expr
 		}
-	case 289:
+	case 319:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2208
+//line sql.y:2402
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 290:
+	case 320:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2216
+//line sql.y:2410
 		{
 			stmt := &ExplainStatement{}
 			stmt.Statement = yyDollar[3].stmt.(*SelectStatement)
 			stmt.Analyze = true
 			yyVAL.stmt = stmt
 		}
-	case 291:
+	case 321:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2417
+		{
+			stmt := &ExplainStatement{}
+			stmt.Statement = yyDollar[4].stmt.(*SelectStatement)
+			stmt.Analyze = true
+			switch strings.ToLower(yyDollar[3].str) {
+			case "verbose":
+				stmt.Verbosity = ExplainVerbose
+			case "summary":
+				stmt.Verbosity = ExplainSummary
+			case "brief":
+				stmt.Verbosity = ExplainBrief
+			default:
+				yylex.Error("EXPLAIN ANALYZE VERBOSE, SUMMARY or BRIEF is expected")
+				goto ret1
+			}
+			yyVAL.stmt = stmt
+		}
+	case 322:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2223
+//line sql.y:2435
 		{
 			stmt := &ExplainStatement{}
 			stmt.Statement = yyDollar[2].stmt.(*SelectStatement)
 			stmt.Analyze = false
 			yyVAL.stmt = stmt
 		}
-	case 292:
-		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:2233
+	case 323:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2442
 		{
-			stmt := &ShowTagKeyCardinalityStatement{}
-			stmt.Database = yyDollar[6].str
-			stmt.Exact = true
-			stmt.Sources = yyDollar[7].sources
-			stmt.Condition = yyDollar[8].expr
-			stmt.Dimensions = yyDollar[9].dimens
-			stmt.Limit = yyDollar[10].intSlice[0]
+			stmt := &ExplainStatement{}
+			stmt.Statement = yyDollar[3].stmt.(*SelectStatement)
+			if strings.ToLower(yyDollar[2].str) == "cost" {
+				stmt.Cost = true
+			} else {
+				yylex.Error("EXPLAIN ANALYZE or EXPLAIN COST is expected")
+				goto ret1
+			}
+			yyVAL.stmt = stmt
+		}
+	case 324:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:2457
+		{
+			stmt := &ShowTagKeyCardinalityStatement{}
+			stmt.Database = yyDollar[6].str
+			stmt.Exact = true
+			stmt.Sources = yyDollar[7].sources
+			stmt.Condition = yyDollar[8].expr
+			stmt.Dimensions = yyDollar[9].dimens
+			stmt.Limit = yyDollar[10].intSlice[0]
 			stmt.Offset = yyDollar[10].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 293:
+	case 325:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2245
+//line sql.y:2469
 		{
 			stmt := &ShowTagKeyCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3809,9 +4145,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 294:
+	case 326:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2256
+//line sql.y:2480
 		{
 			stmt := &ShowTagKeyCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3823,9 +4159,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 295:
+	case 327:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2268
+//line sql.y:2492
 		{
 			stmt := &ShowTagKeyCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3836,9 +4172,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 296:
+	case 328:
 		yyDollar = yyS[yypt-13 : yypt+1]
-//line sql.y:2284
+//line sql.y:2508
 		{
 			stmt := &ShowTagValuesCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3855,9 +4191,9 @@ yydefault:
 			yyVAL.stmt = stmt
 
 		}
-	case 297:
+	case 329:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:2301
+//line sql.y:2525
 		{
 			stmt := &ShowTagValuesCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3872,9 +4208,9 @@ yydefault:
 			stmt.TagKeyCondition = nil
 			yyVAL.stmt = stmt
 		}
-	case 298:
+	case 330:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:2316
+//line sql.y:2540
 		{
 			stmt := &ShowTagValuesCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3891,9 +4227,9 @@ yydefault:
 			yyVAL.stmt = stmt
 
 		}
-	case 299:
+	case 331:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:2333
+//line sql.y:2557
 		{
 			stmt := &ShowTagValuesCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3908,9 +4244,9 @@ yydefault:
 			stmt.TagKeyCondition = nil
 			yyVAL.stmt = stmt
 		}
-	case 300:
+	case 332:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:2351
+//line sql.y:2575
 		{
 			stmt := &ShowFieldKeyCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3922,9 +4258,9 @@ yydefault:
 			stmt.Offset = yyDollar[10].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 301:
+	case 333:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2363
+//line sql.y:2587
 		{
 			stmt := &ShowFieldKeyCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3935,9 +4271,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 302:
+	case 334:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2374
+//line sql.y:2598
 		{
 			stmt := &ShowFieldKeyCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3949,9 +4285,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 303:
+	case 335:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2386
+//line sql.y:2610
 		{
 			stmt := &ShowFieldKeyCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3962,9 +4298,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 304:
+	case 336:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2400
+//line sql.y:2624
 		{
 			stmt := &CreateMeasurementStatement{}
 			stmt.Database = yyDollar[3].ment.Database
@@ -3987,9 +4323,9 @@ yydefault:
 
 			yyVAL.stmt = stmt
 		}
-	case 305:
+	case 337:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2423
+//line sql.y:2647
 		{
 			stmt := &CreateMeasurementStatement{}
 			stmt.Database = yyDollar[3].ment.Database
@@ -4078,18 +4414,18 @@ yydefault:
 			stmt.CompactType = yyDollar[5].cmOption.CompactType
 			yyVAL.stmt = stmt
 		}
-	case 306:
+	case 338:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2513
+//line sql.y:2737
 		{
 			option := &CreateMeasurementStatementOption{}
 			option.Type = "hash"
 			option.EngineType = "tsstore"
 			yyVAL.cmOption = option
 		}
-	case 307:
+	case 339:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2520
+//line sql.y:2744
 		{
 			option := &CreateMeasurementStatementOption{}
 			if yyDollar[3].indexType != nil {
@@ -4104,9 +4440,9 @@ yydefault:
 			option.EngineType = yyDollar[2].str
 			yyVAL.cmOption = option
 		}
-	case 308:
+	case 340:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:2537
+//line sql.y:2761
 		{
 			option := &CreateMeasurementStatementOption{}
 			if yyDollar[3].indexType != nil {
@@ -4137,15 +4473,15 @@ yydefault:
 			option.CompactType = yyDollar[10].str
 			yyVAL.cmOption = option
 		}
-	case 309:
+	case 341:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2569
+//line sql.y:2793
 		{
 			yyVAL.indexType = nil
 		}
-	case 310:
+	case 342:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2573
+//line sql.y:2797
 		{
 			validIndexType := map[string]struct{}{}
 			validIndexType["text"] = struct{}{}
@@ -4161,15 +4497,15 @@ yydefault:
 				yyVAL.indexType = yyDollar[2].indexType
 			}
 		}
-	case 311:
+	case 343:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2590
+//line sql.y:2814
 		{
 			yyVAL.indexType = nil
 		}
-	case 312:
+	case 344:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2594
+//line sql.y:2818
 		{
 			validIndexType := map[string]struct{}{}
 			validIndexType["bloomfilter"] = struct{}{}
@@ -4186,9 +4522,9 @@ yydefault:
 				yyVAL.indexType = yyDollar[2].indexType
 			}
 		}
-	case 313:
+	case 345:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2611
+//line sql.y:2835
 		{
 			indexType := strings.ToLower(yyDollar[2].str)
 			if indexType != "timecluster" {
@@ -4216,104 +4552,104 @@ yydefault:
 				yyVAL.indexType = indextype
 			}
 		}
-	case 314:
+	case 346:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2640
+//line sql.y:2864
 		{
 			yyVAL.strSlice = nil
 		}
-	case 315:
+	case 347:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2644
+//line sql.y:2868
 		{
 			shardKey := yyDollar[2].strSlice
 			sort.Strings(shardKey)
 			yyVAL.strSlice = shardKey
 		}
-	case 316:
+	case 348:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2651
+//line sql.y:2875
 		{
 			yyVAL.int64 = 0
 		}
-	case 317:
+	case 349:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2655
+//line sql.y:2879
 		{
 			yyVAL.int64 = -1
 		}
-	case 318:
+	case 350:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2659
+//line sql.y:2883
 		{
 			if yyDollar[2].int64 == 0 {
 				yylex.Error("syntax error: NUM OF SHARDS SHOULD LARGER THAN 0")
 			}
 			yyVAL.int64 = yyDollar[2].int64
 		}
-	case 319:
+	case 351:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2667
+//line sql.y:2891
 		{
 			yyVAL.str = "tsstore" // default engine type
 		}
-	case 320:
+	case 352:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2671
+//line sql.y:2895
 		{
 			yyVAL.str = "tsstore"
 		}
-	case 321:
+	case 353:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2677
+//line sql.y:2901
 		{
 			yyVAL.str = "columnstore"
 		}
-	case 322:
+	case 354:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2682
+//line sql.y:2906
 		{
 			yyVAL.strSlice = nil
 		}
-	case 323:
+	case 355:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2685
+//line sql.y:2909
 		{
 			yyVAL.strSlice = yyDollar[1].strSlice
 		}
-	case 324:
+	case 356:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2690
+//line sql.y:2914
 		{
 			yyVAL.strSlice = nil
 		}
-	case 325:
+	case 357:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2693
+//line sql.y:2917
 		{
 			yyVAL.strSlice = yyDollar[1].strSlice
 		}
-	case 326:
+	case 358:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2698
+//line sql.y:2922
 		{
 			yyVAL.strSlices = nil
 		}
-	case 327:
+	case 359:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2701
+//line sql.y:2925
 		{
 			yyVAL.strSlices = yyDollar[1].strSlices
 		}
-	case 328:
+	case 360:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2706
+//line sql.y:2930
 		{
 			yyVAL.str = "row"
 		}
-	case 329:
+	case 361:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2710
+//line sql.y:2934
 		{
 			compactionType := strings.ToLower(yyDollar[2].str)
 			if compactionType != "row" && compactionType != "block" {
@@ -4322,9 +4658,9 @@ yydefault:
 			}
 			yyVAL.str = compactionType
 		}
-	case 330:
+	case 362:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2721
+//line sql.y:2945
 		{
 			stmt := &CreateMeasurementStatement{
 				Tags:   make(map[string]int32),
@@ -4353,40 +4689,40 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 331:
+	case 363:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2750
+//line sql.y:2974
 		{
 			yyVAL.stmt = nil
 		}
-	case 332:
+	case 364:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2756
+//line sql.y:2980
 		{
 			fields := []*fieldList{yyDollar[1].fieldOption}
 			yyVAL.fieldOptions = append(fields, yyDollar[2].fieldOptions...)
 		}
-	case 333:
+	case 365:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2762
+//line sql.y:2986
 		{
 			yyVAL.fieldOptions = []*fieldList{yyDollar[1].fieldOption}
 		}
-	case 334:
+	case 366:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2768
+//line sql.y:2992
 		{
 			yyVAL.fieldOption = yyDollar[1].fieldOption
 		}
-	case 335:
+	case 367:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2773
+//line sql.y:2997
 		{
 			yyVAL.fieldOption = yyDollar[1].fieldOption
 		}
-	case 336:
+	case 368:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2779
+//line sql.y:3003
 		{
 			yyVAL.fieldOption = &fieldList{
 				fieldName:  yyDollar[1].str,
@@ -4394,9 +4730,9 @@ yydefault:
 				tagOrField: "tag",
 			}
 		}
-	case 337:
+	case 369:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2788
+//line sql.y:3012
 		{
 			yyVAL.fieldOption = &fieldList{
 				fieldName:  yyDollar[1].str,
@@ -4404,9 +4740,9 @@ yydefault:
 				tagOrField: "field",
 			}
 		}
-	case 338:
+	case 370:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2797
+//line sql.y:3021
 		{
 			yyVAL.fieldOption = &fieldList{
 				fieldName:  yyDollar[1].str,
@@ -4414,27 +4750,27 @@ yydefault:
 				tagOrField: "field",
 			}
 		}
-	case 339:
+	case 371:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2807
+//line sql.y:3031
 		{
 			yyVAL.indexType = &IndexType{
 				types: []string{yyDollar[1].str},
 				lists: [][]string{yyDollar[3].strSlice},
 			}
 		}
-	case 340:
+	case 372:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2815
+//line sql.y:3039
 		{
 			yyVAL.indexType = &IndexType{
 				types: []string{"field"},
 				lists: [][]string{yyDollar[3].strSlice},
 			}
 		}
-	case 341:
+	case 373:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2824
+//line sql.y:3048
 		{
 			indextype := yyDollar[1].indexType
 			if yyDollar[2].indexType != nil {
@@ -4443,28 +4779,28 @@ yydefault:
 			}
 			yyVAL.indexType = indextype
 		}
-	case 342:
+	case 374:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2833
+//line sql.y:3057
 		{
 			yyVAL.indexType = nil
 		}
-	case 343:
+	case 375:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2839
+//line sql.y:3063
 		{
 			yyVAL.strSlice = []string{yyDollar[1].str}
 		}
-	case 344:
+	case 376:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2843
+//line sql.y:3067
 		{
 
 			yyVAL.strSlice = append([]string{yyDollar[1].str}, yyDollar[3].strSlice...)
 		}
-	case 345:
+	case 377:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2850
+//line sql.y:3074
 		{
 			shardType := strings.ToLower(yyDollar[2].str)
 			if shardType != "hash" && shardType != "range" {
@@ -4473,27 +4809,27 @@ yydefault:
 			}
 			yyVAL.str = shardType
 		}
-	case 346:
+	case 378:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2859
+//line sql.y:3083
 		{
 			yyVAL.str = "hash"
 		}
-	case 347:
+	case 379:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2865
+//line sql.y:3089
 		{
 			yyVAL.strSlice = yyDollar[2].strSlice
 		}
-	case 348:
+	case 380:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2871
+//line sql.y:3095
 		{
 			yyVAL.strSlice = yyDollar[2].strSlice
 		}
-	case 349:
+	case 381:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2877
+//line sql.y:3101
 		{
 			m := yyDollar[1].strSlices
 			if yyDollar[3].strSlices != nil {
@@ -4502,82 +4838,82 @@ yydefault:
 			}
 			yyVAL.strSlices = m
 		}
-	case 350:
+	case 382:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2887
+//line sql.y:3111
 		{
 			yyVAL.strSlices = yyDollar[1].strSlices
 		}
-	case 351:
+	case 383:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2893
+//line sql.y:3117
 		{
 			yyVAL.strSlices = yyDollar[2].strSlices
 		}
-	case 352:
+	case 384:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2899
+//line sql.y:3123
 		{
 			yyVAL.strSlices = [][]string{{yyDollar[1].str}, {yyDollar[3].str}}
 		}
-	case 353:
+	case 385:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2903
+//line sql.y:3127
 		{
 			yyVAL.strSlices = [][]string{{yyDollar[1].str}, {fmt.Sprintf("%d", yyDollar[3].int64)}}
 		}
-	case 354:
+	case 386:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2907
+//line sql.y:3131
 		{
 			yyVAL.strSlices = nil
 		}
-	case 355:
+	case 387:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2913
+//line sql.y:3137
 		{
 			yyVAL.strSlice = []string{yyDollar[1].str}
 		}
-	case 356:
+	case 388:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2917
+//line sql.y:3141
 		{
 			yyVAL.strSlice = append(yyDollar[1].strSlice, yyDollar[3].str)
 		}
-	case 357:
+	case 389:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2922
+//line sql.y:3146
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 358:
+	case 390:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2928
+//line sql.y:3152
 		{
 			stmt := &DropShardStatement{}
 			stmt.ID = uint64(yyDollar[3].int64)
 			yyVAL.stmt = stmt
 		}
-	case 359:
+	case 391:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2936
+//line sql.y:3160
 		{
 			stmt := &SetPasswordUserStatement{}
 			stmt.Name = yyDollar[4].str
 			stmt.Password = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 360:
+	case 392:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2947
+//line sql.y:3171
 		{
 			stmt := &ShowGrantsForUserStatement{}
 			stmt.Name = yyDollar[4].str
 			yyVAL.stmt = stmt
 		}
-	case 361:
+	case 393:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2955
+//line sql.y:3179
 		{
 			stmt := &ShowMeasurementCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -4589,9 +4925,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 362:
+	case 394:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2967
+//line sql.y:3191
 		{
 			stmt := &ShowMeasurementCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -4602,9 +4938,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 363:
+	case 395:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2978
+//line sql.y:3202
 		{
 			stmt := &ShowMeasurementCardinalityStatement{}
 			stmt.Database = yyDollar[4].str
@@ -4616,9 +4952,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 364:
+	case 396:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2990
+//line sql.y:3214
 		{
 			stmt := &ShowMeasurementCardinalityStatement{}
 			stmt.Database = yyDollar[4].str
@@ -4629,9 +4965,9 @@ yydefault:
 			stmt.Offset = yyDollar[7].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 365:
+	case 397:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:3004
+//line sql.y:3228
 		{
 			stmt := &ShowSeriesCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -4643,9 +4979,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 366:
+	case 398:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3016
+//line sql.y:3240
 		{
 			stmt := &ShowSeriesCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -4656,9 +4992,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 367:
+	case 399:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3027
+//line sql.y:3251
 		{
 			stmt := &ShowSeriesCardinalityStatement{}
 			stmt.Database = yyDollar[4].str
@@ -4670,9 +5006,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 368:
+	case 400:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3039
+//line sql.y:3263
 		{
 			stmt := &ShowSeriesCardinalityStatement{}
 			stmt.Database = yyDollar[4].str
@@ -4683,23 +5019,23 @@ yydefault:
 			stmt.Offset = yyDollar[7].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 369:
+	case 401:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3053
+//line sql.y:3277
 		{
 			stmt := &ShowShardsStatement{}
 			yyVAL.stmt = stmt
 		}
-	case 370:
+	case 402:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3058
+//line sql.y:3282
 		{
 			stmt := &ShowShardsStatement{mstInfo: yyDollar[4].ment}
 			yyVAL.stmt = stmt
 		}
-	case 371:
+	case 403:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3066
+//line sql.y:3290
 		{
 			stmt := &AlterShardKeyStatement{}
 			stmt.Database = yyDollar[3].ment.Database
@@ -4710,9 +5046,9 @@ yydefault:
 			stmt.Type = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 372:
+	case 404:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3077
+//line sql.y:3301
 		{
 			stmt := &AlterShardKeyStatement{}
 			stmt.Database = yyDollar[3].ment.Database
@@ -4721,24 +5057,46 @@ yydefault:
 			stmt.Type = "hash"
 			yyVAL.stmt = stmt
 		}
-	case 373:
+	case 405:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3091
+//line sql.y:3315
 		{
 			stmt := &ShowShardGroupsStatement{}
 			yyVAL.stmt = stmt
 		}
-	case 374:
+	case 406:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3322
+		{
+			if strings.ToUpper(yyDollar[3].str) != "MAPPING" {
+				yylex.Error("SHOW SHARD command error, only support GROUPS, MAPPING")
+				goto ret1
+			}
+			yyVAL.stmt = &ShowShardMappingStatement{
+				Database: yyDollar[5].str,
+			}
+		}
+	case 407:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3332
+		{
+			if strings.ToUpper(yyDollar[3].str) != "MAPPING" {
+				yylex.Error("SHOW SHARD command error, only support GROUPS, MAPPING")
+				goto ret1
+			}
+			yyVAL.stmt = &ShowShardMappingStatement{}
+		}
+	case 408:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3098
+//line sql.y:3342
 		{
 			stmt := &DropMeasurementStatement{}
 			stmt.Name = yyDollar[3].str
 			yyVAL.stmt = stmt
 		}
-	case 375:
+	case 409:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:3107
+//line sql.y:3351
 		{
 			stmt := &CreateContinuousQueryStatement{
 				Name:     yyDollar[4].str,
@@ -4751,64 +5109,64 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 376:
+	case 410:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3122
+//line sql.y:3366
 		{
 			yyVAL.cqsp = &cqSamplePolicyInfo{
 				ResampleEvery: yyDollar[3].tdur,
 			}
 		}
-	case 377:
+	case 411:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3128
+//line sql.y:3372
 		{
 			yyVAL.cqsp = &cqSamplePolicyInfo{
 				ResampleFor: yyDollar[3].tdur,
 			}
 		}
-	case 378:
+	case 412:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3134
+//line sql.y:3378
 		{
 			yyVAL.cqsp = &cqSamplePolicyInfo{
 				ResampleEvery: yyDollar[3].tdur,
 				ResampleFor:   yyDollar[5].tdur,
 			}
 		}
-	case 379:
+	case 413:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3141
+//line sql.y:3385
 		{
 			yyVAL.cqsp = nil
 		}
-	case 380:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3147
+	case 414:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3391
 		{
-			yyVAL.stmt = &ShowContinuousQueriesStatement{}
+			yyVAL.stmt = &ShowContinuousQueriesStatement{Database: yyDollar[4].str}
 		}
-	case 381:
+	case 415:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3153
+//line sql.y:3397
 		{
 			yyVAL.stmt = &DropContinuousQueryStatement{
 				Name:     yyDollar[4].str,
 				Database: yyDollar[6].str,
 			}
 		}
-	case 382:
+	case 416:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:3161
+//line sql.y:3405
 		{
 			stmt := yyDollar[9].stmt.(*CreateDownSampleStatement)
 			stmt.RpName = yyDollar[4].str
 			stmt.Ops = yyDollar[6].fields
 			yyVAL.stmt = stmt
 		}
-	case 383:
+	case 417:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:3168
+//line sql.y:3412
 		{
 			stmt := yyDollar[11].stmt.(*CreateDownSampleStatement)
 			stmt.RpName = yyDollar[6].str
@@ -4816,65 +5174,65 @@ yydefault:
 			stmt.Ops = yyDollar[8].fields
 			yyVAL.stmt = stmt
 		}
-	case 384:
+	case 418:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3176
+//line sql.y:3420
 		{
 			stmt := yyDollar[7].stmt.(*CreateDownSampleStatement)
 			stmt.Ops = yyDollar[4].fields
 			yyVAL.stmt = stmt
 		}
-	case 385:
+	case 419:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3184
+//line sql.y:3428
 		{
 			yyVAL.stmt = &DropDownSampleStatement{
 				RpName: yyDollar[4].str,
 			}
 		}
-	case 386:
+	case 420:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3190
+//line sql.y:3434
 		{
 			yyVAL.stmt = &DropDownSampleStatement{
 				DbName: yyDollar[4].str,
 				RpName: yyDollar[6].str,
 			}
 		}
-	case 387:
+	case 421:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3197
+//line sql.y:3441
 		{
 			yyVAL.stmt = &DropDownSampleStatement{
 				DropAll: true,
 			}
 		}
-	case 388:
+	case 422:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3203
+//line sql.y:3447
 		{
 			yyVAL.stmt = &DropDownSampleStatement{
 				DbName:  yyDollar[4].str,
 				DropAll: true,
 			}
 		}
-	case 389:
+	case 423:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3212
+//line sql.y:3456
 		{
 			yyVAL.stmt = &ShowDownSampleStatement{}
 		}
-	case 390:
+	case 424:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3216
+//line sql.y:3460
 		{
 			yyVAL.stmt = &ShowDownSampleStatement{
 				DbName: yyDollar[4].str,
 			}
 		}
-	case 391:
+	case 425:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:3224
+//line sql.y:3468
 		{
 			yyVAL.stmt = &CreateDownSampleStatement{
 				Duration:       yyDollar[2].tdur,
@@ -4882,21 +5240,21 @@ yydefault:
 				TimeInterval:   yyDollar[9].tdurs,
 			}
 		}
-	case 392:
+	case 426:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3234
+//line sql.y:3478
 		{
 			yyVAL.tdurs = []time.Duration{yyDollar[1].tdur}
 		}
-	case 393:
+	case 427:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3238
+//line sql.y:3482
 		{
 			yyVAL.tdurs = append([]time.Duration{yyDollar[1].tdur}, yyDollar[3].tdurs...)
 		}
-	case 394:
+	case 428:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3245
+//line sql.y:3489
 		{
 			stmt := &CreateStreamStatement{
 				Name:  yyDollar[3].str,
@@ -4918,9 +5276,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 395:
+	case 429:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3267
+//line sql.y:3511
 		{
 			stmt := &CreateStreamStatement{
 				Name:  yyDollar[3].str,
@@ -4941,112 +5299,216 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 396:
+	case 430:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3290
+//line sql.y:3534
 		{
 			yyVAL.stmt = &ShowStreamsStatement{}
 		}
-	case 397:
+	case 431:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3294
+//line sql.y:3538
 		{
 			yyVAL.stmt = &ShowStreamsStatement{Database: yyDollar[4].str}
 		}
-	case 398:
+	case 432:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3542
+		{
+			yyVAL.stmt = &ShowStreamsStatement{Database: yyDollar[4].str, RetentionPolicy: yyDollar[6].str}
+		}
+	case 433:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3300
+//line sql.y:3546
+		{
+			if strings.ToUpper(yyDollar[3].str) != "TARGETS" {
+				yylex.Error("SHOW STREAM command error, only support TARGETS")
+				goto ret1
+			}
+			yyVAL.stmt = &ShowStreamTargetsStatement{}
+		}
+	case 434:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3554
+		{
+			if strings.ToUpper(yyDollar[3].str) != "TARGETS" {
+				yylex.Error("SHOW STREAM command error, only support TARGETS")
+				goto ret1
+			}
+			yyVAL.stmt = &ShowStreamTargetsStatement{Database: yyDollar[5].str}
+		}
+	case 435:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3564
 		{
 			yyVAL.stmt = &DropStreamsStatement{Name: yyDollar[3].str}
 		}
-	case 399:
+	case 436:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3568
+		{
+			if strings.ToUpper(yyDollar[3].str) != "TARGETS" {
+				yylex.Error("DROP STREAM command error, only support TARGETS ON")
+				goto ret1
+			}
+			yyVAL.stmt = &DropStreamTargetsStatement{Database: yyDollar[5].str}
+		}
+	case 437:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3305
+//line sql.y:3577
 		{
 			yyVAL.stmt = &ShowQueriesStatement{}
 		}
-	case 400:
+	case 438:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3581
+		{
+			if strings.ToUpper(yyDollar[3].str) != "FORMAT" || strings.ToUpper(yyDollar[4].str) != "JSON" {
+				yylex.Error("expect FORMAT JSON for SHOW QUERIES")
+				goto ret1
+			}
+			yyVAL.stmt = &ShowQueriesStatement{Format: "json"}
+		}
+	case 439:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3310
+//line sql.y:3589
+		{
+			if strings.ToUpper(yyDollar[3].str) != "PRECISE" {
+				yylex.Error("expect PRECISE or FORMAT JSON for SHOW QUERIES")
+				goto ret1
+			}
+			yyVAL.stmt = &ShowQueriesStatement{Precise: true}
+		}
+	case 440:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3597
+		{
+			if strings.ToUpper(yyDollar[3].str) != "PRECISE" || strings.ToUpper(yyDollar[4].str) != "FORMAT" || strings.ToUpper(yyDollar[5].str) != "JSON" {
+				yylex.Error("expect PRECISE FORMAT JSON for SHOW QUERIES")
+				goto ret1
+			}
+			yyVAL.stmt = &ShowQueriesStatement{Format: "json", Precise: true}
+		}
+	case 441:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3605
+		{
+			if strings.ToUpper(yyDollar[3].str) != "COUNT" || strings.ToUpper(yyDollar[5].str) != "NODE" {
+				yylex.Error("expect COUNT BY NODE for SHOW QUERIES")
+				goto ret1
+			}
+			yyVAL.stmt = &ShowQueriesStatement{CountByNode: true}
+		}
+	case 442:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3614
 		{
 			yyVAL.stmt = &KillQueryStatement{QueryID: uint64(yyDollar[3].int64)}
 		}
-	case 401:
+	case 443:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3618
+		{
+			yyVAL.stmt = &KillQueryStatement{QueryID: uint64(yyDollar[3].int64), Host: yyDollar[5].str}
+		}
+	case 444:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3622
+		{
+			yyVAL.stmt = &KillQueryStatement{QueryID: uint64(yyDollar[3].int64), Host: yyDollar[5].str}
+		}
+	case 445:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3316
+//line sql.y:3628
 		{
 			yyVAL.strSlice = []string{yyDollar[1].str}
 		}
-	case 402:
+	case 446:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3320
+//line sql.y:3632
 		{
 			yyVAL.strSlice = append([]string{yyDollar[1].str}, yyDollar[3].strSlice...)
 		}
-	case 403:
+	case 447:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3326
+//line sql.y:3638
 		{
 			yyVAL.str = "ALL"
 		}
-	case 404:
+	case 448:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3330
+//line sql.y:3642
 		{
 			yyVAL.str = "ANY"
 		}
-	case 405:
+	case 449:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:3336
+//line sql.y:3648
 		{
 			yyVAL.stmt = &CreateSubscriptionStatement{Name: yyDollar[3].str, Database: yyDollar[5].str, RetentionPolicy: yyDollar[7].str, Destinations: yyDollar[10].strSlice, Mode: yyDollar[9].str}
 		}
-	case 406:
+	case 450:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3340
+//line sql.y:3652
 		{
 			yyVAL.stmt = &CreateSubscriptionStatement{Name: yyDollar[3].str, Database: yyDollar[5].str, RetentionPolicy: "", Destinations: yyDollar[8].strSlice, Mode: yyDollar[7].str}
 		}
-	case 407:
+	case 451:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3346
+//line sql.y:3658
 		{
 			yyVAL.stmt = &ShowSubscriptionsStatement{}
 		}
-	case 408:
+	case 452:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3662
+		{
+			yyVAL.stmt = &ShowSubscriptionsStatement{ShowDetail: true}
+		}
+	case 453:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3352
+//line sql.y:3668
 		{
 			yyVAL.stmt = &DropSubscriptionStatement{Name: "", Database: "", RetentionPolicy: ""}
 		}
-	case 409:
+	case 454:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3356
+//line sql.y:3672
 		{
 			yyVAL.stmt = &DropSubscriptionStatement{Name: "", Database: yyDollar[5].str, RetentionPolicy: ""}
 		}
-	case 410:
+	case 455:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3360
+//line sql.y:3676
 		{
 			yyVAL.stmt = &DropSubscriptionStatement{Name: yyDollar[3].str, Database: yyDollar[5].str, RetentionPolicy: yyDollar[7].str}
 		}
-	case 411:
+	case 456:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3364
+//line sql.y:3680
 		{
 			yyVAL.stmt = &DropSubscriptionStatement{Name: yyDollar[3].str, Database: yyDollar[5].str, RetentionPolicy: ""}
 		}
-	case 412:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3370
+	case 457:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3686
 		{
 			stmt := &ShowConfigsStatement{}
+			stmt.Condition = yyDollar[3].expr
 			yyVAL.stmt = stmt
 		}
-	case 413:
+	case 458:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3692
+		{
+			stmt := &ShowConfigsStatement{}
+			stmt.Component = yyDollar[4].str
+			stmt.Condition = yyDollar[5].expr
+			yyVAL.stmt = stmt
+		}
+	case 459:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3377
+//line sql.y:3701
 		{
 			stmt := &SetConfigStatement{}
 			stmt.Component = yyDollar[3].str
@@ -5054,9 +5516,9 @@ yydefault:
 			stmt.Value = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 414:
+	case 460:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3385
+//line sql.y:3709
 		{
 			stmt := &SetConfigStatement{}
 			stmt.Component = yyDollar[3].str
@@ -5064,9 +5526,9 @@ yydefault:
 			stmt.Value = yyDollar[6].int64
 			yyVAL.stmt = stmt
 		}
-	case 415:
+	case 461:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3393
+//line sql.y:3717
 		{
 			stmt := &SetConfigStatement{}
 			stmt.Component = yyDollar[3].str
@@ -5074,9 +5536,9 @@ yydefault:
 			stmt.Value = yyDollar[6].float64
 			yyVAL.stmt = stmt
 		}
-	case 416:
+	case 462:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3401
+//line sql.y:3725
 		{
 			stmt := &SetConfigStatement{}
 			stmt.Component = yyDollar[3].str
@@ -5084,9 +5546,9 @@ yydefault:
 			stmt.Value = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 417:
+	case 463:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3409
+//line sql.y:3733
 		{
 			stmt := &SetConfigStatement{}
 			stmt.Component = yyDollar[3].str
@@ -5094,17 +5556,206 @@ yydefault:
 			stmt.Value = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 418:
+	case 464:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3743
+		{
+			if strings.ToUpper(yyDollar[1].str) != "CHECK" {
+				yylex.Error("expect CHECK CONFIG")
+				goto ret1
+			}
+			yyVAL.stmt = &CheckConfigStatement{}
+		}
+	case 465:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3753
+		{
+			if strings.ToUpper(yyDollar[3].str) != "LIMITS" {
+				yylex.Error("expect LIMITS for SHOW QUERY")
+				goto ret1
+			}
+			yyVAL.stmt = &ShowQueryLimitsStatement{
+				Database: yyDollar[5].str,
+			}
+		}
+	case 466:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3763
+		{
+			if strings.ToUpper(yyDollar[3].str) != "LIMITS" {
+				yylex.Error("expect LIMITS for SHOW QUERY")
+				goto ret1
+			}
+			yyVAL.stmt = &ShowQueryLimitsStatement{}
+		}
+	case 467:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3773
+		{
+			if strings.ToUpper(yyDollar[2].str) != "VERSION" {
+				yylex.Error("SHOW command error, only support VERSION")
+				goto ret1
+			}
+			yyVAL.stmt = &ShowVersionStatement{}
+		}
+	case 468:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3783
+		{
+			if strings.ToUpper(yyDollar[3].str) != "TRACING" {
+				yylex.Error("SET QUERY command error, only support TRACING")
+				goto ret1
+			}
+			yyVAL.stmt = &SetQueryTracingStatement{Enabled: true}
+		}
+	case 469:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3791
+		{
+			if strings.ToUpper(yyDollar[3].str) != "TRACING" {
+				yylex.Error("SET QUERY command error, only support TRACING")
+				goto ret1
+			}
+			if strings.ToUpper(yyDollar[4].str) != "OFF" {
+				yylex.Error("expect ON or OFF for SET QUERY TRACING")
+				goto ret1
+			}
+			yyVAL.stmt = &SetQueryTracingStatement{Enabled: false}
+		}
+	case 470:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3805
+		{
+			if strings.ToUpper(yyDollar[2].str) != "SLOW" {
+				yylex.Error("SHOW QUERIES command error, only support SLOW")
+				goto ret1
+			}
+			yyVAL.stmt = &ShowSlowQueriesStatement{}
+		}
+	case 471:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3815
+		{
+			if strings.ToUpper(yyDollar[1].str) != "CLEAR" {
+				yylex.Error("expect CLEAR SLOW QUERIES")
+				goto ret1
+			}
+			if strings.ToUpper(yyDollar[2].str) != "SLOW" {
+				yylex.Error("CLEAR command error, only support SLOW QUERIES")
+				goto ret1
+			}
+			yyVAL.stmt = &ClearSlowQueriesStatement{}
+		}
+	case 472:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3419
+//line sql.y:3829
+		{
+			yyVAL.stmt = &ShowDiagnosticsStatement{}
+		}
+	case 473:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3833
+		{
+			yyVAL.stmt = &ShowDiagnosticsStatement{Module: yyDollar[4].str}
+		}
+	case 474:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3839
+		{
+			if strings.ToUpper(yyDollar[1].str) != "DRAIN" {
+				yylex.Error("expect DRAIN NODE")
+				goto ret1
+			}
+			if strings.ToUpper(yyDollar[2].str) != "NODE" {
+				yylex.Error("DRAIN command error, only support NODE")
+				goto ret1
+			}
+			yyVAL.stmt = &DrainNodeStatement{NodeID: uint64(yyDollar[3].int64)}
+		}
+	case 475:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3851
+		{
+			if strings.ToUpper(yyDollar[1].str) != "DRAIN" {
+				yylex.Error("expect DRAIN NODE")
+				goto ret1
+			}
+			if strings.ToUpper(yyDollar[2].str) != "NODE" {
+				yylex.Error("DRAIN command error, only support NODE")
+				goto ret1
+			}
+			if strings.ToUpper(yyDollar[4].str) != "OFF" {
+				yylex.Error("expect OFF for DRAIN NODE")
+				goto ret1
+			}
+			yyVAL.stmt = &DrainNodeStatement{NodeID: uint64(yyDollar[3].int64), Off: true}
+		}
+	case 476:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3869
+		{
+			if strings.ToUpper(yyDollar[1].str) != "REBALANCE" {
+				yylex.Error("expect REBALANCE DATABASE")
+				goto ret1
+			}
+			stmt := &RebalanceDatabaseStatement{}
+			stmt.Database = yyDollar[3].str
+			yyVAL.stmt = stmt
+		}
+	case 477:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3879
+		{
+			if strings.ToUpper(yyDollar[1].str) != "REBALANCE" {
+				yylex.Error("expect REBALANCE DATABASE")
+				goto ret1
+			}
+			if strings.ToUpper(yyDollar[4].str) != "DRYRUN" {
+				yylex.Error("expect DRYRUN for REBALANCE DATABASE")
+				goto ret1
+			}
+			stmt := &RebalanceDatabaseStatement{}
+			stmt.Database = yyDollar[3].str
+			stmt.DryRun = true
+			yyVAL.stmt = stmt
+		}
+	case 478:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3896
+		{
+			switch strings.ToUpper(yyDollar[2].str + " " + yyDollar[3].str) {
+			case "DATA NODES":
+				yyVAL.stmt = &ShowDataNodesStatement{}
+			case "META NODES":
+				yyVAL.stmt = &ShowMetaNodesStatement{}
+			case "EXECUTOR LIMITS":
+				yyVAL.stmt = &ShowExecutorLimitsStatement{}
+			default:
+				yylex.Error("SHOW command error, only support DATA NODES, META NODES, EXECUTOR LIMITS")
+				goto ret1
+			}
+		}
+	case 479:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3910
+		{
+			if strings.ToUpper(yyDollar[2].str) != "DATA" || strings.ToUpper(yyDollar[3].str) != "NODES" {
+				yylex.Error("SHOW command error, only support DATA NODES DETAIL")
+				goto ret1
+			}
+			yyVAL.stmt = &ShowDataNodesStatement{Detail: true}
+		}
+	case 480:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3920
 		{
 			stmt := &ShowClusterStatement{}
 			stmt.NodeID = 0
 			yyVAL.stmt = stmt
 		}
-	case 419:
+	case 481:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3425
+//line sql.y:3926
 		{
 			stmt := &ShowClusterStatement{}
 			stmt.NodeID = 0
@@ -5115,9 +5766,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 420:
+	case 482:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3436
+//line sql.y:3937
 		{
 			stmt := &ShowClusterStatement{}
 			if strings.ToLower(yyDollar[4].str) == "nodeid" {
@@ -5127,9 +5778,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 421:
+	case 483:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:3446
+//line sql.y:3947
 		{
 			stmt := &ShowClusterStatement{}
 			if strings.ToLower(yyDollar[4].str) == "nodeid" {
@@ -5144,9 +5795,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 422:
+	case 484:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:3461
+//line sql.y:3962
 		{
 			stmt := &ShowClusterStatement{}
 			if strings.ToLower(yyDollar[4].str) == "nodetype" {
@@ -110,8 +110,7 @@ func (p *YyParser) Lex(lval *yySymType) int {
 
 				var hints Hints
 				for _, l := range hitLits {
-					support, ok := SupportHit[l]
-					if support && ok {
+					if IsSupportHint(l) {
 						val := &StringLiteral{Val: l}
 						hints = append(hints, &Hint{Expr: val})
 					}
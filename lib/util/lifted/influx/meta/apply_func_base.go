@@ -523,3 +523,12 @@ func ApplyUpdateMeasurement(data *Data, cmd *proto2.Command) error {
 	}
 	return data.UpdateMeasurement(v.GetDb(), v.GetRp(), v.GetMst(), v.GetOptions())
 }
+
+func ApplyDrainNode(data *Data, cmd *proto2.Command) error {
+	ext, _ := proto.GetExtension(cmd, proto2.E_DrainNodeCommand_Command)
+	v, ok := ext.(*proto2.DrainNodeCommand)
+	if !ok {
+		panic(fmt.Errorf("%s is not a DrainNodeCommand", ext))
+	}
+	return data.DrainNode(v.GetNodeID(), v.GetDrained())
+}
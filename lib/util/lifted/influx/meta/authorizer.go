@@ -36,7 +36,7 @@ func (u *UserInfo) AuthorizeQueryForRwUser(database string, query *influxql.Quer
 			}
 			continue
 		case *influxql.DropUserStatement:
-			if stmtType.Name != "rwuser" {
+			if !dropsUser(stmtType, "rwuser") {
 				continue
 			}
 		case *influxql.SetPasswordUserStatement:
@@ -155,3 +155,12 @@ func (e ErrAuthorize) Error() string {
 	}
 	return fmt.Sprintf("%s not authorized to execute %s", e.User, e.Message)
 }
+
+func dropsUser(stmt *influxql.DropUserStatement, name string) bool {
+	for _, u := range stmt.Users() {
+		if u == name {
+			return true
+		}
+	}
+	return false
+}
@@ -195,6 +195,9 @@ type Data struct {
 	Users         []UserInfo
 	MigrateEvents map[string]*MigrateEventInfo
 
+	// target measurements of the dropped streams, which may be left behind
+	DroppedStreamTargets []StreamMeasurementInfo
+
 	// Query ID range segment allocated by all sql nodes
 	QueryIDInit map[SQLHost]uint64 // {"127.0.0.1:8086": 0, "127.0.0.2:8086": 10w, "127.0.0.3:8086": 20w}, span is QueryIDSpan
 
@@ -386,6 +389,7 @@ func init() {
 		proto2.Command_RemoveNodeCommand:                {},
 		proto2.Command_UpdateReplicationCommand:         {},
 		proto2.Command_UpdateMeasurementCommand:         {},
+		proto2.Command_DrainNodeCommand:                 {},
 	}
 }
 
@@ -1601,6 +1605,9 @@ func (data *Data) SetStream(info *StreamInfo) error {
 	info.ID = data.MaxStreamID
 	data.MaxStreamID++
 	data.Streams[info.Name] = info
+	if info.DesMst != nil {
+		data.forgetDroppedStreamTargets(func(t *StreamMeasurementInfo) bool { return t.Equal(info.DesMst) })
+	}
 	return nil
 }
 
@@ -1629,6 +1636,7 @@ func (data *Data) MarkDatabaseDelete(name string) error {
 		return err
 	}
 	dbi.MarkDeleted = true
+	data.forgetDroppedStreamTargets(func(t *StreamMeasurementInfo) bool { return t.Database == name })
 	return nil
 }
 
@@ -1746,6 +1754,9 @@ func (data *Data) MarkRetentionPolicyDelete(database, name string) error {
 		return err
 	}
 	rp.MarkDeleted = true
+	data.forgetDroppedStreamTargets(func(t *StreamMeasurementInfo) bool {
+		return t.Database == database && t.RetentionPolicy == name
+	})
 
 	return nil
 }
@@ -1762,6 +1773,9 @@ func (data *Data) MarkMeasurementDelete(database, policy, measurement string) er
 		return err
 	}
 	mst.MarkDeleted = true
+	data.forgetDroppedStreamTargets(func(t *StreamMeasurementInfo) bool {
+		return t.Database == database && t.RetentionPolicy == policy && t.Name == measurement
+	})
 	return nil
 }
 
@@ -2760,6 +2774,7 @@ func (data *Data) Clone() *Data {
 
 	other.Databases = data.CloneDatabases()
 	other.Streams = data.CloneStreams()
+	other.DroppedStreamTargets = append([]StreamMeasurementInfo(nil), data.DroppedStreamTargets...)
 	other.Users = data.CloneUsers()
 	other.PtView = data.CloneDBPtView()
 	other.MigrateEvents = data.CloneMigrateEvents()
@@ -2853,6 +2868,11 @@ func (data *Data) MarshalBase() *proto2.Data {
 		j++
 	}
 
+	pb.DroppedStreamTargets = make([]*proto2.StreamMeasurementInfo, len(data.DroppedStreamTargets))
+	for i := range data.DroppedStreamTargets {
+		pb.DroppedStreamTargets[i] = data.DroppedStreamTargets[i].marshal()
+	}
+
 	pb.Users = make([]*proto2.UserInfo, len(data.Users))
 	for i := range data.Users {
 		pb.Users[i] = data.Users[i].marshal()
@@ -2950,6 +2970,13 @@ func (data *Data) Unmarshal(pb *proto2.Data) {
 		}
 	}
 
+	data.DroppedStreamTargets = nil
+	for _, t := range pb.GetDroppedStreamTargets() {
+		var target StreamMeasurementInfo
+		target.unmarshal(t)
+		data.DroppedStreamTargets = append(data.DroppedStreamTargets, target)
+	}
+
 	data.Users = make([]UserInfo, len(pb.GetUsers()))
 	for i, x := range pb.GetUsers() {
 		data.Users[i].unmarshal(x)
@@ -3570,13 +3597,68 @@ func (data *Data) ShowStreams(database string, showAll bool) (models.Rows, error
 }
 
 func (data *Data) DropStream(name string) error {
-	if _, ok := data.Streams[name]; !ok {
+	info, ok := data.Streams[name]
+	if !ok {
 		return errno.NewError(errno.StreamNotFound)
 	}
 	delete(data.Streams, name)
+	if info.DesMst != nil {
+		data.addDroppedStreamTarget(*info.DesMst)
+	}
 	return nil
 }
 
+// addDroppedStreamTarget remembers the target measurement of a dropped stream, unless another stream still writes it.
+func (data *Data) addDroppedStreamTarget(target StreamMeasurementInfo) {
+	for _, v := range data.Streams {
+		if v.DesMst != nil && v.DesMst.Equal(&target) {
+			return
+		}
+	}
+	for i := range data.DroppedStreamTargets {
+		if data.DroppedStreamTargets[i].Equal(&target) {
+			return
+		}
+	}
+	data.DroppedStreamTargets = append(data.DroppedStreamTargets, target)
+}
+
+// forgetDroppedStreamTargets stops remembering the target measurements of the dropped streams matched by fn.
+func (data *Data) forgetDroppedStreamTargets(fn func(t *StreamMeasurementInfo) bool) {
+	targets := data.DroppedStreamTargets[:0]
+	for i := range data.DroppedStreamTargets {
+		if !fn(&data.DroppedStreamTargets[i]) {
+			targets = append(targets, data.DroppedStreamTargets[i])
+		}
+	}
+	data.DroppedStreamTargets = targets
+}
+
+// ShowDroppedStreamTargets returns the existing target measurements of database left behind by the dropped streams,
+// sorted by retention policy and name. The targets of all the databases are returned if database is empty.
+func (data *Data) ShowDroppedStreamTargets(database string) []StreamMeasurementInfo {
+	var targets []StreamMeasurementInfo
+	for _, t := range data.DroppedStreamTargets {
+		if database != "" && t.Database != database {
+			continue
+		}
+		if _, err := data.Measurement(t.Database, t.RetentionPolicy, t.Name); err != nil {
+			continue
+		}
+		targets = append(targets, t)
+	}
+	sort.Slice(targets, func(i, j int) bool {
+		if targets[i].Database != targets[j].Database {
+			return targets[i].Database < targets[j].Database
+		}
+		if targets[i].RetentionPolicy != targets[j].RetentionPolicy {
+			return targets[i].RetentionPolicy < targets[j].RetentionPolicy
+		}
+		return targets[i].Name < targets[j].Name
+	})
+	return targets
+}
+
 func (data *Data) CheckStreamExistInDatabase(database string) error {
 	for _, v := range data.Streams {
 		if v.SrcMst.Database == database || v.DesMst.Database == database {
@@ -3831,6 +3913,16 @@ func (data *Data) UpdateReplication(database string, rgId, masterId uint32, peer
 	return nil
 }
 
+// DrainNode marks a data node drained, the new queries are routed away from it, or routes them to it again.
+func (data *Data) DrainNode(nodeID uint64, drained bool) error {
+	dn := data.DataNode(nodeID)
+	if dn == nil {
+		return ErrNodeNotFound
+	}
+	dn.Drained = drained
+	return nil
+}
+
 func (data *Data) UpdateMeasurement(db, rp, mst string, options *proto2.Options) error {
 	rpi, err := data.RetentionPolicy(db, rp)
 	if err != nil {
@@ -1515,6 +1515,30 @@ func TestExpandGroups(t *testing.T) {
 	}
 }
 
+func TestData_DrainNode(t *testing.T) {
+	data := &Data{}
+	_, err := data.CreateDataNode("127.0.0.1:8400", "127.0.0.1:8401", "")
+	require.NoError(t, err)
+
+	typ := proto2.Command_DrainNodeCommand
+	cmd := &proto2.Command{Type: &typ}
+	require.NoError(t, proto.SetExtension(cmd, proto2.E_DrainNodeCommand_Command, &proto2.DrainNodeCommand{
+		NodeID:  proto.Uint64(1),
+		Drained: proto.Bool(true),
+	}))
+	require.NoError(t, ApplyDrainNode(data, cmd))
+	assert2.True(t, data.DataNode(1).Drained)
+
+	// the drain state survives a snapshot
+	other := &Data{}
+	other.Unmarshal(data.Marshal())
+	assert2.True(t, other.DataNode(1).Drained)
+
+	require.NoError(t, data.DrainNode(1, false))
+	assert2.False(t, data.DataNode(1).Drained)
+	assert2.Equal(t, ErrNodeNotFound, data.DrainNode(2, true))
+}
+
 func TestUpdateMeasurement(t *testing.T) {
 	data := initData()
 	dbName := "testDb"
@@ -2642,3 +2666,38 @@ func Test_SQLite_Transaction(t *testing.T) {
 		fmt.Println(name)
 	}
 }
+
+func TestData_DroppedStreamTargets(t *testing.T) {
+	data := initData()
+	require.NoError(t, generateMeasurement(data, "db0", "rp0", "cpu"))
+	target := StreamMeasurementInfo{Database: "db0", RetentionPolicy: "rp0", Name: "cpu"}
+	newStream := func(name string) *StreamInfo {
+		return &StreamInfo{Name: name, SrcMst: &StreamMeasurementInfo{Database: "db0", RetentionPolicy: "rp0", Name: "src"}, DesMst: target.Clone()}
+	}
+	require.NoError(t, data.SetStream(newStream("s1")))
+	require.NoError(t, data.SetStream(newStream("s2")))
+
+	// the target is still written by s2
+	require.NoError(t, data.DropStream("s1"))
+	assert2.Empty(t, data.ShowDroppedStreamTargets(""))
+
+	require.NoError(t, data.DropStream("s2"))
+	assert2.Equal(t, []StreamMeasurementInfo{target}, data.ShowDroppedStreamTargets(""))
+	assert2.Equal(t, []StreamMeasurementInfo{target}, data.ShowDroppedStreamTargets("db0"))
+	assert2.Empty(t, data.ShowDroppedStreamTargets("db1"))
+
+	// the targets survive the snapshots of the meta data
+	other := &Data{}
+	other.Unmarshal(data.Marshal())
+	assert2.Equal(t, []StreamMeasurementInfo{target}, other.ShowDroppedStreamTargets(""))
+	assert2.Equal(t, []StreamMeasurementInfo{target}, data.Clone().ShowDroppedStreamTargets(""))
+
+	// a new stream writing the target adopts it
+	require.NoError(t, data.SetStream(newStream("s3")))
+	assert2.Empty(t, data.DroppedStreamTargets)
+	require.NoError(t, data.DropStream("s3"))
+	assert2.Len(t, data.DroppedStreamTargets, 1)
+
+	require.NoError(t, data.MarkMeasurementDelete("db0", "rp0", "cpu"))
+	assert2.Empty(t, data.DroppedStreamTargets)
+}
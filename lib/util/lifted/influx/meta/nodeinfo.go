@@ -66,6 +66,7 @@ type NodeInfo struct {
 	GossipAddr      string
 	SegregateStatus uint64
 	Role            string
+	Drained         bool
 }
 
 // clone returns a deep copy of ni.
@@ -83,6 +84,7 @@ func (ni NodeInfo) marshal() *proto2.NodeInfo {
 	pb.GossipAddr = proto.String(ni.GossipAddr)
 	pb.SegregateStatus = proto.Uint64(ni.SegregateStatus)
 	pb.Role = proto.String(ni.Role)
+	pb.Drained = proto.Bool(ni.Drained)
 	return pb
 }
 
@@ -97,6 +99,7 @@ func (ni *NodeInfo) unmarshal(pb *proto2.NodeInfo) {
 	ni.GossipAddr = pb.GetGossipAddr()
 	ni.SegregateStatus = pb.GetSegregateStatus()
 	ni.Role = pb.GetRole()
+	ni.Drained = pb.GetDrained()
 }
 
 type DataNode struct {
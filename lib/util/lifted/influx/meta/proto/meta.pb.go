@@ -97,6 +97,7 @@ const (
 	Command_UpdateSqlNodeStatusCommand            Command_Type = 97
 	Command_InsertFilesCommand                    Command_Type = 98
 	Command_UpdateMeasurementCommand              Command_Type = 101
+	Command_DrainNodeCommand                      Command_Type = 102
 )
 
 var Command_Type_name = map[int32]string{
@@ -174,6 +175,7 @@ var Command_Type_name = map[int32]string{
 	97:  "UpdateSqlNodeStatusCommand",
 	98:  "InsertFilesCommand",
 	101: "UpdateMeasurementCommand",
+	102: "DrainNodeCommand",
 }
 
 var Command_Type_value = map[string]int32{
@@ -251,6 +253,7 @@ var Command_Type_value = map[string]int32{
 	"UpdateSqlNodeStatusCommand":            97,
 	"InsertFilesCommand":                    98,
 	"UpdateMeasurementCommand":              101,
+	"DrainNodeCommand":                      102,
 }
 
 func (x Command_Type) Enum() *Command_Type {
@@ -309,6 +312,7 @@ type Data struct {
 	IsSQLiteEnabled      *bool                    `protobuf:"varint,32,opt,name=IsSQLiteEnabled" json:"IsSQLiteEnabled,omitempty"`
 	SqlNodes             []*DataNode              `protobuf:"bytes,33,rep,name=SqlNodes" json:"SqlNodes,omitempty"`
 	MaxMstID             *uint64                  `protobuf:"varint,34,opt,name=MaxMstID" json:"MaxMstID,omitempty"`
+	DroppedStreamTargets []*StreamMeasurementInfo `protobuf:"bytes,35,rep,name=DroppedStreamTargets" json:"DroppedStreamTargets,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -562,6 +566,13 @@ func (m *Data) GetMaxMstID() uint64 {
 	return 0
 }
 
+func (m *Data) GetDroppedStreamTargets() []*StreamMeasurementInfo {
+	if m != nil {
+		return m.DroppedStreamTargets
+	}
+	return nil
+}
+
 type Replications struct {
 	Groups               []*ReplicaGroup `protobuf:"bytes,1,rep,name=Groups" json:"Groups,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
@@ -872,6 +883,7 @@ type NodeInfo struct {
 	GossipAddr           *string  `protobuf:"bytes,7,req,name=GossipAddr" json:"GossipAddr,omitempty"`
 	SegregateStatus      *uint64  `protobuf:"varint,8,opt,name=SegregateStatus" json:"SegregateStatus,omitempty"`
 	Role                 *string  `protobuf:"bytes,10,opt,name=Role" json:"Role,omitempty"`
+	Drained              *bool    `protobuf:"varint,11,opt,name=Drained" json:"Drained,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -964,6 +976,13 @@ func (m *NodeInfo) GetRole() string {
 	return ""
 }
 
+func (m *NodeInfo) GetDrained() bool {
+	if m != nil && m.Drained != nil {
+		return *m.Drained
+	}
+	return false
+}
+
 type DataNode struct {
 	Ni                   *NodeInfo `protobuf:"bytes,1,req,name=Ni" json:"Ni,omitempty"`
 	ConnID               *uint64   `protobuf:"varint,2,opt,name=ConnID" json:"ConnID,omitempty"`
@@ -8780,6 +8799,61 @@ var E_UpdateMeasurementCommand_Command = &proto.ExtensionDesc{
 	Filename:      "meta.proto",
 }
 
+type DrainNodeCommand struct {
+	NodeID               *uint64  `protobuf:"varint,1,req,name=NodeID" json:"NodeID,omitempty"`
+	Drained              *bool    `protobuf:"varint,2,req,name=Drained" json:"Drained,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DrainNodeCommand) Reset()         { *m = DrainNodeCommand{} }
+func (m *DrainNodeCommand) String() string { return proto.CompactTextString(m) }
+func (*DrainNodeCommand) ProtoMessage()    {}
+func (*DrainNodeCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{137}
+}
+func (m *DrainNodeCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DrainNodeCommand.Unmarshal(m, b)
+}
+func (m *DrainNodeCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DrainNodeCommand.Marshal(b, m, deterministic)
+}
+func (m *DrainNodeCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainNodeCommand.Merge(m, src)
+}
+func (m *DrainNodeCommand) XXX_Size() int {
+	return xxx_messageInfo_DrainNodeCommand.Size(m)
+}
+func (m *DrainNodeCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainNodeCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DrainNodeCommand proto.InternalMessageInfo
+
+func (m *DrainNodeCommand) GetNodeID() uint64 {
+	if m != nil && m.NodeID != nil {
+		return *m.NodeID
+	}
+	return 0
+}
+
+func (m *DrainNodeCommand) GetDrained() bool {
+	if m != nil && m.Drained != nil {
+		return *m.Drained
+	}
+	return false
+}
+
+var E_DrainNodeCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*DrainNodeCommand)(nil),
+	Field:         199,
+	Name:          "proto.DrainNodeCommand.command",
+	Tag:           "bytes,199,opt,name=command",
+	Filename:      "meta.proto",
+}
+
 type DataOps struct {
 	Op                   []string `protobuf:"bytes,1,rep,name=Op" json:"Op,omitempty"`
 	NewIndex             *int64   `protobuf:"varint,2,opt,name=newIndex" json:"newIndex,omitempty"`
@@ -8795,7 +8869,7 @@ func (m *DataOps) Reset()         { *m = DataOps{} }
 func (m *DataOps) String() string { return proto.CompactTextString(m) }
 func (*DataOps) ProtoMessage()    {}
 func (*DataOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{137}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{138}
 }
 func (m *DataOps) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DataOps.Unmarshal(m, b)
@@ -8862,7 +8936,7 @@ func (m *CreateSqlNodeCommand) Reset()         { *m = CreateSqlNodeCommand{} }
 func (m *CreateSqlNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateSqlNodeCommand) ProtoMessage()    {}
 func (*CreateSqlNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{138}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{139}
 }
 func (m *CreateSqlNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateSqlNodeCommand.Unmarshal(m, b)
@@ -8919,7 +8993,7 @@ func (m *UpdateSqlNodeStatusCommand) Reset()         { *m = UpdateSqlNodeStatusC
 func (m *UpdateSqlNodeStatusCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateSqlNodeStatusCommand) ProtoMessage()    {}
 func (*UpdateSqlNodeStatusCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{139}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{140}
 }
 func (m *UpdateSqlNodeStatusCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateSqlNodeStatusCommand.Unmarshal(m, b)
@@ -8989,7 +9063,7 @@ func (m *UpdateNodeTmpIndexCommand) Reset()         { *m = UpdateNodeTmpIndexCom
 func (m *UpdateNodeTmpIndexCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateNodeTmpIndexCommand) ProtoMessage()    {}
 func (*UpdateNodeTmpIndexCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{140}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{141}
 }
 func (m *UpdateNodeTmpIndexCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateNodeTmpIndexCommand.Unmarshal(m, b)
@@ -9061,7 +9135,7 @@ func (m *FileInfo) Reset()         { *m = FileInfo{} }
 func (m *FileInfo) String() string { return proto.CompactTextString(m) }
 func (*FileInfo) ProtoMessage()    {}
 func (*FileInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{141}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{142}
 }
 func (m *FileInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FileInfo.Unmarshal(m, b)
@@ -9176,7 +9250,7 @@ func (m *InsertFilesCommand) Reset()         { *m = InsertFilesCommand{} }
 func (m *InsertFilesCommand) String() string { return proto.CompactTextString(m) }
 func (*InsertFilesCommand) ProtoMessage()    {}
 func (*InsertFilesCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{142}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{143}
 }
 func (m *InsertFilesCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InsertFilesCommand.Unmarshal(m, b)
@@ -9427,6 +9501,8 @@ func init() {
 	proto.RegisterType((*Options)(nil), "proto.Options")
 	proto.RegisterExtension(E_UpdateMeasurementCommand_Command)
 	proto.RegisterType((*UpdateMeasurementCommand)(nil), "proto.UpdateMeasurementCommand")
+	proto.RegisterExtension(E_DrainNodeCommand_Command)
+	proto.RegisterType((*DrainNodeCommand)(nil), "proto.DrainNodeCommand")
 	proto.RegisterType((*DataOps)(nil), "proto.DataOps")
 	proto.RegisterExtension(E_CreateSqlNodeCommand_Command)
 	proto.RegisterType((*CreateSqlNodeCommand)(nil), "proto.CreateSqlNodeCommand")
@@ -9442,445 +9518,450 @@ func init() {
 func init() { proto.RegisterFile("meta.proto", fileDescriptor_3b5ea8fe65782bcc) }
 
 var fileDescriptor_3b5ea8fe65782bcc = []byte{
-	// 7035 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7d, 0xeb, 0x8f, 0x1d, 0x49,
-	0x75, 0xb8, 0xfa, 0x3e, 0x66, 0xee, 0xd4, 0xf8, 0xda, 0xe3, 0xf2, 0xeb, 0x7a, 0xd6, 0xf6, 0x8e,
-	0x1b, 0x2f, 0x6b, 0x76, 0xc1, 0xcb, 0x8e, 0x60, 0x59, 0x16, 0x58, 0xf0, 0xcc, 0xf5, 0xe3, 0xb2,
-	0x1e, 0xcf, 0x75, 0xdd, 0x59, 0xfb, 0xf7, 0x03, 0x7e, 0xfc, 0xe8, 0x99, 0x5b, 0x1e, 0x37, 0x73,
-	0x5f, 0xdb, 0xdd, 0x33, 0x9e, 0x59, 0x11, 0xb1, 0x80, 0x94, 0x88, 0x20, 0x14, 0x45, 0x51, 0x78,
-	0x29, 0x21, 0x09, 0x01, 0x12, 0x48, 0x48, 0x02, 0x81, 0x40, 0xc8, 0x42, 0xc2, 0x2b, 0x8a, 0xa2,
-	0x88, 0x6f, 0x91, 0xf2, 0x29, 0x7f, 0x40, 0x94, 0x44, 0xc9, 0x97, 0x3c, 0xa4, 0x44, 0x8a, 0xce,
-	0xa9, 0xaa, 0xae, 0xaa, 0xee, 0xea, 0x1e, 0xdb, 0x8a, 0xf9, 0x34, 0xb7, 0xce, 0x39, 0x5d, 0x75,
-	0xea, 0xd4, 0xa9, 0x53, 0xa7, 0x4e, 0x9d, 0xaa, 0x21, 0x64, 0xc8, 0x93, 0xe0, 0xc2, 0x24, 0x1a,
-	0x27, 0x63, 0x5a, 0xc7, 0x3f, 0xfe, 0x7f, 0xcc, 0x92, 0x5a, 0x3b, 0x48, 0x02, 0x4a, 0x49, 0x6d,
-	0x8d, 0x47, 0xc3, 0x96, 0xb7, 0x50, 0x39, 0x5f, 0x63, 0xf8, 0x9b, 0x1e, 0x25, 0xf5, 0xce, 0xa8,
-	0xcf, 0x77, 0x5b, 0x15, 0x04, 0x8a, 0x02, 0x3d, 0x45, 0x66, 0x96, 0x07, 0xdb, 0x71, 0xc2, 0xa3,
-	0x4e, 0xbb, 0x55, 0x45, 0x8c, 0x06, 0xd0, 0xc7, 0x48, 0xfd, 0xfa, 0xb8, 0xcf, 0xe3, 0x56, 0x6d,
-	0xa1, 0x7a, 0x7e, 0x76, 0xf1, 0x90, 0x68, 0xee, 0x02, 0xc0, 0x3a, 0xa3, 0xdb, 0x63, 0x26, 0xb0,
-	0xf4, 0x69, 0x32, 0x03, 0xcd, 0xae, 0x07, 0x31, 0x8f, 0x5b, 0x75, 0x24, 0x3d, 0x22, 0x49, 0x15,
-	0x1c, 0xc9, 0x35, 0x15, 0xd4, 0xfc, 0x62, 0xcc, 0xa3, 0xb8, 0x35, 0x65, 0xd5, 0x0c, 0x30, 0x51,
-	0x33, 0x62, 0x81, 0xbd, 0x95, 0x60, 0x17, 0xdb, 0x6b, 0xb7, 0xa6, 0x05, 0x7b, 0x29, 0x80, 0x9e,
-	0x27, 0x87, 0x56, 0x82, 0xdd, 0xde, 0x9d, 0x20, 0xea, 0x5f, 0x89, 0xc6, 0xdb, 0x93, 0x4e, 0xbb,
-	0xd5, 0x40, 0x9a, 0x2c, 0x98, 0x9e, 0x21, 0x44, 0x81, 0x3a, 0xed, 0xd6, 0x0c, 0x12, 0x19, 0x10,
-	0xfa, 0x06, 0xd1, 0x03, 0xd1, 0x59, 0x62, 0xb1, 0xa4, 0xe0, 0x4c, 0x53, 0x00, 0xf9, 0x0a, 0x57,
-	0xe4, 0xb3, 0x6e, 0xd9, 0x68, 0x0a, 0xea, 0x93, 0x03, 0x52, 0xa6, 0xdd, 0xe4, 0xfa, 0xf6, 0xb0,
-	0x75, 0x70, 0xa1, 0x72, 0xbe, 0xc9, 0x2c, 0x18, 0x7d, 0x8a, 0x4c, 0x75, 0x93, 0x9b, 0x21, 0xbf,
-	0xdb, 0x3a, 0x84, 0xf5, 0x9d, 0x30, 0x9a, 0xbf, 0x20, 0x30, 0x97, 0x46, 0x49, 0xb4, 0xc7, 0x24,
-	0x19, 0x54, 0x8a, 0x5f, 0x76, 0x79, 0x04, 0xad, 0xb4, 0xe6, 0x16, 0x3c, 0xa8, 0xd4, 0x84, 0x49,
-	0x01, 0xe1, 0x48, 0x2b, 0x01, 0x1d, 0x4e, 0x05, 0x64, 0x82, 0xa5, 0x80, 0x10, 0xd4, 0x69, 0xb7,
-	0x68, 0x2a, 0x20, 0x09, 0x81, 0xd6, 0x56, 0x82, 0xdd, 0x4b, 0x3b, 0x7c, 0x94, 0xac, 0x4e, 0x3a,
-	0xfd, 0xd6, 0x91, 0x05, 0xef, 0x7c, 0x8d, 0x59, 0x30, 0x68, 0x6d, 0x2d, 0xd8, 0xe2, 0xab, 0x3b,
-	0x3c, 0xba, 0x34, 0x0a, 0xd6, 0x07, 0xbc, 0xdf, 0x3a, 0xba, 0xe0, 0x9d, 0x6f, 0xb0, 0x2c, 0x98,
-	0xbe, 0x83, 0x34, 0x57, 0xc2, 0xcd, 0x28, 0x48, 0x38, 0x7e, 0x1d, 0xb7, 0x8e, 0x59, 0x7d, 0x36,
-	0x71, 0x28, 0x4b, 0x9b, 0x1a, 0x1a, 0x5a, 0x0a, 0x06, 0xc1, 0x68, 0x43, 0x37, 0x74, 0x5c, 0x34,
-	0x94, 0x01, 0x4b, 0x01, 0xb4, 0xc7, 0x77, 0x47, 0xbd, 0x60, 0x38, 0x19, 0x80, 0x16, 0x9d, 0x40,
-	0xce, 0xb3, 0x60, 0xfa, 0x24, 0x99, 0xee, 0x25, 0x11, 0x0f, 0x86, 0x71, 0xab, 0x85, 0xcc, 0x1c,
-	0x96, 0xcc, 0x08, 0x28, 0xb2, 0xa1, 0x28, 0xe8, 0x02, 0x99, 0x05, 0xe5, 0x11, 0x98, 0x76, 0xeb,
-	0x24, 0x56, 0x69, 0x82, 0xa4, 0xe2, 0x2e, 0x8f, 0x47, 0xa3, 0x4e, 0xbf, 0x35, 0x8f, 0x78, 0x0d,
-	0xa0, 0xcf, 0x93, 0xd9, 0x1b, 0xdb, 0x3c, 0xda, 0xeb, 0xb4, 0x3b, 0xa3, 0x30, 0x69, 0x3d, 0x82,
-	0x0d, 0x9e, 0x32, 0x47, 0xdc, 0x40, 0x8b, 0x61, 0x37, 0x3f, 0xa0, 0x6d, 0xd2, 0x64, 0x7c, 0x32,
-	0x08, 0x37, 0x02, 0x1c, 0xbf, 0xb8, 0x75, 0x0a, 0x6b, 0x38, 0x63, 0xd6, 0x60, 0x11, 0x88, 0x3a,
-	0xec, 0x8f, 0xe8, 0xeb, 0xc9, 0x61, 0x60, 0x79, 0x7b, 0x3d, 0xde, 0x88, 0xc2, 0x49, 0x12, 0x8e,
-	0x47, 0x9d, 0x76, 0xeb, 0x34, 0xf2, 0x9a, 0x47, 0xd0, 0x73, 0xa4, 0x09, 0x1d, 0xb8, 0xb1, 0x7c,
-	0x27, 0x18, 0x6d, 0x82, 0x20, 0xcf, 0x20, 0xa5, 0x0d, 0x04, 0xc9, 0x5c, 0xdf, 0x1e, 0xae, 0xde,
-	0xc6, 0x89, 0x15, 0xb7, 0x1e, 0x5d, 0xf0, 0xce, 0xd7, 0x99, 0x09, 0x82, 0x21, 0xe9, 0xc4, 0xbd,
-	0x1b, 0xd7, 0xc2, 0x84, 0xab, 0xc1, 0x5b, 0x10, 0x83, 0x97, 0x01, 0xd3, 0x27, 0x49, 0xa3, 0xf7,
-	0xd2, 0x40, 0x4c, 0xb2, 0xb3, 0xee, 0x39, 0x99, 0x12, 0xd0, 0x79, 0xd2, 0x58, 0x09, 0x76, 0x57,
-	0xe2, 0xa4, 0xd3, 0x6e, 0xf9, 0xc8, 0x59, 0x5a, 0x9e, 0x7f, 0x37, 0x99, 0x35, 0x66, 0x10, 0x9d,
-	0x23, 0xd5, 0x2d, 0xbe, 0xd7, 0xf2, 0x16, 0xbc, 0xf3, 0x33, 0x0c, 0x7e, 0x82, 0x35, 0xda, 0x09,
-	0x06, 0xdb, 0xbc, 0x55, 0x59, 0xf0, 0xcc, 0x66, 0x96, 0xba, 0x42, 0xff, 0x04, 0xf6, 0xb9, 0xca,
-	0xb3, 0xde, 0xfc, 0xf3, 0x64, 0x2e, 0x3b, 0x36, 0x8e, 0x0a, 0x8f, 0x9a, 0x15, 0xd6, 0xcc, 0xef,
-	0x5f, 0x24, 0x34, 0x3f, 0x32, 0x8e, 0x1a, 0x5e, 0x67, 0xb3, 0xa4, 0xec, 0xa9, 0xfc, 0x16, 0xc6,
-	0x24, 0x36, 0xaa, 0xf5, 0xdf, 0x46, 0x0e, 0x98, 0x28, 0xfa, 0x24, 0x99, 0x92, 0xaa, 0xe1, 0x59,
-	0xf6, 0xd8, 0x6c, 0x9b, 0x49, 0x12, 0xff, 0xe3, 0x5e, 0xfa, 0x35, 0x42, 0xe8, 0x41, 0x52, 0xe9,
-	0xb4, 0x71, 0xf5, 0x68, 0xb2, 0x4a, 0xa7, 0x2d, 0x84, 0x2b, 0x17, 0x89, 0x0a, 0x42, 0xd3, 0x32,
-	0x3d, 0x4b, 0xea, 0x5d, 0x0e, 0x96, 0xbc, 0x8a, 0x0d, 0xcd, 0xca, 0x86, 0x00, 0xc6, 0x04, 0x86,
-	0x1e, 0x27, 0x53, 0xbd, 0x24, 0x48, 0xb6, 0x61, 0x1d, 0x81, 0x8f, 0x65, 0x29, 0x5d, 0xa6, 0xea,
-	0x7a, 0x99, 0xf2, 0x9f, 0x20, 0x35, 0xf8, 0x28, 0xc7, 0x02, 0x25, 0x35, 0x36, 0x1e, 0x70, 0xd9,
-	0x3c, 0xfe, 0xf6, 0xcf, 0x92, 0xe9, 0x6e, 0xb2, 0x7a, 0x77, 0xc4, 0x23, 0x68, 0x42, 0xae, 0x12,
-	0x62, 0xcd, 0x93, 0x25, 0xff, 0x15, 0x0f, 0xec, 0x2a, 0x0c, 0x22, 0x3d, 0x47, 0xea, 0x48, 0x8b,
-	0x14, 0xb3, 0x8b, 0x07, 0x15, 0xa3, 0xa2, 0x06, 0x56, 0x4f, 0x2b, 0x92, 0xbc, 0x56, 0xb2, 0xbc,
-	0x76, 0x93, 0x4e, 0x1f, 0xd7, 0xc8, 0x26, 0xc3, 0xdf, 0x30, 0x6a, 0x37, 0x79, 0xd4, 0xaa, 0xe1,
-	0x18, 0xc3, 0x4f, 0xe4, 0xf2, 0x4a, 0xa7, 0xdd, 0xaa, 0xa3, 0x31, 0xc6, 0xdf, 0xfe, 0x1b, 0x48,
-	0x43, 0x29, 0x12, 0x3d, 0x4b, 0x6a, 0xed, 0xf5, 0x6e, 0x22, 0x07, 0xa5, 0x99, 0xb2, 0x80, 0x5a,
-	0x86, 0x28, 0xff, 0x9f, 0x3d, 0xd2, 0x50, 0x8b, 0x88, 0x21, 0x85, 0x9a, 0x92, 0xc2, 0xd5, 0x71,
-	0x9c, 0x20, 0x6f, 0x33, 0x0c, 0x7f, 0xd3, 0x16, 0x99, 0x66, 0xdd, 0xe5, 0x8b, 0xfd, 0x7e, 0x84,
-	0xcd, 0xce, 0x30, 0x55, 0x04, 0xcc, 0xda, 0x72, 0x17, 0x3f, 0xa8, 0x0a, 0x8c, 0x2c, 0x66, 0x46,
-	0xa4, 0x9a, 0xf6, 0xf2, 0x28, 0xa9, 0x5f, 0x5b, 0x0b, 0x87, 0xbc, 0x35, 0x25, 0x9c, 0x04, 0x2c,
-	0xc0, 0xe2, 0x70, 0x65, 0x1c, 0xc7, 0xe1, 0x04, 0x1b, 0x99, 0xc6, 0xb6, 0x0d, 0x08, 0x4c, 0xe9,
-	0x1e, 0xdf, 0x8c, 0xf8, 0x66, 0x90, 0x70, 0x59, 0x6d, 0x43, 0x58, 0xd9, 0x0c, 0x38, 0x1d, 0x45,
-	0x82, 0xec, 0x88, 0x51, 0xe4, 0xa4, 0xa1, 0xe6, 0x33, 0x7d, 0x94, 0x54, 0xae, 0x87, 0x72, 0x80,
-	0x72, 0x2b, 0x6a, 0xe5, 0x7a, 0x08, 0x8c, 0xa3, 0x0d, 0x6d, 0xcb, 0x99, 0x25, 0x4b, 0x60, 0x77,
-	0x2e, 0x0e, 0xc2, 0x1d, 0x2e, 0x91, 0x55, 0x61, 0x91, 0x0d, 0x90, 0xff, 0x8d, 0x2a, 0x39, 0x60,
-	0x7a, 0x23, 0xc0, 0xcb, 0xf5, 0x60, 0xc8, 0xb1, 0xb5, 0x19, 0x86, 0xbf, 0xe9, 0x33, 0xe4, 0x78,
-	0x9b, 0xdf, 0x0e, 0xb6, 0x07, 0x09, 0xe3, 0x09, 0x1f, 0xc1, 0x5c, 0xea, 0x8e, 0x07, 0xe1, 0xc6,
-	0x9e, 0x94, 0x78, 0x01, 0x96, 0x5e, 0x25, 0x87, 0x6d, 0x50, 0xc8, 0xd5, 0x84, 0x98, 0x4f, 0x67,
-	0x9e, 0xf5, 0x09, 0xf6, 0x28, 0xff, 0x11, 0xd4, 0xb4, 0x3c, 0x1e, 0x25, 0xe1, 0x68, 0x7b, 0xbc,
-	0x1d, 0x83, 0xa5, 0x09, 0x53, 0xf7, 0x4b, 0xd5, 0x64, 0xe3, 0x65, 0x4d, 0xb9, 0x8f, 0xc4, 0x22,
-	0x15, 0x6d, 0xb5, 0xf9, 0x80, 0x27, 0xbc, 0x8f, 0xba, 0xd1, 0x60, 0x26, 0x88, 0x3e, 0x45, 0x1a,
-	0x68, 0x94, 0x5f, 0xe0, 0x7b, 0xad, 0x29, 0xcb, 0xcc, 0x28, 0x30, 0xd6, 0x9d, 0x12, 0xd1, 0xd7,
-	0x92, 0x83, 0xc2, 0x38, 0xaf, 0x05, 0x9b, 0x17, 0xa3, 0x28, 0xd8, 0x6b, 0x4d, 0x63, 0xad, 0x19,
-	0x28, 0xd8, 0x0b, 0x69, 0x4f, 0xae, 0xa3, 0x26, 0x54, 0x59, 0x5a, 0x86, 0x85, 0x76, 0x15, 0xd7,
-	0x14, 0x58, 0xf5, 0x3d, 0x63, 0xa1, 0x5d, 0x5d, 0x8f, 0x25, 0x82, 0x29, 0x0a, 0xff, 0x5b, 0x1e,
-	0x39, 0x92, 0x11, 0x5c, 0x6f, 0xc2, 0x37, 0x8c, 0xb1, 0xf3, 0xd2, 0xb1, 0x9b, 0x27, 0x8d, 0xf6,
-	0x76, 0x84, 0xf6, 0x0f, 0x95, 0xa3, 0xca, 0xd2, 0x32, 0xbd, 0x40, 0xa8, 0xf6, 0x07, 0x53, 0xaa,
-	0x2a, 0x52, 0x39, 0x30, 0x56, 0x07, 0x6a, 0x38, 0x97, 0x75, 0x07, 0x7c, 0x72, 0xe0, 0x56, 0x10,
-	0x0d, 0xd3, 0x5a, 0xea, 0x58, 0x8b, 0x05, 0xf3, 0xbf, 0x51, 0x27, 0x87, 0x56, 0x78, 0x10, 0x6f,
-	0x47, 0x7c, 0x28, 0x9d, 0x18, 0xa7, 0xbe, 0x3d, 0x4d, 0x66, 0x94, 0x70, 0xc1, 0xe0, 0x54, 0x8b,
-	0x86, 0x40, 0x53, 0xd1, 0xe7, 0xc8, 0x54, 0x6f, 0xe3, 0x0e, 0x1f, 0x06, 0x52, 0xbf, 0x7c, 0xe5,
-	0x34, 0xd9, 0xcd, 0x5d, 0x10, 0x44, 0xd2, 0x67, 0x14, 0x85, 0xac, 0x4a, 0xd4, 0xf2, 0x2a, 0xf1,
-	0x1c, 0x69, 0x86, 0xe0, 0xf2, 0x31, 0x3e, 0xd0, 0xbd, 0x9b, 0x5d, 0x3c, 0x2a, 0x1b, 0xe9, 0x98,
-	0x38, 0x66, 0x93, 0x82, 0x99, 0xb8, 0x34, 0xda, 0x0c, 0x47, 0x7c, 0x6d, 0x6f, 0xc2, 0x51, 0xa1,
-	0x9a, 0xcc, 0x80, 0xd0, 0xb7, 0x90, 0x03, 0xcb, 0xe3, 0x41, 0x2f, 0x19, 0x47, 0x38, 0x01, 0x51,
-	0x77, 0x74, 0x7f, 0x4d, 0x14, 0xb3, 0x08, 0xe9, 0xd3, 0x84, 0x68, 0xe5, 0x40, 0x85, 0x72, 0x6a,
-	0x8d, 0x41, 0x44, 0x2f, 0x13, 0x22, 0x7c, 0xfb, 0xfe, 0x2e, 0x8f, 0x5b, 0x33, 0x28, 0xa9, 0xd7,
-	0x16, 0x49, 0x2a, 0x25, 0x14, 0xd2, 0x32, 0xbe, 0x44, 0x6f, 0x65, 0x14, 0x26, 0xa6, 0x4f, 0x43,
-	0xd0, 0xa7, 0xc9, 0x82, 0xa5, 0xa9, 0x9e, 0x45, 0xc3, 0x53, 0xc1, 0xcd, 0x49, 0x46, 0xcf, 0xd5,
-	0x82, 0x93, 0x55, 0xf2, 0xf9, 0xb7, 0x92, 0x59, 0x63, 0xb0, 0xf6, 0xf3, 0x26, 0xea, 0xa6, 0x37,
-	0xf1, 0x02, 0x39, 0x94, 0xe1, 0xde, 0xfc, 0xbc, 0x26, 0x3e, 0xf7, 0x6d, 0x57, 0xe2, 0x80, 0x1a,
-	0x4b, 0xf8, 0xc6, 0xf4, 0x21, 0xfe, 0xad, 0x9e, 0x9b, 0x6c, 0x85, 0x8a, 0x6b, 0x4f, 0xb6, 0xca,
-	0x3d, 0x4d, 0xb6, 0xca, 0x3d, 0x4d, 0xb6, 0x8a, 0x35, 0xd9, 0x9e, 0x23, 0x07, 0x8c, 0xe1, 0x52,
-	0xbb, 0xcb, 0xe3, 0xee, 0x91, 0x64, 0x16, 0x2d, 0x5d, 0x21, 0xb3, 0x2b, 0x71, 0x72, 0x93, 0x47,
-	0x31, 0x8e, 0xc2, 0x41, 0xfc, 0xf4, 0xc9, 0x62, 0x73, 0x7c, 0xc1, 0xa0, 0x96, 0x4e, 0xb7, 0x01,
-	0xa1, 0x6f, 0x21, 0xb3, 0x9a, 0x79, 0xb5, 0x71, 0x3d, 0x66, 0xce, 0x56, 0xb1, 0x99, 0x02, 0x46,
-	0x4c, 0x4a, 0xd8, 0xed, 0x98, 0xbe, 0x74, 0xdc, 0x9a, 0xb6, 0x76, 0x3b, 0x96, 0x9f, 0x8d, 0xbb,
-	0x1d, 0x8b, 0x3a, 0x3b, 0x69, 0x1b, 0xf9, 0x49, 0xbb, 0x40, 0x66, 0xaf, 0x8e, 0x93, 0x54, 0xd2,
-	0x33, 0x28, 0x69, 0x13, 0x94, 0xb3, 0x59, 0x04, 0x49, 0x2c, 0x18, 0x0c, 0x9b, 0xde, 0x12, 0xa6,
-	0x94, 0xb3, 0x62, 0xd8, 0xf2, 0x18, 0x90, 0x87, 0x86, 0xc6, 0xad, 0x03, 0x96, 0x3c, 0x8c, 0xcd,
-	0x25, 0xca, 0xc3, 0xa0, 0xa4, 0xab, 0xe4, 0xa8, 0xde, 0x7a, 0x69, 0xf1, 0xb7, 0x9a, 0xa8, 0x9e,
-	0x8f, 0x28, 0xe7, 0xdb, 0x41, 0xc2, 0x9c, 0x1f, 0x82, 0x4f, 0x9e, 0x1d, 0xba, 0xfd, 0x66, 0x51,
-	0xd3, 0x54, 0xfc, 0x80, 0x1c, 0x71, 0xac, 0xa9, 0x4e, 0xbd, 0x3f, 0x4a, 0xea, 0x48, 0x20, 0xfd,
-	0x01, 0x51, 0x80, 0x01, 0xb8, 0x16, 0xc4, 0x09, 0xdb, 0x1e, 0xa1, 0xf3, 0x24, 0xd6, 0x15, 0x13,
-	0xe4, 0xff, 0x97, 0x47, 0x0e, 0xda, 0x3a, 0x92, 0xf3, 0xed, 0x4e, 0x91, 0x99, 0x5e, 0x12, 0x44,
-	0x09, 0x56, 0x21, 0xe6, 0x94, 0x06, 0x80, 0x2f, 0x77, 0x69, 0xd4, 0x97, 0xd5, 0x03, 0x4e, 0x15,
-	0xe1, 0x3b, 0xa9, 0x08, 0x17, 0x13, 0xe9, 0xce, 0x69, 0x00, 0x3d, 0x4f, 0xa6, 0xa4, 0xdd, 0x12,
-	0x53, 0x67, 0xce, 0x54, 0x58, 0x94, 0xa9, 0xc4, 0x43, 0x27, 0xd6, 0xa2, 0xed, 0xd1, 0x46, 0x20,
-	0x6a, 0x9a, 0x12, 0x9d, 0x30, 0x40, 0x19, 0x03, 0x3f, 0x9d, 0x33, 0xf0, 0x2d, 0x32, 0xbd, 0x23,
-	0x06, 0xa1, 0x75, 0x00, 0x91, 0xaa, 0xe8, 0x7f, 0xba, 0x22, 0x17, 0x3a, 0x67, 0xcf, 0xcf, 0x90,
-	0x06, 0x3a, 0xdf, 0x9d, 0xb6, 0x58, 0x04, 0x9b, 0x4b, 0x95, 0x96, 0xc7, 0x52, 0x18, 0x8c, 0xe5,
-	0x4a, 0x28, 0x2c, 0xc8, 0x0c, 0x83, 0x9f, 0x08, 0x09, 0x76, 0xb1, 0xb7, 0x00, 0x09, 0x76, 0x71,
-	0x2f, 0x11, 0xf2, 0x28, 0xdd, 0x4b, 0x84, 0x1c, 0xfd, 0x5f, 0x15, 0xd1, 0x10, 0xfe, 0xac, 0x2a,
-	0x82, 0x59, 0xd7, 0x9a, 0x74, 0x8d, 0xef, 0xf0, 0x01, 0xba, 0xb5, 0x55, 0x96, 0x05, 0xc3, 0xcc,
-	0xb1, 0xc2, 0x07, 0xc2, 0xb1, 0xb5, 0x60, 0xc2, 0x80, 0x05, 0xfd, 0xd5, 0xd1, 0x60, 0xaf, 0x35,
-	0x83, 0xd3, 0x33, 0x2d, 0x8b, 0xc0, 0x8a, 0x9a, 0xaa, 0xb8, 0x76, 0x34, 0x98, 0x01, 0xf1, 0x19,
-	0x39, 0x60, 0xae, 0xf4, 0x50, 0x57, 0xea, 0x93, 0xc1, 0x2e, 0x61, 0xc6, 0x70, 0xbf, 0xa0, 0x8f,
-	0x20, 0xf9, 0x8a, 0xf0, 0x7a, 0x50, 0xe6, 0x94, 0xd4, 0x7a, 0x9b, 0xa9, 0xc7, 0x8b, 0xbf, 0xfd,
-	0x93, 0xa4, 0x2e, 0x56, 0xaf, 0x39, 0x52, 0xed, 0xf4, 0x77, 0xb1, 0x9e, 0x3a, 0x83, 0x9f, 0xfe,
-	0xfb, 0xc9, 0x5c, 0xd6, 0xde, 0x38, 0xf5, 0x9c, 0x92, 0xda, 0xca, 0xb8, 0xcf, 0xd5, 0x46, 0x03,
-	0x7e, 0xa3, 0x28, 0x78, 0x9c, 0x84, 0x23, 0xb1, 0xc7, 0x44, 0xff, 0x63, 0x86, 0x59, 0x30, 0xff,
-	0x9c, 0x5c, 0x77, 0xcb, 0x77, 0x65, 0x9f, 0xf2, 0x48, 0x43, 0x85, 0xfa, 0x8a, 0x9a, 0xbf, 0x1a,
-	0xc4, 0x77, 0xd2, 0x7d, 0x4e, 0x10, 0xdf, 0x81, 0xa9, 0x77, 0xb1, 0x3f, 0x94, 0x7a, 0xd0, 0x60,
-	0xa2, 0x00, 0x4d, 0xb0, 0xbb, 0x50, 0x97, 0xf4, 0x66, 0x64, 0x89, 0xbe, 0x89, 0x90, 0x6e, 0x14,
-	0xee, 0x84, 0x03, 0xbe, 0x99, 0x06, 0x25, 0x8f, 0x1a, 0x51, 0xc6, 0x14, 0xc9, 0x0c, 0x3a, 0xbf,
-	0x43, 0x9a, 0x16, 0x12, 0xd7, 0x39, 0xb9, 0x69, 0x90, 0x0c, 0xa6, 0x65, 0x98, 0x78, 0x29, 0x21,
-	0x72, 0x5a, 0x67, 0x1a, 0xe0, 0xbf, 0xea, 0x91, 0xa6, 0xe5, 0x2e, 0xc1, 0x68, 0xb0, 0xb0, 0x2f,
-	0xf7, 0xb4, 0xf0, 0x13, 0x20, 0xab, 0x61, 0x5f, 0xe8, 0x3c, 0x83, 0x9f, 0x50, 0x27, 0x7e, 0x84,
-	0x12, 0x11, 0x02, 0xd6, 0x00, 0xfa, 0x46, 0x42, 0xb0, 0x70, 0x2d, 0x8c, 0x13, 0xb5, 0x2b, 0x98,
-	0x33, 0x2d, 0x2e, 0x20, 0x98, 0x41, 0x03, 0x3e, 0x17, 0x96, 0x94, 0x2b, 0x62, 0x47, 0x67, 0x4d,
-	0x14, 0xb3, 0x08, 0xfd, 0xb3, 0x92, 0x11, 0xa8, 0x06, 0x63, 0xc7, 0xf0, 0x43, 0x6a, 0xa4, 0x28,
-	0xf8, 0x7d, 0xd2, 0x62, 0x13, 0x73, 0xc5, 0xbd, 0x1c, 0xf2, 0x41, 0x3f, 0xc6, 0x41, 0xbd, 0x4a,
-	0xe6, 0x32, 0x8b, 0xb3, 0x8a, 0x44, 0x9c, 0xca, 0xaf, 0xdd, 0xfa, 0x3b, 0x96, 0xfb, 0xca, 0x1f,
-	0x93, 0x63, 0x4e, 0x52, 0x98, 0xdd, 0x2b, 0x71, 0x62, 0xa8, 0x8e, 0x2a, 0xd2, 0xb7, 0x13, 0x02,
-	0x73, 0x43, 0xd0, 0x4a, 0xb7, 0xda, 0xd1, 0xac, 0xa6, 0x61, 0x06, 0xbd, 0xbf, 0x6c, 0x35, 0xa8,
-	0x11, 0xa0, 0x6a, 0xb2, 0x4a, 0x21, 0x06, 0x59, 0x32, 0xa6, 0x25, 0x58, 0x10, 0xfc, 0xed, 0x7f,
-	0xa2, 0x42, 0x88, 0x8e, 0x1c, 0x3a, 0x75, 0x5c, 0x58, 0xc1, 0x4a, 0x6a, 0x05, 0xdf, 0x44, 0xa6,
-	0x7a, 0xd1, 0xc6, 0x0a, 0x6e, 0xd6, 0x2b, 0x06, 0xc7, 0xa2, 0x9a, 0xac, 0xab, 0x23, 0x69, 0xe1,
-	0xab, 0x36, 0x8f, 0xe1, 0xab, 0xda, 0xbd, 0x7c, 0x25, 0x68, 0x41, 0xad, 0x3b, 0xa3, 0x84, 0x47,
-	0x3b, 0xc1, 0x00, 0x2d, 0x66, 0x95, 0xa5, 0x65, 0x18, 0xec, 0x36, 0x1f, 0x04, 0x7b, 0x68, 0x33,
-	0xab, 0x4c, 0x14, 0xa0, 0x07, 0xed, 0x70, 0x28, 0x7c, 0x97, 0x19, 0x86, 0xbf, 0xe9, 0xe3, 0xa4,
-	0xbe, 0x1c, 0x0c, 0x06, 0xe0, 0x92, 0xe7, 0x23, 0xa6, 0x80, 0x61, 0x02, 0xef, 0x3f, 0x43, 0x66,
-	0xb5, 0x30, 0xf0, 0x3b, 0x53, 0x23, 0x1c, 0x91, 0x56, 0x81, 0xf7, 0x5f, 0x22, 0xc7, 0x9c, 0xfd,
-	0x28, 0x74, 0x49, 0xd5, 0x54, 0xad, 0x64, 0xa6, 0xea, 0x79, 0x72, 0x28, 0xbb, 0xa1, 0x17, 0xab,
-	0x49, 0x16, 0xec, 0x5f, 0x53, 0xe3, 0x06, 0x9c, 0x43, 0x3b, 0xf0, 0x57, 0xb5, 0x83, 0xb0, 0xa3,
-	0xa4, 0x8e, 0x03, 0xaf, 0x5c, 0x00, 0x2c, 0xa0, 0x75, 0x1a, 0x84, 0x41, 0x2c, 0xeb, 0x15, 0x05,
-	0xff, 0x1f, 0x3c, 0x7b, 0xcf, 0x03, 0xcb, 0x41, 0x37, 0x0a, 0x87, 0x41, 0xb4, 0xa7, 0x0d, 0xbc,
-	0x01, 0x01, 0xa5, 0xee, 0x8d, 0xa3, 0x04, 0x90, 0x15, 0x44, 0xaa, 0x22, 0x2c, 0xcf, 0xdd, 0x68,
-	0x3c, 0xe1, 0x51, 0x82, 0x9f, 0x0a, 0xdb, 0x60, 0x82, 0xe8, 0x39, 0xd2, 0x54, 0xc5, 0x9b, 0xe8,
-	0xe8, 0xd4, 0x90, 0xc6, 0x06, 0xd2, 0x37, 0x92, 0x23, 0xe0, 0x36, 0xc8, 0xc3, 0x87, 0xcc, 0x2e,
-	0xd6, 0x85, 0x82, 0x5d, 0xff, 0xf2, 0x78, 0x38, 0x09, 0x36, 0xa0, 0x94, 0xee, 0xed, 0xea, 0x2c,
-	0x03, 0xf5, 0xef, 0x4a, 0x87, 0x50, 0x98, 0x10, 0x98, 0x2e, 0x6b, 0xe3, 0x2d, 0x3e, 0x8a, 0xa5,
-	0x13, 0x26, 0x4b, 0x20, 0x02, 0xfc, 0x15, 0xbe, 0xcc, 0xa3, 0x58, 0xae, 0x65, 0x06, 0xa4, 0x88,
-	0xc1, 0x6a, 0x21, 0x83, 0xfe, 0xb3, 0xb6, 0x91, 0xa3, 0xe7, 0x6d, 0xfd, 0xa2, 0x79, 0x6b, 0xa7,
-	0x14, 0xec, 0x93, 0x87, 0xc9, 0xf4, 0xf2, 0x78, 0x38, 0x0c, 0x46, 0x7d, 0xfa, 0x38, 0xa9, 0x25,
-	0xd0, 0x39, 0x18, 0xeb, 0x83, 0xc6, 0xb6, 0x14, 0xb1, 0x17, 0xa0, 0x87, 0x0c, 0x09, 0xfc, 0x9f,
-	0xce, 0x89, 0x09, 0x4f, 0x4f, 0x92, 0x63, 0xcb, 0x11, 0x0f, 0x12, 0xae, 0xf4, 0x4c, 0x12, 0xcf,
-	0x55, 0xe9, 0x09, 0x72, 0xa4, 0x1d, 0x8d, 0x27, 0x59, 0x44, 0x8d, 0x2e, 0x90, 0x53, 0xe2, 0x9b,
-	0x8c, 0xe2, 0x29, 0x8a, 0x3a, 0x3d, 0x43, 0xe6, 0xe1, 0xd3, 0x02, 0xfc, 0x14, 0x3d, 0x47, 0x16,
-	0x7a, 0x3c, 0x71, 0x07, 0xa2, 0x14, 0xd5, 0x34, 0xb4, 0xf3, 0xe2, 0xa4, 0x5f, 0xdc, 0x4e, 0x83,
-	0x3e, 0x42, 0x4e, 0x08, 0x4e, 0xb4, 0x5f, 0xaa, 0x90, 0x33, 0x80, 0x14, 0x0e, 0x4a, 0x1e, 0x49,
-	0xe8, 0x31, 0x72, 0x58, 0x7c, 0x09, 0x6b, 0xa5, 0x02, 0x37, 0xe9, 0x11, 0x72, 0x08, 0x18, 0x37,
-	0x81, 0x07, 0x81, 0x56, 0xf0, 0x61, 0x82, 0x0f, 0x81, 0x7c, 0x7a, 0x3c, 0x49, 0x57, 0x4b, 0x85,
-	0x98, 0xa3, 0x94, 0x1c, 0x84, 0xde, 0x05, 0x49, 0xa0, 0x60, 0x87, 0xe9, 0x29, 0xd2, 0xea, 0xf1,
-	0x04, 0xd7, 0xfb, 0xdc, 0x17, 0x94, 0x9e, 0x26, 0x27, 0x65, 0x3f, 0x0c, 0xc7, 0x46, 0xa1, 0x8f,
-	0x61, 0x4f, 0xa2, 0xf1, 0xc4, 0x85, 0x3c, 0xae, 0x47, 0x50, 0x1d, 0xd6, 0x29, 0x54, 0xcb, 0x1e,
-	0x5c, 0x13, 0x75, 0x12, 0x50, 0xa2, 0x4f, 0x59, 0xd4, 0x3c, 0xa0, 0x84, 0xdc, 0xb2, 0x15, 0x3e,
-	0xa2, 0x51, 0xd9, 0xaf, 0x4e, 0xd1, 0xe3, 0x84, 0xf6, 0x78, 0x92, 0xfd, 0xe4, 0x34, 0x3d, 0x4a,
-	0xe6, 0x90, 0x77, 0x18, 0x03, 0x05, 0x3d, 0x03, 0x1d, 0x46, 0x07, 0x52, 0xea, 0x96, 0xa8, 0x54,
-	0xa1, 0x1f, 0x85, 0x0e, 0x0b, 0xee, 0xb4, 0x23, 0xa6, 0x90, 0xaf, 0x01, 0xe5, 0x81, 0x6f, 0x33,
-	0x4a, 0x61, 0x57, 0xf1, 0x38, 0x08, 0x5c, 0x89, 0x25, 0xb5, 0xbb, 0x0a, 0xfb, 0x34, 0x70, 0x75,
-	0x71, 0x90, 0xf0, 0x48, 0xf9, 0xa5, 0xcb, 0xc3, 0xfe, 0xdc, 0x22, 0x0c, 0x34, 0x13, 0x4d, 0x86,
-	0xa3, 0x4d, 0x45, 0xfc, 0x26, 0x18, 0x68, 0xc9, 0x0d, 0x86, 0x38, 0x14, 0xe2, 0xcd, 0x80, 0x60,
-	0x7c, 0x32, 0x8e, 0x12, 0xb1, 0xfd, 0x50, 0x88, 0x67, 0x40, 0x18, 0xdd, 0x68, 0x7b, 0xc4, 0xc5,
-	0x6e, 0x51, 0xc1, 0xdf, 0x0a, 0x1a, 0x0d, 0xac, 0x1b, 0x2c, 0xd9, 0x6c, 0x3f, 0x47, 0xe7, 0xc9,
-	0x71, 0x10, 0x97, 0x83, 0xe9, 0xb7, 0x01, 0xd3, 0x60, 0x3a, 0x58, 0x30, 0xd2, 0xba, 0xf3, 0x76,
-	0xda, 0x22, 0x47, 0xb1, 0x79, 0x65, 0x4a, 0x14, 0xe6, 0x1d, 0x7a, 0x02, 0xe8, 0x9d, 0xab, 0x42,
-	0x3e, 0x0f, 0x53, 0xd4, 0x10, 0x31, 0x98, 0x12, 0xd8, 0x6f, 0x28, 0xfc, 0x3b, 0xf5, 0x10, 0xc0,
-	0x70, 0x8a, 0xd0, 0xb7, 0x42, 0xbe, 0x0b, 0xfa, 0x27, 0x84, 0x8b, 0xa7, 0x99, 0x0a, 0x7e, 0x11,
-	0xe0, 0xe2, 0x23, 0x0b, 0xbe, 0xa4, 0x25, 0x28, 0x8e, 0x09, 0x14, 0x62, 0x19, 0x3e, 0x60, 0x7c,
-	0x38, 0xde, 0xb1, 0x3f, 0x68, 0xd3, 0xb3, 0xe4, 0xb4, 0xd4, 0xdc, 0xcc, 0x66, 0x59, 0x91, 0x5c,
-	0xa2, 0x8f, 0x92, 0x47, 0xd0, 0x3c, 0x15, 0x10, 0x5c, 0x86, 0x1e, 0x5e, 0xe1, 0x49, 0x11, 0xfe,
-	0x8a, 0x31, 0x3b, 0xd6, 0xc5, 0xd1, 0x9a, 0x42, 0x5d, 0xa5, 0xaf, 0x23, 0x8f, 0x5d, 0x01, 0x65,
-	0xb6, 0x56, 0xec, 0x5b, 0x61, 0x72, 0x27, 0x84, 0xba, 0x38, 0x4b, 0xe5, 0xd8, 0x01, 0x6d, 0x34,
-	0xe4, 0x68, 0xec, 0xa9, 0x8c, 0x7e, 0xbe, 0x1b, 0x04, 0x00, 0x03, 0xbf, 0x16, 0x6c, 0xf1, 0xf1,
-	0x8e, 0x16, 0xf3, 0x0b, 0x0a, 0xa1, 0x0e, 0x7d, 0x15, 0xe2, 0x1a, 0x20, 0xa4, 0x49, 0x10, 0x4b,
-	0xb9, 0x44, 0xac, 0x80, 0x92, 0xe2, 0x84, 0xb2, 0xc0, 0xd7, 0xa9, 0x4f, 0xce, 0xe4, 0x59, 0xc6,
-	0x45, 0x5b, 0xd1, 0xac, 0x42, 0x8f, 0x6f, 0xf2, 0x28, 0xbc, 0xbd, 0x97, 0x9d, 0xbe, 0x5d, 0x68,
-	0xee, 0xd2, 0xee, 0x24, 0x18, 0xf5, 0x6d, 0x95, 0xbd, 0x01, 0x0a, 0xa9, 0x86, 0x4e, 0x46, 0x27,
-	0x14, 0x8e, 0x41, 0x7d, 0x20, 0xe1, 0xa5, 0xa5, 0x28, 0xe4, 0xb7, 0xcd, 0x0e, 0xf7, 0xa4, 0xf0,
-	0x4d, 0xcf, 0xda, 0xc4, 0xaf, 0xc1, 0x4c, 0x60, 0x7c, 0x33, 0x84, 0x35, 0x50, 0x9e, 0x45, 0xae,
-	0xde, 0xbe, 0x1d, 0xf3, 0x54, 0x05, 0x5e, 0xd4, 0xab, 0x4c, 0x26, 0xae, 0xa1, 0x28, 0x6e, 0xa2,
-	0x4d, 0x7d, 0x69, 0xb0, 0x08, 0x36, 0xe7, 0x2a, 0x0f, 0xa2, 0x64, 0x9d, 0x07, 0xe9, 0xf7, 0xb7,
-	0xf0, 0x7b, 0xfb, 0x4b, 0x31, 0x57, 0x15, 0xc5, 0xff, 0x91, 0x22, 0xcb, 0x10, 0x5d, 0xe3, 0xc6,
-	0x5a, 0xf7, 0x7f, 0xd5, 0x4a, 0x56, 0xc0, 0xc3, 0x7b, 0x40, 0x0b, 0xaf, 0x8f, 0x93, 0xf0, 0xf6,
-	0xde, 0xf2, 0x0d, 0xf1, 0x25, 0x9e, 0x22, 0xa7, 0x96, 0xee, 0xbd, 0xa0, 0xc9, 0x3d, 0x9e, 0xe0,
-	0x24, 0xb2, 0x0f, 0x92, 0x14, 0xc9, 0xfb, 0x84, 0xd9, 0x81, 0x49, 0x60, 0x0e, 0xc9, 0xff, 0x83,
-	0xee, 0xa9, 0xe5, 0x2f, 0x3d, 0x15, 0x55, 0xd8, 0xf7, 0x83, 0x05, 0xd5, 0xf3, 0x73, 0x6d, 0x38,
-	0xc1, 0x39, 0xae, 0xd0, 0xff, 0x1f, 0xac, 0x82, 0x54, 0x1f, 0x71, 0xba, 0xac, 0x30, 0x1f, 0x30,
-	0x26, 0xbe, 0xc0, 0xd8, 0xdc, 0x04, 0x30, 0x25, 0x3b, 0xa3, 0x98, 0x47, 0xc9, 0xe5, 0x70, 0xc0,
-	0x53, 0xf8, 0xba, 0x66, 0xc7, 0x61, 0x9b, 0xf8, 0x13, 0x8d, 0x46, 0x7f, 0xee, 0x95, 0x57, 0x5e,
-	0x79, 0xa5, 0xe2, 0xff, 0x6d, 0xa5, 0xc0, 0xa5, 0x70, 0x7a, 0xbc, 0xed, 0xbc, 0x57, 0x2b, 0x42,
-	0xbc, 0x65, 0x67, 0x4e, 0xd9, 0x4f, 0xc0, 0x1f, 0x53, 0xe1, 0xd6, 0xed, 0x21, 0xba, 0x59, 0x4d,
-	0x66, 0x40, 0xe8, 0x63, 0xa4, 0xda, 0xdb, 0x0a, 0x71, 0x7b, 0x5d, 0x70, 0x3a, 0x01, 0x78, 0xc7,
-	0xd9, 0x50, 0xdd, 0x79, 0x36, 0x74, 0x3f, 0xe7, 0x3f, 0x8b, 0x97, 0xc9, 0xf4, 0x86, 0x14, 0xc0,
-	0x41, 0xdb, 0x21, 0x6b, 0x6d, 0xe2, 0xc7, 0x6a, 0xbb, 0xe3, 0x14, 0x1a, 0x53, 0x1f, 0xfb, 0x63,
-	0xa7, 0x3b, 0xe6, 0x12, 0xea, 0x62, 0xbb, 0xb8, 0xc9, 0x3b, 0x96, 0x70, 0x1d, 0x15, 0xea, 0x06,
-	0xff, 0xc9, 0x2b, 0xf7, 0xf3, 0x4a, 0x03, 0x0b, 0xce, 0x71, 0xad, 0xdc, 0xef, 0xb8, 0x62, 0x5c,
-	0x50, 0x38, 0x89, 0x5d, 0x19, 0x33, 0xd1, 0x80, 0xc5, 0x95, 0xe2, 0x6e, 0x86, 0xd8, 0xcd, 0xd7,
-	0x58, 0x92, 0x75, 0xf7, 0x42, 0xf7, 0xf7, 0xb3, 0x5e, 0x99, 0xd7, 0x5a, 0xda, 0x5b, 0x35, 0x08,
-	0x15, 0x63, 0x10, 0x5e, 0x28, 0xe6, 0xee, 0x83, 0xc8, 0xdd, 0x59, 0x63, 0x10, 0xf6, 0xe3, 0xed,
-	0x4b, 0xde, 0xfe, 0x1e, 0xf3, 0x7d, 0x73, 0x78, 0xa3, 0x98, 0xc3, 0x2d, 0xe4, 0xf0, 0x71, 0x35,
-	0x53, 0xf6, 0x69, 0x59, 0xf3, 0xf9, 0xed, 0x6a, 0xb9, 0xcf, 0x7e, 0xbf, 0x3c, 0xc2, 0x66, 0xf2,
-	0x3a, 0xbf, 0x2b, 0x43, 0x49, 0x78, 0xfe, 0x2f, 0x8b, 0xd6, 0xf1, 0x4d, 0x2d, 0x73, 0x56, 0x6a,
-	0x1e, 0xc7, 0xd4, 0x33, 0x67, 0x9f, 0xee, 0xa3, 0x9d, 0xa9, 0xc2, 0x73, 0x54, 0x3c, 0xbb, 0xd8,
-	0xe2, 0x52, 0x00, 0x18, 0x63, 0xc5, 0xb3, 0x8b, 0x14, 0x94, 0x3f, 0xbb, 0xf0, 0xf6, 0x3f, 0xbb,
-	0xf0, 0xee, 0xf9, 0xec, 0xc2, 0x73, 0x9f, 0x5d, 0x94, 0x69, 0xff, 0xc0, 0xd2, 0xfe, 0xb2, 0xf1,
-	0xd0, 0x23, 0xf7, 0xc9, 0x4a, 0xe1, 0x5e, 0xaa, 0x74, 0xd0, 0x8e, 0x93, 0x29, 0x2b, 0xbd, 0x60,
-	0x4a, 0x4f, 0x5d, 0x70, 0x56, 0xe3, 0x24, 0x18, 0x4e, 0x64, 0xb8, 0x5f, 0x03, 0xf0, 0xa0, 0x00,
-	0x9a, 0xc1, 0x78, 0x77, 0x4d, 0x24, 0x45, 0xa6, 0x80, 0x4c, 0x90, 0xbe, 0xee, 0x0a, 0xd2, 0x4b,
-	0x5f, 0x04, 0xe5, 0xd3, 0x64, 0xaa, 0xb8, 0x78, 0xb5, 0x58, 0x28, 0x43, 0x14, 0xca, 0x19, 0xcb,
-	0x24, 0xe4, 0xba, 0xaa, 0xe5, 0xf1, 0x9f, 0x5e, 0xe1, 0xf6, 0xf1, 0x81, 0xe4, 0xe1, 0xcb, 0x20,
-	0xb9, 0x4a, 0x62, 0x14, 0x89, 0xaa, 0x16, 0xcc, 0x3e, 0x06, 0x11, 0x1a, 0x69, 0x1c, 0x83, 0x9c,
-	0x21, 0x44, 0x14, 0xd2, 0xa3, 0x8b, 0x3a, 0x33, 0x20, 0x65, 0x7d, 0x1f, 0x59, 0x7d, 0x2f, 0xe8,
-	0x96, 0xee, 0xfb, 0xd7, 0x3c, 0xc7, 0xee, 0xf8, 0xe1, 0x04, 0xb9, 0x17, 0x97, 0x8a, 0xb9, 0x7e,
-	0x09, 0xb9, 0x6e, 0x59, 0x23, 0x66, 0x30, 0xa4, 0xf9, 0xdd, 0xcc, 0xed, 0xda, 0x9d, 0xcb, 0xe2,
-	0xbb, 0x8a, 0x9b, 0x8a, 0xb0, 0xa9, 0xe3, 0x86, 0x45, 0x76, 0x36, 0xf4, 0x61, 0x47, 0x24, 0xe0,
-	0x5e, 0xe5, 0x52, 0xd6, 0xd3, 0xd8, 0xea, 0x69, 0xae, 0x09, 0xcd, 0xc0, 0xd7, 0x3d, 0x67, 0xd0,
-	0x01, 0x34, 0x12, 0xe8, 0x47, 0x9a, 0x8f, 0xb4, 0x5c, 0x1a, 0x54, 0xb4, 0xe2, 0xff, 0xd5, 0x4c,
-	0xfc, 0xbf, 0xcc, 0x8f, 0x48, 0x2c, 0x3f, 0xc2, 0xc1, 0x92, 0xe6, 0x39, 0xca, 0x86, 0x43, 0xe8,
-	0xa3, 0x22, 0xc7, 0x5b, 0x26, 0x49, 0xcd, 0x1a, 0x19, 0x91, 0x0c, 0x11, 0x8b, 0xef, 0x2c, 0x6e,
-	0x78, 0x1b, 0x1b, 0x3e, 0x66, 0xac, 0x4c, 0xba, 0x62, 0xdd, 0xe6, 0xa7, 0xbd, 0xe2, 0x78, 0x4b,
-	0xa9, 0xb0, 0x52, 0xe5, 0xad, 0x18, 0xca, 0xbb, 0xd8, 0x29, 0xe6, 0x67, 0x07, 0xf9, 0x79, 0x54,
-	0xf3, 0xe3, 0x6c, 0xd3, 0xb2, 0x2b, 0xc5, 0xb1, 0x9e, 0x87, 0x17, 0x14, 0x4e, 0x4f, 0xc3, 0x6a,
-	0x25, 0xa7, 0x61, 0xf5, 0xfc, 0x69, 0xd8, 0xe2, 0xbb, 0x8b, 0xbb, 0xbe, 0x87, 0x5d, 0x5f, 0xb0,
-	0x2d, 0x6a, 0xbe, 0x53, 0xba, 0xef, 0xdf, 0xf3, 0x0a, 0x03, 0x59, 0x0f, 0xaf, 0xe7, 0x65, 0x76,
-	0xf1, 0x65, 0xdb, 0x2e, 0xba, 0x59, 0xd3, 0xfc, 0xff, 0xc8, 0x2b, 0x88, 0xb5, 0x01, 0xa7, 0x57,
-	0xd7, 0xd6, 0xba, 0x98, 0x5c, 0x28, 0x55, 0x4a, 0x95, 0xcd, 0xe4, 0x46, 0x21, 0xfc, 0x4c, 0x72,
-	0x23, 0x62, 0x44, 0xf7, 0x54, 0x11, 0x93, 0x0c, 0x81, 0x41, 0xb1, 0x4a, 0xe0, 0xef, 0xb2, 0x8d,
-	0xc4, 0x87, 0x1c, 0x1b, 0x89, 0x0c, 0x8b, 0xba, 0x17, 0x5f, 0xf1, 0x0a, 0xc2, 0x82, 0xfb, 0xf5,
-	0xa2, 0x84, 0xd7, 0x4c, 0x42, 0x64, 0x19, 0xaf, 0x3f, 0x57, 0xb0, 0xe9, 0x71, 0xf2, 0x7a, 0x8b,
-	0x34, 0x15, 0x0e, 0x23, 0x44, 0x69, 0xf6, 0x28, 0xb0, 0x77, 0x40, 0x66, 0x8f, 0x9e, 0x22, 0x33,
-	0x88, 0x34, 0x4e, 0xb0, 0x34, 0x40, 0xe7, 0x83, 0x56, 0x8d, 0x7c, 0x50, 0x7f, 0x5c, 0x10, 0xe4,
-	0xcc, 0x1e, 0xec, 0x97, 0xf5, 0xe4, 0xc3, 0x56, 0x4f, 0x9c, 0xd5, 0xe9, 0x9e, 0x4c, 0x0a, 0x42,
-	0xa7, 0xb9, 0x06, 0xaf, 0x14, 0x37, 0xf8, 0x8a, 0xe7, 0x68, 0xb1, 0x50, 0x76, 0x97, 0xc1, 0x09,
-	0x8e, 0x27, 0xe3, 0x51, 0x8c, 0x07, 0x75, 0xab, 0x2f, 0x60, 0x23, 0x0d, 0x56, 0x59, 0x7d, 0x01,
-	0x84, 0x72, 0x29, 0x8a, 0xc6, 0x91, 0x3c, 0xbb, 0x10, 0x05, 0x7d, 0xbf, 0x46, 0x9c, 0xc4, 0x8b,
-	0x82, 0xff, 0x7d, 0xcf, 0x15, 0xda, 0xfd, 0x99, 0xa8, 0x7c, 0xc9, 0x02, 0xf4, 0x11, 0x21, 0x8b,
-	0x93, 0xda, 0xf0, 0x16, 0x8a, 0xfe, 0x76, 0x3e, 0x04, 0x9d, 0x93, 0x7a, 0xc9, 0xe2, 0xfc, 0x51,
-	0xd1, 0xd2, 0x09, 0xd3, 0x4a, 0x18, 0x55, 0xe9, 0x76, 0x3e, 0x54, 0x12, 0xd4, 0x76, 0x3a, 0x24,
-	0x25, 0x5b, 0xc4, 0x8f, 0x79, 0x96, 0x71, 0x2d, 0xac, 0x57, 0xb7, 0xfe, 0x57, 0x5e, 0x61, 0xd0,
-	0x1c, 0x8f, 0xe4, 0x44, 0x8e, 0x1d, 0xb6, 0x5f, 0x65, 0xaa, 0x08, 0x18, 0x91, 0xa3, 0xd2, 0x97,
-	0x33, 0x47, 0x15, 0xc1, 0x61, 0x6b, 0xaf, 0xcb, 0x8d, 0x17, 0x3a, 0xb2, 0xa2, 0x84, 0x8e, 0xdc,
-	0x04, 0xe1, 0x62, 0x68, 0x65, 0xa9, 0x6c, 0x8d, 0xfc, 0x05, 0xcf, 0xb2, 0xb3, 0x05, 0x5c, 0xea,
-	0xae, 0x7c, 0xd9, 0xdb, 0x3f, 0xc4, 0x7f, 0xdf, 0xbb, 0x5d, 0x56, 0xcc, 0xdf, 0x27, 0x3c, 0x6b,
-	0xbb, 0xbb, 0x5f, 0xd3, 0x9a, 0xd1, 0xbf, 0xab, 0x16, 0x9f, 0x32, 0xa0, 0x00, 0x97, 0x8c, 0x31,
-	0x97, 0x25, 0x43, 0x80, 0x15, 0x53, 0x80, 0x29, 0xd3, 0x55, 0x63, 0x05, 0xbc, 0xc7, 0xc0, 0xd5,
-	0x39, 0x52, 0xe9, 0xb0, 0xd2, 0x3c, 0xd7, 0x4a, 0x87, 0x3d, 0xbc, 0xe4, 0xd6, 0x45, 0x42, 0xc4,
-	0xd1, 0x08, 0x7e, 0xd6, 0xb0, 0x4e, 0x2c, 0xf1, 0x68, 0x59, 0x60, 0x99, 0x41, 0x65, 0xe6, 0x96,
-	0xce, 0x94, 0xe6, 0x96, 0xde, 0x7b, 0xfe, 0x6a, 0x99, 0xaf, 0xf2, 0xab, 0x9e, 0xe5, 0xa7, 0x15,
-	0x0d, 0x9a, 0x1e, 0xda, 0x1f, 0x78, 0xf9, 0x23, 0xa2, 0x9f, 0xe1, 0x90, 0x96, 0x19, 0xa4, 0x4f,
-	0xd9, 0x06, 0x29, 0xcb, 0xa5, 0xee, 0xc3, 0x5f, 0xa7, 0x26, 0xa1, 0xbd, 0xde, 0x4d, 0xac, 0x40,
-	0x2f, 0x1e, 0x6d, 0x07, 0xf1, 0x96, 0xce, 0x6b, 0x12, 0xa5, 0x34, 0xdf, 0xa9, 0x2f, 0xd3, 0x3a,
-	0x64, 0x09, 0x0c, 0x66, 0x7b, 0x49, 0x76, 0xa4, 0xd2, 0x5e, 0x82, 0x72, 0x77, 0x4d, 0xe6, 0xba,
-	0x56, 0xba, 0x6b, 0x7a, 0x45, 0xa9, 0x1b, 0x2b, 0x4a, 0x99, 0x51, 0xf8, 0xb4, 0xcb, 0x28, 0xe4,
-	0xf8, 0xd4, 0x9d, 0xf9, 0x17, 0xcf, 0x71, 0x3a, 0xb7, 0xdf, 0x56, 0xdc, 0x39, 0x2a, 0xf7, 0xb8,
-	0x15, 0xef, 0x4d, 0x06, 0xa1, 0xc8, 0x64, 0x94, 0x19, 0x89, 0x29, 0x80, 0x2e, 0xc8, 0x3c, 0xda,
-	0xa5, 0xf1, 0xf6, 0xa8, 0xaf, 0xfc, 0x66, 0x13, 0xb4, 0xb8, 0x5c, 0xdc, 0xf1, 0xcf, 0x78, 0xd6,
-	0x6e, 0x2f, 0xd7, 0x27, 0xdd, 0xe5, 0x7f, 0xf4, 0x9c, 0x27, 0x8f, 0x0f, 0xd4, 0xe9, 0x05, 0x32,
-	0x6b, 0xa8, 0xbb, 0x1c, 0x48, 0x13, 0x44, 0x9f, 0x25, 0x4d, 0x9c, 0xac, 0x6b, 0x63, 0x31, 0x3b,
-	0x64, 0x72, 0x96, 0x6b, 0x22, 0xdb, 0x84, 0x8b, 0x97, 0x8a, 0x3b, 0xfb, 0x59, 0xcf, 0xda, 0x28,
-	0x3a, 0x7a, 0xa3, 0xbb, 0xdb, 0x21, 0xb3, 0x46, 0x23, 0x30, 0x04, 0x58, 0x34, 0xe6, 0x9b, 0x06,
-	0xa4, 0xd8, 0xd4, 0xe9, 0xab, 0x33, 0x0d, 0xf0, 0x6f, 0xc9, 0xd4, 0x2f, 0x67, 0xae, 0xe6, 0x7c,
-	0x36, 0x57, 0xd3, 0xc8, 0xd3, 0xb4, 0x73, 0x1d, 0xab, 0xb9, 0x5c, 0xc7, 0x1f, 0x7a, 0xe4, 0xa0,
-	0x9d, 0x18, 0xfc, 0x33, 0x4a, 0x82, 0x7d, 0x42, 0x26, 0x82, 0xf2, 0x6c, 0x16, 0x6c, 0xda, 0x4f,
-	0xa6, 0x08, 0xf6, 0x33, 0xf4, 0xfe, 0x47, 0x3c, 0xa9, 0xbf, 0xf2, 0x4a, 0x53, 0xea, 0x1e, 0xa8,
-	0x6e, 0xa8, 0x62, 0x1a, 0xa7, 0xeb, 0x85, 0x2f, 0x73, 0x69, 0x10, 0x34, 0x00, 0xa7, 0x01, 0x5e,
-	0xd4, 0x59, 0x1e, 0x6f, 0x4b, 0x9d, 0xaa, 0x33, 0x13, 0x84, 0x09, 0x6e, 0xc1, 0xae, 0x31, 0x89,
-	0x54, 0xd1, 0x7f, 0x2f, 0x69, 0xb2, 0x89, 0xc9, 0x84, 0x56, 0x5c, 0xcf, 0x52, 0xdc, 0x45, 0x99,
-	0x8e, 0x09, 0x64, 0xb1, 0x3c, 0x44, 0xa0, 0xa6, 0xd9, 0x14, 0xdf, 0x33, 0x83, 0xca, 0xff, 0x00,
-	0x21, 0xed, 0x25, 0x65, 0x49, 0xa4, 0xe9, 0xf2, 0x52, 0xd3, 0x25, 0xee, 0xc1, 0xa9, 0x6b, 0x80,
-	0xf8, 0x9b, 0x5e, 0x20, 0xd3, 0x6c, 0x22, 0x9a, 0xa8, 0x5a, 0x89, 0x96, 0x16, 0x93, 0x4c, 0x11,
-	0xf9, 0xbf, 0xe2, 0x91, 0x13, 0xe6, 0xd9, 0xff, 0xb5, 0x71, 0x90, 0xfa, 0x96, 0xe2, 0xb6, 0xdc,
-	0x1a, 0x10, 0x66, 0xd2, 0xc3, 0x34, 0x53, 0x2c, 0x25, 0x29, 0xb3, 0x91, 0x9f, 0xb3, 0x6d, 0x64,
-	0x41, 0x83, 0x7a, 0x06, 0xfd, 0xc4, 0x73, 0xe7, 0xa5, 0xd3, 0x37, 0xaa, 0x34, 0x37, 0xcf, 0xba,
-	0x86, 0xa5, 0x69, 0x57, 0x27, 0x3c, 0x0a, 0x92, 0x71, 0x14, 0xcb, 0x7c, 0x37, 0x7a, 0x85, 0xd0,
-	0x4c, 0x4d, 0x21, 0x57, 0x89, 0x88, 0x27, 0x0a, 0xf2, 0xdb, 0x99, 0xe3, 0x13, 0x2b, 0x4e, 0x5f,
-	0xcd, 0x5c, 0xb3, 0xd0, 0x8b, 0x90, 0xb8, 0x80, 0x28, 0x4b, 0xfe, 0x87, 0xc8, 0x5c, 0xb6, 0x6e,
-	0xfa, 0x5a, 0x72, 0x50, 0x9d, 0xac, 0xcb, 0xac, 0x3f, 0xe1, 0xca, 0x66, 0xa0, 0x60, 0xdd, 0x41,
-	0xc1, 0x52, 0x2a, 0x31, 0x03, 0x2d, 0x18, 0xa8, 0xf5, 0xad, 0x20, 0xe1, 0x11, 0x4c, 0x6c, 0x15,
-	0x9c, 0x4e, 0x01, 0x7e, 0x87, 0x1c, 0x71, 0x08, 0x06, 0x98, 0xbd, 0xb8, 0xb9, 0xb9, 0x3a, 0x49,
-	0x73, 0x27, 0x45, 0x49, 0x59, 0x63, 0x63, 0xf7, 0x99, 0x96, 0xfd, 0x0f, 0x93, 0x53, 0xae, 0xf1,
-	0xb8, 0x15, 0x26, 0x77, 0xda, 0xeb, 0x6c, 0x42, 0x9f, 0x22, 0x35, 0xf4, 0x99, 0x44, 0x24, 0xac,
-	0xf4, 0xde, 0x00, 0x12, 0x1a, 0x5e, 0x79, 0xa5, 0xc0, 0x2b, 0xaf, 0x9a, 0xb3, 0xc7, 0x7f, 0x2f,
-	0x39, 0x93, 0x1f, 0x13, 0x8b, 0x85, 0xb7, 0xda, 0x99, 0x66, 0xaf, 0x29, 0xe1, 0x41, 0x7d, 0xa3,
-	0x52, 0xcf, 0xd6, 0xc8, 0x7c, 0x26, 0xeb, 0x41, 0xd8, 0x77, 0x91, 0x22, 0xf9, 0x8c, 0x5d, 0xf1,
-	0x82, 0x39, 0x67, 0x5d, 0x5f, 0xa8, 0x5a, 0xc7, 0xe4, 0x64, 0x21, 0x0d, 0x7d, 0x3d, 0xa9, 0x77,
-	0xfa, 0xb0, 0x80, 0x09, 0x89, 0x1d, 0xb7, 0xae, 0x02, 0x00, 0x22, 0xbc, 0x1d, 0xf2, 0x88, 0x09,
-	0x22, 0x7a, 0x8e, 0x34, 0x8d, 0x64, 0xf8, 0x1d, 0xa5, 0x0c, 0x36, 0xd0, 0xff, 0x45, 0xcf, 0x95,
-	0xae, 0x03, 0x56, 0x54, 0xbb, 0x04, 0x72, 0xef, 0x6c, 0x40, 0xd2, 0xe4, 0x57, 0x79, 0x5b, 0xaa,
-	0x6c, 0xb3, 0xfa, 0xeb, 0xf6, 0x66, 0x35, 0xdf, 0x98, 0x9e, 0xc2, 0x3f, 0xf6, 0xca, 0x73, 0x84,
-	0x1e, 0xe8, 0xf0, 0x61, 0xdf, 0xc5, 0x7f, 0xf1, 0x7a, 0x31, 0xf3, 0x9f, 0xf7, 0xac, 0xe3, 0xa4,
-	0x32, 0xe6, 0x74, 0x37, 0xbe, 0xe3, 0x15, 0x25, 0x32, 0x3d, 0xa4, 0x0e, 0x94, 0x44, 0xf9, 0x7e,
-	0x43, 0x74, 0xe0, 0xb4, 0xb1, 0x81, 0x2f, 0xf3, 0xfc, 0xff, 0xdb, 0x23, 0x4d, 0x99, 0x18, 0x11,
-	0x89, 0x54, 0xdd, 0x53, 0xe2, 0x69, 0x0d, 0x11, 0x1b, 0x11, 0x2b, 0xa4, 0x06, 0x18, 0x37, 0x04,
-	0x4c, 0x8f, 0xb9, 0x0d, 0x1e, 0x71, 0x37, 0xe9, 0xf4, 0xc5, 0x82, 0xd2, 0x64, 0xa2, 0x40, 0x9f,
-	0x21, 0x33, 0xca, 0xfc, 0xa9, 0xf4, 0xf7, 0x96, 0x35, 0x33, 0x24, 0x52, 0xbe, 0x36, 0xa2, 0x48,
-	0x75, 0x18, 0xab, 0x6e, 0x5e, 0x6b, 0x7e, 0x8e, 0xcc, 0x1a, 0xe9, 0x37, 0xf2, 0x42, 0x57, 0x2b,
-	0xf3, 0x70, 0x49, 0x8a, 0x67, 0x26, 0x31, 0xf0, 0xbd, 0x21, 0x1e, 0x77, 0x98, 0x16, 0xc6, 0x57,
-	0x94, 0xfc, 0x2f, 0x7a, 0xf9, 0x3c, 0xb3, 0x07, 0x1a, 0x34, 0xc3, 0xad, 0xa8, 0x5a, 0x6e, 0x45,
-	0xd9, 0xe6, 0xe6, 0x37, 0xed, 0xcd, 0x4d, 0x96, 0x11, 0x3d, 0x4c, 0x9f, 0xf7, 0xdc, 0x89, 0x6f,
-	0x3a, 0x8a, 0xe5, 0x99, 0xaf, 0xc4, 0xcc, 0x91, 0x6a, 0x37, 0x51, 0xfe, 0x1e, 0xfc, 0x04, 0xb6,
-	0x47, 0x62, 0xa7, 0x23, 0xc2, 0x5d, 0xb2, 0x54, 0x16, 0xf1, 0xfb, 0x2d, 0xcf, 0xba, 0xdf, 0xe5,
-	0x6a, 0xde, 0x8c, 0xf8, 0x51, 0x85, 0x6b, 0x73, 0x11, 0x54, 0x1e, 0x47, 0x20, 0xc8, 0xb5, 0x90,
-	0x47, 0x6b, 0x2a, 0x4d, 0xb7, 0xc6, 0xd2, 0xb2, 0x58, 0xba, 0x8c, 0x7c, 0xe1, 0x74, 0xe9, 0x32,
-	0x32, 0x99, 0x4b, 0x96, 0x53, 0xff, 0x47, 0x95, 0xf4, 0x2e, 0xa5, 0xb2, 0x84, 0x25, 0xbe, 0x5d,
-	0x76, 0x1b, 0x54, 0x71, 0x6c, 0x83, 0x54, 0x78, 0xa8, 0xbd, 0x2e, 0xe7, 0x9c, 0x2a, 0xa6, 0x98,
-	0x6e, 0x22, 0x37, 0x81, 0xaa, 0x68, 0xa8, 0x43, 0x3d, 0x7b, 0x22, 0x2c, 0x8e, 0x78, 0x85, 0x53,
-	0x8a, 0xfe, 0x7c, 0x0a, 0x70, 0x5f, 0x67, 0xf2, 0x1e, 0xd2, 0x75, 0x26, 0xc3, 0x3b, 0x26, 0x39,
-	0xef, 0xf8, 0x0a, 0x69, 0xa6, 0x5a, 0xa7, 0xa6, 0xbf, 0x76, 0xe8, 0xbd, 0x12, 0x87, 0xbe, 0x62,
-	0x39, 0xf4, 0xfe, 0xc7, 0x3c, 0x72, 0x08, 0x95, 0xcf, 0x18, 0x7e, 0xe3, 0x3e, 0x97, 0x67, 0xdf,
-	0xe7, 0xf2, 0x65, 0x06, 0x78, 0x66, 0x38, 0xac, 0x27, 0x6e, 0x16, 0xc5, 0xa1, 0x3a, 0xb2, 0x26,
-	0xaf, 0x58, 0x1c, 0xcd, 0x4e, 0x14, 0x61, 0x38, 0xd2, 0x22, 0xec, 0x58, 0x0e, 0xe7, 0x2c, 0x8b,
-	0xb9, 0x8e, 0x7a, 0xfb, 0xaf, 0xa3, 0xef, 0x20, 0x07, 0xcc, 0xaf, 0xa5, 0x17, 0xae, 0x96, 0xb3,
-	0xbc, 0x96, 0x33, 0x8b, 0x9c, 0xbe, 0x2b, 0x77, 0x93, 0x5c, 0x3a, 0xd9, 0x45, 0x97, 0x60, 0xb3,
-	0xe4, 0xfe, 0xdf, 0x7b, 0x32, 0x6b, 0xc3, 0x1e, 0x19, 0x4b, 0x1e, 0xde, 0x3d, 0xc9, 0x83, 0x3e,
-	0x43, 0x88, 0xd8, 0xed, 0xa5, 0x2f, 0x49, 0x69, 0x3e, 0x32, 0xa3, 0xc5, 0x0c, 0x4a, 0xfa, 0x3c,
-	0x69, 0x5a, 0x62, 0x94, 0xf2, 0x2f, 0x36, 0xde, 0x36, 0xb9, 0xad, 0xfe, 0x35, 0x0c, 0x92, 0x68,
-	0x80, 0x3f, 0x24, 0xc7, 0x2c, 0xf2, 0x34, 0x72, 0x5f, 0xbe, 0xf6, 0x58, 0xab, 0x49, 0xe5, 0x9e,
-	0x57, 0x13, 0xff, 0x55, 0xaf, 0x30, 0x37, 0xf8, 0x41, 0xb3, 0x1b, 0x2c, 0xe5, 0xad, 0xe6, 0x95,
-	0xb7, 0x6c, 0x9f, 0xf3, 0x05, 0xcf, 0x91, 0xa0, 0x90, 0xe3, 0xcc, 0x8a, 0x75, 0x97, 0x64, 0x2f,
-	0x97, 0xd8, 0x3c, 0x75, 0xc5, 0xb2, 0x62, 0x5c, 0xb1, 0xbc, 0xdf, 0x40, 0xf7, 0xb5, 0xe2, 0x7e,
-	0xfc, 0xb6, 0x67, 0x65, 0x76, 0x15, 0xb3, 0x68, 0xe5, 0x2e, 0x2c, 0x63, 0xf8, 0x27, 0x18, 0x84,
-	0xc9, 0xde, 0x03, 0x6b, 0xf5, 0x02, 0x99, 0x35, 0xaa, 0x91, 0xfd, 0x33, 0x41, 0xfe, 0x07, 0xc9,
-	0xbc, 0xe9, 0xf5, 0x64, 0xda, 0x74, 0x1d, 0xbf, 0x3e, 0x9b, 0xad, 0xd3, 0x9c, 0xb2, 0x99, 0x0a,
-	0xec, 0xb6, 0x3e, 0x40, 0x8e, 0x18, 0xc5, 0x54, 0x97, 0xdf, 0x62, 0xef, 0x08, 0xce, 0xe6, 0x67,
-	0x7f, 0xb6, 0x56, 0x41, 0x0f, 0x8b, 0xf7, 0xa5, 0x48, 0x1d, 0x56, 0xc1, 0x4f, 0xb0, 0x6a, 0x45,
-	0xf9, 0xe9, 0xb9, 0x80, 0x8c, 0xfd, 0x1e, 0x4e, 0xdd, 0x7a, 0x29, 0x26, 0x31, 0x4f, 0x06, 0x93,
-	0xfc, 0x4b, 0x31, 0xb5, 0xec, 0x4b, 0x31, 0x65, 0x6a, 0xfc, 0x45, 0x57, 0x48, 0x33, 0xc7, 0x9f,
-	0x1e, 0xfb, 0x7f, 0xf7, 0xc4, 0x5b, 0x3a, 0x18, 0xa1, 0x58, 0x4f, 0x23, 0x14, 0xeb, 0xf4, 0x34,
-	0xa9, 0x74, 0x13, 0x69, 0x9b, 0x32, 0x2f, 0xec, 0x54, 0xba, 0x09, 0x7d, 0x2a, 0xbd, 0x10, 0x5d,
-	0xb5, 0xf7, 0xe3, 0xeb, 0xdd, 0x44, 0xcc, 0xfb, 0x58, 0x3d, 0x9a, 0x21, 0xee, 0x45, 0x67, 0xdc,
-	0xc4, 0x9a, 0x15, 0x80, 0x2c, 0x77, 0x13, 0xe7, 0x7b, 0x32, 0x56, 0x54, 0xf8, 0x36, 0xc3, 0x05,
-	0xfb, 0x6d, 0x86, 0x62, 0xfb, 0x63, 0x5c, 0x57, 0xff, 0x52, 0x85, 0xcc, 0x65, 0x9f, 0x48, 0x83,
-	0x69, 0xcb, 0xb1, 0xd0, 0x97, 0xd7, 0xad, 0x54, 0x11, 0x8c, 0x20, 0x37, 0x4e, 0x78, 0xbd, 0xf3,
-	0x75, 0xa6, 0x01, 0xa0, 0xbb, 0xe3, 0x49, 0xea, 0xc6, 0xe1, 0x6f, 0x7a, 0x9a, 0x54, 0x27, 0x89,
-	0x8a, 0xb2, 0xcf, 0x1a, 0xf2, 0x61, 0x00, 0x87, 0x0a, 0x37, 0xb6, 0xa3, 0x08, 0xc6, 0x45, 0x24,
-	0x98, 0xd5, 0x99, 0x06, 0x80, 0x05, 0x9c, 0x44, 0x5c, 0x20, 0xc5, 0x3d, 0xb1, 0xb4, 0x0c, 0xfd,
-	0x8f, 0xa3, 0x0d, 0xe9, 0x32, 0xc3, 0x4f, 0x68, 0xbe, 0xcf, 0xe3, 0x44, 0xfa, 0x21, 0xf8, 0x1b,
-	0x36, 0x9e, 0x1b, 0x77, 0xf8, 0xc6, 0xd6, 0xf2, 0x78, 0x74, 0x7b, 0x10, 0x6e, 0x24, 0xd2, 0x09,
-	0xb1, 0x81, 0x30, 0x69, 0x83, 0xf4, 0x79, 0x9f, 0x3e, 0xba, 0x22, 0x35, 0x66, 0x82, 0xfc, 0x5f,
-	0xf6, 0x5c, 0x37, 0x2d, 0xe8, 0x9b, 0xa5, 0x3c, 0x8c, 0xd8, 0x41, 0xe1, 0xc3, 0x73, 0x9a, 0xb2,
-	0x6c, 0x87, 0xfa, 0x25, 0x7b, 0x87, 0x9a, 0x6f, 0x53, 0x6b, 0x2d, 0xf0, 0x94, 0xbf, 0xe5, 0xf1,
-	0x10, 0x78, 0xfa, 0xb2, 0xcd, 0x53, 0xbe, 0x4d, 0xeb, 0xb4, 0xc6, 0x75, 0xc3, 0xe4, 0x7e, 0x27,
-	0xd6, 0x29, 0x32, 0x83, 0x2b, 0x3e, 0xbe, 0x46, 0x28, 0xd4, 0x49, 0x03, 0xac, 0x17, 0xa7, 0x3c,
-	0xfd, 0xae, 0x56, 0x59, 0xf8, 0xfb, 0x77, 0x5c, 0xe1, 0x6f, 0x8b, 0x45, 0xdd, 0x87, 0xc4, 0x75,
-	0x17, 0xc6, 0x9e, 0x14, 0x15, 0x63, 0x52, 0x94, 0x49, 0xee, 0x77, 0x6d, 0xc9, 0xe5, 0xab, 0xd5,
-	0xad, 0xfe, 0xab, 0xb7, 0xcf, 0x55, 0x9b, 0xc2, 0xb7, 0x2e, 0xee, 0x21, 0x66, 0xe5, 0x0e, 0x46,
-	0x96, 0xa5, 0xf5, 0x50, 0x52, 0x1b, 0x19, 0x27, 0x66, 0xf0, 0x7b, 0x71, 0xb5, 0xb8, 0xa3, 0x5f,
-	0x11, 0x1d, 0x3d, 0x67, 0x67, 0x93, 0xb8, 0x3b, 0xa2, 0xfb, 0xfc, 0x5d, 0xaf, 0xf4, 0xee, 0xd0,
-	0x7e, 0x1e, 0x50, 0x64, 0x9d, 0xaf, 0x88, 0x12, 0x8c, 0x53, 0x3f, 0x1a, 0x4f, 0x2e, 0x0e, 0x06,
-	0xf2, 0xd4, 0x40, 0x15, 0xcb, 0x12, 0x75, 0xbf, 0x2a, 0xd8, 0xf7, 0xcd, 0x74, 0xfc, 0xfd, 0x98,
-	0xff, 0x60, 0xd9, 0xb5, 0xa6, 0x32, 0xe7, 0xe4, 0xf7, 0x6c, 0xe7, 0xa4, 0xb8, 0x12, 0xdd, 0xd6,
-	0x67, 0xbc, 0x82, 0x3b, 0x52, 0x86, 0xd3, 0xe4, 0x59, 0x4e, 0xd3, 0x19, 0x42, 0x22, 0x7d, 0x13,
-	0x43, 0x3c, 0x53, 0x62, 0x40, 0xca, 0xb2, 0x5b, 0x7e, 0xdf, 0x73, 0x65, 0x06, 0xd9, 0xed, 0x6a,
-	0xd6, 0x7e, 0xea, 0xdd, 0xe3, 0x1d, 0xad, 0x42, 0x56, 0x8b, 0x4e, 0xca, 0xa4, 0xc7, 0x0d, 0x4b,
-	0x8b, 0x58, 0x60, 0xab, 0x4c, 0x03, 0x16, 0x6f, 0x15, 0x77, 0xe0, 0x6b, 0xa2, 0x03, 0xaf, 0xd7,
-	0x02, 0xde, 0x9f, 0x3b, 0xdd, 0xa1, 0x2f, 0x7a, 0xfb, 0xdf, 0x24, 0xbb, 0xbf, 0xf0, 0x67, 0x59,
-	0xca, 0xc3, 0x1f, 0xd8, 0x29, 0x0f, 0xfb, 0x35, 0x6c, 0x5a, 0x29, 0xd7, 0x4d, 0x36, 0x10, 0x26,
-	0xc7, 0x4b, 0x32, 0x32, 0x50, 0x2a, 0x4b, 0x65, 0xb6, 0xf1, 0x0f, 0x6d, 0xdb, 0xe8, 0xa8, 0x35,
-	0xd7, 0x6a, 0xe6, 0x9a, 0xdc, 0x83, 0xb4, 0xfa, 0x47, 0xf9, 0x56, 0x33, 0xb5, 0xea, 0x56, 0x7f,
-	0xc9, 0x73, 0x5e, 0xc2, 0xa3, 0x4f, 0x9b, 0x0f, 0x23, 0xc8, 0xa1, 0x70, 0xbc, 0x00, 0x60, 0x10,
-	0x95, 0x71, 0xf4, 0x75, 0x9b, 0x23, 0x47, 0x83, 0x9a, 0xa3, 0x81, 0xe3, 0xf2, 0x9f, 0x33, 0xb5,
-	0xa8, 0xe4, 0xfc, 0xf9, 0x1b, 0xf6, 0xf9, 0x73, 0xae, 0x3e, 0xdd, 0xda, 0xab, 0xde, 0x7e, 0x97,
-	0x0a, 0xef, 0x7b, 0x72, 0x19, 0x2f, 0x5e, 0x54, 0xad, 0x17, 0x2f, 0x16, 0xbb, 0xc5, 0x1c, 0xff,
-	0xb1, 0xe0, 0xf8, 0xb1, 0xc2, 0x89, 0x65, 0xb2, 0xa4, 0xd9, 0xdf, 0x2d, 0xb8, 0xee, 0x58, 0xf4,
-	0xa6, 0x4b, 0x99, 0x71, 0xfa, 0xa6, 0x6d, 0x9c, 0x9c, 0xf5, 0xea, 0x96, 0xdf, 0xe7, 0xbc, 0x4d,
-	0x59, 0xa6, 0x04, 0xdf, 0xb2, 0x95, 0xc0, 0xf1, 0xb5, 0xae, 0xfd, 0xa3, 0x5e, 0xd1, 0x9d, 0xcc,
-	0x9c, 0xbf, 0x73, 0x30, 0xf5, 0x77, 0x9a, 0xe0, 0xe0, 0x94, 0x45, 0xc9, 0xff, 0xc4, 0x8e, 0x92,
-	0xbb, 0x1b, 0xd0, 0x4c, 0x7c, 0xce, 0x2b, 0xbb, 0xe1, 0x79, 0xbf, 0x7a, 0x51, 0xb6, 0x6e, 0x7d,
-	0x3b, 0xb7, 0x6e, 0x15, 0x34, 0xaa, 0x99, 0x5b, 0x25, 0x87, 0x73, 0xbb, 0x1a, 0xe7, 0x16, 0x37,
-	0x7f, 0xe3, 0x4f, 0xe4, 0x7d, 0x67, 0xa0, 0xfe, 0x4d, 0xeb, 0x2d, 0x18, 0xf1, 0x78, 0xcb, 0x52,
-	0x1e, 0x26, 0x37, 0xb6, 0x45, 0x61, 0xad, 0x1c, 0x3d, 0x0c, 0x65, 0xe9, 0x3d, 0x58, 0x2b, 0xdf,
-	0x55, 0xbe, 0x96, 0x5a, 0x76, 0x56, 0xf3, 0x1d, 0xfb, 0xac, 0xa6, 0xac, 0x6a, 0x2d, 0xad, 0x6f,
-	0x7a, 0xe5, 0x57, 0x6d, 0xef, 0xfb, 0xd2, 0x56, 0xfa, 0xc2, 0x58, 0xd5, 0x78, 0x61, 0xac, 0x8c,
-	0xed, 0x3f, 0xf5, 0x1c, 0xf7, 0xf5, 0xdc, 0xcc, 0x68, 0xb6, 0x5f, 0x2e, 0xbe, 0xfe, 0xeb, 0x14,
-	0x5b, 0x49, 0x76, 0xd8, 0x77, 0xed, 0xec, 0xb0, 0xa2, 0x6a, 0x2d, 0xed, 0x2f, 0xbd, 0x5d, 0x4c,
-	0x9f, 0x20, 0x8d, 0xe5, 0x1b, 0xb8, 0x63, 0x54, 0xd1, 0x8e, 0xb4, 0x4d, 0x01, 0x66, 0x29, 0xbe,
-	0x4c, 0x30, 0x7f, 0x96, 0x11, 0x4c, 0x49, 0x93, 0x9a, 0xb9, 0x77, 0x92, 0x69, 0x59, 0xb7, 0x53,
-	0xe7, 0x33, 0x2f, 0xbd, 0x89, 0xa0, 0xb5, 0xf5, 0xd2, 0xdb, 0xcf, 0x7b, 0xfb, 0xdd, 0x8c, 0x76,
-	0x0a, 0xb8, 0xc4, 0x82, 0xbf, 0x9a, 0xb3, 0xe0, 0x25, 0x95, 0xdb, 0x46, 0xa6, 0xf8, 0xfa, 0xf5,
-	0xfd, 0xde, 0x19, 0x28, 0x33, 0x32, 0xdf, 0xf3, 0x72, 0x77, 0x32, 0xf7, 0xd3, 0xbf, 0x41, 0xe9,
-	0xd5, 0xef, 0x32, 0xb7, 0xff, 0xfb, 0xb6, 0xdb, 0x5f, 0x52, 0x8b, 0x6e, 0xed, 0x0b, 0xde, 0x3e,
-	0x17, 0xc9, 0xc1, 0xb4, 0xc6, 0x62, 0x7b, 0x0a, 0x0a, 0x57, 0x63, 0xb2, 0x04, 0x4b, 0xae, 0x38,
-	0xd9, 0x12, 0x11, 0xe2, 0x1a, 0x53, 0xc5, 0xb2, 0x8d, 0xd5, 0x9f, 0xdb, 0x1b, 0xab, 0xd2, 0x96,
-	0xcd, 0xab, 0x3e, 0xf9, 0x9b, 0xec, 0x66, 0xfb, 0x9e, 0xdd, 0x7e, 0x89, 0x93, 0xf2, 0x17, 0xd9,
-	0x24, 0xb9, 0x4c, 0xad, 0xd6, 0x71, 0x6d, 0xe1, 0x3d, 0x79, 0xd0, 0x86, 0x7e, 0xc6, 0x72, 0xa9,
-	0xb2, 0xdc, 0xaa, 0x88, 0xe8, 0x74, 0x5f, 0xae, 0x91, 0x06, 0x04, 0xbe, 0x1d, 0x8a, 0x17, 0xc2,
-	0xfb, 0xf2, 0x4a, 0x79, 0x5a, 0xd6, 0x2f, 0x86, 0xd7, 0x0a, 0x5f, 0x0c, 0x9f, 0x27, 0x8d, 0x68,
-	0x53, 0xc6, 0x0b, 0xe4, 0x1d, 0x54, 0x55, 0x2e, 0x33, 0x45, 0x3f, 0xb0, 0x4d, 0x51, 0x51, 0xcf,
-	0xac, 0x73, 0x50, 0xf3, 0xd5, 0x58, 0x3c, 0x8e, 0x12, 0x6f, 0xd2, 0x7b, 0x62, 0x1f, 0xaa, 0xde,
-	0xa2, 0x3f, 0x43, 0xc8, 0xd2, 0xf6, 0xc6, 0x16, 0x4f, 0xa4, 0xbd, 0xc6, 0x47, 0x8b, 0x34, 0x04,
-	0x7c, 0x85, 0x8b, 0x5b, 0xf2, 0x96, 0x6d, 0xe5, 0xe2, 0x16, 0x94, 0x7b, 0x5b, 0xf2, 0xa4, 0xa2,
-	0xd2, 0xdb, 0x82, 0x0e, 0x5d, 0x1a, 0xf5, 0x27, 0xe3, 0x70, 0x94, 0xc8, 0x24, 0xcf, 0xb4, 0x0c,
-	0xb8, 0xa5, 0x20, 0xe6, 0xdd, 0x20, 0xb9, 0x83, 0x11, 0xb3, 0x19, 0x96, 0x96, 0xfd, 0xcf, 0x56,
-	0x88, 0x99, 0xcb, 0xbb, 0x8c, 0x8f, 0x57, 0xf7, 0xf8, 0x28, 0x0e, 0x93, 0x70, 0x87, 0x4b, 0x2e,
-	0xb3, 0x60, 0xe0, 0xf6, 0xe2, 0x64, 0xc2, 0x47, 0x7d, 0x30, 0xc4, 0xc8, 0x6d, 0x83, 0x19, 0x10,
-	0x58, 0xb9, 0x6f, 0x45, 0x61, 0xc2, 0xd7, 0xee, 0x44, 0x3c, 0xbe, 0x33, 0x1e, 0x88, 0x31, 0xaa,
-	0xb3, 0x0c, 0x94, 0x9e, 0x23, 0x4d, 0xc6, 0x83, 0xbe, 0x26, 0xab, 0x21, 0x99, 0x0d, 0xc4, 0xe7,
-	0xbf, 0x93, 0x71, 0x14, 0x6c, 0xf2, 0xe5, 0x60, 0x12, 0x6c, 0x84, 0xc9, 0x9e, 0x8c, 0x0a, 0x66,
-	0xc1, 0x69, 0x62, 0xe8, 0xf2, 0x9d, 0x20, 0x92, 0x5d, 0xd5, 0x00, 0x3a, 0x47, 0xaa, 0x6b, 0x89,
-	0x3a, 0xb9, 0x84, 0x9f, 0x78, 0x0f, 0x36, 0xd8, 0x8c, 0x91, 0x44, 0x5e, 0x91, 0xd1, 0x00, 0xff,
-	0x87, 0x5e, 0xf1, 0xab, 0x0a, 0x2e, 0x67, 0x8e, 0x4d, 0xa4, 0x51, 0xab, 0xb0, 0x09, 0xbe, 0x20,
-	0x19, 0x27, 0xe9, 0x9b, 0x92, 0x71, 0x62, 0x26, 0x55, 0xd7, 0xac, 0x17, 0xe2, 0x73, 0xaf, 0x12,
-	0x94, 0x68, 0xe0, 0x0f, 0x5d, 0x1a, 0x58, 0x96, 0x30, 0xf1, 0x6b, 0x1e, 0x99, 0x06, 0x1b, 0xbb,
-	0x3a, 0xc1, 0x5c, 0xbb, 0xd5, 0x89, 0x4c, 0x90, 0xaa, 0xac, 0x4e, 0x40, 0x31, 0x46, 0xfc, 0xae,
-	0x3a, 0x6b, 0xc3, 0x5b, 0xda, 0xaa, 0x9c, 0xff, 0x77, 0x0c, 0xe2, 0x7d, 0xac, 0xcc, 0xbf, 0x63,
-	0x38, 0x43, 0xc8, 0x15, 0x9e, 0xac, 0x4e, 0x44, 0x38, 0x56, 0x8c, 0x9e, 0x01, 0x49, 0x2f, 0x13,
-	0xd6, 0xed, 0x50, 0x6f, 0x7a, 0x99, 0x10, 0x16, 0x11, 0xe7, 0x5b, 0x18, 0xa5, 0x37, 0x58, 0xec,
-	0x53, 0x00, 0x39, 0x59, 0x8c, 0x53, 0x80, 0x92, 0x24, 0x81, 0x1f, 0xd9, 0x49, 0x02, 0xae, 0xa6,
-	0x9d, 0x27, 0x59, 0x8e, 0xe7, 0x38, 0xfe, 0x97, 0x8f, 0x32, 0xb2, 0x9d, 0x28, 0x59, 0x0f, 0x7f,
-	0xec, 0x3c, 0xc9, 0x72, 0xb0, 0xa8, 0xbb, 0xf2, 0x55, 0xaf, 0xe4, 0x49, 0x92, 0xf4, 0x96, 0x98,
-	0x87, 0x7c, 0xe3, 0xef, 0x82, 0xff, 0xe7, 0xa3, 0x33, 0xd0, 0xab, 0x66, 0x06, 0x7a, 0xd9, 0x6d,
-	0x99, 0x9f, 0xd8, 0xb7, 0x65, 0x0a, 0xb9, 0xd0, 0xcc, 0xfe, 0x4d, 0x85, 0x34, 0x2e, 0x87, 0x22,
-	0xc6, 0x01, 0x8a, 0x10, 0xf3, 0x97, 0xb6, 0xf9, 0x68, 0x83, 0xcb, 0x83, 0x8d, 0xb4, 0x0c, 0x3c,
-	0x0e, 0x30, 0x1b, 0x41, 0x3e, 0xb9, 0x8b, 0x05, 0x80, 0x0e, 0x79, 0xb4, 0xc9, 0xe5, 0xc2, 0x20,
-	0x0a, 0x18, 0x8e, 0xd8, 0x4d, 0xf8, 0x28, 0x51, 0x01, 0x62, 0x51, 0x42, 0x6a, 0xfc, 0xaf, 0x1e,
-	0x75, 0x71, 0xaf, 0x0a, 0x0b, 0x60, 0xa9, 0x63, 0x79, 0x4a, 0x39, 0x85, 0x70, 0x55, 0x04, 0x9b,
-	0xd1, 0x4f, 0x33, 0x81, 0x85, 0x2d, 0xd1, 0x00, 0x3c, 0xbb, 0x40, 0x9d, 0x02, 0xac, 0x78, 0x9a,
-	0x5e, 0x03, 0xa0, 0xd6, 0x61, 0x28, 0x3c, 0x3b, 0xf1, 0x10, 0x81, 0x2a, 0x22, 0x46, 0xe6, 0xe2,
-	0x12, 0x89, 0x11, 0x45, 0x5c, 0xaa, 0xc6, 0x77, 0x45, 0x12, 0xaf, 0x78, 0x70, 0x20, 0x2d, 0xc3,
-	0x24, 0xbd, 0x1d, 0x0e, 0x78, 0x2f, 0x7c, 0x99, 0x2f, 0xed, 0x81, 0x37, 0x7b, 0x40, 0x4c, 0x52,
-	0x0b, 0xe8, 0x7f, 0xdc, 0x73, 0xbd, 0x1a, 0x43, 0xdf, 0x40, 0x66, 0x94, 0x90, 0x95, 0x1b, 0x7c,
-	0x28, 0x4d, 0x27, 0x1f, 0xc8, 0x43, 0xcc, 0x94, 0xa2, 0x2c, 0xa2, 0xfd, 0x97, 0x76, 0x44, 0x3b,
-	0xdf, 0x56, 0x3a, 0xb4, 0x4b, 0xe4, 0x3d, 0x8d, 0x0b, 0x17, 0x9e, 0x42, 0xba, 0xff, 0x09, 0x00,
-	0x00, 0xff, 0xff, 0xbc, 0xac, 0x61, 0x99, 0x62, 0x6a, 0x00, 0x00,
+	// 7105 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x3d, 0x5b, 0x8c, 0x1d, 0xc9,
+	0x55, 0xea, 0xfb, 0x98, 0xb9, 0x53, 0x33, 0xd7, 0x1e, 0x97, 0x5f, 0xd7, 0xb3, 0xb6, 0x77, 0xdc,
+	0xeb, 0xcd, 0x3a, 0xbb, 0x89, 0x37, 0x3b, 0x4a, 0x36, 0x9b, 0x4d, 0xb2, 0x89, 0x67, 0xae, 0x1f,
+	0x37, 0xeb, 0xf1, 0x5c, 0xd7, 0x9d, 0xb5, 0x21, 0x09, 0x21, 0x3d, 0x73, 0xcb, 0xe3, 0xce, 0xdc,
+	0xd7, 0x76, 0xf7, 0xd8, 0x9e, 0x55, 0x50, 0x36, 0x89, 0x04, 0x09, 0x11, 0x42, 0x08, 0x91, 0x97,
+	0x20, 0x40, 0x5e, 0x90, 0x40, 0x80, 0x84, 0x84, 0x84, 0xb0, 0x09, 0xe4, 0x05, 0x28, 0x42, 0xfc,
+	0x21, 0xf1, 0xc5, 0x17, 0x5f, 0x08, 0x24, 0xf8, 0x01, 0x21, 0x81, 0x84, 0xce, 0xa9, 0xaa, 0xae,
+	0xaa, 0xee, 0xea, 0x1e, 0xdb, 0xc2, 0xfb, 0x35, 0xb7, 0xce, 0x39, 0x5d, 0x75, 0xea, 0xd4, 0xa9,
+	0x53, 0xa7, 0x4e, 0x9d, 0xaa, 0x21, 0x64, 0xc8, 0x93, 0xe0, 0xec, 0x24, 0x1a, 0x27, 0x63, 0x5a,
+	0xc7, 0x3f, 0xfe, 0x4f, 0xe7, 0x48, 0xad, 0x1d, 0x24, 0x01, 0xa5, 0xa4, 0xb6, 0xce, 0xa3, 0x61,
+	0xcb, 0x5b, 0xac, 0x9c, 0xa9, 0x31, 0xfc, 0x4d, 0x0f, 0x91, 0x7a, 0x67, 0xd4, 0xe7, 0x77, 0x5a,
+	0x15, 0x04, 0x8a, 0x02, 0x3d, 0x4e, 0x66, 0x56, 0x06, 0x3b, 0x71, 0xc2, 0xa3, 0x4e, 0xbb, 0x55,
+	0x45, 0x8c, 0x06, 0xd0, 0x47, 0x49, 0xfd, 0xca, 0xb8, 0xcf, 0xe3, 0x56, 0x6d, 0xb1, 0x7a, 0x66,
+	0x76, 0x69, 0xbf, 0x68, 0xee, 0x2c, 0xc0, 0x3a, 0xa3, 0x1b, 0x63, 0x26, 0xb0, 0xf4, 0x29, 0x32,
+	0x03, 0xcd, 0x6e, 0x04, 0x31, 0x8f, 0x5b, 0x75, 0x24, 0x3d, 0x28, 0x49, 0x15, 0x1c, 0xc9, 0x35,
+	0x15, 0xd4, 0xfc, 0x42, 0xcc, 0xa3, 0xb8, 0x35, 0x65, 0xd5, 0x0c, 0x30, 0x51, 0x33, 0x62, 0x81,
+	0xbd, 0xd5, 0xe0, 0x0e, 0xb6, 0xd7, 0x6e, 0x4d, 0x0b, 0xf6, 0x52, 0x00, 0x3d, 0x43, 0xf6, 0xaf,
+	0x06, 0x77, 0x7a, 0x37, 0x83, 0xa8, 0x7f, 0x31, 0x1a, 0xef, 0x4c, 0x3a, 0xed, 0x56, 0x03, 0x69,
+	0xb2, 0x60, 0x7a, 0x92, 0x10, 0x05, 0xea, 0xb4, 0x5b, 0x33, 0x48, 0x64, 0x40, 0xe8, 0xeb, 0x45,
+	0x0f, 0x44, 0x67, 0x89, 0xc5, 0x92, 0x82, 0x33, 0x4d, 0x01, 0xe4, 0xab, 0x5c, 0x91, 0xcf, 0xba,
+	0x65, 0xa3, 0x29, 0xa8, 0x4f, 0xe6, 0xa4, 0x4c, 0xbb, 0xc9, 0x95, 0x9d, 0x61, 0x6b, 0xdf, 0x62,
+	0xe5, 0x4c, 0x93, 0x59, 0x30, 0xfa, 0x24, 0x99, 0xea, 0x26, 0xd7, 0x42, 0x7e, 0xbb, 0xb5, 0x1f,
+	0xeb, 0x3b, 0x6a, 0x34, 0x7f, 0x56, 0x60, 0xce, 0x8f, 0x92, 0x68, 0x97, 0x49, 0x32, 0xa8, 0x14,
+	0xbf, 0xec, 0xf2, 0x08, 0x5a, 0x69, 0xcd, 0x2f, 0x7a, 0x50, 0xa9, 0x09, 0x93, 0x02, 0xc2, 0x91,
+	0x56, 0x02, 0x3a, 0x90, 0x0a, 0xc8, 0x04, 0x4b, 0x01, 0x21, 0xa8, 0xd3, 0x6e, 0xd1, 0x54, 0x40,
+	0x12, 0x02, 0xad, 0xad, 0x06, 0x77, 0xce, 0xdf, 0xe2, 0xa3, 0x64, 0x6d, 0xd2, 0xe9, 0xb7, 0x0e,
+	0x2e, 0x7a, 0x67, 0x6a, 0xcc, 0x82, 0x41, 0x6b, 0xeb, 0xc1, 0x36, 0x5f, 0xbb, 0xc5, 0xa3, 0xf3,
+	0xa3, 0x60, 0x63, 0xc0, 0xfb, 0xad, 0x43, 0x8b, 0xde, 0x99, 0x06, 0xcb, 0x82, 0xe9, 0xdb, 0x49,
+	0x73, 0x35, 0xdc, 0x8a, 0x82, 0x84, 0xe3, 0xd7, 0x71, 0xeb, 0xb0, 0xd5, 0x67, 0x13, 0x87, 0xb2,
+	0xb4, 0xa9, 0xa1, 0xa1, 0xe5, 0x60, 0x10, 0x8c, 0x36, 0x75, 0x43, 0x47, 0x44, 0x43, 0x19, 0xb0,
+	0x14, 0x40, 0x7b, 0x7c, 0x7b, 0xd4, 0x0b, 0x86, 0x93, 0x01, 0x68, 0xd1, 0x51, 0xe4, 0x3c, 0x0b,
+	0xa6, 0x4f, 0x90, 0xe9, 0x5e, 0x12, 0xf1, 0x60, 0x18, 0xb7, 0x5a, 0xc8, 0xcc, 0x01, 0xc9, 0x8c,
+	0x80, 0x22, 0x1b, 0x8a, 0x82, 0x2e, 0x92, 0x59, 0x50, 0x1e, 0x81, 0x69, 0xb7, 0x8e, 0x61, 0x95,
+	0x26, 0x48, 0x2a, 0xee, 0xca, 0x78, 0x34, 0xea, 0xf4, 0x5b, 0x0b, 0x88, 0xd7, 0x00, 0xfa, 0x1c,
+	0x99, 0xbd, 0xba, 0xc3, 0xa3, 0xdd, 0x4e, 0xbb, 0x33, 0x0a, 0x93, 0xd6, 0x43, 0xd8, 0xe0, 0x71,
+	0x73, 0xc4, 0x0d, 0xb4, 0x18, 0x76, 0xf3, 0x03, 0xda, 0x26, 0x4d, 0xc6, 0x27, 0x83, 0x70, 0x33,
+	0xc0, 0xf1, 0x8b, 0x5b, 0xc7, 0xb1, 0x86, 0x93, 0x66, 0x0d, 0x16, 0x81, 0xa8, 0xc3, 0xfe, 0x88,
+	0xbe, 0x8e, 0x1c, 0x00, 0x96, 0x77, 0x36, 0xe2, 0xcd, 0x28, 0x9c, 0x24, 0xe1, 0x78, 0xd4, 0x69,
+	0xb7, 0x4e, 0x20, 0xaf, 0x79, 0x04, 0x3d, 0x4d, 0x9a, 0xd0, 0x81, 0xab, 0x2b, 0x37, 0x83, 0xd1,
+	0x16, 0x08, 0xf2, 0x24, 0x52, 0xda, 0x40, 0x90, 0xcc, 0x95, 0x9d, 0xe1, 0xda, 0x0d, 0x9c, 0x58,
+	0x71, 0xeb, 0xe1, 0x45, 0xef, 0x4c, 0x9d, 0x99, 0x20, 0x18, 0x92, 0x4e, 0xdc, 0xbb, 0x7a, 0x39,
+	0x4c, 0xb8, 0x1a, 0xbc, 0x45, 0x31, 0x78, 0x19, 0x30, 0x7d, 0x82, 0x34, 0x7a, 0x2f, 0x0e, 0xc4,
+	0x24, 0x3b, 0xe5, 0x9e, 0x93, 0x29, 0x01, 0x5d, 0x20, 0x8d, 0xd5, 0xe0, 0xce, 0x6a, 0x9c, 0x74,
+	0xda, 0x2d, 0x1f, 0x39, 0x4b, 0xcb, 0xb4, 0x4b, 0x0e, 0xb5, 0xa3, 0xf1, 0x64, 0xc2, 0xfb, 0x62,
+	0x7c, 0xd6, 0x83, 0x68, 0x8b, 0x27, 0x71, 0xeb, 0x11, 0x4b, 0xee, 0x02, 0xb7, 0xca, 0x83, 0x78,
+	0x27, 0xe2, 0x43, 0xa5, 0x7a, 0xce, 0x2f, 0x17, 0xde, 0x45, 0x66, 0x8d, 0x39, 0x49, 0xe7, 0x49,
+	0x75, 0x9b, 0xef, 0xb6, 0xbc, 0x45, 0xef, 0xcc, 0x0c, 0x83, 0x9f, 0x60, 0xdf, 0x6e, 0x05, 0x83,
+	0x1d, 0xde, 0xaa, 0x2c, 0x7a, 0x26, 0xe3, 0xcb, 0x5d, 0x51, 0xad, 0xc0, 0x3e, 0x5b, 0x79, 0xc6,
+	0x5b, 0x78, 0x8e, 0xcc, 0x67, 0x47, 0xdb, 0x51, 0xe1, 0x21, 0xb3, 0xc2, 0x9a, 0xf9, 0xfd, 0x0b,
+	0x84, 0xe6, 0xc7, 0xda, 0x51, 0xc3, 0x6b, 0x6d, 0x96, 0x94, 0x85, 0x96, 0xdf, 0xc2, 0x28, 0xc7,
+	0x46, 0xb5, 0xfe, 0x5b, 0xc9, 0x9c, 0x89, 0xa2, 0x4f, 0x90, 0x29, 0xa9, 0x6c, 0x9e, 0x65, 0xe1,
+	0xcd, 0xb6, 0x99, 0x24, 0xf1, 0x3f, 0xee, 0xa5, 0x5f, 0x23, 0x84, 0xee, 0x23, 0x95, 0x4e, 0x1b,
+	0xd7, 0xa3, 0x26, 0xab, 0x74, 0xda, 0x62, 0xb8, 0xe4, 0xb2, 0x53, 0x41, 0x68, 0x5a, 0xa6, 0xa7,
+	0x48, 0xbd, 0xcb, 0x61, 0x6d, 0xa8, 0x62, 0x43, 0xb3, 0xb2, 0x21, 0x80, 0x31, 0x81, 0xa1, 0x47,
+	0xc8, 0x54, 0x2f, 0x09, 0x92, 0x1d, 0x58, 0x99, 0xe0, 0x63, 0x59, 0x4a, 0x17, 0xbe, 0xba, 0x5e,
+	0xf8, 0xfc, 0xc7, 0x49, 0x0d, 0x3e, 0xca, 0xb1, 0x40, 0x49, 0x8d, 0x8d, 0x07, 0x5c, 0x36, 0x8f,
+	0xbf, 0xfd, 0x53, 0x64, 0xba, 0x9b, 0xac, 0xdd, 0x1e, 0xf1, 0x08, 0x9a, 0x90, 0xeb, 0x8e, 0x58,
+	0x45, 0x65, 0xc9, 0x7f, 0xd9, 0x03, 0x4b, 0x0d, 0x83, 0x48, 0x4f, 0x93, 0x3a, 0xd2, 0x22, 0xc5,
+	0xec, 0xd2, 0x3e, 0xc5, 0xa8, 0xa8, 0x81, 0xd5, 0xd3, 0x8a, 0x24, 0xaf, 0x95, 0x2c, 0xaf, 0xdd,
+	0xa4, 0xd3, 0xc7, 0x55, 0xb7, 0xc9, 0xf0, 0x37, 0x8c, 0xda, 0x35, 0x1e, 0xb5, 0x6a, 0x38, 0xc6,
+	0xf0, 0x13, 0xb9, 0xbc, 0xd8, 0x69, 0xb7, 0xea, 0x68, 0xde, 0xf1, 0xb7, 0xff, 0x7a, 0xd2, 0x50,
+	0x8a, 0x44, 0x4f, 0x91, 0x5a, 0x7b, 0xa3, 0x9b, 0xc8, 0x41, 0x69, 0xa6, 0x2c, 0xa0, 0x96, 0x21,
+	0xca, 0xff, 0x58, 0x85, 0x34, 0xd4, 0xb2, 0x64, 0x48, 0xa1, 0xa6, 0xa4, 0x70, 0x69, 0x1c, 0x27,
+	0xc8, 0xdb, 0x0c, 0xc3, 0xdf, 0xb4, 0x45, 0xa6, 0x59, 0x77, 0xe5, 0x5c, 0xbf, 0x1f, 0x61, 0xb3,
+	0x33, 0x4c, 0x15, 0x01, 0xb3, 0xbe, 0xd2, 0xc5, 0x0f, 0xaa, 0x02, 0x23, 0x8b, 0x99, 0x11, 0xa9,
+	0xa6, 0xbd, 0x3c, 0x44, 0xea, 0x97, 0xd7, 0xc3, 0x21, 0x6f, 0x4d, 0x09, 0xb7, 0x03, 0x0b, 0xb0,
+	0xdc, 0x5c, 0x1c, 0xc7, 0x71, 0x38, 0xc1, 0x46, 0xa6, 0xb1, 0x6d, 0x03, 0x02, 0x46, 0xa2, 0xc7,
+	0xb7, 0x22, 0xbe, 0x15, 0x24, 0x5c, 0x56, 0xdb, 0x10, 0x76, 0x3b, 0x03, 0x4e, 0x47, 0x91, 0x20,
+	0x3b, 0xf8, 0x1b, 0xb8, 0x6c, 0x47, 0x41, 0x38, 0xe2, 0xfd, 0xd6, 0x2c, 0x9a, 0x16, 0x55, 0xf4,
+	0x39, 0x69, 0x28, 0xdb, 0x41, 0x1f, 0x26, 0x95, 0x2b, 0xa1, 0x1c, 0xba, 0xdc, 0xea, 0x5d, 0xb9,
+	0x12, 0x42, 0x97, 0xd0, 0x5e, 0xb7, 0xe5, 0x9c, 0x93, 0x25, 0xb0, 0x71, 0xe7, 0x06, 0xe1, 0x2d,
+	0x2e, 0x91, 0x55, 0x61, 0xfd, 0x0d, 0x90, 0xff, 0x8d, 0x2a, 0x99, 0x33, 0x3d, 0x1f, 0xe0, 0xf2,
+	0x4a, 0x30, 0xe4, 0xd8, 0xda, 0x0c, 0xc3, 0xdf, 0xf4, 0x69, 0x72, 0xa4, 0xcd, 0x6f, 0x04, 0x3b,
+	0x83, 0x84, 0xf1, 0x84, 0x8f, 0x60, 0x96, 0x75, 0xc7, 0x83, 0x70, 0x73, 0x57, 0x8e, 0x45, 0x01,
+	0x96, 0x5e, 0x22, 0x07, 0x6c, 0x50, 0xc8, 0xd5, 0x54, 0x59, 0x48, 0xe7, 0xa4, 0xf5, 0x09, 0xf6,
+	0x28, 0xff, 0x11, 0xd4, 0xb4, 0x32, 0x1e, 0x25, 0xe1, 0x68, 0x67, 0xbc, 0x13, 0x83, 0x0d, 0x0a,
+	0x53, 0x57, 0x4f, 0xd5, 0x64, 0xe3, 0x65, 0x4d, 0xb9, 0x8f, 0xc4, 0x82, 0x18, 0x6d, 0xb7, 0xf9,
+	0x80, 0x27, 0xbc, 0x8f, 0x5a, 0xd3, 0x60, 0x26, 0x88, 0x3e, 0x49, 0x1a, 0xb8, 0x00, 0x3c, 0xcf,
+	0x77, 0x5b, 0x53, 0x96, 0x01, 0x52, 0x60, 0xac, 0x3b, 0x25, 0xa2, 0xaf, 0x21, 0xfb, 0xc4, 0x42,
+	0xb0, 0x1e, 0x6c, 0x9d, 0x8b, 0xa2, 0x60, 0xb7, 0x35, 0x8d, 0xb5, 0x66, 0xa0, 0x60, 0x49, 0xa4,
+	0xa5, 0xb9, 0x82, 0x3a, 0x52, 0x65, 0x69, 0x19, 0x16, 0xf5, 0x35, 0x5c, 0xbf, 0xc0, 0xc3, 0xf0,
+	0x8c, 0x45, 0x7d, 0x6d, 0x23, 0x96, 0x08, 0xa6, 0x28, 0xfc, 0x6f, 0x79, 0xe4, 0x60, 0x46, 0x70,
+	0xbd, 0x09, 0xdf, 0x34, 0xc6, 0xce, 0x4b, 0xc7, 0x6e, 0x81, 0x34, 0xda, 0x3b, 0x11, 0x5a, 0x46,
+	0x54, 0x8e, 0x2a, 0x4b, 0xcb, 0xf4, 0x2c, 0xa1, 0xda, 0xf7, 0x4c, 0xa9, 0xaa, 0x48, 0xe5, 0xc0,
+	0x58, 0x1d, 0xa8, 0xe1, 0x2c, 0xd7, 0x1d, 0xf0, 0xc9, 0xdc, 0xf5, 0x20, 0x1a, 0xa6, 0xb5, 0xd4,
+	0xb1, 0x16, 0x0b, 0xe6, 0x7f, 0xa3, 0x4e, 0xf6, 0x67, 0x56, 0x2d, 0xa7, 0xbe, 0x3d, 0x45, 0x66,
+	0x94, 0x70, 0xc1, 0x14, 0x55, 0x8b, 0x86, 0x40, 0x53, 0xd1, 0x67, 0xc9, 0x54, 0x6f, 0xf3, 0x26,
+	0x1f, 0x06, 0x52, 0xbf, 0x7c, 0xe5, 0xa0, 0xd9, 0xcd, 0x9d, 0x15, 0x44, 0xd2, 0x3f, 0x15, 0x85,
+	0xac, 0x4a, 0xd4, 0xf2, 0x2a, 0xf1, 0x2c, 0x69, 0x86, 0xe0, 0x5e, 0x32, 0x3e, 0xd0, 0xbd, 0x9b,
+	0x5d, 0x3a, 0x24, 0x1b, 0xe9, 0x98, 0x38, 0x66, 0x93, 0x82, 0x01, 0x39, 0x3f, 0xda, 0x0a, 0x47,
+	0x7c, 0x7d, 0x77, 0xc2, 0x51, 0xa1, 0x9a, 0xcc, 0x80, 0xd0, 0x37, 0x93, 0xb9, 0x95, 0xf1, 0xa0,
+	0x97, 0x8c, 0x23, 0x9c, 0x80, 0xa8, 0x3b, 0xba, 0xbf, 0x26, 0x8a, 0x59, 0x84, 0xf4, 0x29, 0x42,
+	0xb4, 0x72, 0xa0, 0x42, 0x39, 0xb5, 0xc6, 0x20, 0xa2, 0x17, 0x08, 0x11, 0xfb, 0x88, 0xfe, 0x1d,
+	0x1e, 0xb7, 0x66, 0x50, 0x52, 0xaf, 0x29, 0x92, 0x54, 0x4a, 0x28, 0xa4, 0x65, 0x7c, 0x89, 0x9e,
+	0xd1, 0x28, 0x4c, 0x4c, 0xff, 0x89, 0xa0, 0xff, 0x94, 0x05, 0x4b, 0x23, 0x3e, 0x8b, 0x86, 0xa7,
+	0x82, 0x1b, 0xa1, 0x8c, 0x9e, 0xab, 0xa5, 0x28, 0xab, 0xe4, 0x0b, 0x6f, 0x21, 0xb3, 0xc6, 0x60,
+	0xed, 0xe5, 0x67, 0xd4, 0x4d, 0x3f, 0xe3, 0x79, 0xb2, 0x3f, 0xc3, 0xbd, 0xf9, 0x79, 0x4d, 0x7c,
+	0xee, 0xdb, 0x4e, 0xc6, 0x9c, 0x1a, 0x4b, 0xf8, 0xc6, 0xf4, 0x2e, 0xfe, 0xb3, 0x9e, 0x9b, 0x6c,
+	0x85, 0x8a, 0x6b, 0x4f, 0xb6, 0xca, 0x5d, 0x4d, 0xb6, 0xca, 0x5d, 0x4d, 0xb6, 0x8a, 0x35, 0xd9,
+	0x9e, 0x25, 0x73, 0xc6, 0x70, 0xa9, 0x9d, 0xec, 0x11, 0xf7, 0x48, 0x32, 0x8b, 0x96, 0xae, 0x92,
+	0xd9, 0xd5, 0x38, 0xb9, 0xc6, 0xa3, 0x18, 0x47, 0x61, 0x1f, 0x7e, 0xfa, 0x44, 0xb1, 0x39, 0x3e,
+	0x6b, 0x50, 0x4b, 0x07, 0xdf, 0x80, 0xd0, 0x37, 0x93, 0x59, 0xcd, 0xbc, 0xda, 0x24, 0x1f, 0x36,
+	0x67, 0xab, 0xd8, 0xb8, 0x01, 0x23, 0x26, 0x25, 0xec, 0xac, 0x4c, 0xbf, 0x3d, 0x6e, 0x4d, 0x5b,
+	0x3b, 0x2b, 0xcb, 0xa7, 0xc7, 0x9d, 0x95, 0x45, 0x9d, 0x9d, 0xb4, 0x8d, 0xfc, 0xa4, 0x5d, 0x24,
+	0xb3, 0x97, 0xc6, 0x49, 0x2a, 0xe9, 0x19, 0x94, 0xb4, 0x09, 0xca, 0xd9, 0x2c, 0x82, 0x24, 0x16,
+	0x0c, 0x86, 0x4d, 0x6f, 0x3f, 0x53, 0xca, 0x59, 0x31, 0x6c, 0x79, 0x0c, 0xc8, 0x43, 0x43, 0xe3,
+	0xd6, 0x9c, 0x25, 0x0f, 0x63, 0x23, 0x8b, 0xf2, 0x30, 0x28, 0xe9, 0x1a, 0x39, 0xa4, 0xb7, 0x79,
+	0x5a, 0xfc, 0xad, 0x26, 0xaa, 0xe7, 0x43, 0xca, 0x2d, 0x77, 0x90, 0x30, 0xe7, 0x87, 0xe0, 0xad,
+	0x67, 0x87, 0x6e, 0xaf, 0x59, 0xd4, 0x34, 0x15, 0x3f, 0x20, 0x07, 0x1d, 0x6b, 0xaa, 0x53, 0xef,
+	0x0f, 0x91, 0x3a, 0x12, 0x48, 0x7f, 0x40, 0x14, 0x60, 0x00, 0x2e, 0x07, 0x71, 0xc2, 0x76, 0x46,
+	0xe8, 0x56, 0x89, 0x75, 0xc5, 0x04, 0xf9, 0xff, 0xe3, 0x91, 0x7d, 0xb6, 0x8e, 0xe4, 0xbc, 0xbe,
+	0xe3, 0x64, 0xa6, 0x97, 0x04, 0x51, 0x82, 0x55, 0x88, 0x39, 0xa5, 0x01, 0xe0, 0x3f, 0x9d, 0x1f,
+	0xf5, 0x65, 0xf5, 0x80, 0x53, 0x45, 0xf8, 0x4e, 0x2a, 0xc2, 0xb9, 0x44, 0x3a, 0x7a, 0x1a, 0x40,
+	0xcf, 0x90, 0x29, 0x69, 0xb7, 0xc4, 0xd4, 0x99, 0x37, 0x15, 0x16, 0x65, 0x2a, 0xf1, 0xd0, 0x89,
+	0xf5, 0x68, 0x67, 0xb4, 0x19, 0x88, 0x9a, 0xa6, 0x44, 0x27, 0x0c, 0x50, 0xc6, 0xc0, 0x4f, 0xe7,
+	0x0c, 0x7c, 0x8b, 0x4c, 0xdf, 0x12, 0x83, 0xd0, 0x9a, 0x43, 0xa4, 0x2a, 0xfa, 0x9f, 0xaa, 0xc8,
+	0x85, 0xce, 0xd9, 0xf3, 0x93, 0xa4, 0x81, 0x6e, 0x79, 0xa7, 0x2d, 0x16, 0xc1, 0xe6, 0x72, 0xa5,
+	0xe5, 0xb1, 0x14, 0x06, 0x63, 0xb9, 0x1a, 0x0a, 0x0b, 0x32, 0xc3, 0xe0, 0x27, 0x42, 0x82, 0x3b,
+	0xd8, 0x5b, 0x80, 0x04, 0x77, 0x70, 0x97, 0x11, 0xf2, 0x28, 0xdd, 0x65, 0x84, 0x1c, 0x3d, 0x63,
+	0x15, 0x3d, 0x11, 0x9e, 0xae, 0x2a, 0x82, 0x59, 0xd7, 0x9a, 0x74, 0x99, 0xdf, 0xe2, 0x03, 0x74,
+	0x78, 0xab, 0x2c, 0x0b, 0x86, 0x99, 0x63, 0x85, 0x2a, 0x84, 0xcb, 0x6b, 0xc1, 0x84, 0x01, 0x0b,
+	0xfa, 0x6b, 0xa3, 0xc1, 0x6e, 0x6b, 0x06, 0xa7, 0x67, 0x5a, 0x16, 0x41, 0x1c, 0x35, 0x55, 0x71,
+	0xed, 0x68, 0x30, 0x03, 0xe2, 0x33, 0x32, 0x67, 0xae, 0xf4, 0x50, 0x57, 0xea, 0x93, 0xc1, 0xfe,
+	0x61, 0xc6, 0x70, 0xbf, 0xa0, 0x8f, 0x20, 0xf9, 0x8a, 0xf0, 0x7a, 0x50, 0xe6, 0x94, 0xd4, 0x7a,
+	0x5b, 0xa9, 0xc7, 0x8b, 0xbf, 0xfd, 0x63, 0xa4, 0x2e, 0x56, 0xaf, 0x79, 0x52, 0xed, 0xf4, 0xef,
+	0x60, 0x3d, 0x75, 0x06, 0x3f, 0xfd, 0xf7, 0x91, 0xf9, 0xac, 0xbd, 0x71, 0xea, 0x39, 0x25, 0xb5,
+	0xd5, 0x71, 0x9f, 0xab, 0x2d, 0x08, 0xfc, 0x46, 0x51, 0xf0, 0x38, 0x09, 0x47, 0x62, 0xf7, 0x89,
+	0xfe, 0xc7, 0x0c, 0xb3, 0x60, 0xfe, 0x69, 0xb9, 0xee, 0x96, 0xef, 0xd7, 0x3e, 0xe9, 0x91, 0x86,
+	0x0a, 0x2b, 0x16, 0x35, 0x7f, 0x29, 0x88, 0x6f, 0xa6, 0x3b, 0xa0, 0x20, 0xbe, 0x09, 0x53, 0xef,
+	0x5c, 0x7f, 0x28, 0xf5, 0xa0, 0xc1, 0x44, 0x01, 0x9a, 0x60, 0xb7, 0xa1, 0x2e, 0xe9, 0xcd, 0xc8,
+	0x12, 0x7d, 0x23, 0x21, 0xdd, 0x28, 0xbc, 0x15, 0x0e, 0xf8, 0x56, 0x1a, 0x00, 0x3d, 0x64, 0x44,
+	0x34, 0x53, 0x24, 0x33, 0xe8, 0xfc, 0x0e, 0x69, 0x5a, 0x48, 0x5c, 0xe7, 0xe4, 0xa6, 0x41, 0x32,
+	0x98, 0x96, 0x61, 0xe2, 0xa5, 0x84, 0xc8, 0x69, 0x9d, 0x69, 0x80, 0xff, 0x8a, 0x47, 0x9a, 0x96,
+	0xbb, 0x04, 0xa3, 0xc1, 0xc2, 0xbe, 0xdc, 0xed, 0xc2, 0x4f, 0x80, 0xac, 0x85, 0x7d, 0xa1, 0xf3,
+	0x0c, 0x7e, 0x42, 0x9d, 0xf8, 0x11, 0x4a, 0x44, 0x08, 0x58, 0x03, 0xe8, 0x1b, 0x08, 0xc1, 0xc2,
+	0xe5, 0x30, 0x4e, 0xd4, 0xae, 0x60, 0xde, 0xb4, 0xb8, 0x80, 0x60, 0x06, 0x0d, 0xf8, 0x5c, 0x58,
+	0x52, 0xae, 0x88, 0x1d, 0x09, 0x36, 0x51, 0xcc, 0x22, 0xf4, 0x4f, 0x49, 0x46, 0xa0, 0x1a, 0x8c,
+	0x53, 0xc3, 0x0f, 0xa9, 0x91, 0xa2, 0xe0, 0xf7, 0x49, 0x8b, 0x4d, 0xcc, 0x15, 0xf7, 0x42, 0xc8,
+	0x07, 0xfd, 0x18, 0x07, 0xf5, 0x12, 0x99, 0xcf, 0x2c, 0xce, 0x2a, 0x46, 0x71, 0x3c, 0xbf, 0x76,
+	0xeb, 0xef, 0x58, 0xee, 0x2b, 0x7f, 0x4c, 0x0e, 0x3b, 0x49, 0x61, 0x76, 0xaf, 0xc6, 0x89, 0xa1,
+	0x3a, 0xaa, 0x48, 0xdf, 0x46, 0x08, 0xcc, 0x0d, 0x41, 0x2b, 0xdd, 0x6a, 0x47, 0xb3, 0x9a, 0x86,
+	0x19, 0xf4, 0xfe, 0x8a, 0xd5, 0xa0, 0x46, 0x80, 0xaa, 0xc9, 0x2a, 0x85, 0x18, 0x64, 0xc9, 0x98,
+	0x96, 0x60, 0x41, 0xf0, 0xb7, 0xff, 0x89, 0x0a, 0x21, 0x3a, 0x4a, 0xe9, 0xd4, 0x71, 0x61, 0x05,
+	0x2b, 0xa9, 0x15, 0x7c, 0x23, 0x99, 0xea, 0x45, 0x9b, 0xab, 0xb8, 0x8d, 0xaf, 0xec, 0x19, 0x03,
+	0x93, 0xb4, 0xf0, 0x55, 0x9b, 0xc7, 0xf0, 0x55, 0xed, 0x6e, 0xbe, 0x12, 0xb4, 0xa0, 0xd6, 0x9d,
+	0x51, 0xc2, 0xa3, 0x5b, 0xc1, 0x00, 0x2d, 0x66, 0x95, 0xa5, 0x65, 0x18, 0xec, 0x36, 0x1f, 0x04,
+	0xbb, 0x68, 0x33, 0xab, 0x4c, 0x14, 0xa0, 0x07, 0xed, 0x70, 0x28, 0x7c, 0x97, 0x19, 0x86, 0xbf,
+	0xe9, 0x63, 0xa4, 0xbe, 0x12, 0x0c, 0x06, 0xe0, 0x92, 0xe7, 0xa3, 0xb3, 0x80, 0x61, 0x02, 0xef,
+	0x3f, 0x4d, 0x66, 0xb5, 0x30, 0xf0, 0x3b, 0x53, 0x23, 0x1c, 0x51, 0x5d, 0x81, 0xf7, 0x5f, 0x24,
+	0x87, 0x9d, 0xfd, 0x28, 0x74, 0x49, 0xd5, 0x54, 0xad, 0x64, 0xa6, 0xea, 0x19, 0xb2, 0x3f, 0xbb,
+	0xa1, 0x17, 0xab, 0x49, 0x16, 0xec, 0x5f, 0x56, 0xe3, 0x06, 0x9c, 0x43, 0x3b, 0xf0, 0x57, 0xb5,
+	0x83, 0xb0, 0x43, 0xa4, 0x8e, 0x03, 0xaf, 0x5c, 0x00, 0x2c, 0xa0, 0x75, 0x1a, 0x84, 0x41, 0x2c,
+	0xeb, 0x15, 0x05, 0xff, 0x5f, 0x3c, 0x7b, 0xcf, 0x03, 0xcb, 0x41, 0x37, 0x0a, 0x87, 0x41, 0xb4,
+	0xab, 0x0d, 0xbc, 0x01, 0x01, 0xa5, 0xee, 0x8d, 0xa3, 0x04, 0x90, 0x15, 0x44, 0xaa, 0x22, 0x2c,
+	0xcf, 0xdd, 0x68, 0x3c, 0xe1, 0x51, 0x82, 0x9f, 0x0a, 0xdb, 0x60, 0x82, 0xe8, 0x69, 0xd2, 0x54,
+	0xc5, 0x6b, 0xe8, 0xe8, 0xd4, 0x90, 0xc6, 0x06, 0xd2, 0x37, 0x90, 0x83, 0xe0, 0x36, 0xc8, 0x83,
+	0x8e, 0xcc, 0x2e, 0xd6, 0x85, 0x82, 0x5d, 0xff, 0xca, 0x78, 0x38, 0x09, 0x36, 0xa1, 0x94, 0xee,
+	0xed, 0xea, 0x2c, 0x03, 0xf5, 0x6f, 0x4b, 0x87, 0x50, 0x98, 0x10, 0x98, 0x2e, 0xeb, 0xe3, 0x6d,
+	0x3e, 0x8a, 0xa5, 0x13, 0x26, 0x4b, 0x20, 0x02, 0xfc, 0x15, 0xbe, 0xc4, 0xa3, 0x58, 0xae, 0x65,
+	0x06, 0xa4, 0x88, 0xc1, 0x6a, 0x21, 0x83, 0xfe, 0x33, 0xb6, 0x91, 0xa3, 0x67, 0x6c, 0xfd, 0xa2,
+	0x79, 0x6b, 0xa7, 0x14, 0xec, 0x0b, 0x07, 0xc8, 0xf4, 0xca, 0x78, 0x38, 0x0c, 0x46, 0x7d, 0xfa,
+	0x18, 0xa9, 0x25, 0xd0, 0x39, 0x18, 0xeb, 0x7d, 0xc6, 0xb6, 0x14, 0xb1, 0x67, 0xa1, 0x87, 0x0c,
+	0x09, 0xfc, 0x7f, 0x9e, 0x17, 0x13, 0x9e, 0x1e, 0x23, 0x87, 0x57, 0x22, 0x1e, 0x24, 0x5c, 0xe9,
+	0x99, 0x24, 0x9e, 0xaf, 0xd2, 0xa3, 0xe4, 0x60, 0x3b, 0x1a, 0x4f, 0xb2, 0x88, 0x1a, 0x5d, 0x24,
+	0xc7, 0xc5, 0x37, 0x19, 0xc5, 0x53, 0x14, 0x75, 0x7a, 0x92, 0x2c, 0xc0, 0xa7, 0x05, 0xf8, 0x29,
+	0x7a, 0x9a, 0x2c, 0xf6, 0x78, 0xe2, 0x0e, 0x44, 0x29, 0xaa, 0x69, 0x68, 0xe7, 0x85, 0x49, 0xbf,
+	0xb8, 0x9d, 0x06, 0x7d, 0x88, 0x1c, 0x15, 0x9c, 0x68, 0xbf, 0x54, 0x21, 0x67, 0x00, 0x29, 0x1c,
+	0x94, 0x3c, 0x92, 0xd0, 0xc3, 0xe4, 0x80, 0xf8, 0x12, 0xd6, 0x4a, 0x05, 0x6e, 0xd2, 0x83, 0x64,
+	0x3f, 0x30, 0x6e, 0x02, 0xf7, 0x01, 0xad, 0xe0, 0xc3, 0x04, 0xef, 0x07, 0xf9, 0xf4, 0x78, 0x92,
+	0xae, 0x96, 0x0a, 0x31, 0x4f, 0x29, 0xd9, 0x07, 0xbd, 0x0b, 0x92, 0x40, 0xc1, 0x0e, 0xd0, 0xe3,
+	0xa4, 0xd5, 0xe3, 0x09, 0xae, 0xf7, 0xb9, 0x2f, 0x28, 0x3d, 0x41, 0x8e, 0xc9, 0x7e, 0x18, 0x8e,
+	0x8d, 0x42, 0x1f, 0xc6, 0x9e, 0x44, 0xe3, 0x89, 0x0b, 0x79, 0x44, 0x8f, 0xa0, 0x3a, 0x18, 0x54,
+	0xa8, 0x96, 0x3d, 0xb8, 0x26, 0xea, 0x18, 0xa0, 0x44, 0x9f, 0xb2, 0xa8, 0x05, 0x40, 0x09, 0xb9,
+	0x65, 0x2b, 0x7c, 0x48, 0xa3, 0xb2, 0x5f, 0x1d, 0xa7, 0x47, 0x08, 0xed, 0xf1, 0x24, 0xfb, 0xc9,
+	0x09, 0x7a, 0x88, 0xcc, 0x23, 0xef, 0x30, 0x06, 0x0a, 0x7a, 0x12, 0x3a, 0x8c, 0x0e, 0xa4, 0xd4,
+	0x2d, 0x51, 0xa9, 0x42, 0x3f, 0x0c, 0x1d, 0x16, 0xdc, 0x69, 0x47, 0x4c, 0x21, 0x1f, 0x01, 0xe5,
+	0x81, 0x6f, 0x33, 0x4a, 0x61, 0x57, 0xf1, 0x18, 0x08, 0x5c, 0x89, 0x25, 0xb5, 0xbb, 0x0a, 0xfb,
+	0x14, 0x70, 0x75, 0x6e, 0x90, 0xf0, 0x48, 0xf9, 0xa5, 0x2b, 0xc3, 0xfe, 0xfc, 0x12, 0x0c, 0x34,
+	0x13, 0x4d, 0x86, 0xa3, 0x2d, 0x45, 0xfc, 0x46, 0x18, 0x68, 0xc9, 0x0d, 0x86, 0x38, 0x14, 0xe2,
+	0x4d, 0x80, 0x60, 0x7c, 0x32, 0x8e, 0x12, 0xb1, 0xfd, 0x50, 0x88, 0xa7, 0x41, 0x18, 0xdd, 0x68,
+	0x67, 0xc4, 0xc5, 0x6e, 0x51, 0xc1, 0xdf, 0x02, 0x1a, 0x0d, 0xac, 0x1b, 0x2c, 0xd9, 0x6c, 0x3f,
+	0x4b, 0x17, 0xc8, 0x11, 0x10, 0x97, 0x83, 0xe9, 0xb7, 0x02, 0xd3, 0x60, 0x3a, 0x58, 0x30, 0xd2,
+	0xba, 0xf3, 0x36, 0xda, 0x22, 0x87, 0xb0, 0x79, 0x65, 0x4a, 0x14, 0xe6, 0xed, 0x7a, 0x02, 0xe8,
+	0x9d, 0xab, 0x42, 0x3e, 0x07, 0x53, 0xd4, 0x10, 0x31, 0x98, 0x12, 0xd8, 0x6f, 0x28, 0xfc, 0x3b,
+	0xf4, 0x10, 0xc0, 0x70, 0x8a, 0xa0, 0xb8, 0x42, 0xbe, 0x13, 0xfa, 0x27, 0x84, 0x8b, 0x27, 0xa7,
+	0x0a, 0x7e, 0x0e, 0xe0, 0xe2, 0x23, 0x0b, 0xbe, 0xac, 0x25, 0x28, 0x0e, 0x10, 0x14, 0x62, 0x05,
+	0x3e, 0x60, 0x7c, 0x38, 0xbe, 0x65, 0x7f, 0xd0, 0xa6, 0xa7, 0xc8, 0x09, 0xa9, 0xb9, 0x99, 0xcd,
+	0xb2, 0x22, 0x39, 0x4f, 0x1f, 0x26, 0x0f, 0xa1, 0x79, 0x2a, 0x20, 0xb8, 0x00, 0x3d, 0xbc, 0xc8,
+	0x93, 0x22, 0xfc, 0x45, 0x63, 0x76, 0x6c, 0x88, 0x43, 0x37, 0x85, 0xba, 0x44, 0x5f, 0x4b, 0x1e,
+	0xbd, 0x08, 0xca, 0x6c, 0xad, 0xd8, 0xd7, 0xc3, 0xe4, 0x66, 0x08, 0x75, 0x71, 0x96, 0xca, 0xb1,
+	0x03, 0xda, 0x68, 0xc8, 0xd1, 0xd8, 0x53, 0x19, 0xfd, 0x7c, 0x17, 0x08, 0x00, 0x06, 0x7e, 0x3d,
+	0xd8, 0xe6, 0xe3, 0x5b, 0x5a, 0xcc, 0xcf, 0x2b, 0x84, 0x3a, 0x60, 0x56, 0x88, 0xcb, 0x80, 0x90,
+	0x26, 0x41, 0x2c, 0xe5, 0x12, 0xb1, 0x0a, 0x4a, 0x8a, 0x13, 0xca, 0x02, 0x5f, 0xa1, 0x3e, 0x39,
+	0x99, 0x67, 0x19, 0x17, 0x6d, 0x45, 0xb3, 0x06, 0x3d, 0xbe, 0xc6, 0xa3, 0xf0, 0xc6, 0x6e, 0x76,
+	0xfa, 0x76, 0xa1, 0xb9, 0xf3, 0x77, 0x26, 0xc1, 0xa8, 0x6f, 0xab, 0xec, 0x55, 0x50, 0x48, 0x35,
+	0x74, 0x32, 0x3a, 0xa1, 0x70, 0x0c, 0xea, 0x03, 0x09, 0x2f, 0x2f, 0x47, 0x21, 0xbf, 0x61, 0x76,
+	0xb8, 0x27, 0x85, 0x6f, 0x7a, 0xd6, 0x26, 0x7e, 0x1d, 0x66, 0x02, 0xe3, 0x5b, 0x21, 0xac, 0x81,
+	0xf2, 0x94, 0x72, 0xed, 0xc6, 0x8d, 0x98, 0xa7, 0x2a, 0xf0, 0x82, 0x5e, 0x65, 0x32, 0x71, 0x0d,
+	0x45, 0x71, 0x0d, 0x6d, 0xea, 0x8b, 0x83, 0x25, 0xb0, 0x39, 0x97, 0x78, 0x10, 0x25, 0x1b, 0x3c,
+	0x48, 0xbf, 0xbf, 0x8e, 0xdf, 0xdb, 0x5f, 0x8a, 0xb9, 0xaa, 0x28, 0x7e, 0x46, 0x8a, 0x2c, 0x43,
+	0x74, 0x99, 0x1b, 0x6b, 0xdd, 0xcf, 0xaa, 0x95, 0xac, 0x80, 0x87, 0x77, 0x83, 0x16, 0x5e, 0x19,
+	0x27, 0xe1, 0x8d, 0xdd, 0x95, 0xab, 0xe2, 0x4b, 0x3c, 0xb1, 0x4e, 0x2d, 0xdd, 0x7b, 0x40, 0x93,
+	0x7b, 0x3c, 0xc1, 0x49, 0x64, 0x1f, 0x31, 0x29, 0x92, 0xf7, 0x0a, 0xb3, 0x03, 0x93, 0xc0, 0x1c,
+	0x92, 0x9f, 0x83, 0xee, 0xa9, 0xe5, 0x2f, 0x3d, 0x2f, 0x55, 0xd8, 0xf7, 0x81, 0x05, 0xd5, 0xf3,
+	0x73, 0x7d, 0x38, 0xc1, 0x39, 0xae, 0xd0, 0x3f, 0x0f, 0x56, 0x41, 0xaa, 0x8f, 0x38, 0xc9, 0x56,
+	0x98, 0xf7, 0x1b, 0x13, 0x5f, 0x60, 0x6c, 0x6e, 0x02, 0x98, 0x92, 0x9d, 0x51, 0xcc, 0xa3, 0xe4,
+	0x42, 0x38, 0xe0, 0x29, 0x7c, 0x43, 0xb3, 0xe3, 0xb0, 0x4d, 0x5c, 0x98, 0xf9, 0x20, 0x1c, 0x99,
+	0x6d, 0xdd, 0x78, 0xbc, 0xd1, 0xe8, 0xcf, 0xbf, 0xfc, 0xf2, 0xcb, 0x2f, 0x57, 0xfc, 0x7f, 0xa8,
+	0x14, 0x38, 0x1a, 0x4e, 0x3f, 0xb8, 0x9d, 0xf7, 0x75, 0x45, 0xe0, 0xb7, 0xec, 0x24, 0x2a, 0xfb,
+	0x09, 0x78, 0x69, 0x2a, 0x08, 0xbb, 0x33, 0x44, 0xe7, 0xab, 0xc9, 0x0c, 0x08, 0x7d, 0x94, 0x54,
+	0x7b, 0xdb, 0x21, 0x6e, 0xba, 0x0b, 0xce, 0x2c, 0x00, 0xef, 0x38, 0x31, 0xaa, 0x3b, 0x4f, 0x8c,
+	0xee, 0xe5, 0x54, 0x68, 0xe9, 0x02, 0x99, 0xde, 0x94, 0x02, 0xd8, 0x67, 0xbb, 0x69, 0xad, 0x2d,
+	0xfc, 0x58, 0x6d, 0x82, 0x9c, 0x42, 0x63, 0xea, 0x63, 0x7f, 0xec, 0x74, 0xd2, 0x5c, 0x42, 0x5d,
+	0x6a, 0x17, 0x37, 0x79, 0xd3, 0x12, 0xae, 0xa3, 0x42, 0xdd, 0xe0, 0xbf, 0x79, 0xe5, 0xde, 0x5f,
+	0x69, 0xb8, 0xc1, 0x39, 0xae, 0x95, 0x7b, 0x1d, 0x57, 0x8c, 0x16, 0x0a, 0xd7, 0xb1, 0x2b, 0x23,
+	0x29, 0x1a, 0xb0, 0xb4, 0x5a, 0xdc, 0xcd, 0x10, 0xbb, 0xf9, 0x88, 0x25, 0x59, 0x77, 0x2f, 0x74,
+	0x7f, 0x3f, 0xe3, 0x95, 0xf9, 0xb2, 0xa5, 0xbd, 0x55, 0x83, 0x50, 0x31, 0x06, 0xe1, 0xf9, 0x62,
+	0xee, 0x3e, 0x80, 0xdc, 0x9d, 0x32, 0x06, 0x61, 0x2f, 0xde, 0xbe, 0xe4, 0xed, 0xed, 0x47, 0xdf,
+	0x33, 0x87, 0x57, 0x8b, 0x39, 0xdc, 0x46, 0x0e, 0x1f, 0x53, 0x33, 0x65, 0x8f, 0x96, 0x35, 0x9f,
+	0xdf, 0xae, 0x96, 0x7b, 0xf2, 0xf7, 0xca, 0x23, 0x6c, 0x31, 0xaf, 0xf0, 0xdb, 0x32, 0xc0, 0x84,
+	0xf9, 0x02, 0xb2, 0x68, 0x1d, 0xea, 0xd4, 0x32, 0x27, 0xa8, 0xe6, 0x21, 0x4d, 0x3d, 0x73, 0x22,
+	0xea, 0x3e, 0xf0, 0x99, 0x2a, 0x3c, 0x5d, 0xc5, 0x13, 0x8d, 0x6d, 0x2e, 0x05, 0x80, 0x91, 0x57,
+	0x3c, 0xd1, 0x48, 0x41, 0xf9, 0x13, 0x0d, 0x6f, 0xef, 0x13, 0x0d, 0xef, 0xae, 0x4f, 0x34, 0x3c,
+	0xf7, 0x89, 0x46, 0x99, 0xf6, 0x0f, 0x2c, 0xed, 0x2f, 0x1b, 0x0f, 0x3d, 0x72, 0xbf, 0x52, 0x29,
+	0xdc, 0x61, 0x95, 0x0e, 0xda, 0x11, 0x32, 0x65, 0x25, 0x1d, 0x4c, 0xe9, 0xa9, 0x0b, 0x2e, 0x6c,
+	0x9c, 0x04, 0xc3, 0x89, 0x3c, 0x04, 0xd0, 0x00, 0x3c, 0x3e, 0x80, 0x66, 0x30, 0x0a, 0x5e, 0x13,
+	0x69, 0x99, 0x29, 0x20, 0x13, 0xba, 0xaf, 0xbb, 0x42, 0xf7, 0xd2, 0x43, 0x41, 0xf9, 0x34, 0x99,
+	0x2a, 0x2e, 0x5d, 0x2a, 0x16, 0xca, 0x10, 0x85, 0x72, 0xd2, 0x32, 0x09, 0xb9, 0xae, 0x6a, 0x79,
+	0xfc, 0xb7, 0x57, 0xb8, 0xa9, 0xbc, 0x2f, 0x79, 0xf8, 0x32, 0x74, 0xae, 0xd2, 0x28, 0x45, 0xaa,
+	0xac, 0x05, 0xb3, 0x0f, 0x47, 0x84, 0x46, 0x1a, 0x87, 0x23, 0x27, 0x09, 0x11, 0x85, 0xf4, 0x40,
+	0xa3, 0xce, 0x0c, 0x48, 0x59, 0xdf, 0x47, 0x56, 0xdf, 0x0b, 0xba, 0xa5, 0xfb, 0xfe, 0x35, 0xcf,
+	0xb1, 0x67, 0x7e, 0x30, 0xa1, 0xef, 0xa5, 0xe5, 0x62, 0xae, 0x5f, 0x44, 0xae, 0x5b, 0xd6, 0x88,
+	0x19, 0x0c, 0x69, 0x7e, 0xb7, 0x72, 0x7b, 0x79, 0xe7, 0xb2, 0xf8, 0xce, 0xe2, 0xa6, 0x22, 0x6c,
+	0xea, 0x88, 0x61, 0x91, 0x9d, 0x0d, 0x7d, 0xc8, 0x11, 0x1f, 0xb8, 0x5b, 0xb9, 0x94, 0xf5, 0x34,
+	0xb6, 0x7a, 0x9a, 0x6b, 0x42, 0x33, 0xf0, 0x75, 0xcf, 0x19, 0x8a, 0x00, 0x8d, 0x04, 0xfa, 0x91,
+	0xe6, 0x23, 0x2d, 0x97, 0x86, 0x1a, 0xad, 0x53, 0x81, 0x6a, 0xe6, 0x54, 0xa0, 0xcc, 0x8f, 0x48,
+	0x2c, 0x3f, 0xc2, 0xc1, 0x92, 0xe6, 0x39, 0xca, 0x06, 0x49, 0xe8, 0xc3, 0x22, 0xcb, 0x5c, 0xa6,
+	0x4e, 0xcd, 0x1a, 0x39, 0x99, 0x0c, 0x11, 0x4b, 0xef, 0x28, 0x6e, 0x78, 0x07, 0x1b, 0x3e, 0x6c,
+	0xac, 0x4c, 0xba, 0x62, 0xdd, 0xe6, 0xa7, 0xbc, 0xe2, 0x28, 0x4c, 0xa9, 0xb0, 0x52, 0xe5, 0xad,
+	0x18, 0xca, 0xbb, 0xd4, 0x29, 0xe6, 0xe7, 0x16, 0xf2, 0xf3, 0xb0, 0xe6, 0xc7, 0xd9, 0xa6, 0x65,
+	0x57, 0x8a, 0x23, 0x40, 0x0f, 0x2e, 0x54, 0x9c, 0x9e, 0x91, 0xd5, 0x4a, 0xce, 0xc8, 0xea, 0xf9,
+	0x33, 0xb2, 0xa5, 0x77, 0x15, 0x77, 0x7d, 0x17, 0xbb, 0xbe, 0x68, 0x5b, 0xd4, 0x7c, 0xa7, 0x74,
+	0xdf, 0xbf, 0xe7, 0x15, 0x86, 0xb7, 0x1e, 0x5c, 0xcf, 0xcb, 0xec, 0xe2, 0x4b, 0xb6, 0x5d, 0x74,
+	0xb3, 0xa6, 0xf9, 0xff, 0x91, 0x57, 0x10, 0x81, 0x03, 0x4e, 0x2f, 0xad, 0xaf, 0x77, 0x31, 0x19,
+	0x51, 0xaa, 0x94, 0x2a, 0x9b, 0xc9, 0x90, 0x42, 0xf8, 0x99, 0x64, 0x48, 0xc4, 0x88, 0xee, 0xa9,
+	0x22, 0x26, 0x25, 0x02, 0x83, 0x62, 0x95, 0xc0, 0xdf, 0x65, 0x1b, 0x89, 0x0f, 0x3a, 0x36, 0x12,
+	0x19, 0x16, 0x75, 0x2f, 0xbe, 0xe2, 0x15, 0x04, 0x0b, 0xf7, 0xea, 0x45, 0x09, 0xaf, 0x99, 0x04,
+	0xca, 0x32, 0x5e, 0x7f, 0xa1, 0x60, 0xd3, 0xe3, 0xe4, 0xf5, 0x3a, 0x69, 0x2a, 0x1c, 0xc6, 0x8d,
+	0xd2, 0x6c, 0x53, 0x60, 0x6f, 0x4e, 0x66, 0x9b, 0x1e, 0x27, 0x33, 0x88, 0x34, 0xce, 0xb5, 0x34,
+	0x40, 0xe7, 0x8f, 0x56, 0x8d, 0xfc, 0x51, 0x7f, 0x5c, 0x10, 0xfa, 0xcc, 0x1e, 0xf7, 0x97, 0xf5,
+	0xe4, 0x43, 0x56, 0x4f, 0x9c, 0xd5, 0xe9, 0x9e, 0x4c, 0x0a, 0x02, 0xaa, 0xb9, 0x06, 0x2f, 0x16,
+	0x37, 0xf8, 0xb2, 0xe7, 0x68, 0xb1, 0x50, 0x76, 0x17, 0xc0, 0x09, 0x8e, 0x27, 0xe3, 0x51, 0x8c,
+	0xc7, 0x77, 0x6b, 0xcf, 0x63, 0x23, 0x0d, 0x56, 0x59, 0x7b, 0x1e, 0x84, 0x72, 0x3e, 0x8a, 0xc6,
+	0x91, 0x3c, 0xd1, 0x10, 0x05, 0x7d, 0xc3, 0x47, 0x9c, 0xcf, 0x8b, 0x82, 0xff, 0x7d, 0xcf, 0x15,
+	0xf0, 0x7d, 0x55, 0x54, 0xbe, 0x64, 0x01, 0xfa, 0xb0, 0x90, 0xc5, 0x31, 0x6d, 0x78, 0x0b, 0x45,
+	0x7f, 0x23, 0x1f, 0x98, 0xce, 0x49, 0xbd, 0x64, 0x71, 0xfe, 0x88, 0x68, 0xe9, 0xa8, 0x69, 0x25,
+	0x8c, 0xaa, 0x74, 0x3b, 0x1f, 0x2c, 0x09, 0x75, 0x3b, 0x1d, 0x92, 0x92, 0x2d, 0xe2, 0x47, 0x3d,
+	0xcb, 0xb8, 0x16, 0xd6, 0xab, 0x5b, 0xff, 0x5b, 0xaf, 0x30, 0x94, 0x8e, 0x07, 0x75, 0x22, 0xf3,
+	0x0e, 0xdb, 0xaf, 0x32, 0x55, 0x04, 0x8c, 0xc8, 0x5c, 0xe9, 0xcb, 0x99, 0xa3, 0x8a, 0xe0, 0xb0,
+	0xb5, 0x37, 0xe4, 0xc6, 0x0b, 0x1d, 0x59, 0x51, 0x42, 0x47, 0x6e, 0x82, 0x70, 0x31, 0xb4, 0xb2,
+	0x54, 0xb6, 0x46, 0xfe, 0x92, 0x67, 0xd9, 0xd9, 0x02, 0x2e, 0x75, 0x57, 0xbe, 0xec, 0xed, 0x1d,
+	0xf8, 0xbf, 0xe7, 0xdd, 0x2e, 0x2b, 0xe6, 0xef, 0x13, 0x9e, 0xb5, 0xdd, 0xdd, 0xab, 0x69, 0xcd,
+	0xe8, 0x3f, 0x56, 0x8b, 0xcf, 0x1e, 0x50, 0x80, 0xcb, 0xc6, 0x98, 0xcb, 0x92, 0x21, 0xc0, 0x8a,
+	0x29, 0xc0, 0x94, 0xe9, 0xaa, 0xb1, 0x02, 0xde, 0x65, 0xe0, 0xea, 0x34, 0xa9, 0x74, 0x58, 0x69,
+	0xf6, 0x6b, 0xa5, 0xc3, 0x1e, 0x5c, 0xca, 0xeb, 0x12, 0x21, 0xe2, 0xc0, 0x04, 0x3f, 0x6b, 0x58,
+	0xe7, 0x98, 0x78, 0xe0, 0x2c, 0xb0, 0xcc, 0xa0, 0x32, 0x33, 0x4e, 0x67, 0x4a, 0x33, 0x4e, 0xef,
+	0x3e, 0xab, 0xb5, 0xcc, 0x57, 0xf9, 0x0d, 0xcf, 0xf2, 0xd3, 0x8a, 0x06, 0x4d, 0x0f, 0xed, 0x0f,
+	0xbc, 0xfc, 0xc1, 0xd1, 0xab, 0x38, 0xa4, 0x65, 0x06, 0xe9, 0x93, 0xb6, 0x41, 0xca, 0x72, 0xa9,
+	0xfb, 0xf0, 0xd3, 0xd4, 0x24, 0xb4, 0x37, 0xba, 0x89, 0x15, 0xfe, 0xc5, 0x03, 0xef, 0x20, 0xde,
+	0xd6, 0xd9, 0x4e, 0xa2, 0x94, 0x66, 0x41, 0xf5, 0x65, 0xb2, 0x87, 0x2c, 0x81, 0xc1, 0x6c, 0x2f,
+	0xcb, 0x8e, 0x54, 0xda, 0xcb, 0x50, 0xee, 0xae, 0xcb, 0x0c, 0xd8, 0x4a, 0x77, 0x5d, 0xaf, 0x28,
+	0x75, 0x63, 0x45, 0x29, 0x33, 0x0a, 0x9f, 0x72, 0x19, 0x85, 0x1c, 0x9f, 0xba, 0x33, 0xff, 0xee,
+	0x39, 0xce, 0xec, 0xf6, 0xda, 0x8a, 0x3b, 0x47, 0xe5, 0x2e, 0xb7, 0xe2, 0xbd, 0xc9, 0x20, 0x14,
+	0xf9, 0x8d, 0x32, 0x4f, 0x31, 0x05, 0xd0, 0x45, 0x99, 0x5d, 0xbb, 0x3c, 0xde, 0x19, 0xf5, 0x95,
+	0xdf, 0x6c, 0x82, 0x96, 0x56, 0x8a, 0x3b, 0xfe, 0x69, 0xcf, 0xda, 0xed, 0xe5, 0xfa, 0xa4, 0xbb,
+	0xfc, 0xaf, 0x9e, 0xf3, 0x3c, 0xf2, 0xbe, 0x3a, 0xbd, 0x48, 0x66, 0x0d, 0x75, 0x97, 0x03, 0x69,
+	0x82, 0xe8, 0x33, 0xa4, 0x89, 0x93, 0x75, 0x7d, 0x2c, 0x66, 0x87, 0x4c, 0xd9, 0x72, 0x4d, 0x64,
+	0x9b, 0x70, 0xe9, 0x7c, 0x71, 0x67, 0x3f, 0xe3, 0x59, 0x1b, 0x45, 0x47, 0x6f, 0x74, 0x77, 0x3b,
+	0x64, 0xd6, 0x68, 0x04, 0x86, 0x00, 0x8b, 0xc6, 0x7c, 0xd3, 0x80, 0x14, 0x9b, 0x3a, 0x7d, 0x75,
+	0xa6, 0x01, 0xfe, 0x75, 0x99, 0x10, 0xe6, 0xcc, 0xe0, 0x5c, 0xc8, 0x66, 0x70, 0x1a, 0xd9, 0x9b,
+	0x76, 0x06, 0x64, 0x35, 0x97, 0x01, 0xf9, 0x43, 0x8f, 0xec, 0xb3, 0xd3, 0x85, 0x5f, 0xa5, 0xd4,
+	0xd8, 0xc7, 0x65, 0x7a, 0x28, 0xcf, 0xe6, 0xc6, 0xa6, 0xfd, 0x64, 0x8a, 0x60, 0x2f, 0x43, 0xef,
+	0x7f, 0xd8, 0x93, 0xfa, 0x2b, 0xaf, 0x40, 0xa5, 0xee, 0x81, 0xea, 0x86, 0x2a, 0xa6, 0x71, 0xba,
+	0x5e, 0xf8, 0x12, 0x97, 0x06, 0x41, 0x03, 0x70, 0x1a, 0xe0, 0xf5, 0x9d, 0x95, 0xf1, 0x8e, 0xd4,
+	0xa9, 0x3a, 0x33, 0x41, 0x98, 0xf6, 0x16, 0xdc, 0x31, 0x26, 0x91, 0x2a, 0xfa, 0xef, 0x21, 0x4d,
+	0x36, 0x31, 0x99, 0xd0, 0x8a, 0xeb, 0x59, 0x8a, 0xbb, 0x24, 0x93, 0x34, 0x81, 0x2c, 0x96, 0x87,
+	0x08, 0xd4, 0x34, 0x9b, 0xe2, 0x7b, 0x66, 0x50, 0xf9, 0xef, 0x27, 0xa4, 0xbd, 0xac, 0x2c, 0x89,
+	0x34, 0x5d, 0x5e, 0x6a, 0xba, 0xc4, 0xbd, 0x39, 0x75, 0x6d, 0x10, 0x7f, 0xd3, 0xb3, 0x64, 0x9a,
+	0x4d, 0x44, 0x13, 0x55, 0x2b, 0xfd, 0xd2, 0x62, 0x92, 0x29, 0x22, 0xff, 0xd7, 0x3d, 0x72, 0xd4,
+	0xcc, 0x08, 0xb8, 0x3c, 0x0e, 0x52, 0xdf, 0x52, 0xdc, 0xae, 0x5b, 0x07, 0xc2, 0x4c, 0xd2, 0x98,
+	0x66, 0x8a, 0xa5, 0x24, 0x65, 0x36, 0xf2, 0xb3, 0xb6, 0x8d, 0x2c, 0x68, 0x50, 0xcf, 0xa0, 0x9f,
+	0x78, 0xee, 0x6c, 0x75, 0xfa, 0x06, 0x95, 0xfc, 0xe6, 0x59, 0x97, 0xb3, 0x34, 0xed, 0xda, 0x84,
+	0x47, 0x41, 0x32, 0x8e, 0x62, 0x99, 0x05, 0x47, 0x2f, 0x12, 0x9a, 0xa9, 0x29, 0xe4, 0x2a, 0x3d,
+	0xf1, 0x68, 0x41, 0xd6, 0x3b, 0x73, 0x7c, 0x62, 0xc5, 0xe9, 0xab, 0x99, 0xcb, 0x17, 0x7a, 0x11,
+	0x12, 0x17, 0x16, 0x65, 0xc9, 0xff, 0x20, 0x99, 0xcf, 0xd6, 0x4d, 0x5f, 0x43, 0xf6, 0xa9, 0xf3,
+	0x76, 0x99, 0x0b, 0x28, 0x5c, 0xd9, 0x0c, 0x14, 0xac, 0x3b, 0x28, 0x58, 0x4a, 0x25, 0x66, 0xa0,
+	0x05, 0x03, 0xb5, 0xbe, 0x1e, 0x24, 0x3c, 0x82, 0x89, 0xad, 0x82, 0xd3, 0x29, 0xc0, 0xef, 0x90,
+	0x83, 0x0e, 0xc1, 0x00, 0xb3, 0xe7, 0xb6, 0xb6, 0xd6, 0x26, 0x69, 0x46, 0xa5, 0x28, 0x29, 0x6b,
+	0x6c, 0xec, 0x3e, 0xd3, 0xb2, 0xff, 0x21, 0x72, 0xdc, 0x35, 0x1e, 0xd7, 0xc3, 0xe4, 0x66, 0x7b,
+	0x83, 0x4d, 0xe8, 0x93, 0xa4, 0x86, 0x3e, 0x93, 0x88, 0x84, 0x95, 0xde, 0x26, 0x40, 0x42, 0xc3,
+	0x2b, 0xaf, 0x14, 0x78, 0xe5, 0x55, 0x73, 0xf6, 0xf8, 0xef, 0x21, 0x27, 0xf3, 0x63, 0x62, 0xb1,
+	0xf0, 0x16, 0x3b, 0xff, 0xec, 0x91, 0x12, 0x1e, 0xd4, 0x37, 0x2a, 0x21, 0x6d, 0x9d, 0x2c, 0x64,
+	0x72, 0x21, 0x84, 0x7d, 0x17, 0x89, 0x93, 0x4f, 0xdb, 0x15, 0x2f, 0x9a, 0x73, 0xd6, 0xf5, 0x85,
+	0xaa, 0x75, 0x4c, 0x8e, 0x15, 0xd2, 0xd0, 0xd7, 0x91, 0x7a, 0xa7, 0x0f, 0x0b, 0x98, 0x90, 0xd8,
+	0x11, 0xeb, 0x82, 0x00, 0x20, 0xc2, 0x1b, 0x21, 0x8f, 0x98, 0x20, 0xa2, 0xa7, 0x49, 0xd3, 0x48,
+	0x91, 0xbf, 0xa5, 0x94, 0xc1, 0x06, 0xfa, 0xbf, 0xec, 0xb9, 0x92, 0x78, 0xc0, 0x8a, 0x6a, 0x97,
+	0x40, 0xee, 0x9d, 0x0d, 0x48, 0x9a, 0x12, 0x2b, 0xef, 0x50, 0x95, 0x6d, 0x56, 0x7f, 0xcb, 0xde,
+	0xac, 0xe6, 0x1b, 0xd3, 0x53, 0xf8, 0xc7, 0x5e, 0x79, 0xe6, 0xd0, 0x7d, 0x1d, 0x3e, 0xec, 0xb9,
+	0xf8, 0x2f, 0x5d, 0x29, 0x66, 0xfe, 0x73, 0x9e, 0x75, 0x9c, 0x54, 0xc6, 0x9c, 0xee, 0xc6, 0x77,
+	0xbc, 0xa2, 0xf4, 0xa6, 0x07, 0xd4, 0x81, 0x92, 0x28, 0xdf, 0x6f, 0x8b, 0x0e, 0x9c, 0x30, 0x36,
+	0xf0, 0x65, 0x9e, 0xff, 0xff, 0x7a, 0xa4, 0x29, 0xd3, 0x25, 0x22, 0x91, 0xc0, 0x7b, 0x5c, 0x3c,
+	0xee, 0x21, 0x62, 0x23, 0x62, 0x85, 0xd4, 0x00, 0xe3, 0xde, 0x80, 0xe9, 0x31, 0xb7, 0xc1, 0x23,
+	0xee, 0x26, 0x9d, 0xbe, 0x58, 0x50, 0x9a, 0x4c, 0x14, 0xe8, 0xd3, 0x64, 0x46, 0x99, 0x3f, 0x95,
+	0x14, 0xdf, 0xb2, 0x66, 0x86, 0x44, 0xca, 0xf7, 0x4e, 0x14, 0xa9, 0x0e, 0x63, 0xd5, 0xcd, 0x6b,
+	0xd0, 0xcf, 0x92, 0x59, 0x23, 0x29, 0x47, 0x5e, 0xf3, 0x6a, 0x65, 0x9e, 0x4e, 0x49, 0xf1, 0xcc,
+	0x24, 0x06, 0xbe, 0x37, 0xc5, 0xf3, 0x12, 0xd3, 0xc2, 0xf8, 0x8a, 0x92, 0xff, 0x45, 0x2f, 0x9f,
+	0x7d, 0x76, 0x5f, 0x83, 0x66, 0xb8, 0x15, 0x55, 0xcb, 0xad, 0x28, 0xdb, 0xdc, 0xfc, 0x8e, 0xbd,
+	0xb9, 0xc9, 0x32, 0xa2, 0x87, 0xe9, 0x73, 0x9e, 0x3b, 0x1d, 0x4e, 0x47, 0xb1, 0x3c, 0xf3, 0x9d,
+	0x9a, 0x79, 0x52, 0xed, 0x26, 0xca, 0xdf, 0x83, 0x9f, 0xc0, 0xf6, 0x48, 0xec, 0x74, 0x44, 0xb8,
+	0x4b, 0x96, 0xca, 0x22, 0x7e, 0xbf, 0xeb, 0x59, 0xb7, 0xbe, 0x5c, 0xcd, 0x9b, 0x11, 0x3f, 0xaa,
+	0x70, 0x6d, 0x2e, 0x82, 0xca, 0xe3, 0x08, 0x04, 0xb9, 0x1e, 0xf2, 0x68, 0x5d, 0x25, 0xef, 0xd6,
+	0x58, 0x5a, 0x16, 0x4b, 0x97, 0x91, 0x45, 0x9c, 0x2e, 0x5d, 0x46, 0x7e, 0x73, 0xc9, 0x72, 0xea,
+	0xff, 0xa8, 0x92, 0xde, 0xb0, 0x54, 0x96, 0xb0, 0xc4, 0xb7, 0xcb, 0x6e, 0x83, 0x2a, 0x8e, 0x6d,
+	0x90, 0x0a, 0x0f, 0xb5, 0x37, 0xe4, 0x9c, 0x53, 0xc5, 0x14, 0xd3, 0x4d, 0xe4, 0x26, 0x50, 0x15,
+	0x0d, 0x75, 0xa8, 0x67, 0x4f, 0x84, 0xc5, 0x11, 0xaf, 0x70, 0x4a, 0xd1, 0x9f, 0x4f, 0x01, 0xee,
+	0x4b, 0x4e, 0xde, 0x03, 0xba, 0xe4, 0x64, 0x78, 0xc7, 0x24, 0xe7, 0x1d, 0x5f, 0x24, 0xcd, 0x54,
+	0xeb, 0xd4, 0xf4, 0xd7, 0x0e, 0xbd, 0x57, 0xe2, 0xd0, 0x57, 0x2c, 0x87, 0xde, 0xff, 0xa8, 0x47,
+	0xf6, 0xa3, 0xf2, 0x19, 0xc3, 0x6f, 0xdc, 0xf2, 0xf2, 0xec, 0x5b, 0x5e, 0xbe, 0xcc, 0x0b, 0xcf,
+	0x0c, 0x87, 0xf5, 0xc8, 0xce, 0x92, 0x38, 0x54, 0x47, 0xd6, 0xe4, 0xc5, 0x8b, 0x43, 0xd9, 0x89,
+	0x22, 0x0c, 0x47, 0x5a, 0x84, 0x1d, 0xcb, 0x81, 0x9c, 0x65, 0x31, 0xd7, 0x51, 0x6f, 0xef, 0x75,
+	0xf4, 0xed, 0x64, 0xce, 0xfc, 0x5a, 0x7a, 0xe1, 0x6a, 0x39, 0xcb, 0x6b, 0x39, 0xb3, 0xc8, 0xe9,
+	0x3b, 0x73, 0xf7, 0xcb, 0xa5, 0x93, 0x5d, 0x74, 0x35, 0x36, 0x4b, 0xee, 0xff, 0x93, 0x27, 0xb3,
+	0x36, 0xec, 0x91, 0xb1, 0xe4, 0xe1, 0xdd, 0x95, 0x3c, 0xe8, 0xd3, 0x84, 0x88, 0xdd, 0x5e, 0xfa,
+	0x96, 0x95, 0xe6, 0x23, 0x33, 0x5a, 0xcc, 0xa0, 0xa4, 0xcf, 0x91, 0xa6, 0x25, 0x46, 0x29, 0xff,
+	0x62, 0xe3, 0x6d, 0x93, 0xdb, 0xea, 0x5f, 0xc3, 0x20, 0x89, 0x06, 0xf8, 0x43, 0x72, 0xd8, 0x22,
+	0x4f, 0x23, 0xf7, 0xe5, 0x6b, 0x8f, 0xb5, 0x9a, 0x54, 0xee, 0x7a, 0x35, 0xf1, 0x5f, 0xf1, 0x0a,
+	0x33, 0x86, 0xef, 0x37, 0xbb, 0xc1, 0x52, 0xde, 0x6a, 0x5e, 0x79, 0xcb, 0xf6, 0x39, 0x9f, 0xf7,
+	0x1c, 0x09, 0x0a, 0x39, 0xce, 0xac, 0x58, 0x77, 0x49, 0x4e, 0x73, 0x89, 0xcd, 0x53, 0x17, 0x2f,
+	0x2b, 0xc6, 0xc5, 0xcb, 0x7b, 0x0d, 0x74, 0x5f, 0x2e, 0xee, 0xc7, 0x17, 0x3c, 0x2b, 0xb3, 0xab,
+	0x98, 0x45, 0x2b, 0x77, 0x61, 0x05, 0xc3, 0x3f, 0xc1, 0x20, 0x4c, 0x76, 0xef, 0x5b, 0xab, 0x17,
+	0xc9, 0xac, 0x51, 0x8d, 0xec, 0x9f, 0x09, 0xf2, 0x3f, 0x40, 0x16, 0x4c, 0xaf, 0x27, 0xd3, 0xa6,
+	0xeb, 0xf8, 0xf5, 0x99, 0x6c, 0x9d, 0xe6, 0x94, 0xcd, 0x54, 0x60, 0xb7, 0xf5, 0x7e, 0x72, 0xd0,
+	0x28, 0xa6, 0xba, 0xfc, 0x66, 0x7b, 0x47, 0x70, 0x2a, 0x3f, 0xfb, 0xb3, 0xb5, 0x0a, 0x7a, 0x58,
+	0xbc, 0xcf, 0x47, 0xea, 0xb0, 0x0a, 0x7e, 0x82, 0x55, 0x2b, 0xca, 0x5a, 0xcf, 0x05, 0x64, 0xec,
+	0xf7, 0x73, 0xea, 0xd6, 0xcb, 0x32, 0x89, 0x79, 0x32, 0x98, 0xe4, 0x5f, 0x96, 0xa9, 0x65, 0x5f,
+	0x96, 0x29, 0x53, 0xe3, 0x2f, 0xba, 0x42, 0x9a, 0x39, 0xfe, 0xf4, 0xd8, 0xff, 0x97, 0x27, 0xde,
+	0xde, 0xc1, 0x08, 0xc5, 0x46, 0x1a, 0xa1, 0xd8, 0xa0, 0x27, 0x48, 0xa5, 0x9b, 0x48, 0xdb, 0x94,
+	0x79, 0x91, 0xa7, 0xd2, 0x4d, 0xe8, 0x93, 0xe9, 0x35, 0xe9, 0xaa, 0xbd, 0x1f, 0xdf, 0xe8, 0x26,
+	0x62, 0xde, 0xc7, 0xea, 0x29, 0x0d, 0x71, 0x5b, 0x3a, 0xe3, 0x26, 0xd6, 0xac, 0x00, 0x64, 0xb9,
+	0x9b, 0xb8, 0xd0, 0x93, 0xb1, 0xa2, 0xc2, 0x17, 0x1b, 0xce, 0xda, 0x2f, 0x36, 0x14, 0xdb, 0x1f,
+	0xe3, 0x12, 0xfb, 0x97, 0x2a, 0x64, 0x3e, 0xfb, 0x48, 0x1b, 0x4c, 0x5b, 0x8e, 0x85, 0xbe, 0xbc,
+	0x84, 0xa5, 0x8a, 0x60, 0x04, 0xb9, 0x71, 0xc2, 0xeb, 0x9d, 0xa9, 0x33, 0x0d, 0x00, 0xdd, 0x1d,
+	0x4f, 0x52, 0x37, 0x0e, 0x7f, 0xd3, 0x13, 0xa4, 0x3a, 0x49, 0x54, 0x94, 0x7d, 0xd6, 0x90, 0x0f,
+	0x03, 0x38, 0x54, 0xb8, 0xb9, 0x13, 0x45, 0x30, 0x2e, 0x22, 0xc1, 0xac, 0xce, 0x34, 0x00, 0x2c,
+	0xe0, 0x24, 0xe2, 0x02, 0x29, 0x6e, 0x8f, 0xa5, 0x65, 0xe8, 0x7f, 0x1c, 0x6d, 0x4a, 0x97, 0x19,
+	0x7e, 0x42, 0xf3, 0x7d, 0x1e, 0x27, 0xd2, 0x0f, 0xc1, 0xdf, 0xb0, 0xf1, 0xdc, 0xbc, 0xc9, 0x37,
+	0xb7, 0x57, 0xc6, 0xa3, 0x1b, 0x83, 0x70, 0x33, 0x91, 0x4e, 0x88, 0x0d, 0x84, 0x49, 0x1b, 0xa4,
+	0x8f, 0xfe, 0xf4, 0xd1, 0x15, 0xa9, 0x31, 0x13, 0xe4, 0xff, 0x9a, 0xe7, 0xba, 0x7f, 0x41, 0xdf,
+	0x24, 0xe5, 0x61, 0xc4, 0x0e, 0x0a, 0x9f, 0xbe, 0xd3, 0x94, 0x65, 0x3b, 0xd4, 0x2f, 0xd9, 0x3b,
+	0xd4, 0x7c, 0x9b, 0x5a, 0x6b, 0x81, 0xa7, 0xfc, 0xdd, 0x8f, 0x07, 0xc0, 0xd3, 0x97, 0x6d, 0x9e,
+	0xf2, 0x6d, 0x5a, 0xa7, 0x35, 0xae, 0x7b, 0x27, 0xf7, 0x3a, 0xb1, 0x8e, 0x93, 0x19, 0x5c, 0xf1,
+	0xf1, 0x3d, 0x44, 0xa1, 0x4e, 0x1a, 0x60, 0xbd, 0x50, 0xe5, 0xe9, 0x77, 0xb8, 0xca, 0xc2, 0xdf,
+	0xbf, 0xe7, 0x0a, 0x7f, 0x5b, 0x2c, 0xea, 0x3e, 0x24, 0xae, 0x1b, 0x32, 0xf6, 0xa4, 0xa8, 0x18,
+	0x93, 0xa2, 0x4c, 0x72, 0xbf, 0x6f, 0x4b, 0x2e, 0x5f, 0xad, 0x6e, 0xf5, 0x3f, 0xbc, 0x3d, 0x2e,
+	0xe0, 0x14, 0xbe, 0x80, 0x71, 0x17, 0x31, 0x2b, 0x77, 0x30, 0xb2, 0x2c, 0xad, 0x87, 0x92, 0xda,
+	0xc8, 0x38, 0x31, 0x83, 0xdf, 0x4b, 0x6b, 0xc5, 0x1d, 0xfd, 0x8a, 0xe8, 0xe8, 0x69, 0x3b, 0x9b,
+	0xc4, 0xdd, 0x11, 0xdd, 0xe7, 0xef, 0x7a, 0xa5, 0x37, 0x8a, 0xf6, 0xf2, 0x80, 0x22, 0xeb, 0x7c,
+	0x45, 0x94, 0x60, 0x9c, 0xfa, 0xd1, 0x78, 0x72, 0x6e, 0x30, 0x90, 0xa7, 0x06, 0xaa, 0x58, 0x96,
+	0xa8, 0xfb, 0x55, 0xc1, 0xbe, 0x6f, 0xa6, 0xe3, 0xef, 0xc5, 0xfc, 0x07, 0xca, 0x2e, 0x3b, 0x95,
+	0x39, 0x27, 0x7f, 0x60, 0x3b, 0x27, 0xc5, 0x95, 0xe8, 0xb6, 0x3e, 0xed, 0x15, 0xdc, 0x9c, 0x32,
+	0x9c, 0x26, 0xcf, 0x72, 0x9a, 0x4e, 0x12, 0x12, 0xe9, 0x9b, 0x18, 0xe2, 0xf1, 0x12, 0x03, 0x52,
+	0x96, 0xdd, 0xf2, 0x87, 0x9e, 0x2b, 0x33, 0xc8, 0x6e, 0x57, 0xb3, 0xf6, 0xf7, 0xde, 0x5d, 0xde,
+	0xdc, 0x2a, 0x64, 0xb5, 0xe8, 0xa4, 0x4c, 0x7a, 0xdc, 0xb0, 0xb4, 0x88, 0x05, 0xb6, 0xca, 0x34,
+	0x60, 0xe9, 0x7a, 0x71, 0x07, 0xbe, 0x26, 0x3a, 0xf0, 0x3a, 0x2d, 0xe0, 0xbd, 0xb9, 0xd3, 0x1d,
+	0xfa, 0xa2, 0xb7, 0xf7, 0xfd, 0xb2, 0x7b, 0x0b, 0x7f, 0x96, 0xa5, 0x3c, 0xfc, 0x91, 0x9d, 0xf2,
+	0xb0, 0x57, 0xc3, 0xa6, 0x95, 0x72, 0xdd, 0x6f, 0x03, 0x61, 0x72, 0xbc, 0x24, 0x23, 0x03, 0xa5,
+	0xb2, 0x54, 0x66, 0x1b, 0xff, 0xd8, 0xb6, 0x8d, 0x8e, 0x5a, 0x73, 0xad, 0x66, 0x2e, 0xcf, 0xdd,
+	0x4f, 0xab, 0x7f, 0x92, 0x6f, 0x35, 0x53, 0xab, 0x6e, 0xf5, 0x57, 0x3d, 0xe7, 0xd5, 0x3c, 0xfa,
+	0x94, 0xf9, 0x5c, 0x82, 0x1c, 0x0a, 0xc7, 0xbb, 0x00, 0x06, 0x51, 0x19, 0x47, 0x5f, 0xb7, 0x39,
+	0x72, 0x34, 0xa8, 0x39, 0x1a, 0x38, 0xae, 0x04, 0x3a, 0x53, 0x8b, 0x4a, 0xce, 0x9f, 0xbf, 0x61,
+	0x9f, 0x3f, 0xe7, 0xea, 0xd3, 0xad, 0xbd, 0xe2, 0xed, 0x75, 0xd5, 0xf0, 0x9e, 0x27, 0x97, 0xf1,
+	0x0e, 0x46, 0xd5, 0x7a, 0x07, 0x63, 0xa9, 0x5b, 0xcc, 0xf1, 0x9f, 0x0a, 0x8e, 0x1f, 0x2d, 0x9c,
+	0x58, 0x26, 0x4b, 0x9a, 0xfd, 0x3b, 0x05, 0x97, 0x20, 0x8b, 0x5e, 0x7a, 0x29, 0x33, 0x4e, 0xdf,
+	0xb4, 0x8d, 0x93, 0xb3, 0x5e, 0xdd, 0xf2, 0x7b, 0x9d, 0x77, 0x2c, 0xcb, 0x94, 0xe0, 0x5b, 0xb6,
+	0x12, 0x38, 0xbe, 0xd6, 0xb5, 0x7f, 0xc4, 0x2b, 0xba, 0xa9, 0x99, 0xf3, 0x77, 0xf6, 0xa5, 0xfe,
+	0x4e, 0x13, 0x1c, 0x9c, 0xb2, 0x28, 0xf9, 0x9f, 0xd9, 0x51, 0x72, 0x77, 0x03, 0x9a, 0x89, 0xcf,
+	0x7a, 0x65, 0xf7, 0x3e, 0xef, 0x55, 0x2f, 0xca, 0xd6, 0xad, 0x6f, 0xe7, 0xd6, 0xad, 0x82, 0x46,
+	0x35, 0x73, 0x6b, 0xe4, 0x40, 0x6e, 0x57, 0xe3, 0xdc, 0xe2, 0xe6, 0x6f, 0xfc, 0x89, 0xbc, 0xef,
+	0x0c, 0xd4, 0xbf, 0x66, 0xbd, 0x10, 0x23, 0x9e, 0x74, 0x59, 0xce, 0xc3, 0xe4, 0xc6, 0xb6, 0x28,
+	0xac, 0x95, 0xa3, 0x87, 0xa1, 0x2c, 0xbd, 0x1d, 0x6b, 0xe5, 0xbb, 0xca, 0xd7, 0x55, 0xcb, 0xce,
+	0x6a, 0xbe, 0x63, 0x9f, 0xd5, 0x94, 0x55, 0xad, 0xa5, 0xf5, 0x4d, 0xaf, 0xfc, 0x02, 0xee, 0x3d,
+	0x5f, 0xda, 0x4a, 0xdf, 0x1d, 0xab, 0x1a, 0xef, 0x8e, 0x95, 0xb1, 0xfd, 0xe7, 0x9e, 0xe3, 0xbe,
+	0x9e, 0x9b, 0x19, 0xcd, 0xf6, 0x4b, 0xc5, 0x97, 0x82, 0x9d, 0x62, 0x2b, 0xc9, 0x0e, 0xfb, 0xae,
+	0x9d, 0x1d, 0x56, 0x54, 0xad, 0xa5, 0xfd, 0xa5, 0x77, 0x8e, 0xe9, 0xe3, 0xa4, 0xb1, 0x72, 0x15,
+	0x77, 0x8c, 0x2a, 0xda, 0x91, 0xb6, 0x29, 0xc0, 0x2c, 0xc5, 0x97, 0x09, 0xe6, 0x2f, 0x32, 0x82,
+	0x29, 0x69, 0x52, 0x33, 0xf7, 0x0e, 0x32, 0x2d, 0xeb, 0x76, 0xea, 0x7c, 0xe6, 0xfd, 0x37, 0x11,
+	0xb4, 0xb6, 0xde, 0x7f, 0xfb, 0x45, 0x6f, 0xaf, 0xfb, 0xd2, 0x4e, 0x01, 0x97, 0x58, 0xf0, 0x57,
+	0x72, 0x16, 0xbc, 0xa4, 0x72, 0xdb, 0xc8, 0x14, 0x5f, 0xca, 0xbe, 0xd7, 0x3b, 0x03, 0x65, 0x46,
+	0xe6, 0x7b, 0x5e, 0xee, 0x4e, 0xe6, 0x5e, 0xfa, 0x37, 0x28, 0xbd, 0x10, 0x5e, 0xe6, 0xf6, 0x7f,
+	0xdf, 0x76, 0xfb, 0x4b, 0x6a, 0xd1, 0xad, 0x7d, 0xde, 0xdb, 0xe3, 0x7a, 0x39, 0x98, 0xd6, 0x58,
+	0x6c, 0x4f, 0x41, 0xe1, 0x6a, 0x4c, 0x96, 0x60, 0xc9, 0x15, 0x27, 0x5b, 0x22, 0x42, 0x5c, 0x63,
+	0xaa, 0x58, 0xb6, 0xb1, 0xfa, 0x4b, 0x7b, 0x63, 0x55, 0xda, 0xb2, 0x79, 0xd5, 0x27, 0x7f, 0xbf,
+	0xdd, 0x6c, 0xdf, 0xb3, 0xdb, 0x2f, 0x71, 0x52, 0xfe, 0x2a, 0x9b, 0x24, 0x97, 0xa9, 0xd5, 0x3a,
+	0xae, 0x2d, 0xbc, 0x3d, 0x0f, 0xda, 0xd0, 0xcf, 0x58, 0x2e, 0x55, 0x96, 0x5b, 0x15, 0x11, 0x9d,
+	0xee, 0xcb, 0x35, 0xd2, 0x80, 0xc0, 0xb7, 0x43, 0xf1, 0xa2, 0x78, 0x5f, 0x5e, 0x29, 0x4f, 0xcb,
+	0xfa, 0x85, 0xf1, 0x5a, 0xe1, 0x0b, 0xe3, 0x0b, 0xa4, 0x11, 0x6d, 0xc9, 0x78, 0x81, 0xbc, 0x83,
+	0xaa, 0xca, 0x65, 0xa6, 0xe8, 0x07, 0xb6, 0x29, 0x2a, 0xea, 0x99, 0x75, 0x0e, 0x6a, 0xbe, 0x25,
+	0x8b, 0xc7, 0x51, 0xe2, 0x55, 0x7c, 0x4f, 0xec, 0x43, 0xd5, 0x6b, 0xf8, 0x27, 0x09, 0x59, 0xde,
+	0xd9, 0xdc, 0xe6, 0x89, 0xb4, 0xd7, 0xf8, 0x94, 0x91, 0x86, 0x80, 0xaf, 0x70, 0x6e, 0x5b, 0xde,
+	0xb2, 0xad, 0x9c, 0xdb, 0x86, 0x72, 0x6f, 0x5b, 0x9e, 0x54, 0x54, 0x7a, 0xdb, 0xd0, 0xa1, 0xf3,
+	0xa3, 0xfe, 0x64, 0x1c, 0x8e, 0x12, 0x99, 0xe4, 0x99, 0x96, 0x01, 0xb7, 0x1c, 0xc4, 0xbc, 0x1b,
+	0x24, 0x37, 0x31, 0x62, 0x36, 0xc3, 0xd2, 0xb2, 0xff, 0x99, 0x0a, 0x31, 0x73, 0x79, 0x57, 0xf0,
+	0x49, 0xeb, 0x1e, 0x1f, 0xc5, 0x61, 0x12, 0xde, 0xe2, 0x92, 0xcb, 0x2c, 0x18, 0xb8, 0x3d, 0x37,
+	0x99, 0xf0, 0x51, 0x1f, 0x0c, 0x31, 0x72, 0xdb, 0x60, 0x06, 0x04, 0x56, 0xee, 0xeb, 0x51, 0x98,
+	0xf0, 0xf5, 0x9b, 0x11, 0x8f, 0x6f, 0x8e, 0x07, 0x62, 0x8c, 0xea, 0x2c, 0x03, 0xa5, 0xa7, 0x49,
+	0x93, 0xf1, 0xa0, 0xaf, 0xc9, 0x6a, 0x48, 0x66, 0x03, 0xf1, 0xb9, 0xf0, 0x64, 0x1c, 0x05, 0x5b,
+	0x7c, 0x25, 0x98, 0x04, 0x9b, 0x61, 0xb2, 0x2b, 0xa3, 0x82, 0x59, 0x70, 0x9a, 0x18, 0xba, 0x72,
+	0x33, 0x88, 0x64, 0x57, 0x35, 0x80, 0xce, 0x93, 0xea, 0x7a, 0xa2, 0x4e, 0x2e, 0xe1, 0x27, 0xde,
+	0x83, 0x0d, 0xb6, 0x62, 0x24, 0x91, 0x57, 0x64, 0x34, 0xc0, 0xff, 0xa1, 0x57, 0xfc, 0xd6, 0x82,
+	0xcb, 0x99, 0x63, 0x13, 0x69, 0xd4, 0x2a, 0x6c, 0x82, 0xef, 0x4a, 0xc6, 0x49, 0xfa, 0xd2, 0x64,
+	0x9c, 0x98, 0x49, 0xd5, 0x35, 0xeb, 0x45, 0xf9, 0xdc, 0xab, 0x04, 0x25, 0x1a, 0xf8, 0x43, 0x97,
+	0x06, 0x96, 0x25, 0x4c, 0x7c, 0xcc, 0xcb, 0x3f, 0x09, 0x51, 0xe4, 0x63, 0x9b, 0x4f, 0xab, 0x0b,
+	0x57, 0x4b, 0x15, 0xcb, 0x92, 0x02, 0xfe, 0x26, 0x7b, 0x05, 0xc3, 0x6e, 0x4c, 0xb3, 0xf2, 0x9b,
+	0x1e, 0x99, 0x06, 0x73, 0xbf, 0x36, 0xc1, 0xb4, 0xbf, 0xb5, 0x89, 0xcc, 0xd5, 0xaa, 0xac, 0x4d,
+	0x40, 0x47, 0x47, 0xfc, 0xb6, 0x3a, 0xf6, 0xc3, 0x0b, 0xe3, 0xaa, 0x9c, 0xff, 0xdf, 0x14, 0xe2,
+	0x01, 0xaf, 0xcc, 0xff, 0xa6, 0x38, 0x49, 0xc8, 0x45, 0x9e, 0xac, 0x4d, 0x44, 0x64, 0x58, 0x28,
+	0x92, 0x01, 0x49, 0xef, 0x35, 0xd6, 0xed, 0xa8, 0x73, 0x7a, 0xaf, 0x11, 0xd6, 0x33, 0xe7, 0x63,
+	0x1d, 0xa5, 0x97, 0x69, 0xec, 0x03, 0x09, 0x39, 0x6f, 0x8d, 0x03, 0x89, 0x92, 0x7c, 0x85, 0x1f,
+	0xd9, 0xf9, 0x0a, 0xae, 0xa6, 0x9d, 0x87, 0x6a, 0x8e, 0xf7, 0x42, 0xfe, 0x9f, 0x4f, 0x55, 0xb2,
+	0x9d, 0x28, 0x59, 0x9a, 0x7f, 0xec, 0x3c, 0x54, 0x73, 0xb0, 0xa8, 0xbb, 0xf2, 0x55, 0xaf, 0xe4,
+	0xcd, 0x94, 0xf4, 0xc2, 0x9a, 0x87, 0x7c, 0x8b, 0x17, 0xff, 0xdd, 0xff, 0xdc, 0x48, 0x27, 0xc3,
+	0x57, 0xcd, 0x64, 0xf8, 0xb2, 0x8b, 0x3b, 0x3f, 0xb1, 0x2f, 0xee, 0x14, 0x72, 0xa1, 0x99, 0xfd,
+	0xbb, 0x0a, 0x69, 0x5c, 0x08, 0x45, 0xb8, 0x05, 0x14, 0x21, 0xe6, 0x2f, 0xee, 0xf0, 0xd1, 0x26,
+	0x97, 0x67, 0x2c, 0x69, 0x19, 0x78, 0x1c, 0x60, 0x62, 0x84, 0x7c, 0x13, 0x18, 0x0b, 0x00, 0x1d,
+	0xf2, 0x68, 0x8b, 0xcb, 0x35, 0x4a, 0x14, 0x30, 0x32, 0x72, 0x27, 0xe1, 0xa3, 0x44, 0xc5, 0xaa,
+	0x45, 0x09, 0xa9, 0xf1, 0x5f, 0x9c, 0xd4, 0xc5, 0x15, 0x2f, 0x2c, 0xc0, 0xa4, 0x8c, 0xe5, 0x81,
+	0xe9, 0x14, 0xc2, 0x55, 0x11, 0xcc, 0x57, 0x3f, 0x4d, 0x4a, 0x16, 0x66, 0x4d, 0x03, 0xf0, 0x18,
+	0x05, 0x75, 0x0a, 0xb0, 0xe2, 0xed, 0x7c, 0x0d, 0x80, 0x5a, 0x87, 0xa1, 0x70, 0x32, 0xc5, 0x9b,
+	0x08, 0xaa, 0x88, 0x18, 0x99, 0x16, 0x4c, 0x24, 0x46, 0x14, 0x71, 0xd5, 0x1c, 0xdf, 0x16, 0xf9,
+	0xc4, 0xe2, 0xed, 0x83, 0xb4, 0x0c, 0x93, 0xf4, 0x46, 0x38, 0xe0, 0xbd, 0xf0, 0x25, 0xbe, 0xbc,
+	0x0b, 0x8e, 0xf5, 0x9c, 0x98, 0xa4, 0x16, 0xd0, 0xff, 0xb8, 0xe7, 0x7a, 0xd6, 0x86, 0xbe, 0x9e,
+	0xcc, 0x28, 0x21, 0x2b, 0x8f, 0x7c, 0x7f, 0x9a, 0xd9, 0x3e, 0x90, 0xe7, 0xa9, 0x29, 0x45, 0x59,
+	0x70, 0xfd, 0xaf, 0xed, 0xe0, 0x7a, 0xbe, 0xad, 0x74, 0x68, 0x97, 0xc9, 0xbb, 0x1b, 0x67, 0xcf,
+	0x3e, 0x89, 0x74, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0xb8, 0xf6, 0x77, 0x26, 0x6f, 0x6b, 0x00,
+	0x00,
 }
@@ -56,7 +56,13 @@ func (sgi *ShardGroupInfo) walkShards(fn func(sh *ShardInfo)) {
 	}
 }
 
-func (sgi ShardGroupInfo) getShardsAndSeriesKeyForHintQuery(tagsGroup *influx.PointTags, aliveShardIdxes []int, mst *MeasurementInfo, ski *ShardKeyInfo) ([]ShardInfo, []byte) {
+// isForceBroadcastQuery reports whether the query is sent to all the shards, forced for all the queries
+// by the force-broadcast-query config or for one query by the force_broadcast hint.
+func isForceBroadcastQuery(opt *query.SelectOptions) bool {
+	return (opt != nil && opt.ForceBroadcast) || sysconfig.GetEnableForceBroadcastQuery() == sysconfig.OnForceBroadcastQuery
+}
+
+func (sgi ShardGroupInfo) getShardsAndSeriesKeyForHintQuery(tagsGroup *influx.PointTags, aliveShardIdxes []int, mst *MeasurementInfo, ski *ShardKeyInfo, opt *query.SelectOptions) ([]ShardInfo, []byte) {
 	shards := make([]ShardInfo, 0, len(sgi.Shards))
 	sort.Sort(tagsGroup)
 	r := influx.Row{Name: mst.Name, Tags: *tagsGroup}
@@ -66,7 +72,7 @@ func (sgi ShardGroupInfo) getShardsAndSeriesKeyForHintQuery(tagsGroup *influx.Po
 		r.ShardKey = r.ShardKey[len(mst.Name)+1:]
 	}
 	// Force the query to be broadcast
-	if sysconfig.GetEnableForceBroadcastQuery() == sysconfig.OnForceBroadcastQuery {
+	if isForceBroadcastQuery(opt) {
 		return sgi.genShardInfosByIndex(aliveShardIdxes), r.IndexKey
 	}
 	var shardIdxes []int
@@ -110,7 +116,7 @@ func (sgi ShardGroupInfo) TargetShardsHintQuery(mst *MeasurementInfo, ski *Shard
 	}
 
 	// it's used for specific or full series of the hint query
-	return sgi.getShardsAndSeriesKeyForHintQuery(tagsGroup[0], aliveShardIdxes, mst, ski)
+	return sgi.getShardsAndSeriesKeyForHintQuery(tagsGroup[0], aliveShardIdxes, mst, ski, opt)
 
 }
 
@@ -122,7 +128,7 @@ func (sgi *ShardGroupInfo) genShardInfosByIndex(aliveShardIdxes []int) []ShardIn
 	return shards
 }
 
-func (sgi ShardGroupInfo) TargetShards(mst *MeasurementInfo, ski *ShardKeyInfo, condition influxql.Expr, aliveShardIdxes []int) []ShardInfo {
+func (sgi ShardGroupInfo) TargetShards(mst *MeasurementInfo, ski *ShardKeyInfo, condition influxql.Expr, opt *query.SelectOptions, aliveShardIdxes []int) []ShardInfo {
 	if ski == nil || ski.ShardKey == nil || (ski.Type == HASH && condition == nil) {
 		return sgi.genShardInfosByIndex(aliveShardIdxes)
 	}
@@ -134,7 +140,7 @@ func (sgi ShardGroupInfo) TargetShards(mst *MeasurementInfo, ski *ShardKeyInfo,
 	}
 
 	// Force the query to be broadcast
-	if sysconfig.GetEnableForceBroadcastQuery() == sysconfig.OnForceBroadcastQuery {
+	if isForceBroadcastQuery(opt) {
 		return sgi.genShardInfosByIndex(aliveShardIdxes)
 	}
 	var shardKeyAndValue []byte
@@ -19,6 +19,8 @@ package meta
 import (
 	"strings"
 	"unicode"
+
+	"github.com/openGemini/openGemini/lib/config"
 )
 
 var unsupportedCharsInDBName = `,:;./\`
@@ -36,6 +38,26 @@ func ValidMeasurementName(name string) bool {
 	return validName(name, unsupportedCharsInMstName)
 }
 
+// ValidNameStrictness checks the name of a database or measurement against the strictness of
+// config.NameValidationASCII or config.NameValidationStrict, on top of ValidName or ValidMeasurementName.
+func ValidNameStrictness(name string, strictness string) bool {
+	for _, r := range name {
+		switch strictness {
+		case config.NameValidationASCII:
+			if r > unicode.MaxASCII {
+				return false
+			}
+		case config.NameValidationStrict:
+			if r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-') {
+				return false
+			}
+		default:
+			return true
+		}
+	}
+	return true
+}
+
 func validName(name string, unsupported string) bool {
 	for _, r := range name {
 		if !unicode.IsPrint(r) {
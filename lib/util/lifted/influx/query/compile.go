@@ -1094,12 +1094,9 @@ func (c *compiledStatement) Prepare(shardMapper ShardMapper, sopt SelectOptions)
 		}
 	}
 
-	var isFullSeriesQuery, isSpecificSeriesQuery bool
-	if isFullSeriesQuery = hybridqp.IsFullSeriesQuery(c.stmt); isFullSeriesQuery {
-		sopt.HintType = hybridqp.FullSeriesQuery
-	} else if isSpecificSeriesQuery = hybridqp.IsSpecificSeriesQuery(c.stmt); isSpecificSeriesQuery {
-		sopt.HintType = hybridqp.SpecificSeriesQuery
-	}
+	sopt.SetStatementHints(c.stmt)
+	isFullSeriesQuery := sopt.HintType == hybridqp.FullSeriesQuery
+	isSpecificSeriesQuery := sopt.HintType == hybridqp.SpecificSeriesQuery
 
 	// Create an iterator creator based on the shards in the cluster.
 	shards, err := shardMapper.MapShards(c.stmt.Sources, timeRange, sopt, c.stmt.Condition)
@@ -0,0 +1,124 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultQueryEventBufferSize is the number of events buffered for each subscriber of the query event bus.
+const DefaultQueryEventBufferSize = 1024
+
+const (
+	QueryEventStart    = "start"
+	QueryEventComplete = "complete"
+)
+
+const (
+	QueryStatusRunning = "running"
+	QueryStatusSuccess = "success"
+	QueryStatusFailed  = "failed"
+)
+
+// QueryEvent is published when a statement starts or completes.
+type QueryEvent struct {
+	Type      string        `json:"type"`
+	QueryID   uint64        `json:"qid"`
+	Database  string        `json:"database"`
+	Statement string        `json:"statement"`
+	Time      time.Time     `json:"time"`
+	Duration  time.Duration `json:"duration"`
+	Status    string        `json:"status"`
+	Error     string        `json:"error,omitempty"`
+}
+
+// QueryEventSubscriber receives the query events published after it subscribed.
+type QueryEventSubscriber struct {
+	C <-chan *QueryEvent
+	c chan *QueryEvent
+}
+
+// QueryEventBus fans the query events out to its subscribers.
+// Publishing never blocks, an event is dropped for a subscriber whose buffer is full.
+type QueryEventBus struct {
+	mu          sync.RWMutex
+	bufferSize  int
+	subscribers map[*QueryEventSubscriber]struct{}
+	dropped     int64
+}
+
+func NewQueryEventBus(bufferSize int) *QueryEventBus {
+	return &QueryEventBus{
+		bufferSize:  bufferSize,
+		subscribers: make(map[*QueryEventSubscriber]struct{}),
+	}
+}
+
+// Subscribe returns a subscriber which must be released by Unsubscribe.
+func (b *QueryEventBus) Subscribe() *QueryEventSubscriber {
+	c := make(chan *QueryEvent, b.bufferSize)
+	s := &QueryEventSubscriber{C: c, c: c}
+
+	b.mu.Lock()
+	b.subscribers[s] = struct{}{}
+	b.mu.Unlock()
+	return s
+}
+
+// Unsubscribe removes the subscriber and closes its channel.
+func (b *QueryEventBus) Unsubscribe(s *QueryEventSubscriber) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.subscribers[s]; !ok {
+		return
+	}
+	delete(b.subscribers, s)
+	close(s.c)
+}
+
+// Publish sends the event to every subscriber without blocking.
+func (b *QueryEventBus) Publish(ev *QueryEvent) {
+	if b == nil {
+		return
+	}
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for s := range b.subscribers {
+		select {
+		case s.c <- ev:
+		default:
+			atomic.AddInt64(&b.dropped, 1)
+		}
+	}
+}
+
+// HasSubscribers reports whether any subscriber is listening, so that callers can skip building events.
+func (b *QueryEventBus) HasSubscribers() bool {
+	if b == nil {
+		return false
+	}
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.subscribers) > 0
+}
+
+// Dropped returns the number of events dropped because a subscriber buffer was full.
+func (b *QueryEventBus) Dropped() int64 {
+	return atomic.LoadInt64(&b.dropped)
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query_test
+
+import (
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryEventBus(t *testing.T) {
+	bus := query.NewQueryEventBus(1)
+	assert.False(t, bus.HasSubscribers())
+	// publishing without subscribers is a no-op
+	bus.Publish(&query.QueryEvent{Type: query.QueryEventStart})
+
+	sub := bus.Subscribe()
+	assert.True(t, bus.HasSubscribers())
+
+	bus.Publish(&query.QueryEvent{Type: query.QueryEventStart, QueryID: 1})
+	// the buffer is full, the event is dropped rather than blocking
+	bus.Publish(&query.QueryEvent{Type: query.QueryEventComplete, QueryID: 1})
+	assert.Equal(t, int64(1), bus.Dropped())
+
+	ev := <-sub.C
+	assert.Equal(t, query.QueryEventStart, ev.Type)
+	assert.Equal(t, uint64(1), ev.QueryID)
+
+	bus.Unsubscribe(sub)
+	_, ok := <-sub.C
+	assert.False(t, ok)
+	assert.False(t, bus.HasSubscribers())
+	// unsubscribing twice is harmless
+	bus.Unsubscribe(sub)
+
+	var nilBus *query.QueryEventBus
+	assert.False(t, nilBus.HasSubscribers())
+	nilBus.Publish(&query.QueryEvent{})
+}
@@ -58,6 +58,9 @@ const (
 	PanicCrashEnv = "INFLUXDB_PANIC_CRASH"
 )
 
+// DefaultRetryAfter is the retry delay suggested to a query rejected by the max-concurrent-queries limit.
+const DefaultRetryAfter = time.Second
+
 type qCtxKey uint8
 
 const (
@@ -87,8 +90,9 @@ func ErrMaxSelectPointsLimitExceeded(n, limit int) error {
 
 // ErrMaxConcurrentQueriesLimitExceeded is an error when a query cannot be run
 // because the maximum number of queries has been reached.
+// The error suggests the client to retry after DefaultRetryAfter.
 func ErrMaxConcurrentQueriesLimitExceeded(n, limit int) error {
-	return fmt.Errorf("max-concurrent-queries limit exceeded(%d, %d)", n, limit)
+	return errno.NewError(errno.MaxConcurrentQueriesExceeded, n, limit).SetRetryAfter(DefaultRetryAfter)
 }
 
 // CoarseAuthorizer determines if certain operations are authorized at the database level.
@@ -157,8 +161,9 @@ func (a openAuthorizer) AuthorizeQuery(_ string, _ *influxql.Query) error { retu
 //}
 
 type RowsChan struct {
-	Rows    models.Rows // models.Rows of data
-	Partial bool        // is partial of rows
+	Rows        models.Rows // models.Rows of data
+	Partial     bool        // is partial of rows
+	ColumnTypes []string    // types of the columns of the rows, in the order of the columns
 }
 
 // ExecutionOptions contains the options for executing a query.
@@ -178,6 +183,12 @@ type ExecutionOptions struct {
 	// Node to execute on.
 	NodeID uint64
 
+	// The user executing the query, empty when authentication is disabled.
+	UserID string
+
+	// The network address of the client sending the query.
+	RemoteAddr string
+
 	// The requested maximum number of points to return in each result.
 	ChunkSize int
 
@@ -214,6 +225,21 @@ type ExecutionOptions struct {
 
 	// IterID indicates the number of iteration in incremental query, starting from 0.
 	IterID int32
+
+	// ReportShards adds the shards scanned by each SELECT statement to its result as a message.
+	ReportShards bool
+
+	// ReportQueryID adds the query ID assigned to each SELECT statement to its first result as a message,
+	// so that the client can kill the query or find it in the logs.
+	ReportQueryID bool
+
+	// ReportColumnTypes adds the types of the columns of each SELECT statement to its results,
+	// so that the client does not infer them from the values.
+	ReportColumnTypes bool
+
+	// LimitsChecked indicates that the limits of the query were checked by CheckQueryLimits before it is
+	// executed, so that its statements are not checked again.
+	LimitsChecked bool
 }
 
 func NewExecutionOptions(db, rp string, nodeID uint64, chunkSize, innerChunkSize int, chunked, readOnly, quiet, parallelQuery bool) *ExecutionOptions {
@@ -257,6 +283,13 @@ type StatementNormalizer interface {
 	NormalizeStatement(stmt influxql.Statement, database, retentionPolicy string) error
 }
 
+// QueryLimiter is implemented by a StatementExecutor limiting the queries before they are executed.
+type QueryLimiter interface {
+	// CheckQueryLimits checks all the statements of the query against the limits at once, and returns
+	// an error suggesting a retry delay if the query is rejected.
+	CheckQueryLimits(query *influxql.Query, opt ExecutionOptions) error
+}
+
 // Executor executes every statement in an Query.
 type Executor struct {
 	// Used for executing a statement in the query.
@@ -265,6 +298,9 @@ type Executor struct {
 	// Used for tracking running queries.
 	TaskManager *TaskManager
 
+	// Used for publishing the start and completion of statements.
+	EventBus *QueryEventBus
+
 	// writer is used for INTO statement
 	PointsWriter interface {
 		RetryWritePointRows(database, retentionPolicy string, points []influx.Row) error
@@ -281,6 +317,7 @@ func NewExecutor(concurrence int) *Executor {
 
 	return &Executor{
 		TaskManager: NewTaskManager(),
+		EventBus:    NewQueryEventBus(DefaultQueryEventBufferSize),
 		Logger:      logger.NewLogger(errno.ModuleHTTP).With(zap.String("service", "executor")),
 	}
 }
@@ -296,6 +333,18 @@ func (e *Executor) WithLogger(log *logger.Logger) {
 	e.Logger = log.With(zap.String("service", "query"))
 }
 
+// CheckQueryLimits checks the query against the max-concurrent-queries limit and the limits of the
+// StatementExecutor before it is executed, so that a rejected query is answered before any of its results.
+func (e *Executor) CheckQueryLimits(query *influxql.Query, opt ExecutionOptions) error {
+	if err := e.TaskManager.checkConcurrentQueries(); err != nil {
+		return err
+	}
+	if l, ok := e.StatementExecutor.(QueryLimiter); ok {
+		return l.CheckQueryLimits(query, opt)
+	}
+	return nil
+}
+
 // ExecuteQuery executes each statement within a query.
 func (e *Executor) ExecuteQuery(query *influxql.Query, opt ExecutionOptions, closing chan struct{}, qDuration *statistics.SQLSlowQueryStatistics) <-chan *Result {
 	results := make(chan *Result)
@@ -307,6 +356,42 @@ func (e *Executor) ExecuteQuery(query *influxql.Query, opt ExecutionOptions, clo
 	return results
 }
 
+// ExecuteScript executes the statements of a script with ExecuteQuery and returns their results in order,
+// the results sent by a statement are merged into one. The statements are authorized, tracked and normalized
+// against the default database and retention policy of opt like those of a query. The script stops at the
+// first failed statement, the result of each following statement is ErrNotExecuted, or the error of the
+// query if it failed as a whole.
+func (e *Executor) ExecuteScript(query *influxql.Query, opt ExecutionOptions, closing chan struct{}) []*Result {
+	scriptResults := make([]*Result, len(query.Statements))
+	notExecuted := ErrNotExecuted
+	for r := range e.ExecuteQuery(query, opt, closing, nil) {
+		if r.StatementID < 0 || r.StatementID >= len(scriptResults) {
+			// the query failed as a whole, such as when it panicked
+			if r.Err != nil {
+				notExecuted = r.Err
+			}
+			continue
+		}
+		result := scriptResults[r.StatementID]
+		if result == nil {
+			result = &Result{StatementID: r.StatementID}
+			scriptResults[r.StatementID] = result
+		}
+		result.Series = append(result.Series, r.Series...)
+		result.Messages = append(result.Messages, r.Messages...)
+		result.Partial = r.Partial
+		if result.Err == nil {
+			result.Err = r.Err
+		}
+	}
+	for i := range scriptResults {
+		if scriptResults[i] == nil {
+			scriptResults[i] = &Result{StatementID: i, Err: notExecuted}
+		}
+	}
+	return scriptResults
+}
+
 func (e *Executor) executeQuery(query *influxql.Query, opt ExecutionOptions, closing <-chan struct{}, qStat *statistics.SQLSlowQueryStatistics, results chan *Result) {
 	defer close(results)
 	defer e.recover(query, results)
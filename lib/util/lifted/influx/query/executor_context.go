@@ -96,6 +96,14 @@ func (ctx *ExecutionContext) Err() error {
 	return ctx.err
 }
 
+// StatementQueryID returns the query ID assigned to the executing statement, 0 if not assigned.
+func (ctx *ExecutionContext) StatementQueryID() uint64 {
+	if ctx.statementID < 0 || ctx.statementID >= len(ctx.QueryID) {
+		return 0
+	}
+	return ctx.QueryID[ctx.statementID]
+}
+
 func (ctx *ExecutionContext) Value(key interface{}) interface{} {
 	switch key {
 	case monitorContextKey{}:
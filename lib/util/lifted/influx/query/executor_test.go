@@ -1,14 +1,19 @@
 package query_test
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/influxdata/influxdb/models"
 	"github.com/openGemini/openGemini/lib/errno"
 	Logger "github.com/openGemini/openGemini/lib/logger"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/coordinator"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 )
 
@@ -57,3 +62,130 @@ func newMockStatementExecutor() *coordinator.StatementExecutor {
 	}
 	return statementExecutor
 }
+
+func TestErrMaxConcurrentQueriesLimitExceeded(t *testing.T) {
+	err := query.ErrMaxConcurrentQueriesLimitExceeded(4, 4)
+	assert.EqualError(t, err, "max-concurrent-queries limit exceeded(4, 4)")
+	assert.True(t, errno.Equal(err, errno.MaxConcurrentQueriesExceeded))
+	assert.Equal(t, query.DefaultRetryAfter, errno.RetryAfter(err))
+}
+
+// limitedStatementExecutor rejects every query with err before it is executed.
+type limitedStatementExecutor struct {
+	scriptStatementExecutor
+	err error
+}
+
+func (e *limitedStatementExecutor) CheckQueryLimits(_ *influxql.Query, _ query.ExecutionOptions) error {
+	return e.err
+}
+
+func TestQueryExecutor_CheckQueryLimits(t *testing.T) {
+	q, err := influxql.ParseQuery(`SHOW MEASUREMENTS`)
+	require.NoError(t, err)
+
+	e := NewQueryExecutor()
+	se := &limitedStatementExecutor{}
+	e.StatementExecutor = se
+	e.TaskManager.Register = se
+	e.TaskManager.MaxConcurrentQueries = 1
+	assert.NoError(t, e.CheckQueryLimits(q, query.ExecutionOptions{}))
+
+	// the limits of the statement executor
+	se.err = errno.NewError(errno.RateLimited, "db0")
+	assert.True(t, errno.Equal(e.CheckQueryLimits(q, query.ExecutionOptions{}), errno.RateLimited))
+	se.err = nil
+
+	// the max-concurrent-queries limit
+	_, detach, err := e.TaskManager.AttachQuery(q, query.ExecutionOptions{}, nil, nil)
+	require.NoError(t, err)
+	assert.True(t, errno.Equal(e.CheckQueryLimits(q, query.ExecutionOptions{}), errno.MaxConcurrentQueriesExceeded))
+	detach()
+	assert.NoError(t, e.CheckQueryLimits(q, query.ExecutionOptions{}))
+}
+
+// scriptStatementExecutor sends two rows for each statement and fails the statement failAt.
+type scriptStatementExecutor struct {
+	executed []influxql.Statement
+	failAt   int
+}
+
+func (e *scriptStatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query.ExecutionContext, seq int) error {
+	e.executed = append(e.executed, stmt)
+	if seq == e.failAt {
+		return errors.New("statement failed")
+	}
+	for i := 0; i < 2; i++ {
+		if err := ctx.Send(&query.Result{Series: models.Rows{{Name: stmt.String()}}}, seq); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *scriptStatementExecutor) Statistics(buffer []byte) ([]byte, error) {
+	return buffer, nil
+}
+
+func (e *scriptStatementExecutor) RetryRegisterQueryIDOffset(host string) (uint64, error) {
+	return 0, nil
+}
+
+func (e *scriptStatementExecutor) NormalizeStatement(stmt influxql.Statement, database, retentionPolicy string) error {
+	if s, ok := stmt.(*influxql.ShowQueryLimitsStatement); ok && s.Database == "" {
+		if database == "" {
+			return errors.New("database name required")
+		}
+		s.Database = database
+	}
+	return nil
+}
+
+func parseScript(t *testing.T, script string) *influxql.Query {
+	YyParser := &influxql.YyParser{Query: influxql.Query{}}
+	YyParser.Scanner = influxql.NewScanner(strings.NewReader(script))
+	YyParser.ParseTokens()
+	q, err := YyParser.GetQuery()
+	require.NoError(t, err)
+	return q
+}
+
+func TestQueryExecutor_ExecuteScript(t *testing.T) {
+	e := NewQueryExecutor()
+	se := &scriptStatementExecutor{failAt: -1}
+	e.StatementExecutor = se
+	e.TaskManager.Register = se
+
+	// every statement is normalized against the default database of the options
+	q := parseScript(t, `SHOW QUERY LIMITS ON db0; SHOW QUERY LIMITS`)
+	results := e.ExecuteScript(q, query.ExecutionOptions{Database: "db1"}, nil)
+	require.Len(t, results, 2)
+	for i, result := range results {
+		require.NoError(t, result.Err)
+		assert.Equal(t, i, result.StatementID)
+		assert.Len(t, result.Series, 2)
+	}
+	assert.Equal(t, "db0", q.Statements[0].(*influxql.ShowQueryLimitsStatement).Database)
+	assert.Equal(t, "db1", q.Statements[1].(*influxql.ShowQueryLimitsStatement).Database)
+
+	// the default database is not taken from a previous statement
+	se.executed = nil
+	q = parseScript(t, `SHOW QUERY LIMITS ON db0; SHOW QUERY LIMITS`)
+	results = e.ExecuteScript(q, query.ExecutionOptions{}, nil)
+	require.Len(t, results, 2)
+	assert.NoError(t, results[0].Err)
+	assert.EqualError(t, results[1].Err, "database name required")
+	assert.Len(t, se.executed, 1)
+
+	// the script stops at the first failed statement
+	se.executed = nil
+	se.failAt = 0
+	q = parseScript(t, `SHOW QUERY LIMITS ON db0; SHOW QUERY LIMITS ON db1; SHOW QUERY LIMITS ON db2`)
+	results = e.ExecuteScript(q, query.ExecutionOptions{}, nil)
+	require.Len(t, results, 3)
+	assert.EqualError(t, results[0].Err, "statement failed")
+	assert.Equal(t, query.ErrNotExecuted, results[1].Err)
+	assert.Equal(t, query.ErrNotExecuted, results[2].Err)
+	assert.Equal(t, 2, results[2].StatementID)
+	assert.Len(t, se.executed, 1)
+}
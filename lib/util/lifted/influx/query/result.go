@@ -11,6 +11,7 @@ Huawei Cloud Computing Technologies Co., Ltd.
 import (
 	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/bytedance/sonic"
 	"github.com/influxdata/influxdb/models"
@@ -21,6 +22,9 @@ import (
 const (
 	// WarningLevel is the message level for a warning.
 	WarningLevel = "warning"
+
+	// InfoLevel is the message level for an information.
+	InfoLevel = "info"
 )
 
 // TagSet is a fundamental concept within the query system. It represents a composite series,
@@ -90,6 +94,18 @@ func ReadOnlyWarning(stmt string) *Message {
 	}
 }
 
+// QueryIDMessagePrefix prefixes the text of the message carrying the query ID of a SELECT statement.
+const QueryIDMessagePrefix = "qid: "
+
+// QueryIDMessage generates a message that tells the user the ID assigned to their query,
+// with which the query can be killed or found in the logs.
+func QueryIDMessage(qid uint64) *Message {
+	return &Message{
+		Level: InfoLevel,
+		Text:  QueryIDMessagePrefix + strconv.FormatUint(qid, 10),
+	}
+}
+
 // Result represents a resultset returned from a single statement.
 // Rows represents a list of rows that can be sorted consistently by name/tag.
 type Result struct {
@@ -97,6 +113,8 @@ type Result struct {
 	// to combine statement results if they're being buffered in memory.
 	StatementID int
 	Series      models.Rows
+	// ColumnTypes are the types of the columns of the series, in the order of the columns.
+	ColumnTypes []string
 	Messages    []*Message
 	Partial     bool
 	Err         error
@@ -108,6 +126,7 @@ func (r *Result) MarshalJSON() ([]byte, error) {
 	var o struct {
 		StatementID int           `json:"statement_id"`
 		Series      []*models.Row `json:"series,omitempty"`
+		ColumnTypes []string      `json:"column_types,omitempty"`
 		Messages    []*Message    `json:"messages,omitempty"`
 		Partial     bool          `json:"partial,omitempty"`
 		Err         string        `json:"error,omitempty"`
@@ -116,6 +135,7 @@ func (r *Result) MarshalJSON() ([]byte, error) {
 	// Copy fields to output struct.
 	o.StatementID = r.StatementID
 	o.Series = r.Series
+	o.ColumnTypes = r.ColumnTypes
 	o.Messages = r.Messages
 	o.Partial = r.Partial
 	if r.Err != nil {
@@ -130,6 +150,7 @@ func (r *Result) UnmarshalJSON(b []byte) error {
 	var o struct {
 		StatementID int           `json:"statement_id"`
 		Series      []*models.Row `json:"series,omitempty"`
+		ColumnTypes []string      `json:"column_types,omitempty"`
 		Messages    []*Message    `json:"messages,omitempty"`
 		Partial     bool          `json:"partial,omitempty"`
 		Err         string        `json:"error,omitempty"`
@@ -141,6 +162,7 @@ func (r *Result) UnmarshalJSON(b []byte) error {
 	}
 	r.StatementID = o.StatementID
 	r.Series = o.Series
+	r.ColumnTypes = o.ColumnTypes
 	r.Messages = o.Messages
 	r.Partial = o.Partial
 	if o.Err != "" {
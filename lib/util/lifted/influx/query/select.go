@@ -47,6 +47,9 @@ type SelectOptions struct {
 	// Maximum number of memory a query can use
 	MaxQueryMem int64
 
+	// Maximum number of points an INTO query can write, unlimited if 0
+	MaxIntoPoints int64
+
 	// Maximum parallelism a query can use
 	MaxQueryParallel int
 
@@ -67,11 +70,29 @@ type SelectOptions struct {
 
 	HintType hybridqp.HintType
 
+	// ForceBroadcast sends the query to all the shards, set by the force_broadcast hint
+	ForceBroadcast bool
+
+	// Availability is the number of replicas of each replica group required readable, set by WITH AVAILABILITY
+	Availability influxql.ReadAvailability
+
 	IncQuery bool
 	QueryID  string
 	IterID   int32
 }
 
+// SetStatementHints sets the options of the statement used to map its shards, from its hints and its
+// WITH AVAILABILITY clause.
+func (opt *SelectOptions) SetStatementHints(stmt *influxql.SelectStatement) {
+	if hybridqp.IsFullSeriesQuery(stmt) {
+		opt.HintType = hybridqp.FullSeriesQuery
+	} else if hybridqp.IsSpecificSeriesQuery(stmt) {
+		opt.HintType = hybridqp.SpecificSeriesQuery
+	}
+	opt.ForceBroadcast = hybridqp.IsForceBroadcastQuery(stmt)
+	opt.Availability = stmt.Availability
+}
+
 type LogicalPlanCreator interface {
 	// Creates a simple iterator for use in an InfluxQL Logical.
 	CreateLogicalPlan(ctx context.Context, sources influxql.Sources, schema hybridqp.Catalog) (hybridqp.QueryNode, error)
@@ -134,6 +155,52 @@ func Prepare(stmt *influxql.SelectStatement, shardMapper ShardMapper, opt Select
 	return c.Prepare(shardMapper, opt)
 }
 
+// PrepareContext is like Prepare but gives up once ctx is done with an error, mapping the shards can be slow
+// for complex sources. A statement prepared after giving up is closed in the background.
+func PrepareContext(ctx context.Context, stmt *influxql.SelectStatement, shardMapper ShardMapper, opt SelectOptions) (PreparedStatement, error) {
+	if err := ContextError(ctx); err != nil {
+		return nil, err
+	}
+
+	type prepared struct {
+		stmt PreparedStatement
+		err  error
+	}
+	ch := make(chan prepared, 1)
+	go func() {
+		s, err := Prepare(stmt, shardMapper, opt)
+		ch <- prepared{stmt: s, err: err}
+	}()
+
+	select {
+	case p := <-ch:
+		return p.stmt, p.err
+	case <-ctx.Done():
+		err := ctx.Err()
+		if err == nil {
+			// an ExecutionContext without any task is done without error, it can not be interrupted
+			p := <-ch
+			return p.stmt, p.err
+		}
+		go func() {
+			if p := <-ch; p.stmt != nil {
+				_ = p.stmt.Close()
+			}
+		}()
+		return nil, err
+	}
+}
+
+// ContextError returns the error of ctx if it is done, without blocking.
+func ContextError(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+		return nil
+	}
+}
+
 // ProcessorOptions is an object passed to CreateIterator to specify creation options.
 type ProcessorOptions struct {
 	Name string
@@ -193,6 +260,10 @@ type ProcessorOptions struct {
 	// Limits on the creation of iterators.
 	MaxSeriesN int
 
+	// Maximum number of points written to the INTO target, unlimited if 0.
+	// It is only used by the sql node and not marshaled.
+	MaxIntoPoints int64
+
 	// If this channel is set and is closed, the iterator should try to exit
 	// and close as soon as possible.
 	InterruptCh <-chan struct{}
@@ -330,6 +401,7 @@ func NewProcessorOptionsStmt(stmt *influxql.SelectStatement, sopt SelectOptions)
 	opt.Limit, opt.Offset = stmt.Limit, stmt.Offset
 	opt.SLimit, opt.SOffset = stmt.SLimit, stmt.SOffset
 	opt.MaxSeriesN = sopt.MaxSeriesN
+	opt.MaxIntoPoints = sopt.MaxIntoPoints
 	opt.Authorizer = sopt.Authorizer
 
 	opt.ChunkedSize = sopt.ChunkedSize
@@ -44,6 +44,10 @@ func RewriteStatement(stmt influxql.Statement) (influxql.Statement, error) {
 }
 
 func rewriteShowFieldKeysStatement(stmt *influxql.ShowFieldKeysStatement) (influxql.Statement, error) {
+	// Check for time in WHERE clause (not supported).
+	if influxql.HasTimeExpr(stmt.Condition) {
+		return nil, errors.New("SHOW FIELD KEYS doesn't support time in WHERE clause")
+	}
 	return stmt, nil
 }
 
@@ -186,6 +186,20 @@ func (t *TaskManager) queryError(qid uint64, err error) {
 	}
 }
 
+// checkConcurrentQueries returns an error if the max-concurrent-queries limit is reached.
+func (t *TaskManager) checkConcurrentQueries() error {
+	if t.MaxConcurrentQueries <= 0 {
+		return nil
+	}
+	t.mu.RLock()
+	n := len(t.queries)
+	t.mu.RUnlock()
+	if n >= t.MaxConcurrentQueries {
+		return ErrMaxConcurrentQueriesLimitExceeded(n, t.MaxConcurrentQueries)
+	}
+	return nil
+}
+
 // AttachQuery attaches a running query to be managed by the TaskManager.
 // Returns the query id of the newly attached query or an error if it was
 // unable to assign a query id or attach the query to the TaskManager.
@@ -196,14 +210,13 @@ func (t *TaskManager) queryError(qid uint64, err error) {
 func (t *TaskManager) AttachQuery(q *influxql.Query, opt ExecutionOptions, interrupt <-chan struct{}, qStat *statistics.SQLSlowQueryStatistics) (*ExecutionContext, func(), error) {
 	t.mu.RLock()
 	isShutDown := t.shutdown
-	curQueriesCount := len(t.queries)
 	t.mu.RUnlock()
 
 	if isShutDown {
 		return nil, nil, ErrQueryEngineShutdown
 	}
-	if t.MaxConcurrentQueries > 0 && curQueriesCount >= t.MaxConcurrentQueries {
-		return nil, nil, ErrMaxConcurrentQueriesLimitExceeded(len(t.queries), t.MaxConcurrentQueries)
+	if err := t.checkConcurrentQueries(); err != nil {
+		return nil, nil, err
 	}
 
 	// only the first query can try to register query id offset
@@ -67,6 +67,7 @@ type Service struct {
 
 	lastRunsLock sync.RWMutex
 	lastRuns     map[string]time.Time // continuous query last run time. e.g.{"cq_name1": time}
+	lastExecs    map[string]LastRun   // continuous query last execution by this node, successful or not
 
 	// report continuous query last successfully run time
 	reportInterval time.Duration // at least DefaultReportTime
@@ -80,12 +81,19 @@ type Service struct {
 	cqLeaseChanged chan struct{} // last cq has changed, notify sql node to get cq lease
 }
 
+// LastRun is the last execution of a continuous query.
+type LastRun struct {
+	Time time.Time // the time the execution finished
+	Err  error     // the error of the execution, nil if it succeeded
+}
+
 // NewService creates a new Service instance named continuousQuery
 func NewService(hostname string, interval time.Duration, number int) *Service {
 	s := &Service{
-		hostname: hostname,
-		closing:  make(chan struct{}),
-		lastRuns: map[string]time.Time{},
+		hostname:  hostname,
+		closing:   make(chan struct{}),
+		lastRuns:  map[string]time.Time{},
+		lastExecs: map[string]LastRun{},
 
 		reportInterval: DefaultReportTime,
 		lastReportTime: time.Now(),
@@ -174,6 +182,7 @@ func (s *Service) getContinuousQueries() []*ContinuousQuery {
 			s.reportInterval = continuousQueries[i].reportInterval
 		}
 	}
+	s.pruneLastExecs(continuousQueries)
 	s.logger.Debug("get newly continuous query lease", zap.Int("CQ numbers", len(continuousQueries)))
 	return continuousQueries
 }
@@ -250,6 +259,9 @@ func (s *Service) ExecuteContinuousQuery(cq *ContinuousQuery, now time.Time) (bo
 
 	// execute the query and write the results
 	res := s.runContinuousQueryAndWriteResult(cq)
+	s.lastRunsLock.Lock()
+	s.lastExecs[cq.name] = LastRun{Time: time.Now(), Err: res.Err}
+	s.lastRunsLock.Unlock()
 	if res.Err != nil {
 		return false, res.Err
 	}
@@ -262,6 +274,33 @@ func (s *Service) ExecuteContinuousQuery(cq *ContinuousQuery, now time.Time) (bo
 	return true, nil
 }
 
+// pruneLastExecs forgets the last executions of the continuous queries this node no longer runs,
+// they have been dropped or their lease has moved to another sql node.
+func (s *Service) pruneLastExecs(continuousQueries []*ContinuousQuery) {
+	leased := make(map[string]struct{}, len(continuousQueries))
+	for _, cq := range continuousQueries {
+		leased[cq.name] = struct{}{}
+	}
+	s.lastRunsLock.Lock()
+	defer s.lastRunsLock.Unlock()
+	for name := range s.lastExecs {
+		if _, ok := leased[name]; !ok {
+			delete(s.lastExecs, name)
+		}
+	}
+}
+
+// LastRun returns the last execution of a continuous query by this node, false if the node has not run it.
+func (s *Service) LastRun(name string) (LastRun, bool) {
+	if s == nil {
+		return LastRun{}, false
+	}
+	s.lastRunsLock.RLock()
+	defer s.lastRunsLock.RUnlock()
+	run, ok := s.lastExecs[name]
+	return run, ok
+}
+
 // runContinuousQueryAndWriteResult will run the query and write the results.
 func (s *Service) runContinuousQueryAndWriteResult(cq *ContinuousQuery) *query.Result {
 	// Wrap the CQ's inner SELECT statement in a Query for the Executor.
@@ -251,6 +251,12 @@ func TestService_ExecuteContinuousQuery_Error(t *testing.T) {
 	ok, err := s.ExecuteContinuousQuery(cq, now)
 	assert.False(t, ok)
 	assert.EqualError(t, err, "mock error")
+
+	// the failed execution is the last run
+	run, ok := s.LastRun(cq.name)
+	assert.True(t, ok)
+	assert.False(t, run.Time.Before(now))
+	assert.EqualError(t, run.Err, "mock error")
 }
 
 // mockRegister is a mock task manager
@@ -270,11 +276,57 @@ func TestService_ExecuteContinuousQuery_Successfully(t *testing.T) {
 		},
 	}
 
+	_, ok := s.LastRun(cq.name)
+	assert.False(t, ok)
+
 	now := time.Now()
 	ok, err := s.ExecuteContinuousQuery(cq, now)
 	assert.True(t, ok)
 	assert.NoError(t, err)
 	assert.Equal(t, s.lastRuns[strings.ToLower(cq.name)], now.Truncate(time.Hour))
+
+	run, ok := s.LastRun(cq.name)
+	assert.True(t, ok)
+	assert.False(t, run.Time.Before(now))
+	assert.NoError(t, run.Err)
+
+	// no continuous query is run without the service
+	var nilService *Service
+	_, ok = nilService.LastRun(cq.name)
+	assert.False(t, ok)
+}
+
+func TestService_LastRunForgotten(t *testing.T) {
+	leases := []string{"cq0", "cq1"}
+	cqInfos := map[string]*meta.ContinuousQueryInfo{
+		"cq0": {Name: "cq0", Query: `CREATE CONTINUOUS QUERY cq0 ON db0 BEGIN SELECT mean(v0) INTO mst FROM m0 GROUP BY time(1h) END`},
+		"cq1": {Name: "cq1", Query: `CREATE CONTINUOUS QUERY cq1 ON db0 BEGIN SELECT mean(v0) INTO mst FROM m0 GROUP BY time(1h) END`},
+	}
+	s := NewTestService()
+	s.MetaClient = &MockMetaClient{
+		DatabasesFn: func() map[string]*meta.DatabaseInfo {
+			return map[string]*meta.DatabaseInfo{"db0": {Name: "db0", DefaultRetentionPolicy: "rp0", ContinuousQueries: cqInfos}}
+		},
+		GetCqLeaseFn: func() ([]string, error) {
+			return leases, nil
+		},
+	}
+	s.lastExecs["cq0"] = LastRun{Time: time.Now()}
+	s.lastExecs["cq1"] = LastRun{Time: time.Now()}
+
+	// the lease of cq1 has moved to another sql node
+	leases = []string{"cq0"}
+	assert.Len(t, s.getContinuousQueries(), 1)
+	_, ok := s.LastRun("cq0")
+	assert.True(t, ok)
+	_, ok = s.LastRun("cq1")
+	assert.False(t, ok)
+
+	// cq0 has been dropped
+	delete(cqInfos, "cq0")
+	assert.Empty(t, s.getContinuousQueries())
+	_, ok = s.LastRun("cq0")
+	assert.False(t, ok)
 }
 
 func TestService_ExecuteContinuousQuery_Cooling(t *testing.T) {